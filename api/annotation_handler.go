@@ -0,0 +1,138 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// AnnotationsResponse is returned by the annotation listing endpoints.
+type AnnotationsResponse struct {
+	Annotations []*storage.Annotation `json:"annotations"`
+}
+
+// PutAnnotationRequest is the body of the PUT /dag/node/:hash/annotations
+// endpoint. It replaces the author's previous annotation of the node.
+type PutAnnotationRequest struct {
+	Author string         `json:"author"`
+	Rating storage.Rating `json:"rating,omitempty"`
+	Labels []string       `json:"labels,omitempty"`
+	Notes  string         `json:"notes,omitempty"`
+}
+
+// annotationStore returns the driver's AnnotationStore, writing a 501
+// response when the driver does not support annotations.
+func (s *Server) annotationStore(c *fiber.Ctx) (storage.AnnotationStore, bool) {
+	store, ok := s.driver.(storage.AnnotationStore)
+	if !ok {
+		_ = c.Status(fiber.StatusNotImplemented).JSON(llm.ErrorResponse{Error: "annotations are not supported by the storage driver"})
+	}
+	return store, ok
+}
+
+// handleListAnnotations returns every annotation, optionally filtered by the
+// rating, label and author query parameters.
+func (s *Server) handleListAnnotations(c *fiber.Ctx) error {
+	store, ok := s.annotationStore(c)
+	if !ok {
+		return nil
+	}
+
+	rating, err := storage.ParseRating(c.Query("rating"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: err.Error()})
+	}
+
+	annotations, err := store.ListAnnotations(c.Context(), storage.AnnotationFilter{
+		Rating: rating,
+		Label:  c.Query("label"),
+		Author: c.Query("author"),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to list annotations"})
+	}
+	return c.JSON(AnnotationsResponse{Annotations: annotations})
+}
+
+// handleGetAnnotations returns the annotations of a node.
+func (s *Server) handleGetAnnotations(c *fiber.Ctx) error {
+	store, ok := s.annotationStore(c)
+	if !ok {
+		return nil
+	}
+
+	hash, err := storage.ResolveHash(c.Context(), s.driver, c.Params("hash"))
+	if err != nil {
+		return hashError(c, err)
+	}
+
+	annotations, err := store.Annotations(c.Context(), hash)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to get annotations"})
+	}
+	return c.JSON(AnnotationsResponse{Annotations: annotations})
+}
+
+// handlePutAnnotation stores the author's annotation of a node.
+func (s *Server) handlePutAnnotation(c *fiber.Ctx) error {
+	store, ok := s.annotationStore(c)
+	if !ok {
+		return nil
+	}
+
+	var req PutAnnotationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "invalid JSON body"})
+	}
+	req.Author = strings.TrimSpace(req.Author)
+	if req.Author == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "author is required"})
+	}
+	rating, err := storage.ParseRating(string(req.Rating))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: err.Error()})
+	}
+
+	hash, err := storage.ResolveHash(c.Context(), s.driver, c.Params("hash"))
+	if err != nil {
+		return hashError(c, err)
+	}
+
+	annotation := &storage.Annotation{
+		Hash:   hash,
+		Author: req.Author,
+		Rating: rating,
+		Labels: req.Labels,
+		Notes:  req.Notes,
+	}
+	if err := store.PutAnnotation(c.Context(), annotation); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to store annotation"})
+	}
+
+	annotations, err := store.Annotations(c.Context(), hash)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to get annotations"})
+	}
+	return c.JSON(AnnotationsResponse{Annotations: annotations})
+}
+
+// handleDeleteAnnotation removes an author's annotation of a node.
+func (s *Server) handleDeleteAnnotation(c *fiber.Ctx) error {
+	store, ok := s.annotationStore(c)
+	if !ok {
+		return nil
+	}
+
+	hash, err := storage.ResolveHash(c.Context(), s.driver, c.Params("hash"))
+	if err != nil {
+		return hashError(c, err)
+	}
+
+	if err := store.DeleteAnnotation(c.Context(), hash, c.Params("author")); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to delete annotation"})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
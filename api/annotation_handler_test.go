@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+var _ = Describe("Annotation handlers", func() {
+	var (
+		server *Server
+		driver *inmemory.Driver
+		ctx    context.Context
+
+		root, reply *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger, _ := zap.NewDevelopment()
+		driver = inmemory.NewDriver()
+
+		var err error
+		server, err = NewServer(Config{ListenAddr: ":0"}, driver, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		root = merkle.NewNode(apiTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(apiTestBucket("assistant", "Hi there!"), root)
+		for _, n := range []*merkle.Node{root, reply} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	do := func(method, path, body string) (int, []byte) {
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.StatusCode, data
+	}
+
+	It("rates a node by abbreviated hash and lists it by rating", func() {
+		status, body := do(http.MethodPut, "/dag/node/"+reply.Hash[:8]+"/annotations",
+			`{"author":"alice","rating":"good","labels":["concise"],"notes":"exactly right"}`)
+		Expect(status).To(Equal(fiber.StatusOK))
+
+		var resp AnnotationsResponse
+		Expect(json.Unmarshal(body, &resp)).To(Succeed())
+		Expect(resp.Annotations).To(HaveLen(1))
+		Expect(resp.Annotations[0].Hash).To(Equal(reply.Hash))
+		Expect(resp.Annotations[0].Rating).To(Equal(storage.RatingGood))
+
+		status, body = do(http.MethodGet, "/annotations?rating=good", "")
+		Expect(status).To(Equal(fiber.StatusOK))
+		Expect(json.Unmarshal(body, &resp)).To(Succeed())
+		Expect(resp.Annotations).To(HaveLen(1))
+
+		status, body = do(http.MethodGet, "/annotations?label=verbose", "")
+		Expect(status).To(Equal(fiber.StatusOK))
+		Expect(json.Unmarshal(body, &resp)).To(Succeed())
+		Expect(resp.Annotations).To(BeEmpty())
+
+		status, body = do(http.MethodGet, "/dag/node/"+reply.Hash, "")
+		Expect(status).To(Equal(fiber.StatusOK))
+		Expect(string(body)).To(ContainSubstring(reply.Hash))
+	})
+
+	It("deletes an author's annotation", func() {
+		Expect(driver.PutAnnotation(ctx, &storage.Annotation{Hash: reply.Hash, Author: "alice", Rating: storage.RatingBad})).To(Succeed())
+
+		status, _ := do(http.MethodDelete, "/dag/node/"+reply.Hash+"/annotations/alice", "")
+		Expect(status).To(Equal(fiber.StatusNoContent))
+
+		status, body := do(http.MethodGet, "/dag/node/"+reply.Hash+"/annotations", "")
+		Expect(status).To(Equal(fiber.StatusOK))
+		var resp AnnotationsResponse
+		Expect(json.Unmarshal(body, &resp)).To(Succeed())
+		Expect(resp.Annotations).To(BeEmpty())
+	})
+
+	It("rejects invalid annotations", func() {
+		status, _ := do(http.MethodPut, "/dag/node/"+reply.Hash+"/annotations", `{"rating":"good"}`)
+		Expect(status).To(Equal(fiber.StatusBadRequest))
+
+		status, _ = do(http.MethodPut, "/dag/node/"+reply.Hash+"/annotations", `{"author":"alice","rating":"meh"}`)
+		Expect(status).To(Equal(fiber.StatusBadRequest))
+
+		status, _ = do(http.MethodPut, "/dag/node/ffffffffffff/annotations", `{"author":"alice","rating":"good"}`)
+		Expect(status).To(Equal(fiber.StatusNotFound))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/papercomputeco/tapes/api/mcp"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/merkle"
 	"github.com/papercomputeco/tapes/pkg/storage"
 )
@@ -43,9 +44,22 @@ func NewServer(config Config, driver storage.Driver, dagLoader merkle.DagLoader,
 	app.Get("/ping", s.handlePing)
 	app.Get("/dag/stats", s.handleDAGStats)
 	app.Get("/dag/node/:hash", s.handleGetNode)
+	app.Get("/dag/node/:hash/annotations", s.handleGetAnnotations)
+	app.Put("/dag/node/:hash/annotations", s.handlePutAnnotation)
+	app.Delete("/dag/node/:hash/annotations/:author", s.handleDeleteAnnotation)
+	app.Get("/dag/blob/:hash", s.handleGetBlob)
 	app.Get("/dag/history", s.handleListHistories)
 	app.Get("/dag/history/:hash", s.handleGetHistory)
+	app.Get("/dag/diff/:a/:b", s.handleDiff)
+	app.Get("/dag/tree", s.handleTree)
+	app.Get("/dag/tree/:hash", s.handleTree)
 	app.Post("/dag/nodes", s.handlePushNodes)
+	app.Get("/annotations", s.handleListAnnotations)
+	app.Get("/refs", s.handleListRefs)
+	app.Post("/refs", s.handleSyncRefs)
+	app.Get("/refs/:name", s.handleGetRef)
+	app.Put("/refs/:name", s.handleSetRef)
+	app.Delete("/refs/:name", s.handleDeleteRef)
 	app.Get("/v1/search", s.handleSearchEndpoint)
 
 	// Register MCP server if vector driver and embedder are configured
@@ -82,10 +96,11 @@ func NewServer(config Config, driver storage.Driver, dagLoader merkle.DagLoader,
 
 // Run starts the API server on the configured address.
 func (s *Server) Run() error {
-	s.logger.Info("starting API server",
-		zap.String("listen", s.config.ListenAddr),
-	)
-	return s.app.Listen(s.config.ListenAddr)
+	ln, err := listener.Listen(s.config.ListenAddr, s.config.TLS)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", s.config.ListenAddr, err)
+	}
+	return s.RunWithListener(ln)
 }
 
 // RunWithListener starts the API server using the provided listener.
@@ -183,4 +183,112 @@ var _ = Describe("buildHistory", func() {
 			Expect(history.Messages[0].Usage.TotalTokens).To(Equal(150))
 		})
 	})
+
+	Context("when the conversation continues an earlier one", func() {
+		var before, after *merkle.Node
+
+		BeforeEach(func() {
+			ask := merkle.NewNode(apiTestBucket("user", "Refactor the parser"), nil)
+			before = merkle.NewNode(apiTestBucket("assistant", "Started on the lexer"), ask)
+			summary := merkle.NewNode(apiTestBucket("user", "This session is being continued from a previous conversation."), nil)
+			after = merkle.NewNode(apiTestBucket("assistant", "Finishing the parser"), summary)
+			for _, n := range []*merkle.Node{ask, before, summary, after} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(driver.(storage.EdgeStore).PutEdge(ctx, &storage.Edge{
+				From: summary.Hash, To: before.Hash, Kind: storage.EdgeContinues, Reason: storage.ContinuesSummary,
+			})).To(Succeed())
+		})
+
+		It("links the history to the continued conversation", func() {
+			history, err := server.buildHistory(ctx, after.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Depth).To(Equal(2))
+			Expect(history.Continues).NotTo(BeNil())
+			Expect(history.Continues.To).To(Equal(before.Hash))
+		})
+
+		It("spans the continued conversations", func() {
+			history, err := server.buildHistory(ctx, after.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.spanContinuations(ctx, history)).To(Succeed())
+			Expect(history.Depth).To(Equal(4))
+			Expect(history.Messages[0].Content[0].Text).To(Equal("Refactor the parser"))
+			Expect(history.Messages[3].Hash).To(Equal(after.Hash))
+		})
+	})
+
+	Context("when the continued conversation shares its system message", func() {
+		var before, after *merkle.Node
+
+		BeforeEach(func() {
+			system := merkle.NewNode(apiTestBucket("system", "You are an agent."), nil)
+			ask := merkle.NewNode(apiTestBucket("user", "Refactor the parser"), system)
+			before = merkle.NewNode(apiTestBucket("assistant", "Started on the lexer"), ask)
+			summary := merkle.NewNode(apiTestBucket("user", "This session is being continued from a previous conversation."), system)
+			after = merkle.NewNode(apiTestBucket("assistant", "Finishing the parser"), summary)
+			for _, n := range []*merkle.Node{system, ask, before, summary, after} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(driver.(storage.EdgeStore).PutEdge(ctx, &storage.Edge{
+				From: summary.Hash, To: before.Hash, Kind: storage.EdgeContinues, Reason: storage.ContinuesSummary,
+			})).To(Succeed())
+		})
+
+		It("links the history by its opening message", func() {
+			history, err := server.buildHistory(ctx, after.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Continues).NotTo(BeNil())
+			Expect(history.Continues.To).To(Equal(before.Hash))
+
+			earlier, err := server.buildHistory(ctx, before.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(earlier.Continues).To(BeNil())
+		})
+	})
+
+	Context("when a message spawns a subagent", func() {
+		var task, subRoot, subLeaf *merkle.Node
+
+		BeforeEach(func() {
+			ask := merkle.NewNode(apiTestBucket("user", "Audit the repo"), nil)
+			task = merkle.NewNode(apiTestBucket("assistant", "Spawning an explorer"), ask)
+			subRoot = merkle.NewNode(apiTestBucket("user", "Find every TODO"), nil)
+			subLeaf = merkle.NewNode(apiTestBucket("assistant", "Found three"), subRoot)
+			for _, n := range []*merkle.Node{ask, task, subRoot, subLeaf} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(driver.(storage.EdgeStore).PutEdge(ctx, &storage.Edge{
+				From: task.Hash, To: subRoot.Hash, Kind: storage.EdgeSubagent,
+				Reason: storage.SubagentPrompt, ToolUseID: "toolu_1",
+			})).To(Succeed())
+		})
+
+		It("links the spawning message to the subagent", func() {
+			history, err := server.buildHistory(ctx, task.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Messages[1].Subagents).To(HaveLen(1))
+			Expect(history.Messages[1].Subagents[0].To).To(Equal(subRoot.Hash))
+			Expect(history.Messages[1].Subagents[0].ToolUseID).To(Equal("toolu_1"))
+		})
+
+		It("links the subagent's history to its parent", func() {
+			history, err := server.buildHistory(ctx, subLeaf.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Parent).NotTo(BeNil())
+			Expect(history.Parent.From).To(Equal(task.Hash))
+		})
+
+		It("nests the subagent's conversation", func() {
+			history, err := server.buildHistory(ctx, task.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.expandSubagents(ctx, history, map[string]bool{})).To(Succeed())
+			Expect(history.Messages[1].SubagentHistories).To(HaveLen(1))
+			Expect(history.Messages[1].SubagentHistories[0].HeadHash).To(Equal(subLeaf.Hash))
+			Expect(history.Messages[1].SubagentHistories[0].Depth).To(Equal(2))
+		})
+	})
 })
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+var _ = Describe("handleGetBlob", func() {
+	var (
+		server *Server
+		driver *sqlite.Driver
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger, _ := zap.NewDevelopment()
+
+		var err error
+		driver, err = sqlite.NewDriver(ctx, ":memory:")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		server, err = NewServer(Config{ListenAddr: ":0"}, driver, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("serves a stored tool output by its hash", func() {
+		output := strings.Repeat("test output\n", 1000)
+		node := merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "user",
+			Content:  []llm.ContentBlock{{Type: "tool_result", ToolResultID: "call_1", ToolOutput: output}},
+			Model:    "test-model",
+			Provider: "test-provider",
+		}, nil)
+		_, err := driver.Put(ctx, node)
+		Expect(err).NotTo(HaveOccurred())
+
+		sum := sha256.Sum256([]byte(output))
+		req, err := http.NewRequest(http.MethodGet, "/dag/blob/"+hex.EncodeToString(sum[:]), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(fiber.StatusOK))
+		Expect(resp.Header.Get(fiber.HeaderContentType)).To(HavePrefix("text/plain"))
+
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(Equal(output))
+	})
+
+	It("returns 404 for an unknown blob", func() {
+		req, err := http.NewRequest(http.MethodGet, "/dag/blob/missing", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(fiber.StatusNotFound))
+	})
+
+	It("returns 501 when the driver has no blob store", func() {
+		logger, _ := zap.NewDevelopment()
+		inMem := inmemory.NewDriver()
+		inMemServer, err := NewServer(Config{ListenAddr: ":0"}, inMem, inMem, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, err := http.NewRequest(http.MethodGet, "/dag/blob/missing", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := inMemServer.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(fiber.StatusNotImplemented))
+	})
+})
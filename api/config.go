@@ -3,14 +3,20 @@ package api
 
 import (
 	"github.com/papercomputeco/tapes/pkg/embeddings"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/vector"
 )
 
 // Config is the API server configuration.
 type Config struct {
-	// ListenAddr is the address to listen on (e.g., ":8081")
+	// ListenAddr is the address to listen on (e.g., ":8081"). Addresses
+	// prefixed with "unix:" listen on a unix domain socket.
 	ListenAddr string
 
+	// TLS optionally serves the API over HTTPS, verifying client
+	// certificates if a client CA is configured.
+	TLS listener.TLSConfig
+
 	// VectorDriver for semantic search (optional, enables MCP server)
 	VectorDriver vector.Driver
 
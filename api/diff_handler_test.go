@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+var _ = Describe("handleDiff", func() {
+	var (
+		server *Server
+		ctx    context.Context
+
+		root, reply, askA, askB *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger, _ := zap.NewDevelopment()
+		driver := inmemory.NewDriver()
+
+		var err error
+		server, err = NewServer(Config{ListenAddr: ":0"}, driver, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		root = merkle.NewNode(apiTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(apiTestBucket("assistant", "Hi there!"), root)
+		askA = merkle.NewNode(apiTestBucket("user", "Tell me a joke"), reply)
+		askB = merkle.NewNode(apiTestBucket("user", "Tell me a fact"), reply)
+		for _, n := range []*merkle.Node{root, reply, askA, askB} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	get := func(path string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+
+	It("returns the shared prefix and divergent turns of two branches", func() {
+		resp := get("/dag/diff/" + askA.Hash + "/" + askB.Hash)
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(fiber.StatusOK))
+
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		var diff DiffResponse
+		Expect(json.Unmarshal(body, &diff)).To(Succeed())
+
+		Expect(diff.AncestorHash).To(Equal(reply.Hash))
+		Expect(diff.Shared).To(HaveLen(2))
+		Expect(diff.A).To(HaveLen(1))
+		Expect(diff.A[0].Content[0].Text).To(Equal("Tell me a joke"))
+		Expect(diff.B[0].Content[0].Text).To(Equal("Tell me a fact"))
+		Expect(diff.Turns).To(Equal([]DiffTurn{{
+			Depth:          2,
+			AHash:          askA.Hash,
+			BHash:          askB.Hash,
+			ContentChanged: true,
+		}}))
+	})
+
+	It("returns 404 for an unknown node", func() {
+		resp := get("/dag/diff/" + askA.Hash + "/nonexistent")
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(fiber.StatusNotFound))
+	})
+})
@@ -2,12 +2,14 @@ package api
 
 import (
 	"context"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
 )
 
 // HistoryResponse contains the conversation history for a given node.
@@ -18,6 +20,12 @@ type HistoryResponse struct {
 	HeadHash string `json:"head_hash"`
 	// Depth is the number of messages in the history
 	Depth int `json:"depth"`
+	// Continues links the history's opening message to the conversation it
+	// continues after context compaction, if any
+	Continues *storage.Edge `json:"continues,omitempty"`
+	// Parent links the history's opening message to the tool call that
+	// spawned it, if the history is a subagent's conversation
+	Parent *storage.Edge `json:"parent,omitempty"`
 }
 
 // HistoryMessage represents a message in the conversation history.
@@ -30,6 +38,35 @@ type HistoryMessage struct {
 	Provider   string             `json:"provider,omitempty"`
 	StopReason string             `json:"stop_reason,omitempty"`
 	Usage      *llm.Usage         `json:"usage,omitempty"`
+
+	// RequestedModel is the model the client asked for, set only when it
+	// differs from Model (e.g. a proxy routing rule rewrote the request).
+	RequestedModel string `json:"requested_model,omitempty"`
+
+	// Upstream is the upstream base URL that served a response.
+	Upstream string `json:"upstream,omitempty"`
+
+	// ProviderRequestID is the upstream provider's ID for a response.
+	ProviderRequestID string `json:"provider_request_id,omitempty"`
+
+	// ChoiceIndex is the provider's index for a response that was one of
+	// several sampled choices (n > 1).
+	ChoiceIndex *int `json:"choice_index,omitempty"`
+
+	// Params are the generation parameters that produced a response.
+	Params *llm.GenerationParams `json:"params,omitempty"`
+
+	// Metadata is the session metadata (session ID, tags) attached via proxy
+	// request headers.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Subagents link the message to the conversations of the subagents it
+	// spawned.
+	Subagents []*storage.Edge `json:"subagents,omitempty"`
+
+	// SubagentHistories are the subagents' conversations, included with
+	// ?subagents=true.
+	SubagentHistories []*HistoryResponse `json:"subagent_histories,omitempty"`
 }
 
 // handlePing returns a simple health check response.
@@ -72,6 +109,11 @@ func (s *Server) handleGetNode(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "hash parameter required"})
 	}
 
+	hash, err := storage.ResolveHash(c.Context(), s.driver, hash)
+	if err != nil {
+		return hashError(c, err)
+	}
+
 	node, err := s.driver.Get(c.Context(), hash)
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(llm.ErrorResponse{Error: "node not found"})
@@ -80,6 +122,37 @@ func (s *Server) handleGetNode(c *fiber.Ctx) error {
 	return c.JSON(node)
 }
 
+// handleGetBlob returns the raw payload of a blob by its SHA-256 hash.
+func (s *Server) handleGetBlob(c *fiber.Ctx) error {
+	hash := c.Params("hash")
+	if hash == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "hash parameter required"})
+	}
+
+	blobs, ok := s.driver.(storage.BlobStore)
+	if !ok {
+		return c.Status(fiber.StatusNotImplemented).JSON(llm.ErrorResponse{Error: "blob storage is not supported by the storage driver"})
+	}
+
+	blob, err := blobs.Blob(c.Context(), hash)
+	if err != nil {
+		var notFound storage.NotFoundError
+		if errors.As(err, &notFound) {
+			return c.Status(fiber.StatusNotFound).JSON(llm.ErrorResponse{Error: "blob not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to get blob"})
+	}
+
+	contentType := blob.MediaType
+	if contentType == "" {
+		contentType = fiber.MIMETextPlainCharsetUTF8
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderETag, `"`+blob.Hash+`"`)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	return c.Send(blob.Data)
+}
+
 // handleListHistories returns all conversation histories (one per leaf node).
 func (s *Server) handleListHistories(c *fiber.Ctx) error {
 	ctx := c.Context()
@@ -109,17 +182,37 @@ func (s *Server) handleListHistories(c *fiber.Ctx) error {
 }
 
 // handleGetHistory returns the full conversation history leading up to a given node.
+// With ?span=true the history also includes the conversations it continues
+// across context compactions, and with ?subagents=true it nests the
+// conversations of the subagents its messages spawned.
 func (s *Server) handleGetHistory(c *fiber.Ctx) error {
 	hash := c.Params("hash")
 	if hash == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "hash parameter required"})
 	}
 
+	hash, err := storage.ResolveHash(c.Context(), s.driver, hash)
+	if err != nil {
+		return hashError(c, err)
+	}
+
 	history, err := s.buildHistory(c.Context(), hash)
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(llm.ErrorResponse{Error: "node not found"})
 	}
 
+	if c.QueryBool("span") {
+		if err := s.spanContinuations(c.Context(), history); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to load continued conversations"})
+		}
+	}
+
+	if c.QueryBool("subagents") {
+		if err := s.expandSubagents(c.Context(), history, map[string]bool{}); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to load subagent conversations"})
+		}
+	}
+
 	return c.JSON(history)
 }
 
@@ -164,23 +257,268 @@ func (s *Server) buildHistory(ctx context.Context, hash string) (*HistoryRespons
 
 	messages := make([]HistoryMessage, len(ancestry))
 	for i, node := range ancestry {
-		idx := len(ancestry) - 1 - i
-
-		messages[idx] = HistoryMessage{
-			Hash:       node.Hash,
-			ParentHash: node.ParentHash,
-			Role:       node.Bucket.Role,
-			Content:    node.Bucket.Content,
-			Model:      node.Bucket.Model,
-			Provider:   node.Bucket.Provider,
-			StopReason: node.StopReason,
-			Usage:      node.Usage,
-		}
+		messages[len(ancestry)-1-i] = newHistoryMessage(node)
 	}
 
-	return &HistoryResponse{
+	history := &HistoryResponse{
 		Messages: messages,
 		HeadHash: hash,
 		Depth:    len(messages),
-	}, nil
+	}
+	if err := s.attachEdges(ctx, history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// attachEdges links a history to the conversation it continues, the tool
+// call that spawned it and the subagents its messages spawned, when the
+// driver stores edges.
+func (s *Server) attachEdges(ctx context.Context, history *HistoryResponse) error {
+	store, ok := s.driver.(storage.EdgeStore)
+	if !ok || len(history.Messages) == 0 {
+		return nil
+	}
+
+	// Conversations with the same system prompt share their root, so they
+	// are linked by their opening message.
+	opening := history.Messages[0].Hash
+	for _, msg := range history.Messages {
+		if !storage.IsSystemRole(msg.Role) {
+			opening = msg.Hash
+			break
+		}
+	}
+	continues, err := store.EdgesFrom(ctx, opening, storage.EdgeContinues)
+	if err != nil {
+		return err
+	}
+	if len(continues) > 0 {
+		history.Continues = continues[0]
+	}
+	parents, err := store.EdgesTo(ctx, opening, storage.EdgeSubagent)
+	if err != nil {
+		return err
+	}
+	if len(parents) > 0 {
+		history.Parent = parents[0]
+	}
+
+	for i := range history.Messages {
+		if history.Messages[i].Role != "assistant" {
+			continue
+		}
+		subagents, err := store.EdgesFrom(ctx, history.Messages[i].Hash, storage.EdgeSubagent)
+		if err != nil {
+			return err
+		}
+		history.Messages[i].Subagents = subagents
+	}
+	return nil
+}
+
+// expandSubagents nests the conversation of every subagent spawned in
+// history, following each subagent to its deepest leaf. seen guards against
+// edge cycles.
+func (s *Server) expandSubagents(ctx context.Context, history *HistoryResponse, seen map[string]bool) error {
+	for i := range history.Messages {
+		for _, edge := range history.Messages[i].Subagents {
+			if seen[edge.To] {
+				continue
+			}
+			seen[edge.To] = true
+
+			dag, err := merkle.LoadDag(ctx, s.dagLoader, edge.To)
+			if err != nil {
+				return err
+			}
+			sub, err := s.buildHistory(ctx, deepestLeaf(dag).Hash)
+			if err != nil {
+				return err
+			}
+			if err := s.expandSubagents(ctx, sub, seen); err != nil {
+				return err
+			}
+			history.Messages[i].SubagentHistories = append(history.Messages[i].SubagentHistories, sub)
+		}
+	}
+	return nil
+}
+
+// deepestLeaf returns the leaf of dag furthest from its root, breaking ties
+// by hash.
+func deepestLeaf(dag *merkle.Dag) *merkle.DagNode {
+	var deepest *merkle.DagNode
+	deepestDepth := -1
+	for _, leaf := range dag.Leaves() {
+		depth := 0
+		for n := leaf; n.Parent != nil; n = n.Parent {
+			depth++
+		}
+		if depth > deepestDepth || (depth == deepestDepth && leaf.Hash < deepest.Hash) {
+			deepest, deepestDepth = leaf, depth
+		}
+	}
+	return deepest
+}
+
+// spanContinuations prepends the conversations history continues, following
+// continues edges back to the first conversation of the logical session.
+func (s *Server) spanContinuations(ctx context.Context, history *HistoryResponse) error {
+	seen := map[string]bool{}
+	for edge := history.Continues; edge != nil && !seen[edge.To]; {
+		seen[edge.To] = true
+		earlier, err := s.buildHistory(ctx, edge.To)
+		if err != nil {
+			return err
+		}
+		history.Messages = append(earlier.Messages, history.Messages...)
+		edge = earlier.Continues
+	}
+	history.Depth = len(history.Messages)
+	return nil
+}
+
+// newHistoryMessage converts a node into its HistoryMessage representation.
+func newHistoryMessage(node *merkle.Node) HistoryMessage {
+	msg := HistoryMessage{
+		Hash:              node.Hash,
+		ParentHash:        node.ParentHash,
+		Role:              node.Bucket.Role,
+		Content:           node.Bucket.Content,
+		Model:             node.Bucket.Model,
+		Provider:          node.Bucket.Provider,
+		StopReason:        node.StopReason,
+		Usage:             node.Usage,
+		Upstream:          node.Upstream,
+		Params:            node.Params,
+		ProviderRequestID: node.ProviderRequestID,
+		ChoiceIndex:       node.ChoiceIndex,
+		Metadata:          node.Metadata,
+	}
+	if node.RequestedModel != node.Bucket.Model {
+		msg.RequestedModel = node.RequestedModel
+	}
+	return msg
+}
+
+// DiffResponse is returned by the GET /dag/diff/:a/:b endpoint. It compares
+// the conversation branches ending at two nodes.
+type DiffResponse struct {
+	// AHash and BHash are the tips of the compared branches.
+	AHash string `json:"a_hash"`
+	BHash string `json:"b_hash"`
+
+	// AncestorHash is the fork point of the branches, empty when they do not
+	// share a root.
+	AncestorHash string `json:"ancestor_hash,omitempty"`
+
+	// Shared is the common prefix of both branches, oldest first.
+	Shared []HistoryMessage `json:"shared"`
+
+	// A and B are the divergent messages of each branch, oldest first.
+	A []HistoryMessage `json:"a"`
+	B []HistoryMessage `json:"b"`
+
+	// Turns pairs the divergent messages by depth.
+	Turns []DiffTurn `json:"turns"`
+}
+
+// DiffTurn compares the messages at the same depth of two branches. Hashes
+// refer to messages in DiffResponse.A and DiffResponse.B; one is empty when
+// the other branch is longer.
+type DiffTurn struct {
+	Depth          int    `json:"depth"`
+	AHash          string `json:"a_hash,omitempty"`
+	BHash          string `json:"b_hash,omitempty"`
+	RoleChanged    bool   `json:"role_changed"`
+	ContentChanged bool   `json:"content_changed"`
+	ModelChanged   bool   `json:"model_changed"`
+	UsageChanged   bool   `json:"usage_changed"`
+}
+
+// handleDiff compares the conversation branches ending at two nodes.
+func (s *Server) handleDiff(c *fiber.Ctx) error {
+	a, b := c.Params("a"), c.Params("b")
+	if a == "" || b == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "two hash parameters required"})
+	}
+
+	a, err := storage.ResolveHash(c.Context(), s.driver, a)
+	if err != nil {
+		return hashError(c, err)
+	}
+	b, err = storage.ResolveHash(c.Context(), s.driver, b)
+	if err != nil {
+		return hashError(c, err)
+	}
+
+	diff, err := s.buildDiff(c.Context(), a, b)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(llm.ErrorResponse{Error: "node not found"})
+	}
+
+	return c.JSON(diff)
+}
+
+// buildDiff constructs a DiffResponse for the branches ending at a and b.
+func (s *Server) buildDiff(ctx context.Context, a, b string) (*DiffResponse, error) {
+	diff, err := merkle.Diff(ctx, s.dagLoader, a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DiffResponse{
+		AHash:  a,
+		BHash:  b,
+		Shared: historyMessages(diff.Shared),
+		A:      historyMessages(diff.A),
+		B:      historyMessages(diff.B),
+		Turns:  make([]DiffTurn, len(diff.Turns)),
+	}
+	if diff.Ancestor != nil {
+		resp.AncestorHash = diff.Ancestor.Hash
+	}
+
+	for i, turn := range diff.Turns {
+		resp.Turns[i] = DiffTurn{
+			Depth:          turn.Depth,
+			RoleChanged:    turn.RoleChanged,
+			ContentChanged: turn.ContentChanged,
+			ModelChanged:   turn.ModelChanged,
+			UsageChanged:   turn.UsageChanged,
+		}
+		if turn.A != nil {
+			resp.Turns[i].AHash = turn.A.Hash
+		}
+		if turn.B != nil {
+			resp.Turns[i].BHash = turn.B.Hash
+		}
+	}
+
+	return resp, nil
+}
+
+// historyMessages converts nodes into HistoryMessages, preserving order.
+func historyMessages(nodes []*merkle.Node) []HistoryMessage {
+	messages := make([]HistoryMessage, len(nodes))
+	for i, node := range nodes {
+		messages[i] = newHistoryMessage(node)
+	}
+	return messages
+}
+
+// hashError writes the error response for a hash parameter that could not be
+// resolved by storage.ResolveHash.
+func hashError(c *fiber.Ctx, err error) error {
+	var ambiguous storage.AmbiguousHashError
+	var notFound storage.NotFoundError
+	switch {
+	case errors.As(err, &ambiguous):
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: ambiguous.Error()})
+	case errors.As(err, &notFound):
+		return c.Status(fiber.StatusNotFound).JSON(llm.ErrorResponse{Error: "node not found"})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to resolve hash"})
+	}
 }
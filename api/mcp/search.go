@@ -18,8 +18,9 @@ var (
 // SearchInput represents the input arguments for the MCP search tool.
 // It uses jsonschema tags specific to the MCP protocol.
 type SearchInput struct {
-	Query string `json:"query" jsonschema:"the search query text to find relevant sessions"`
-	TopK  int    `json:"top_k,omitempty" jsonschema:"number of results to return (default: 5)"`
+	Query string            `json:"query" jsonschema:"the search query text to find relevant sessions"`
+	TopK  int               `json:"top_k,omitempty" jsonschema:"number of results to return (default: 5)"`
+	Tags  map[string]string `json:"tags,omitempty" jsonschema:"session metadata key/value pairs that results must carry"`
 }
 
 // handleSearch processes a search request via MCP.
@@ -32,7 +33,7 @@ func (s *Server) handleSearch(ctx context.Context, _ *mcp.CallToolRequest, input
 		s.config.DagLoader,
 		s.config.Logger,
 	)
-	output, err := searcher.Search(input.Query, input.TopK)
+	output, err := searcher.Search(input.Query, input.TopK, input.Tags)
 	if err != nil {
 		return &mcp.CallToolResult{
 			IsError: true,
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// RefsResponse is returned by the GET /refs endpoint.
+type RefsResponse struct {
+	// Refs ordered by name.
+	Refs []*storage.Ref `json:"refs"`
+}
+
+// SetRefRequest is the body of the PUT /refs/:name endpoint.
+type SetRefRequest struct {
+	// Hash is the node to point the ref at. It may be abbreviated or name
+	// another ref.
+	Hash string `json:"hash"`
+
+	// Kind is "tag" or "branch". Defaults to "tag".
+	Kind storage.RefKind `json:"kind,omitempty"`
+
+	// Force moves an existing ref of the same kind. Without it, setting an
+	// existing ref fails with 409 Conflict.
+	Force bool `json:"force,omitempty"`
+}
+
+// SyncRefsResponse is returned by the POST /refs endpoint.
+type SyncRefsResponse struct {
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+
+	// Conflicts names the refs that diverged from the server's and were
+	// left untouched.
+	Conflicts []string `json:"conflicts,omitempty"`
+	Errors    int      `json:"errors"`
+}
+
+// refStore returns the driver's RefStore, writing a 501 response when the
+// driver does not support refs.
+func (s *Server) refStore(c *fiber.Ctx) (storage.RefStore, bool) {
+	refs, ok := s.driver.(storage.RefStore)
+	if !ok {
+		_ = c.Status(fiber.StatusNotImplemented).JSON(llm.ErrorResponse{Error: "refs are not supported by the storage driver"})
+	}
+	return refs, ok
+}
+
+// handleListRefs returns every tag and branch.
+func (s *Server) handleListRefs(c *fiber.Ctx) error {
+	store, ok := s.refStore(c)
+	if !ok {
+		return nil
+	}
+
+	refs, err := store.Refs(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to list refs"})
+	}
+	return c.JSON(RefsResponse{Refs: refs})
+}
+
+// handleGetRef returns a single ref by name.
+func (s *Server) handleGetRef(c *fiber.Ctx) error {
+	store, ok := s.refStore(c)
+	if !ok {
+		return nil
+	}
+
+	ref, err := store.Ref(c.Context(), c.Params("name"))
+	if err != nil {
+		return refError(c, err)
+	}
+	return c.JSON(ref)
+}
+
+// handleSetRef creates a ref, or moves it when the request is forced.
+func (s *Server) handleSetRef(c *fiber.Ctx) error {
+	store, ok := s.refStore(c)
+	if !ok {
+		return nil
+	}
+
+	// Fiber reuses the request buffer backing params, and drivers may keep
+	// the name.
+	name := strings.Clone(c.Params("name"))
+	if err := storage.ValidateRefName(name); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: err.Error()})
+	}
+
+	var req SetRefRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "invalid JSON body"})
+	}
+	if req.Kind == "" {
+		req.Kind = storage.RefTag
+	}
+	if req.Kind != storage.RefTag && req.Kind != storage.RefBranch {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "kind must be tag or branch"})
+	}
+
+	hash, err := storage.ResolveHash(c.Context(), s.driver, req.Hash)
+	if err != nil {
+		return hashError(c, err)
+	}
+
+	existing, err := store.Ref(c.Context(), name)
+	switch {
+	case err == nil && !req.Force:
+		return c.Status(fiber.StatusConflict).JSON(llm.ErrorResponse{Error: "ref " + name + " already exists"})
+	case err == nil && existing.Kind != req.Kind:
+		return c.Status(fiber.StatusConflict).JSON(llm.ErrorResponse{Error: "ref " + name + " is a " + string(existing.Kind)})
+	case err != nil && !errors.As(err, new(storage.RefNotFoundError)):
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to get ref"})
+	}
+
+	ref := &storage.Ref{Name: name, Hash: hash, Kind: req.Kind}
+	if err := store.PutRef(c.Context(), ref); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to set ref"})
+	}
+
+	ref, err = store.Ref(c.Context(), name)
+	if err != nil {
+		return refError(c, err)
+	}
+	return c.JSON(ref)
+}
+
+// handleDeleteRef removes a ref. The node it points at is kept.
+func (s *Server) handleDeleteRef(c *fiber.Ctx) error {
+	store, ok := s.refStore(c)
+	if !ok {
+		return nil
+	}
+
+	if err := store.DeleteRef(c.Context(), c.Params("name")); err != nil {
+		return refError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// handleSyncRefs accepts a JSON array of refs, as sent by tapes push, and
+// applies them with storage.SyncRef: new refs are created and branches are
+// fast-forwarded, but refs that have diverged are left untouched.
+func (s *Server) handleSyncRefs(c *fiber.Ctx) error {
+	if _, ok := s.refStore(c); !ok {
+		return nil
+	}
+
+	var refs []*storage.Ref
+	if err := c.BodyParser(&refs); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{Error: "invalid JSON body"})
+	}
+
+	resp := SyncRefsResponse{}
+	for _, ref := range refs {
+		if err := storage.ValidateRefName(ref.Name); err != nil {
+			s.logger.Warn("rejected ref", zap.String("name", ref.Name), zap.Error(err))
+			resp.Errors++
+			continue
+		}
+		if ok, err := s.driver.Has(c.Context(), ref.Hash); err != nil || !ok {
+			s.logger.Warn("ref points at unknown node", zap.String("name", ref.Name), zap.String("hash", ref.Hash))
+			resp.Errors++
+			continue
+		}
+
+		changed, err := storage.SyncRef(c.Context(), s.driver, ref)
+		var conflict storage.RefConflictError
+		switch {
+		case errors.As(err, &conflict):
+			resp.Conflicts = append(resp.Conflicts, ref.Name)
+		case err != nil:
+			s.logger.Warn("failed to sync ref", zap.String("name", ref.Name), zap.Error(err))
+			resp.Errors++
+		case changed:
+			resp.Updated++
+		default:
+			resp.Unchanged++
+		}
+	}
+
+	return c.JSON(resp)
+}
+
+// refError writes the response for a failed ref lookup.
+func refError(c *fiber.Ctx, err error) error {
+	if errors.As(err, new(storage.RefNotFoundError)) {
+		return c.Status(fiber.StatusNotFound).JSON(llm.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to get ref"})
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+var _ = Describe("Ref handlers", func() {
+	var (
+		server *Server
+		driver *inmemory.Driver
+		ctx    context.Context
+
+		root, reply, fork *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger, _ := zap.NewDevelopment()
+		driver = inmemory.NewDriver()
+
+		var err error
+		server, err = NewServer(Config{ListenAddr: ":0"}, driver, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		root = merkle.NewNode(apiTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(apiTestBucket("assistant", "Hi there!"), root)
+		fork = merkle.NewNode(apiTestBucket("assistant", "Goodbye!"), root)
+		for _, n := range []*merkle.Node{root, reply, fork} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	do := func(method, path, body string) (int, []byte) {
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.StatusCode, data
+	}
+
+	It("creates tags from abbreviated hashes and resolves them as hashes", func() {
+		status, body := do(http.MethodPut, "/refs/v1", `{"hash":"`+reply.Hash[:8]+`"}`)
+		Expect(status).To(Equal(fiber.StatusOK))
+
+		var ref storage.Ref
+		Expect(json.Unmarshal(body, &ref)).To(Succeed())
+		Expect(ref.Hash).To(Equal(reply.Hash))
+		Expect(ref.Kind).To(Equal(storage.RefTag))
+
+		status, body = do(http.MethodGet, "/dag/history/v1", "")
+		Expect(status).To(Equal(fiber.StatusOK))
+		var history HistoryResponse
+		Expect(json.Unmarshal(body, &history)).To(Succeed())
+		Expect(history.HeadHash).To(Equal(reply.Hash))
+	})
+
+	It("refuses to overwrite refs unless forced", func() {
+		status, _ := do(http.MethodPut, "/refs/main", `{"hash":"`+root.Hash+`","kind":"branch"}`)
+		Expect(status).To(Equal(fiber.StatusOK))
+
+		status, _ = do(http.MethodPut, "/refs/main", `{"hash":"`+fork.Hash+`","kind":"branch"}`)
+		Expect(status).To(Equal(fiber.StatusConflict))
+
+		status, _ = do(http.MethodPut, "/refs/main", `{"hash":"`+fork.Hash+`","kind":"branch","force":true}`)
+		Expect(status).To(Equal(fiber.StatusOK))
+	})
+
+	It("rejects invalid names", func() {
+		status, _ := do(http.MethodPut, "/refs/HEAD", `{"hash":"`+root.Hash+`"}`)
+		Expect(status).To(Equal(fiber.StatusBadRequest))
+
+		status, _ = do(http.MethodPut, "/refs/"+reply.Hash[:8], `{"hash":"`+root.Hash+`"}`)
+		Expect(status).To(Equal(fiber.StatusBadRequest))
+	})
+
+	It("lists and deletes refs", func() {
+		Expect(driver.PutRef(ctx, &storage.Ref{Name: "v1", Hash: root.Hash, Kind: storage.RefTag})).To(Succeed())
+
+		status, body := do(http.MethodGet, "/refs", "")
+		Expect(status).To(Equal(fiber.StatusOK))
+		var refs RefsResponse
+		Expect(json.Unmarshal(body, &refs)).To(Succeed())
+		Expect(refs.Refs).To(HaveLen(1))
+
+		status, _ = do(http.MethodDelete, "/refs/v1", "")
+		Expect(status).To(Equal(fiber.StatusNoContent))
+
+		status, _ = do(http.MethodGet, "/refs/v1", "")
+		Expect(status).To(Equal(fiber.StatusNotFound))
+	})
+
+	It("syncs pushed refs without discarding diverged branches", func() {
+		Expect(driver.PutRef(ctx, &storage.Ref{Name: "main", Hash: reply.Hash, Kind: storage.RefBranch})).To(Succeed())
+
+		pushed := []storage.Ref{
+			{Name: "main", Hash: fork.Hash, Kind: storage.RefBranch},
+			{Name: "v1", Hash: root.Hash, Kind: storage.RefTag},
+			{Name: "missing", Hash: "ffffffffffff", Kind: storage.RefTag},
+		}
+		payload, err := json.Marshal(pushed)
+		Expect(err).NotTo(HaveOccurred())
+
+		status, body := do(http.MethodPost, "/refs", string(payload))
+		Expect(status).To(Equal(fiber.StatusOK))
+		var resp SyncRefsResponse
+		Expect(json.Unmarshal(body, &resp)).To(Succeed())
+		Expect(resp.Updated).To(Equal(1))
+		Expect(resp.Conflicts).To(ConsistOf("main"))
+		Expect(resp.Errors).To(Equal(1))
+
+		main, err := driver.Ref(ctx, "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(main.Hash).To(Equal(reply.Hash))
+	})
+})
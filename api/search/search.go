@@ -6,18 +6,21 @@ package search
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"go.uber.org/zap"
 
 	"github.com/papercomputeco/tapes/pkg/embeddings"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/vector"
 )
 
 // Input represents the input arguments for a search request.
 type Input struct {
-	Query string `json:"query"`
-	TopK  int    `json:"top_k,omitempty"`
+	Query string            `json:"query"`
+	TopK  int               `json:"top_k,omitempty"`
+	Tags  map[string]string `json:"tags,omitempty"`
 }
 
 // Result represents a single search result.
@@ -28,6 +31,14 @@ type Result struct {
 	Preview string  `json:"preview"`
 	Turns   int     `json:"turns"`
 	Branch  []Turn  `json:"branch"`
+
+	// Metadata is the session metadata (session ID, tags) recorded across
+	// the branch.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Continues is the hash of the node the branch's conversation continues
+	// after context compaction, if any.
+	Continues string `json:"continues,omitempty"`
 }
 
 // Turn represents a single turn in a conversation.
@@ -45,6 +56,10 @@ type Output struct {
 	Count   int      `json:"count"`
 }
 
+// tagOverfetch is the factor by which a tag-filtered search over-fetches from
+// the vector store, since filtering happens after the similarity query.
+const tagOverfetch = 5
+
 type Searcher struct {
 	ctx context.Context
 
@@ -73,9 +88,12 @@ func NewSearcher(
 // Search performs a semantic search over stored LLM sessions.
 // It embeds the query text, queries the vector store for similar documents,
 // then loads the full conversation branch from the Merkle DAG for each result.
+// When tags is non-empty, only branches whose metadata carries every tag are
+// returned.
 func (s *Searcher) Search(
 	query string,
 	topK int,
+	tags map[string]string,
 ) (*Output, error) {
 	if topK <= 0 {
 		topK = 5
@@ -84,6 +102,7 @@ func (s *Searcher) Search(
 	s.logger.Debug("search request",
 		zap.String("query", query),
 		zap.Int("topK", topK),
+		zap.Any("tags", tags),
 	)
 
 	// Embed the query
@@ -93,7 +112,11 @@ func (s *Searcher) Search(
 	}
 
 	// Query the vector store
-	results, err := s.vectorDriver.Query(s.ctx, queryEmbedding, topK)
+	fetchK := topK
+	if len(tags) > 0 {
+		fetchK = topK * tagOverfetch
+	}
+	results, err := s.vectorDriver.Query(s.ctx, queryEmbedding, fetchK)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query vector store: %w", err)
 	}
@@ -111,7 +134,13 @@ func (s *Searcher) Search(
 		}
 
 		searchResult := s.BuildResult(result, dag)
+		if !storage.MatchesTags(searchResult.Metadata, tags) {
+			continue
+		}
 		searchResults = append(searchResults, searchResult)
+		if len(searchResults) == topK {
+			break
+		}
 	}
 
 	return &Output{
@@ -126,6 +155,7 @@ func (s *Searcher) BuildResult(result vector.QueryResult, dag *merkle.Dag) Resul
 	turns := []Turn{}
 	preview := ""
 	role := ""
+	var metadata map[string]string
 
 	// Build turns from the DAG using Walk (depth-first from root to leaves)
 	err := dag.Walk(func(node *merkle.DagNode) (bool, error) {
@@ -137,6 +167,13 @@ func (s *Searcher) BuildResult(result vector.QueryResult, dag *merkle.Dag) Resul
 			Matched: isMatched,
 		})
 
+		if len(node.Metadata) > 0 {
+			if metadata == nil {
+				metadata = map[string]string{}
+			}
+			maps.Copy(metadata, node.Metadata)
+		}
+
 		// Get preview from the matched node
 		if isMatched {
 			preview = node.Bucket.ExtractText()
@@ -152,11 +189,42 @@ func (s *Searcher) BuildResult(result vector.QueryResult, dag *merkle.Dag) Resul
 	}
 
 	return Result{
-		Hash:    result.Hash,
-		Score:   result.Score,
-		Role:    role,
-		Preview: preview,
-		Turns:   len(turns),
-		Branch:  turns,
+		Hash:      result.Hash,
+		Score:     result.Score,
+		Role:      role,
+		Preview:   preview,
+		Turns:     len(turns),
+		Branch:    turns,
+		Metadata:  metadata,
+		Continues: s.continues(turns),
+	}
+}
+
+// continues returns the node the conversation of a root-first branch
+// continues, when the DAG loader stores edges. The edge starts at the
+// branch's opening turn, its first that is not a system message.
+func (s *Searcher) continues(turns []Turn) string {
+	store, ok := s.dagLoader.(storage.EdgeStore)
+	if !ok || len(turns) == 0 {
+		return ""
+	}
+	opening := turns[0].Hash
+	for _, turn := range turns {
+		if !storage.IsSystemRole(turn.Role) {
+			opening = turn.Hash
+			break
+		}
+	}
+	edges, err := store.EdgesFrom(s.ctx, opening, storage.EdgeContinues)
+	if err != nil {
+		s.logger.Warn("could not load continued conversation",
+			zap.String("hash", opening),
+			zap.Error(err),
+		)
+		return ""
+	}
+	if len(edges) == 0 {
+		return ""
 	}
+	return edges[0].To
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/papercomputeco/tapes/api/search"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
 	testutils "github.com/papercomputeco/tapes/pkg/utils/test"
 	"github.com/papercomputeco/tapes/pkg/vector"
@@ -41,7 +42,7 @@ var _ = Describe("Search", func() {
 
 	Describe("Search function", func() {
 		It("returns empty results when vector store has no matches", func() {
-			output, err := searcher.Search("hello", 5)
+			output, err := searcher.Search("hello", 5, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(output.Query).To(Equal("hello"))
 			Expect(output.Count).To(Equal(0))
@@ -67,7 +68,7 @@ var _ = Describe("Search", func() {
 				},
 			}
 
-			output, err := searcher.Search("greeting", 5)
+			output, err := searcher.Search("greeting", 5, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(output.Query).To(Equal("greeting"))
 			Expect(output.Count).To(Equal(1))
@@ -77,26 +78,69 @@ var _ = Describe("Search", func() {
 			Expect(output.Results[0].Branch).To(HaveLen(2))
 		})
 
+		It("reports the conversation a result continues", func() {
+			earlier := merkle.NewNode(testutils.NewTestBucket("assistant", "Started on the lexer"), nil)
+			summary := merkle.NewNode(testutils.NewTestBucket("user", "Continue from the summary"), nil)
+			reply := merkle.NewNode(testutils.NewTestBucket("assistant", "Finishing the parser"), summary)
+			for _, n := range []*merkle.Node{earlier, summary, reply} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(driver.PutEdge(ctx, &storage.Edge{From: summary.Hash, To: earlier.Hash, Kind: storage.EdgeContinues})).To(Succeed())
+
+			vectorDriver.Results = []vector.QueryResult{
+				{Document: vector.Document{ID: reply.Hash, Hash: reply.Hash}, Score: 0.9},
+			}
+
+			output, err := searcher.Search("parser", 5, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Results).To(HaveLen(1))
+			Expect(output.Results[0].Continues).To(Equal(earlier.Hash))
+		})
+
 		It("defaults topK to 5 when zero", func() {
-			output, err := searcher.Search("test", 0)
+			output, err := searcher.Search("test", 0, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(output).NotTo(BeNil())
 		})
 
 		It("returns an error when embedding fails", func() {
 			embedder.FailOn = "fail-query"
-			_, err := searcher.Search("fail-query", 5)
+			_, err := searcher.Search("fail-query", 5, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to embed query"))
 		})
 
 		It("returns an error when vector query fails", func() {
 			vectorDriver.FailQuery = true
-			_, err := searcher.Search("test", 5)
+			_, err := searcher.Search("test", 5, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to query vector store"))
 		})
 
+		It("filters results by session metadata tags", func() {
+			root := merkle.NewNode(testutils.NewTestBucket("user", "Hello"), nil)
+			tagged := merkle.NewNode(testutils.NewTestBucket("assistant", "Hi there"), root,
+				merkle.NodeMeta{Metadata: map[string]string{"ticket": "ENG-42"}})
+			untagged := merkle.NewNode(testutils.NewTestBucket("assistant", "Hey"), root)
+
+			for _, n := range []*merkle.Node{root, tagged, untagged} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			vectorDriver.Results = []vector.QueryResult{
+				{Document: vector.Document{ID: untagged.Hash, Hash: untagged.Hash}, Score: 0.9},
+				{Document: vector.Document{ID: tagged.Hash, Hash: tagged.Hash}, Score: 0.8},
+			}
+
+			output, err := searcher.Search("greeting", 1, map[string]string{"ticket": "ENG-42"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Results).To(HaveLen(1))
+			Expect(output.Results[0].Hash).To(Equal(tagged.Hash))
+			Expect(output.Results[0].Metadata).To(HaveKeyWithValue("ticket", "ENG-42"))
+		})
+
 		It("skips results where DAG loading fails", func() {
 			// Add a result that references a hash not in the store
 			vectorDriver.Results = []vector.QueryResult{
@@ -109,7 +153,7 @@ var _ = Describe("Search", func() {
 				},
 			}
 
-			output, err := searcher.Search("test", 5)
+			output, err := searcher.Search("test", 5, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(output.Count).To(Equal(0))
 		})
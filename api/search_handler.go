@@ -7,12 +7,14 @@ import (
 
 	apisearch "github.com/papercomputeco/tapes/api/search"
 	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
 )
 
 // handleSearchEndpoint handles GET /v1/search requests.
 // Query parameters:
 //   - query (required): the search query text
 //   - top_k (optional, default 5): number of results to return
+//   - tag (optional, repeatable): key=value session metadata filter
 func (s *Server) handleSearchEndpoint(c *fiber.Ctx) error {
 	// Verify search is configured
 	if s.config.VectorDriver == nil || s.config.Embedder == nil {
@@ -39,6 +41,17 @@ func (s *Server) handleSearchEndpoint(c *fiber.Ctx) error {
 		topK = parsed
 	}
 
+	var rawTags []string
+	for _, t := range c.Context().QueryArgs().PeekMulti("tag") {
+		rawTags = append(rawTags, string(t))
+	}
+	tags, err := storage.ParseTags(rawTags)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(llm.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
 	searcher := apisearch.NewSearcher(
 		c.Context(),
 		s.config.Embedder,
@@ -46,7 +59,7 @@ func (s *Server) handleSearchEndpoint(c *fiber.Ctx) error {
 		s.dagLoader,
 		s.logger,
 	)
-	output, err := searcher.Search(query, topK)
+	output, err := searcher.Search(query, topK, tags)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{
 			Error: err.Error(),
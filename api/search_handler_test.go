@@ -193,6 +193,49 @@ var _ = Describe("handleSearchEndpoint", func() {
 		})
 	})
 
+	Context("when filtering by tag", func() {
+		It("returns only results carrying the tag", func() {
+			root := merkle.NewNode(testutils.NewTestBucket("user", "Hello"), nil)
+			tagged := merkle.NewNode(testutils.NewTestBucket("assistant", "Hi there"), root,
+				merkle.NodeMeta{Metadata: map[string]string{"ticket": "ENG-42"}})
+			untagged := merkle.NewNode(testutils.NewTestBucket("assistant", "Hey"), root)
+
+			for _, n := range []*merkle.Node{root, tagged, untagged} {
+				_, err := inMem.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			vectorDriver.Results = []vector.QueryResult{
+				{Document: vector.Document{ID: untagged.Hash, Hash: untagged.Hash}, Score: 0.9},
+				{Document: vector.Document{ID: tagged.Hash, Hash: tagged.Hash}, Score: 0.8},
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/v1/search?query=greeting&tag=ticket=ENG-42", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := server.app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(fiber.StatusOK))
+
+			var output apisearch.Output
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Unmarshal(body, &output)).To(Succeed())
+
+			Expect(output.Results).To(HaveLen(1))
+			Expect(output.Results[0].Hash).To(Equal(tagged.Hash))
+		})
+
+		It("returns 400 for malformed tags", func() {
+			req, err := http.NewRequest(http.MethodGet, "/v1/search?query=test&tag=ENG-42", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := server.app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(fiber.StatusBadRequest))
+		})
+	})
+
 	Context("when vector query fails", func() {
 		It("returns 500", func() {
 			vectorDriver.FailQuery = true
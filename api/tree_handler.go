@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// treePreviewLen bounds the text preview of each node in a TreeResponse.
+const treePreviewLen = 80
+
+// TreeResponse is returned by the GET /dag/tree and GET /dag/tree/:hash
+// endpoints.
+type TreeResponse struct {
+	// Nodes in depth-first order, each parent before its children.
+	Nodes []TreeNode `json:"nodes"`
+}
+
+// TreeNode is a lightweight summary of a node for rendering branch trees.
+type TreeNode struct {
+	Hash       string     `json:"hash"`
+	ParentHash *string    `json:"parent_hash,omitempty"`
+	Role       string     `json:"role"`
+	Model      string     `json:"model,omitempty"`
+	Preview    string     `json:"preview"`
+	Usage      *llm.Usage `json:"usage,omitempty"`
+
+	// Depth is the node's distance from its conversation root.
+	Depth int `json:"depth"`
+
+	// Leaf is true for nodes with no children, the tips of branches.
+	Leaf bool `json:"leaf"`
+}
+
+// handleTree returns the subtree under a node, or every conversation when no
+// hash is given.
+func (s *Server) handleTree(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	var starts []string
+	if hash := c.Params("hash"); hash != "" {
+		hash, err := storage.ResolveHash(ctx, s.driver, hash)
+		if err != nil {
+			return hashError(c, err)
+		}
+		starts = append(starts, hash)
+	} else {
+		roots, err := s.driver.Roots(ctx)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to get roots"})
+		}
+		for _, root := range roots {
+			starts = append(starts, root.Hash)
+		}
+	}
+
+	resp := TreeResponse{Nodes: []TreeNode{}}
+	for _, hash := range starts {
+		nodes, err := s.buildTree(ctx, hash)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "failed to load tree"})
+		}
+		resp.Nodes = append(resp.Nodes, nodes...)
+	}
+
+	return c.JSON(resp)
+}
+
+// buildTree summarizes the node with the given hash and all its descendants
+// in depth-first order.
+func (s *Server) buildTree(ctx context.Context, hash string) ([]TreeNode, error) {
+	dag, err := merkle.LoadDag(ctx, s.dagLoader, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []TreeNode
+	var visit func(n *merkle.DagNode, depth int)
+	visit = func(n *merkle.DagNode, depth int) {
+		nodes = append(nodes, TreeNode{
+			Hash:       n.Hash,
+			ParentHash: n.ParentHash,
+			Role:       n.Bucket.Role,
+			Model:      n.Bucket.Model,
+			Preview:    preview(n.Bucket.ExtractText()),
+			Usage:      n.Usage,
+			Depth:      depth,
+			Leaf:       len(n.Children) == 0,
+		})
+		for _, child := range n.Children {
+			visit(child, depth+1)
+		}
+	}
+	visit(dag.Get(hash), len(dag.Ancestors(hash))-1)
+
+	return nodes, nil
+}
+
+// preview flattens text to a single line of at most treePreviewLen runes.
+func preview(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > treePreviewLen {
+		return string(runes[:treePreviewLen-3]) + "..."
+	}
+	return text
+}
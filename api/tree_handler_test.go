@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+var _ = Describe("handleTree", func() {
+	var (
+		server *Server
+		ctx    context.Context
+
+		//      root
+		//       |
+		//     reply
+		//     /    \
+		//  askA    askB
+		root, reply, askA, askB, other *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger, _ := zap.NewDevelopment()
+		driver := inmemory.NewDriver()
+
+		var err error
+		server, err = NewServer(Config{ListenAddr: ":0"}, driver, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		root = merkle.NewNode(apiTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(apiTestBucket("assistant", "Hi there!"), root)
+		askA = merkle.NewNode(apiTestBucket("user", "Tell me a joke"), reply)
+		askB = merkle.NewNode(apiTestBucket("user", "Tell me a fact"), reply)
+		other = merkle.NewNode(apiTestBucket("user", "Unrelated"), nil)
+		for _, n := range []*merkle.Node{root, reply, askA, askB, other} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	getTree := func(path string) TreeResponse {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(fiber.StatusOK))
+
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		var tree TreeResponse
+		Expect(json.Unmarshal(body, &tree)).To(Succeed())
+		return tree
+	}
+
+	It("returns the subtree under a node in depth-first order", func() {
+		tree := getTree("/dag/tree/" + reply.Hash[:8])
+
+		Expect(tree.Nodes).To(HaveLen(3))
+		Expect(tree.Nodes[0].Hash).To(Equal(reply.Hash))
+		Expect(tree.Nodes[0].Depth).To(Equal(1))
+		Expect(tree.Nodes[0].Leaf).To(BeFalse())
+		Expect([]string{tree.Nodes[1].Hash, tree.Nodes[2].Hash}).To(ConsistOf(askA.Hash, askB.Hash))
+		Expect(tree.Nodes[1].Leaf).To(BeTrue())
+		Expect(tree.Nodes[1].Depth).To(Equal(2))
+	})
+
+	It("returns every conversation without a hash", func() {
+		tree := getTree("/dag/tree")
+
+		Expect(tree.Nodes).To(HaveLen(5))
+		var roots []string
+		for _, n := range tree.Nodes {
+			if n.ParentHash == nil {
+				roots = append(roots, n.Hash)
+			}
+		}
+		Expect(roots).To(ConsistOf(root.Hash, other.Hash))
+	})
+})
+
+var _ = Describe("Abbreviated hashes", func() {
+	var (
+		server *Server
+		node   *merkle.Node
+	)
+
+	BeforeEach(func() {
+		logger, _ := zap.NewDevelopment()
+		driver := inmemory.NewDriver()
+
+		var err error
+		server, err = NewServer(Config{ListenAddr: ":0"}, driver, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		node = merkle.NewNode(apiTestBucket("user", "Hello"), nil)
+		_, err = driver.Put(context.Background(), node)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	get := func(path string) (int, []byte) {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := server.app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.StatusCode, body
+	}
+
+	It("resolves prefixes for nodes", func() {
+		status, body := get("/dag/node/" + node.Hash[:6])
+		Expect(status).To(Equal(fiber.StatusOK))
+		Expect(string(body)).To(ContainSubstring(node.Hash))
+	})
+
+	It("resolves prefixes for histories and reports the full head hash", func() {
+		status, body := get("/dag/history/" + node.Hash[:6])
+		Expect(status).To(Equal(fiber.StatusOK))
+
+		var history HistoryResponse
+		Expect(json.Unmarshal(body, &history)).To(Succeed())
+		Expect(history.HeadHash).To(Equal(node.Hash))
+	})
+
+	It("returns 404 for prefixes matching nothing", func() {
+		status, _ := get("/dag/node/" + "zzzzzz")
+		Expect(status).To(Equal(fiber.StatusNotFound))
+	})
+})
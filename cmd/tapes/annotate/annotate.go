@@ -0,0 +1,225 @@
+// Package annotatecmder provides the annotate subcommand for rating and
+// labelling recorded responses.
+package annotatecmder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+const annotateLongDesc string = `Rate, label and comment on a recorded node.
+
+Ratings are good, bad or failure (a notable failure worth studying). Labels
+are free-form and can be added and removed one at a time. Each author keeps
+one annotation per node; annotating again updates it. Annotations are stored
+beside the nodes and never change their hashes.
+
+Rated and labelled sessions can be found with "tapes deck --rating" and
+"--label", and exported with "tapes dataset export --rating".
+
+The hash may be abbreviated, name a ref, or be HEAD (the default), the
+current checkout. Without any changes, the node's annotations are shown.
+
+Examples:
+  tapes annotate --rating good              Rate the current checkout
+  tapes annotate abc123 --rating failure --label hallucination --note "invented an API"
+  tapes annotate abc123 --unlabel hallucination
+  tapes annotate abc123 --rating none       Clear your rating
+  tapes annotate abc123 --delete            Remove your annotation
+  tapes annotate abc123                     Show the node's annotations
+  tapes annotate --list --rating failure    List every failure`
+
+const annotateShortDesc string = "Rate, label and comment on a node"
+
+type annotateCommander struct {
+	hash       string
+	sqlitePath string
+	author     string
+	rating     string
+	labels     []string
+	unlabels   []string
+	note       string
+	remove     bool
+	list       bool
+}
+
+// NewAnnotateCmd creates the annotate cobra command.
+func NewAnnotateCmd() *cobra.Command {
+	cmder := &annotateCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "annotate [hash]",
+		Short: annotateShortDesc,
+		Long:  annotateLongDesc,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hash = storage.HeadRef
+			if len(args) > 0 {
+				cmder.hash = args[0]
+			}
+			return cmder.run(cmd.Context(), cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database")
+	cmd.Flags().StringVar(&cmder.author, "author", storage.DefaultAuthor(), "Author of the annotation")
+	cmd.Flags().StringVar(&cmder.rating, "rating", "", "Rate the node good|bad|failure, or none to clear")
+	cmd.Flags().StringArrayVar(&cmder.labels, "label", nil, "Add a label (repeatable)")
+	cmd.Flags().StringArrayVar(&cmder.unlabels, "unlabel", nil, "Remove a label (repeatable)")
+	cmd.Flags().StringVar(&cmder.note, "note", "", "Set the free-text notes")
+	cmd.Flags().BoolVar(&cmder.remove, "delete", false, "Remove your annotation of the node")
+	cmd.Flags().BoolVar(&cmder.list, "list", false, "List every annotation, filtered by --rating, --label and --author if given")
+
+	return cmd
+}
+
+func (c *annotateCommander) run(ctx context.Context, cmd *cobra.Command) error {
+	flags := cmd.Flags()
+	editing := flags.Changed("rating") || flags.Changed("label") || flags.Changed("unlabel") || flags.Changed("note")
+	if c.remove && editing {
+		return errors.New("--delete cannot be combined with other changes")
+	}
+	if c.list && (c.remove || flags.Changed("unlabel") || flags.Changed("note") || len(c.labels) > 1) {
+		return errors.New("--list only takes --rating, one --label and --author")
+	}
+
+	dbPath, err := sqlitepath.ResolveSQLitePath(c.sqlitePath)
+	if err != nil {
+		return fmt.Errorf("could not resolve database: %w", err)
+	}
+
+	driver, err := sqlite.NewDriver(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open database %s: %w", dbPath, err)
+	}
+	defer driver.Close()
+
+	if c.list {
+		return c.runList(ctx, cmd, driver, flags.Changed("author"))
+	}
+
+	hash, err := dagapi.ResolveHead(c.hash)
+	if err != nil {
+		return err
+	}
+	hash, err = storage.ResolveHash(ctx, driver, hash)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case c.remove:
+		if err := driver.DeleteAnnotation(ctx, hash, c.author); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Removed %s's annotation of %s\n", c.author, cliui.HashStyle.Render(dagapi.ShortHash(hash)))
+		return nil
+	case editing:
+		if err := c.edit(ctx, cmd, driver, hash); err != nil {
+			return err
+		}
+	}
+
+	annotations, err := driver.Annotations(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if len(annotations) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s has no annotations\n", cliui.HashStyle.Render(dagapi.ShortHash(hash)))
+		return nil
+	}
+	printAnnotations(cmd.OutOrStdout(), annotations)
+	return nil
+}
+
+// edit applies the flags to the author's annotation of hash.
+func (c *annotateCommander) edit(ctx context.Context, cmd *cobra.Command, store storage.AnnotationStore, hash string) error {
+	existing, err := store.Annotations(ctx, hash)
+	if err != nil {
+		return err
+	}
+	a := &storage.Annotation{Hash: hash, Author: c.author}
+	if i := slices.IndexFunc(existing, func(e *storage.Annotation) bool { return e.Author == c.author }); i >= 0 {
+		a = existing[i]
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("rating") {
+		rating := c.rating
+		if strings.EqualFold(rating, "none") {
+			rating = ""
+		}
+		a.Rating, err = storage.ParseRating(rating)
+		if err != nil {
+			return err
+		}
+	}
+	for _, label := range c.labels {
+		label = strings.TrimSpace(label)
+		if label != "" && !slices.Contains(a.Labels, label) {
+			a.Labels = append(a.Labels, label)
+		}
+	}
+	a.Labels = slices.DeleteFunc(a.Labels, func(label string) bool { return slices.Contains(c.unlabels, label) })
+	if flags.Changed("note") {
+		a.Notes = c.note
+	}
+
+	if a.Rating == "" && len(a.Labels) == 0 && a.Notes == "" {
+		return store.DeleteAnnotation(ctx, hash, c.author)
+	}
+	return store.PutAnnotation(ctx, a)
+}
+
+func (c *annotateCommander) runList(ctx context.Context, cmd *cobra.Command, store storage.AnnotationStore, byAuthor bool) error {
+	rating, err := storage.ParseRating(c.rating)
+	if err != nil {
+		return err
+	}
+	filter := storage.AnnotationFilter{Rating: rating}
+	if len(c.labels) > 0 {
+		filter.Label = c.labels[0]
+	}
+	if byAuthor {
+		filter.Author = c.author
+	}
+
+	annotations, err := store.ListAnnotations(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(annotations) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No annotations match")
+		return nil
+	}
+	printAnnotations(cmd.OutOrStdout(), annotations)
+	return nil
+}
+
+func printAnnotations(w io.Writer, annotations []*storage.Annotation) {
+	for _, a := range annotations {
+		line := cliui.HashStyle.Render(dagapi.ShortHash(a.Hash)) + "  " + a.Author
+		if a.Rating != "" {
+			line += "  " + string(a.Rating)
+		}
+		if len(a.Labels) > 0 {
+			line += "  [" + strings.Join(a.Labels, ", ") + "]"
+		}
+		fmt.Fprintln(w, line)
+		if a.Notes != "" {
+			fmt.Fprintln(w, "    "+a.Notes)
+		}
+	}
+}
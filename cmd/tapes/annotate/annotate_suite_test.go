@@ -0,0 +1,13 @@
+package annotatecmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAnnotateCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Annotate Commander Suite")
+}
@@ -0,0 +1,122 @@
+package annotatecmder
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+var _ = Describe("Annotate Command", func() {
+	var (
+		ctx    context.Context
+		dbPath string
+		reply  *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dbPath = filepath.Join(GinkgoT().TempDir(), "tapes.sqlite")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+
+		root := merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "user",
+			Content:  []llm.ContentBlock{{Type: "text", Text: "Hello"}},
+			Model:    "test-model",
+			Provider: "test",
+		}, nil)
+		reply = merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "assistant",
+			Content:  []llm.ContentBlock{{Type: "text", Text: "Hi there!"}},
+			Model:    "test-model",
+			Provider: "test",
+		}, root)
+		for _, n := range []*merkle.Node{root, reply} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	execute := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := NewAnnotateCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append(args, "--sqlite", dbPath, "--author", "alice"))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	annotations := func() []*storage.Annotation {
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+		list, err := driver.Annotations(ctx, reply.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		return list
+	}
+
+	It("rates, labels and comments on a node", func() {
+		out, err := execute(reply.Hash[:8], "--rating", "failure", "--label", "hallucination", "--label", "slow", "--note", "invented an API")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("alice  failure  [hallucination, slow]"))
+		Expect(out).To(ContainSubstring("invented an API"))
+
+		_, err = execute(reply.Hash, "--unlabel", "slow")
+		Expect(err).NotTo(HaveOccurred())
+
+		list := annotations()
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Rating).To(Equal(storage.RatingFailure))
+		Expect(list[0].Labels).To(Equal([]string{"hallucination"}))
+		Expect(list[0].Notes).To(Equal("invented an API"))
+	})
+
+	It("lists annotations by rating", func() {
+		_, err := execute(reply.Hash, "--rating", "good")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := execute("--list", "--rating", "good")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring(reply.Hash[:12]))
+
+		out, err = execute("--list", "--rating", "bad")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("No annotations match"))
+	})
+
+	It("clears ratings and deletes annotations", func() {
+		_, err := execute(reply.Hash, "--rating", "bad")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := execute(reply.Hash, "--rating", "none")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("has no annotations"))
+
+		_, err = execute(reply.Hash, "--label", "keep")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = execute(reply.Hash, "--delete")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(annotations()).To(BeEmpty())
+	})
+
+	It("rejects unknown ratings", func() {
+		_, err := execute(reply.Hash, "--rating", "meh")
+		Expect(err).To(MatchError(ContainSubstring("unknown rating")))
+
+		_, err = execute(reply.Hash, "--delete", "--rating", "good")
+		Expect(err).To(HaveOccurred())
+	})
+})
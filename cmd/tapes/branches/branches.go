@@ -0,0 +1,210 @@
+// Package branchescmder provides the branches subcommand for listing the
+// branches of conversations in the DAG as a tree.
+package branchescmder
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/api"
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/logger"
+)
+
+type branchesCommander struct {
+	root      string
+	apiTarget string
+	debug     bool
+
+	logger *zap.Logger
+}
+
+const branchesLongDesc string = `List conversation branches as a tree.
+
+Shows every leaf under the given node, like git log --graph. Runs of turns
+without branching are collapsed into a single line, so the tree shows only
+roots (●), fork points (○) and branch tips (◆) with the number of turns
+between them. Hashes may be abbreviated to any unique prefix, or be a tag,
+a branch or HEAD.
+
+If no hash is provided, all conversations are listed.
+
+Examples:
+  tapes branches               List the branches of every conversation
+  tapes branches abc123        List the branches under a node`
+
+const branchesShortDesc string = "List conversation branches as a tree"
+
+// previewLen bounds the content preview of each tree line.
+const previewLen = 50
+
+func NewBranchesCmd() *cobra.Command {
+	cmder := &branchesCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "branches [root]",
+		Short: branchesShortDesc,
+		Long:  branchesLongDesc,
+		Args:  cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			configDir, _ := cmd.Flags().GetString("config-dir")
+			cfger, err := config.NewConfiger(configDir)
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			cfg, err := cfger.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			if !cmd.Flags().Changed("api-target") {
+				cmder.apiTarget = cfg.Client.APITarget
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				cmder.root = args[0]
+			}
+
+			var err error
+			cmder.debug, err = cmd.Flags().GetBool("debug")
+			if err != nil {
+				return fmt.Errorf("could not get debug flag: %w", err)
+			}
+
+			return cmder.run()
+		},
+	}
+
+	defaults := config.NewDefaultConfig()
+	cmd.Flags().StringVarP(&cmder.apiTarget, "api-target", "a", defaults.Client.APITarget, "Tapes API server URL")
+
+	return cmd
+}
+
+func (c *branchesCommander) run() error {
+	c.logger = logger.NewLogger(c.debug)
+	defer func() { _ = c.logger.Sync() }()
+
+	c.logger.Debug("listing branches",
+		zap.String("root", c.root),
+		zap.String("api_target", c.apiTarget),
+	)
+
+	root, err := dagapi.ResolveHead(c.root)
+	if err != nil {
+		return err
+	}
+
+	tree, err := dagapi.Tree(c.apiTarget, root)
+	if err != nil {
+		return fmt.Errorf("fetching tree: %w", err)
+	}
+
+	if len(tree.Nodes) == 0 {
+		fmt.Println("No conversations found.")
+		return nil
+	}
+
+	Render(os.Stdout, tree)
+	return nil
+}
+
+// Render writes tree to w, one line per root, fork point and leaf.
+func Render(w io.Writer, tree *api.TreeResponse) {
+	nodes := make(map[string]api.TreeNode, len(tree.Nodes))
+	children := make(map[string][]api.TreeNode, len(tree.Nodes))
+	for _, n := range tree.Nodes {
+		nodes[n.Hash] = n
+		if n.ParentHash != nil {
+			children[*n.ParentHash] = append(children[*n.ParentHash], n)
+		}
+	}
+
+	r := renderer{w: w, children: children}
+	for _, n := range tree.Nodes {
+		// Nodes whose parent is not part of the tree start a new tree.
+		if n.ParentHash != nil {
+			if _, ok := nodes[*n.ParentHash]; ok {
+				continue
+			}
+		}
+		r.line("", n, 0)
+		r.branches(n, "")
+		fmt.Fprintln(w)
+	}
+}
+
+type renderer struct {
+	w        io.Writer
+	children map[string][]api.TreeNode
+}
+
+// branches renders the children of parent, collapsing each chain of
+// non-branching turns down to its fork point or leaf.
+func (r renderer) branches(parent api.TreeNode, prefix string) {
+	kids := r.children[parent.Hash]
+	for i, child := range kids {
+		last := i == len(kids)-1
+
+		end, skipped := child, 0
+		for len(r.children[end.Hash]) == 1 {
+			end = r.children[end.Hash][0]
+			skipped++
+		}
+
+		connector, indent := "├─", "│ "
+		if last {
+			connector, indent = "└─", "  "
+		}
+		r.line(prefix+cliui.BranchStyle.Render(connector), end, skipped)
+		r.branches(end, prefix+cliui.BranchStyle.Render(indent))
+	}
+}
+
+// line renders a single node. skipped is the number of collapsed turns
+// between the node and the line above it.
+func (r renderer) line(prefix string, n api.TreeNode, skipped int) {
+	marker := "○"
+	switch {
+	case n.ParentHash == nil:
+		marker = "●"
+	case n.Leaf:
+		marker = "◆"
+	}
+
+	line := fmt.Sprintf("%s%s %s %s",
+		prefix,
+		cliui.MatchedStyle.Render(marker),
+		cliui.HashStyle.Render(dagapi.ShortHash(n.Hash)),
+		cliui.RoleStyle.Render("["+n.Role+"]"),
+	)
+	if n.Preview != "" {
+		preview := n.Preview
+		if runes := []rune(preview); len(runes) > previewLen {
+			preview = string(runes[:previewLen-3]) + "..."
+		}
+		line += " " + cliui.PreviewStyle.Render(preview)
+	}
+
+	var notes []string
+	if skipped > 0 {
+		notes = append(notes, fmt.Sprintf("+%d turns", skipped))
+	}
+	if n.Leaf {
+		notes = append(notes, fmt.Sprintf("%d turns", n.Depth+1))
+	}
+	for _, note := range notes {
+		line += " " + cliui.DimStyle.Render("("+note+")")
+	}
+
+	fmt.Fprintln(r.w, line)
+}
@@ -0,0 +1,13 @@
+package branchescmder_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBranches(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Branches Command Suite")
+}
@@ -0,0 +1,72 @@
+package branchescmder_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/api"
+	branchescmder "github.com/papercomputeco/tapes/cmd/tapes/branches"
+)
+
+func treeNode(hash, parent string, depth int, leaf bool) api.TreeNode {
+	n := api.TreeNode{Hash: hash, Role: "user", Preview: "turn " + hash, Depth: depth, Leaf: leaf}
+	if parent != "" {
+		n.ParentHash = &parent
+	}
+	return n
+}
+
+var _ = Describe("NewBranchesCmd", func() {
+	It("accepts an optional root", func() {
+		cmd := branchescmder.NewBranchesCmd()
+		Expect(cmd.Use).To(Equal("branches [root]"))
+		Expect(cmd.Args(cmd, []string{})).To(Succeed())
+		Expect(cmd.Args(cmd, []string{"abc123"})).To(Succeed())
+	})
+})
+
+var _ = Describe("Render", func() {
+	It("collapses linear runs and draws a tree of forks and leaves", func() {
+		//  root - a1 - a2 - fork - b1 - b2
+		//                     \
+		//                      c1
+		tree := &api.TreeResponse{Nodes: []api.TreeNode{
+			treeNode("root", "", 0, false),
+			treeNode("a1", "root", 1, false),
+			treeNode("a2", "a1", 2, false),
+			treeNode("fork", "a2", 3, false),
+			treeNode("b1", "fork", 4, false),
+			treeNode("b2", "b1", 5, true),
+			treeNode("c1", "fork", 4, true),
+		}}
+
+		var out bytes.Buffer
+		branchescmder.Render(&out, tree)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+
+		Expect(lines).To(HaveLen(4))
+		Expect(lines[0]).To(HavePrefix("● root"))
+		Expect(lines[1]).To(HavePrefix("└─○ fork"))
+		Expect(lines[1]).To(ContainSubstring("(+2 turns)"))
+		Expect(lines[2]).To(HavePrefix("  ├─◆ b2"))
+		Expect(lines[2]).To(ContainSubstring("(+1 turns) (6 turns)"))
+		Expect(lines[3]).To(HavePrefix("  └─◆ c1"))
+		Expect(lines[3]).To(ContainSubstring("(5 turns)"))
+	})
+
+	It("starts a tree at a node whose parent is not included", func() {
+		tree := &api.TreeResponse{Nodes: []api.TreeNode{
+			treeNode("mid", "elsewhere", 3, false),
+			treeNode("tip", "mid", 4, true),
+		}}
+
+		var out bytes.Buffer
+		branchescmder.Render(&out, tree)
+
+		Expect(out.String()).To(ContainSubstring("○ mid"))
+		Expect(out.String()).To(ContainSubstring("└─◆ tip"))
+	})
+})
@@ -0,0 +1,31 @@
+// Package cacmder provides the ca command for managing the local certificate
+// authority used by the proxy's forward mode.
+package cacmder
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const caLongDesc string = `Manage the local certificate authority.
+
+The proxy's forward mode (tapes serve --forward-listen) terminates TLS for
+known LLM API hosts with certificates issued by a local CA, so that tools
+which cannot change their base URL can be recorded with HTTPS_PROXY alone.
+Clients must trust the CA before their traffic can be intercepted.
+
+Use subcommands to manage the CA:
+  tapes ca install    Create the CA and print trust instructions`
+
+const caShortDesc string = "Manage the local certificate authority"
+
+func NewCACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ca",
+		Short: caShortDesc,
+		Long:  caLongDesc,
+	}
+
+	cmd.AddCommand(newInstallCmd())
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+package cacmder_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCACommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CA Commander Suite")
+}
@@ -0,0 +1,56 @@
+package cacmder_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cacmder "github.com/papercomputeco/tapes/cmd/tapes/ca"
+	"github.com/papercomputeco/tapes/pkg/ca"
+)
+
+var _ = Describe("CA command", func() {
+	var (
+		tmpDir  string
+		origDir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir = GinkgoT().TempDir()
+		origDir, err = os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+
+		// Create a local .tapes dir so the manager picks it up
+		Expect(os.MkdirAll(filepath.Join(tmpDir, ".tapes"), 0o755)).To(Succeed())
+		Expect(os.Chdir(tmpDir)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Chdir(origDir)).To(Succeed())
+	})
+
+	It("creates the CA once and prints trust instructions", func() {
+		var out bytes.Buffer
+		cmd := cacmder.NewCACmd()
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"install"})
+		Expect(cmd.Execute()).To(Succeed())
+
+		_, err := ca.Load(filepath.Join(tmpDir, ".tapes"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("Created certificate authority"))
+		Expect(out.String()).To(ContainSubstring("NODE_EXTRA_CA_CERTS="))
+		Expect(out.String()).To(ContainSubstring("HTTPS_PROXY=http://127.0.0.1:8088"))
+
+		out.Reset()
+		cmd = cacmder.NewCACmd()
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"install"})
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Using certificate authority"))
+	})
+})
@@ -0,0 +1,96 @@
+package cacmder
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/pkg/ca"
+	"github.com/papercomputeco/tapes/pkg/config"
+)
+
+const installLongDesc string = `Create the local certificate authority and print trust instructions.
+
+The CA is generated once and stored in the .tapes/ directory. Installing
+it into a trust store is left to you since it requires elevated
+privileges; the printed commands cover the common system and runtime
+trust stores.
+
+Examples:
+  tapes ca install`
+
+const installShortDesc string = "Create the CA and print trust instructions"
+
+func newInstallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: installShortDesc,
+		Long:  installLongDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			configDir, _ := cmd.Flags().GetString("config-dir")
+			return runInstall(cmd.OutOrStdout(), configDir)
+		},
+	}
+
+	return cmd
+}
+
+func runInstall(w io.Writer, configDir string) error {
+	dir, err := ca.Dir(configDir)
+	if err != nil {
+		return err
+	}
+
+	_, created, err := ca.LoadOrCreate(dir)
+	if err != nil {
+		return fmt.Errorf("loading certificate authority: %w", err)
+	}
+
+	certPath := filepath.Join(dir, ca.CertFile)
+	if created {
+		fmt.Fprintf(w, "Created certificate authority %s\n", certPath)
+	} else {
+		fmt.Fprintf(w, "Using certificate authority %s\n", certPath)
+	}
+
+	forwardListen := "127.0.0.1:8088"
+	if cfger, err := config.NewConfiger(configDir); err == nil {
+		if cfg, err := cfger.LoadConfig(); err == nil && cfg.Proxy.ForwardListen != "" {
+			forwardListen = cfg.Proxy.ForwardListen
+		}
+	}
+
+	fmt.Fprintf(w, `
+Trust the CA system-wide:
+  macOS:  sudo security add-trusted-cert -d -r trustRoot -k /Library/Keychains/System.keychain %[1]s
+  Linux:  sudo cp %[1]s /usr/local/share/ca-certificates/tapes.crt && sudo update-ca-certificates
+
+Or per runtime, for tools that bring their own trust store:
+  export NODE_EXTRA_CA_CERTS=%[1]s
+  export SSL_CERT_FILE=%[1]s
+  export REQUESTS_CA_BUNDLE=%[1]s
+
+Then start the forward proxy and point tools at it:
+  tapes serve --forward-listen %[2]s --forward-passthrough
+  export HTTPS_PROXY=http://%[3]s
+
+--forward-passthrough tunnels traffic to hosts that are not intercepted, so
+tools can still reach them. The forward proxy does not authenticate clients:
+keep it on loopback, or anyone who can reach it can relay traffic through it.
+`, certPath, forwardListen, proxyHost(forwardListen))
+
+	return nil
+}
+
+// proxyHost turns a listen address into a host clients can connect to.
+func proxyHost(listen string) string {
+	host, port, err := net.SplitHostPort(listen)
+	if err != nil || host != "" {
+		return listen
+	}
+	return net.JoinHostPort("localhost", port)
+}
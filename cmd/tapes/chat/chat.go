@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
 	"github.com/papercomputeco/tapes/pkg/config"
 	"github.com/papercomputeco/tapes/pkg/dotdir"
 	"github.com/papercomputeco/tapes/pkg/logger"
@@ -66,11 +67,14 @@ which transparently records the conversation in the Merkle DAG.
 Supported providers: Ollama.
 
 If a checkout state exists (from "tapes checkout"), the conversation
-resumes from that point. Re-running "tapes chat" always starts from the
-same checked-out hash - it does not advance the checkout state.
+resumes from HEAD. When a branch is checked out, HEAD follows the branch:
+each session resumes from the branch's latest turn, including the turns
+recorded by the previous session. A detached checkout of a hash or tag
+always starts from the same checked-out hash.
 
 Use "tapes checkout <hash>" to checkout a specific conversation point,
-or "tapes checkout" (no hash provided) to clear the checkout and start fresh.
+"tapes checkout <branch>" to follow a branch, or "tapes checkout" (no hash
+provided) to clear the checkout and start fresh.
 
 Examples:
   tapes chat --model llama3.2
@@ -136,11 +140,24 @@ func (c *chatCommander) run() error {
 		return fmt.Errorf("loading checkout state: %w", err)
 	}
 
+	// A branch may have advanced since it was checked out.
+	if checkout != nil && checkout.Branch != "" {
+		checkout, err = c.refreshBranch(dotdirManager, checkout)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Build initial message history from checkout
 	var messages []ollamaMessage
 	if checkout != nil {
-		fmt.Printf("Resuming from checkout %s (%d messages)\n",
-			utils.Truncate(checkout.Hash, 16), len(checkout.Messages))
+		if checkout.Branch != "" {
+			fmt.Printf("Resuming branch %s at %s (%d messages)\n",
+				checkout.Branch, utils.Truncate(checkout.Hash, 16), len(checkout.Messages))
+		} else {
+			fmt.Printf("Resuming from checkout %s (%d messages)\n",
+				utils.Truncate(checkout.Hash, 16), len(checkout.Messages))
+		}
 		for _, msg := range checkout.Messages {
 			messages = append(messages, ollamaMessage{
 				Role:    msg.Role,
@@ -206,6 +223,27 @@ func (c *chatCommander) run() error {
 	return nil
 }
 
+// refreshBranch updates a checkout attached to a branch to the branch's
+// current tip. If the branch cannot be fetched, the saved checkout is used.
+func (c *chatCommander) refreshBranch(dotdirManager *dotdir.Manager, checkout *dotdir.CheckoutState) (*dotdir.CheckoutState, error) {
+	state, err := dagapi.Checkout(c.apiTarget, checkout.Branch)
+	if err != nil {
+		c.logger.Warn("could not refresh branch, resuming from saved checkout",
+			zap.String("branch", checkout.Branch),
+			zap.Error(err),
+		)
+		return checkout, nil
+	}
+	if state.Branch == "" {
+		return nil, fmt.Errorf("%s is no longer a branch; run 'tapes checkout' to pick a new starting point", checkout.Branch)
+	}
+
+	if err := dotdirManager.SaveCheckout(state, ""); err != nil {
+		return nil, fmt.Errorf("saving checkout: %w", err)
+	}
+	return state, nil
+}
+
 // sendAndStream sends a chat request to the proxy and streams the response to stdout.
 // Returns the full assistant response text.
 func (c *chatCommander) sendAndStream(messages []ollamaMessage) (string, error) {
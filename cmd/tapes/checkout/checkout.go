@@ -3,20 +3,14 @@
 package checkoutcmder
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
 	"github.com/papercomputeco/tapes/pkg/config"
 	"github.com/papercomputeco/tapes/pkg/dotdir"
-	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/logger"
 	"github.com/papercomputeco/tapes/pkg/utils"
 )
@@ -29,35 +23,24 @@ type checkoutCommander struct {
 	logger *zap.Logger
 }
 
-// historyResponse mirrors the API's HistoryResponse type for JSON deserialization.
-type historyResponse struct {
-	Messages []historyMessage `json:"messages"`
-	HeadHash string           `json:"head_hash"`
-	Depth    int              `json:"depth"`
-}
-
-// historyMessage mirrors the API's HistoryMessage type.
-type historyMessage struct {
-	Hash       string             `json:"hash"`
-	ParentHash *string            `json:"parent_hash,omitempty"`
-	Role       string             `json:"role"`
-	Content    []llm.ContentBlock `json:"content"`
-	Model      string             `json:"model,omitempty"`
-	Provider   string             `json:"provider,omitempty"`
-	StopReason string             `json:"stop_reason,omitempty"`
-	Usage      *llm.Usage         `json:"usage,omitempty"`
-}
-
 const checkoutLongDesc string = `Experimental: Checkout a point in the conversation for replay.
 
 Fetches the conversation history up to the given hash from the API server
 and saves the state as the starting point for a "tapes chat" session.
+Hashes may be abbreviated to any unique prefix, or be the name of a tag or
+branch.
+
+Checking out a branch attaches HEAD to it: "tapes chat" then resumes from
+the branch's latest turn, which advances as new turns are recorded. Checking
+out a hash or tag detaches HEAD at that node.
 
 If no hash is provided, clears the checkout state so the next chat session
 starts a new root conversation.
 
 Examples:
   tapes checkout abc123def456   Checkout a specific conversation point
+  tapes checkout main           Checkout the branch main
+  tapes checkout HEAD           Refresh the current checkout
   tapes checkout                Clear checkout state, start fresh`
 
 const checkoutShortDesc string = "Checkout a conversation point"
@@ -127,80 +110,30 @@ func (c *checkoutCommander) run() error {
 		zap.String("api_target", c.apiTarget),
 	)
 
-	// Fetch the conversation history from the API
-	history, err := c.fetchHistory(c.hash)
+	hash, err := dagapi.ResolveHead(c.hash)
 	if err != nil {
-		return fmt.Errorf("fetching history: %w", err)
+		return err
 	}
 
-	// Convert API messages to checkout messages
-	messages := make([]dotdir.CheckoutMessage, 0, len(history.Messages))
-	for _, msg := range history.Messages {
-		text := extractText(msg.Content)
-		messages = append(messages, dotdir.CheckoutMessage{
-			Role:    msg.Role,
-			Content: text,
-		})
+	// Fetch the conversation history from the API
+	state, err := dagapi.Checkout(c.apiTarget, hash)
+	if err != nil {
+		return fmt.Errorf("fetching history: %w", err)
 	}
 
-	// Save the checkout state
-	state := &dotdir.CheckoutState{
-		Hash:     history.HeadHash,
-		Messages: messages,
-	}
 	if err := dotdirManager.SaveCheckout(state, ""); err != nil {
 		return fmt.Errorf("saving checkout: %w", err)
 	}
 
-	fmt.Printf("Checked out %s (%d messages)\n", utils.Truncate(history.HeadHash, 16), len(messages))
-	for _, msg := range messages {
+	if state.Branch != "" {
+		fmt.Printf("Checked out branch %s at %s (%d messages)\n", state.Branch, utils.Truncate(state.Hash, 16), len(state.Messages))
+	} else {
+		fmt.Printf("Checked out %s (%d messages)\n", utils.Truncate(state.Hash, 16), len(state.Messages))
+	}
+	for _, msg := range state.Messages {
 		preview := utils.Truncate(msg.Content, 60)
 		fmt.Printf("  [%s] %s\n", msg.Role, preview)
 	}
 
 	return nil
 }
-
-// fetchHistory calls the API to get the conversation history for a given hash.
-func (c *checkoutCommander) fetchHistory(hash string) (*historyResponse, error) {
-	url := fmt.Sprintf("%s/dag/history/%s", c.apiTarget, hash)
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("requesting history from API: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading API response: %w", err)
-	}
-
-	var history historyResponse
-	if err := json.Unmarshal(body, &history); err != nil {
-		return nil, fmt.Errorf("parsing API response: %w", err)
-	}
-
-	return &history, nil
-}
-
-// extractText concatenates all text content blocks from a message.
-func extractText(content []llm.ContentBlock) string {
-	var b strings.Builder
-	for _, block := range content {
-		if block.Type == "text" {
-			b.WriteString(block.Text)
-		}
-	}
-	return b.String()
-}
@@ -0,0 +1,258 @@
+// Package dagapi is a small client for the /dag and /refs endpoints of the
+// tapes API, shared by the commands that browse the conversation DAG.
+package dagapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/papercomputeco/tapes/api"
+	"github.com/papercomputeco/tapes/pkg/dotdir"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// requestTimeout bounds each API request.
+const requestTimeout = 10 * time.Second
+
+// Node fetches a single node. hash may be abbreviated.
+func Node(apiTarget, hash string) (*merkle.Node, error) {
+	var node merkle.Node
+	if err := get(apiTarget, "/dag/node/"+url.PathEscape(hash), &node); err != nil {
+		return nil, err
+	}
+	return &node, nil
+}
+
+// History fetches the conversation leading up to a node. hash may be
+// abbreviated; the response's HeadHash is the full hash.
+func History(apiTarget, hash string) (*api.HistoryResponse, error) {
+	var history api.HistoryResponse
+	if err := get(apiTarget, "/dag/history/"+url.PathEscape(hash), &history); err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// Tree fetches the subtree under a node, or every conversation when hash is
+// empty.
+func Tree(apiTarget, hash string) (*api.TreeResponse, error) {
+	path := "/dag/tree"
+	if hash != "" {
+		path += "/" + url.PathEscape(hash)
+	}
+
+	var tree api.TreeResponse
+	if err := get(apiTarget, path, &tree); err != nil {
+		return nil, err
+	}
+	return &tree, nil
+}
+
+// Diff compares the branches ending at a and b.
+func Diff(apiTarget, a, b string) (*api.DiffResponse, error) {
+	var diff api.DiffResponse
+	if err := get(apiTarget, "/dag/diff/"+url.PathEscape(a)+"/"+url.PathEscape(b), &diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
+
+// Refs lists every tag and branch.
+func Refs(apiTarget string) ([]*storage.Ref, error) {
+	var refs api.RefsResponse
+	if err := get(apiTarget, "/refs", &refs); err != nil {
+		return nil, err
+	}
+	return refs.Refs, nil
+}
+
+// Ref fetches a single ref. Returns nil without an error when there is no
+// ref with name.
+func Ref(apiTarget, name string) (*storage.Ref, error) {
+	var ref storage.Ref
+	err := get(apiTarget, "/refs/"+url.PathEscape(name), &ref)
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ref, nil
+}
+
+// SetRef creates the ref name, or moves it when req.Force is set.
+func SetRef(apiTarget, name string, req api.SetRefRequest) (*storage.Ref, error) {
+	var ref storage.Ref
+	if err := do(apiTarget, http.MethodPut, "/refs/"+url.PathEscape(name), req, &ref); err != nil {
+		return nil, err
+	}
+	return &ref, nil
+}
+
+// DeleteRef removes the ref name.
+func DeleteRef(apiTarget, name string) error {
+	return do(apiTarget, http.MethodDelete, "/refs/"+url.PathEscape(name), nil, nil)
+}
+
+// ResolveHead returns hash unchanged unless it is HEAD, which resolves to
+// the checked-out branch, or to the checked-out hash when detached.
+func ResolveHead(hash string) (string, error) {
+	if hash != storage.HeadRef {
+		return hash, nil
+	}
+
+	state, err := dotdir.NewManager().LoadCheckoutState("")
+	if err != nil {
+		return "", fmt.Errorf("loading checkout state: %w", err)
+	}
+	if state == nil {
+		return "", errors.New("HEAD is not set; run 'tapes checkout <hash>' first")
+	}
+	return state.Head(), nil
+}
+
+// Checkout fetches the conversation ending at hash as a checkout state.
+// When hash names a branch, the state is attached to the branch.
+func Checkout(apiTarget, hash string) (*dotdir.CheckoutState, error) {
+	history, err := History(apiTarget, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &dotdir.CheckoutState{
+		Hash:     history.HeadHash,
+		Messages: make([]dotdir.CheckoutMessage, 0, len(history.Messages)),
+	}
+	for _, msg := range history.Messages {
+		state.Messages = append(state.Messages, dotdir.CheckoutMessage{
+			Role:    msg.Role,
+			Content: checkoutText(msg.Content),
+		})
+	}
+
+	if storage.ValidateRefName(hash) == nil {
+		ref, err := Ref(apiTarget, hash)
+		if err != nil {
+			return nil, err
+		}
+		if ref != nil && ref.Kind == storage.RefBranch {
+			state.Branch = ref.Name
+		}
+	}
+	return state, nil
+}
+
+// checkoutText concatenates the text blocks of a message, the content chat
+// replays for it.
+func checkoutText(content []llm.ContentBlock) string {
+	var b strings.Builder
+	for _, block := range content {
+		if block.Type == "text" {
+			b.WriteString(block.Text)
+		}
+	}
+	return b.String()
+}
+
+// apiError is an error response from the API.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+// get requests path from the API and decodes the JSON response into out.
+// Error responses are returned as errors carrying the API's message.
+func get(apiTarget, path string, out any) error {
+	return do(apiTarget, http.MethodGet, path, nil, out)
+}
+
+// do sends a request with an optional JSON body to the API and decodes the
+// JSON response into out, if out is non-nil.
+func do(apiTarget, method, path string, in, out any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, apiTarget+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %s from API: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading API response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var errResp llm.ErrorResponse
+		if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
+			return &apiError{status: resp.StatusCode, message: errResp.Error}
+		}
+		return &apiError{
+			status:  resp.StatusCode,
+			message: fmt.Sprintf("API returned status %d: %s", resp.StatusCode, string(body)),
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("parsing API response: %w", err)
+	}
+	return nil
+}
+
+// shortHashLen is the length hashes are abbreviated to for display.
+const shortHashLen = 12
+
+// ShortHash abbreviates a node hash for display. Abbreviated hashes are
+// accepted wherever the API takes a hash.
+func ShortHash(hash string) string {
+	if len(hash) <= shortHashLen {
+		return hash
+	}
+	return hash[:shortHashLen]
+}
+
+// Preview flattens the text of content blocks to a single line of at most
+// maxLen runes.
+func Preview(content []llm.ContentBlock, maxLen int) string {
+	bucket := merkle.Bucket{Content: content}
+	text := strings.Join(strings.Fields(bucket.ExtractText()), " ")
+	if runes := []rune(text); len(runes) > maxLen {
+		return string(runes[:maxLen-3]) + "..."
+	}
+	return text
+}
@@ -0,0 +1,13 @@
+package dagapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDagAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DAG API Client Suite")
+}
@@ -0,0 +1,131 @@
+package dagapi_test
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/api"
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+func textBucket(role, text string) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:    "test-model",
+		Provider: "test-provider",
+	}
+}
+
+var _ = Describe("Client", func() {
+	var (
+		apiTarget   string
+		root, reply *merkle.Node
+	)
+
+	BeforeEach(func() {
+		driver := inmemory.NewDriver()
+		root = merkle.NewNode(textBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(textBucket("assistant", "Hi there!"), root)
+		for _, n := range []*merkle.Node{root, reply} {
+			_, err := driver.Put(context.Background(), n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		server, err := api.NewServer(api.Config{}, driver, driver, zap.NewNop())
+		Expect(err).NotTo(HaveOccurred())
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func() { _ = server.RunWithListener(ln) }()
+		DeferCleanup(server.Shutdown)
+
+		apiTarget = "http://" + ln.Addr().String()
+	})
+
+	It("fetches nodes by abbreviated hash", func() {
+		node, err := dagapi.Node(apiTarget, dagapi.ShortHash(reply.Hash))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node.Hash).To(Equal(reply.Hash))
+		Expect(node.Bucket.ExtractText()).To(Equal("Hi there!"))
+	})
+
+	It("fetches histories", func() {
+		history, err := dagapi.History(apiTarget, reply.Hash[:8])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history.HeadHash).To(Equal(reply.Hash))
+		Expect(history.Messages).To(HaveLen(2))
+	})
+
+	It("fetches trees", func() {
+		tree, err := dagapi.Tree(apiTarget, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tree.Nodes).To(HaveLen(2))
+	})
+
+	It("fetches diffs", func() {
+		diff, err := dagapi.Diff(apiTarget, root.Hash, reply.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.AncestorHash).To(Equal(root.Hash))
+		Expect(diff.B).To(HaveLen(1))
+	})
+
+	It("creates, resolves and deletes refs", func() {
+		ref, err := dagapi.SetRef(apiTarget, "main", api.SetRefRequest{Hash: root.Hash[:8], Kind: storage.RefBranch})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref.Hash).To(Equal(root.Hash))
+
+		refs, err := dagapi.Refs(apiTarget)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(refs).To(HaveLen(1))
+
+		node, err := dagapi.Node(apiTarget, "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node.Hash).To(Equal(root.Hash))
+
+		Expect(dagapi.DeleteRef(apiTarget, "main")).To(Succeed())
+		ref, err = dagapi.Ref(apiTarget, "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref).To(BeNil())
+	})
+
+	It("attaches checkouts of branches to the branch", func() {
+		_, err := dagapi.SetRef(apiTarget, "main", api.SetRefRequest{Hash: reply.Hash, Kind: storage.RefBranch})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dagapi.SetRef(apiTarget, "v1", api.SetRefRequest{Hash: reply.Hash})
+		Expect(err).NotTo(HaveOccurred())
+
+		state, err := dagapi.Checkout(apiTarget, "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Branch).To(Equal("main"))
+		Expect(state.Hash).To(Equal(reply.Hash))
+		Expect(state.Messages).To(HaveLen(2))
+		Expect(state.Messages[1].Content).To(Equal("Hi there!"))
+
+		state, err = dagapi.Checkout(apiTarget, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Branch).To(BeEmpty())
+		Expect(state.Head()).To(Equal(reply.Hash))
+	})
+
+	It("returns the API's error message", func() {
+		_, err := dagapi.Node(apiTarget, "zzzzzz")
+		Expect(err).To(MatchError("node not found"))
+	})
+})
+
+var _ = Describe("Preview", func() {
+	It("flattens and truncates text", func() {
+		content := []llm.ContentBlock{{Type: "text", Text: "first line\nsecond   line"}}
+		Expect(dagapi.Preview(content, 80)).To(Equal("first line second line"))
+		Expect(dagapi.Preview(content, 10)).To(Equal("first l..."))
+	})
+})
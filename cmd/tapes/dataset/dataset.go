@@ -0,0 +1,23 @@
+// Package datasetcmder provides the `tapes dataset` CLI commands for turning
+// recorded sessions into fine-tuning datasets.
+package datasetcmder
+
+import "github.com/spf13/cobra"
+
+// NewDatasetCmd creates the parent dataset command.
+func NewDatasetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dataset",
+		Short: "Build fine-tuning datasets from sessions",
+		Long: `Turn recorded sessions into fine-tuning datasets.
+
+Examples:
+  tapes dataset export -o train.jsonl
+  tapes dataset export --format anthropic --status completed -o train.jsonl
+  tapes dataset export --format parquet --validation 0.1 -o ./dataset`,
+	}
+
+	cmd.AddCommand(newExportCmd())
+
+	return cmd
+}
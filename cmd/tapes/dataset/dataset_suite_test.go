@@ -0,0 +1,13 @@
+package datasetcmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDatasetCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dataset Commander Suite")
+}
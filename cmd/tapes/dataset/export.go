@@ -0,0 +1,313 @@
+package datasetcmder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/dataset"
+	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+const exportLongDesc string = `Export conversations as a fine-tuning dataset.
+
+Every branch of the selected sessions becomes one example, running from the
+root of the conversation to its last assistant response. Branches that are
+a prefix of another selected branch are skipped, so turns shared by several
+branches are not trained on twice.
+
+Formats:
+  openai      OpenAI chat fine-tuning JSONL, with tool_calls and tool messages
+  anthropic   Anthropic Messages JSONL, with tool_use and tool_result blocks
+  sharegpt    ShareGPT conversations JSONL, with function_call and observation turns
+  parquet     A Parquet table of id, split, model and messages (OpenAI JSON)
+
+Select sessions with the same filters as "tapes deck", with extracted
+session facets, or by hash. Without a selection every session is exported.
+--rating and --label keep only the branches containing a node annotated
+with "tapes annotate" or from the deck.
+
+With --validation, examples are split deterministically by hash and --output
+names a directory that receives train and validation files.
+
+Examples:
+  tapes dataset export -o train.jsonl
+  tapes dataset export --status completed --since 168h -o train.jsonl
+  tapes dataset export --rating good -o good.jsonl
+  tapes dataset export --format anthropic --outcome fully_achieved -o train.jsonl
+  tapes dataset export --format sharegpt --tag ticket=ENG-42 > eng-42.jsonl
+  tapes dataset export --format parquet --validation 0.1 -o ./dataset
+  tapes dataset export abc123 def456 -o picked.jsonl`
+
+const exportShortDesc string = "Export conversations as a fine-tuning dataset"
+
+type exportCommander struct {
+	hashes     []string
+	sqlitePath string
+	format     string
+	output     string
+	validation float64
+
+	since   string
+	from    string
+	to      string
+	model   string
+	status  string
+	project string
+	tags    []string
+	params  []string
+	rating  string
+	label   string
+
+	facets dataset.FacetFilter
+}
+
+func newExportCmd() *cobra.Command {
+	cmder := &exportCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "export [hash...]",
+		Short: exportShortDesc,
+		Long:  exportLongDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hashes = args
+			return cmder.run(cmd.Context(), cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database")
+	cmd.Flags().StringVar(&cmder.format, "format", string(dataset.FormatOpenAI), "Output format (openai|anthropic|sharegpt|parquet)")
+	cmd.Flags().StringVarP(&cmder.output, "output", "o", "", "Output file, or directory when --validation is set")
+	cmd.Flags().Float64Var(&cmder.validation, "validation", 0, "Fraction of examples to hold out for validation (0 to 1)")
+	cmd.Flags().StringVar(&cmder.since, "since", "", "Look back duration (e.g. 24h)")
+	cmd.Flags().StringVar(&cmder.from, "from", "", "Start time (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().StringVar(&cmder.to, "to", "", "End time (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().StringVar(&cmder.model, "model", "", "Filter by model")
+	cmd.Flags().StringVar(&cmder.status, "status", "", "Filter by status (completed|failed|abandoned)")
+	cmd.Flags().StringVar(&cmder.project, "project", "", "Filter by project name")
+	cmd.Flags().StringArrayVar(&cmder.tags, "tag", nil, "Filter by session metadata tag key=value (repeatable)")
+	cmd.Flags().StringArrayVar(&cmder.params, "param", nil, "Filter by generation parameter key=value (repeatable)")
+	cmd.Flags().StringVar(&cmder.rating, "rating", "", "Export branches with a message rated good|bad|failure")
+	cmd.Flags().StringVar(&cmder.label, "label", "", "Export branches with a message annotated with a label")
+	cmd.Flags().StringVar(&cmder.facets.Outcome, "outcome", "", "Filter by extracted session outcome")
+	cmd.Flags().StringVar(&cmder.facets.GoalCategory, "goal-category", "", "Filter by extracted session goal category")
+	cmd.Flags().StringVar(&cmder.facets.SessionType, "session-type", "", "Filter by extracted session type")
+
+	return cmd
+}
+
+func (c *exportCommander) run(ctx context.Context, cmd *cobra.Command) error {
+	format, err := dataset.ParseFormat(c.format)
+	if err != nil {
+		return err
+	}
+	if c.validation < 0 || c.validation >= 1 {
+		return fmt.Errorf("--validation must be in [0, 1), got %v", c.validation)
+	}
+	toStdout := c.output == "" || c.output == "-"
+	if toStdout && c.validation > 0 {
+		return errors.New("--validation requires --output to name a directory")
+	}
+	if toStdout && format == dataset.FormatParquet {
+		return errors.New("parquet output requires --output")
+	}
+
+	filters, err := c.parseFilters()
+	if err != nil {
+		return err
+	}
+	filtered := !isZeroFilters(filters) || !c.facets.IsZero()
+	if filtered && len(c.hashes) > 0 {
+		return errors.New("hashes cannot be combined with filters")
+	}
+
+	dbPath, err := sqlitepath.ResolveSQLitePath(c.sqlitePath)
+	if err != nil {
+		return fmt.Errorf("could not resolve database: %w", err)
+	}
+
+	hashes, err := c.selectHashes(ctx, dbPath, filters)
+	if err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return errors.New("no conversations match")
+	}
+
+	driver, err := sqlite.NewDriver(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open database %s: %w", dbPath, err)
+	}
+	defer driver.Close()
+
+	if filters.Rating != "" || filters.Label != "" {
+		hashes, err = dataset.Annotated(ctx, driver, hashes, storage.AnnotationFilter{Rating: filters.Rating, Label: filters.Label})
+		if err != nil {
+			return err
+		}
+	}
+
+	examples, err := dataset.Build(ctx, driver, hashes, c.validation)
+	if err != nil {
+		return err
+	}
+	if len(examples) == 0 {
+		return errors.New("no selected conversation has an assistant response")
+	}
+
+	if toStdout {
+		return dataset.Write(cmd.OutOrStdout(), format, examples)
+	}
+
+	if c.validation == 0 {
+		if err := writeFile(c.output, format, examples); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d examples to %s\n", len(examples), c.output)
+		return nil
+	}
+
+	if err := os.MkdirAll(c.output, 0o755); err != nil {
+		return fmt.Errorf("creating dataset directory: %w", err)
+	}
+	for _, split := range []string{dataset.SplitTrain, dataset.SplitValidation} {
+		var subset []*dataset.Example
+		for _, example := range examples {
+			if example.Split == split {
+				subset = append(subset, example)
+			}
+		}
+		path := filepath.Join(c.output, split+"."+format.Extension())
+		if err := writeFile(path, format, subset); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d %s examples to %s\n", len(subset), split, path)
+	}
+	return nil
+}
+
+// selectHashes resolves the conversations to export: the given hashes, or
+// the leaves of every session matching the filters.
+func (c *exportCommander) selectHashes(ctx context.Context, dbPath string, filters deck.Filters) ([]string, error) {
+	if len(c.hashes) > 0 {
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not open database %s: %w", dbPath, err)
+		}
+		defer driver.Close()
+
+		hashes := make([]string, 0, len(c.hashes))
+		for _, arg := range c.hashes {
+			hash, err := dagapi.ResolveHead(arg)
+			if err != nil {
+				return nil, err
+			}
+			hash, err = storage.ResolveHash(ctx, driver, hash)
+			if err != nil {
+				return nil, err
+			}
+			hashes = append(hashes, hash)
+		}
+		return hashes, nil
+	}
+
+	query, closeFn, err := deck.NewQuery(ctx, dbPath, deck.DefaultPricing())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = closeFn() }()
+
+	return dataset.Select(ctx, query, filters, deck.NewEntFacetStore(query.EntClient()), c.facets)
+}
+
+func (c *exportCommander) parseFilters() (deck.Filters, error) {
+	filters := deck.Filters{
+		Model:   strings.TrimSpace(c.model),
+		Status:  strings.TrimSpace(c.status),
+		Project: strings.TrimSpace(c.project),
+	}
+
+	tags, err := storage.ParseTags(c.tags)
+	if err != nil {
+		return filters, err
+	}
+	filters.Tags = tags
+
+	params, err := storage.ParseTags(c.params)
+	if err != nil {
+		return filters, err
+	}
+	filters.Params = params
+
+	rating, err := storage.ParseRating(c.rating)
+	if err != nil {
+		return filters, err
+	}
+	filters.Rating = rating
+	filters.Label = strings.TrimSpace(c.label)
+
+	if c.since != "" {
+		duration, err := time.ParseDuration(c.since)
+		if err != nil {
+			return filters, fmt.Errorf("invalid since duration: %w", err)
+		}
+		filters.Since = duration
+	}
+
+	if c.from != "" {
+		parsed, err := parseTime(c.from)
+		if err != nil {
+			return filters, fmt.Errorf("invalid from time: %w", err)
+		}
+		filters.From = &parsed
+	}
+
+	if c.to != "" {
+		parsed, err := parseTime(c.to)
+		if err != nil {
+			return filters, fmt.Errorf("invalid to time: %w", err)
+		}
+		filters.To = &parsed
+	}
+
+	return filters, nil
+}
+
+func isZeroFilters(f deck.Filters) bool {
+	return f.Since == 0 && f.From == nil && f.To == nil && f.Model == "" &&
+		f.Status == "" && f.Project == "" && len(f.Tags) == 0 && len(f.Params) == 0 &&
+		f.Rating == "" && f.Label == ""
+}
+
+func parseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse("2006-01-02", value); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, errors.New("expected RFC3339 or YYYY-MM-DD")
+}
+
+func writeFile(path string, format dataset.Format, examples []*dataset.Example) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", path, err)
+	}
+	if err := dataset.Write(f, format, examples); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,116 @@
+package datasetcmder
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+func datasetTestBucket(role, text string) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:    "test-model",
+		Provider: "test",
+	}
+}
+
+var _ = Describe("Dataset Export Command", func() {
+	var (
+		ctx    context.Context
+		dir    string
+		dbPath string
+
+		reply, fork *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		dbPath = filepath.Join(dir, "tapes.sqlite")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+
+		root := merkle.NewNode(datasetTestBucket("user", "Hello"), nil, merkle.NodeMeta{Project: "tapes"})
+		reply = merkle.NewNode(datasetTestBucket("assistant", "Hi there!"), root, merkle.NodeMeta{Project: "tapes"})
+		other := merkle.NewNode(datasetTestBucket("user", "Bye"), nil, merkle.NodeMeta{Project: "other"})
+		fork = merkle.NewNode(datasetTestBucket("assistant", "Goodbye!"), other, merkle.NodeMeta{Project: "other"})
+		for _, n := range []*merkle.Node{root, reply, other, fork} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	execute := func(args ...string) (string, string, error) {
+		var out, errOut bytes.Buffer
+		cmd := newExportCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&errOut)
+		cmd.SetArgs(append(args, "--sqlite", dbPath))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), errOut.String(), err
+	}
+
+	It("exports every session to stdout by default", func() {
+		out, _, err := execute()
+		Expect(err).NotTo(HaveOccurred())
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(out).To(ContainSubstring(`{"role":"assistant","content":"Hi there!"}`))
+	})
+
+	It("filters sessions like deck", func() {
+		out, _, err := execute("--project", "other", "--format", "sharegpt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.TrimSpace(out)).To(Equal(`{"conversations":[{"from":"human","value":"Bye"},{"from":"gpt","value":"Goodbye!"}]}`))
+	})
+
+	It("exports given hashes", func() {
+		out, _, err := execute(reply.Hash[:12], "--format", "anthropic")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Hi there!"))
+		Expect(out).NotTo(ContainSubstring("Goodbye!"))
+	})
+
+	It("writes train and validation files into a directory", func() {
+		target := filepath.Join(dir, "dataset")
+		_, stderr, err := execute("--format", "parquet", "--validation", "0.5", "-o", target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stderr).To(ContainSubstring("train examples"))
+
+		for _, name := range []string{"train.parquet", "validation.parquet"} {
+			raw, err := os.ReadFile(filepath.Join(target, name))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw[:4])).To(Equal("PAR1"))
+		}
+	})
+
+	It("rejects invalid combinations", func() {
+		_, _, err := execute("--format", "parquet")
+		Expect(err).To(MatchError(ContainSubstring("requires --output")))
+
+		_, _, err = execute("--validation", "0.2")
+		Expect(err).To(MatchError(ContainSubstring("directory")))
+
+		_, _, err = execute(reply.Hash, "--project", "tapes")
+		Expect(err).To(MatchError(ContainSubstring("cannot be combined")))
+
+		_, _, err = execute("--format", "csv")
+		Expect(err).To(HaveOccurred())
+
+		_, _, err = execute("--outcome", "fully_achieved")
+		Expect(err).To(MatchError("no conversations match"))
+	})
+})
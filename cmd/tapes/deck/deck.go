@@ -13,6 +13,7 @@ import (
 	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
 	"github.com/papercomputeco/tapes/pkg/credentials"
 	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/storage"
 )
 
 const (
@@ -26,6 +27,9 @@ Examples:
   tapes deck --from 2026-01-30 --to 2026-01-31
   tapes deck --sort cost --model claude-sonnet-4.5
   tapes deck --session sess_a8f2c1d3
+  tapes deck --tag ticket=ENG-42 --tag arm=b
+  tapes deck --param reasoning_effort=high
+  tapes deck --rating failure
   tapes deck --web
   tapes deck --web --port 9999
   tapes deck --pricing ./pricing.json
@@ -51,6 +55,10 @@ type deckCommander struct {
 	model            string
 	status           string
 	project          string
+	tags             []string
+	params           []string
+	rating           string
+	label            string
 	session          string
 	refresh          uint
 	web              bool
@@ -87,6 +95,10 @@ func NewDeckCmd() *cobra.Command {
 	cmd.Flags().StringVar(&cmder.model, "model", "", "Filter by model")
 	cmd.Flags().StringVar(&cmder.status, "status", "", "Filter by status (completed|failed|abandoned)")
 	cmd.Flags().StringVar(&cmder.project, "project", "", "Filter by project name")
+	cmd.Flags().StringArrayVar(&cmder.tags, "tag", nil, "Filter by session metadata tag key=value (repeatable)")
+	cmd.Flags().StringArrayVar(&cmder.params, "param", nil, "Filter by generation parameter key=value, e.g. temperature=0 (repeatable)")
+	cmd.Flags().StringVar(&cmder.rating, "rating", "", "Filter by sessions with a message rated good|bad|failure")
+	cmd.Flags().StringVar(&cmder.label, "label", "", "Filter by sessions with a message annotated with a label")
 	cmd.Flags().StringVar(&cmder.session, "session", "", "Drill into a specific session ID")
 	cmd.Flags().UintVar(&cmder.refresh, "refresh", 10, "Auto-refresh interval in seconds (0 to disable)")
 	cmd.Flags().BoolVar(&cmder.web, "web", false, "Serve the web dashboard locally")
@@ -249,6 +261,25 @@ func (c *deckCommander) parseFilters() (deck.Filters, error) {
 		filters.SortDir = sortDirDesc
 	}
 
+	tags, err := storage.ParseTags(c.tags)
+	if err != nil {
+		return filters, err
+	}
+	filters.Tags = tags
+
+	params, err := storage.ParseTags(c.params)
+	if err != nil {
+		return filters, err
+	}
+	filters.Params = params
+
+	rating, err := storage.ParseRating(c.rating)
+	if err != nil {
+		return filters, err
+	}
+	filters.Rating = rating
+	filters.Label = strings.TrimSpace(c.label)
+
 	if c.since != "" {
 		duration, err := time.ParseDuration(c.since)
 		if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/muesli/termenv"
 
 	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/storage"
 )
 
 // themeOverride is set by the CLI --theme flag before the TUI starts.
@@ -156,6 +157,7 @@ type deckModel struct {
 	searchActive     bool
 	sortedCache      *sortedMessagesCache
 	sortedGroupCache *sortedGroupCache
+	author           string
 }
 
 type sortedMessagesCache struct {
@@ -384,15 +386,16 @@ type deckKeyMap struct {
 	Search key.Binding
 	Period key.Binding
 	Replay key.Binding
+	Rate   key.Binding
 	Quit   key.Binding
 }
 
 func (k deckKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Down, k.Up, k.Enter, k.Back, k.Sort, k.Filter, k.Search, k.Period, k.Replay, k.Quit}
+	return []key.Binding{k.Down, k.Up, k.Enter, k.Back, k.Sort, k.Filter, k.Search, k.Period, k.Replay, k.Rate, k.Quit}
 }
 
 func (k deckKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{{k.Down, k.Up, k.Enter, k.Back}, {k.Sort, k.Filter, k.Search, k.Period, k.Replay, k.Quit}}
+	return [][]key.Binding{{k.Down, k.Up, k.Enter, k.Back}, {k.Sort, k.Filter, k.Search, k.Period, k.Replay, k.Rate, k.Quit}}
 }
 
 func defaultKeyMap() deckKeyMap {
@@ -406,10 +409,15 @@ func defaultKeyMap() deckKeyMap {
 		Search: key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
 		Period: key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "period")),
 		Replay: key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "replay")),
+		Rate:   key.NewBinding(key.WithKeys("+", "-", "!", "0"), key.WithHelp("+/-/!/0", "rate good/bad/failure/clear")),
 		Quit:   key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
 	}
 }
 
+type messageRatedMsg struct {
+	err error
+}
+
 type sessionLoadedMsg struct {
 	detail *deck.SessionDetail
 	err    error
@@ -554,6 +562,7 @@ func newDeckModel(query deck.Querier, filters deck.Filters, overview *deck.Overv
 		searchInput:      ti,
 		sortedCache:      &sortedMessagesCache{},
 		sortedGroupCache: &sortedGroupCache{},
+		author:           storage.DefaultAuthor(),
 	}
 }
 
@@ -588,7 +597,7 @@ func (m deckModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
 		m.metricsReady = false
 		m.overviewStats = nil
 
-		metricsCmd := computeMetricsCmd(m.overview.Sessions)
+		metricsCmd := computeMetricsCmd(m.overview.Sessions, m.overview.Embeddings)
 
 		// Try to find the previously selected session in the filtered list
 		// so the cursor stays valid when search is active.
@@ -619,6 +628,11 @@ func (m deckModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
 		m.metricsReady = true
 		m.overviewStats = &msg.stats
 		return m, nil
+	case messageRatedMsg:
+		if msg.err != nil || m.detail == nil {
+			return m, nil
+		}
+		return m, loadSessionCmd(m.query, m.detail.Summary.ID, true)
 	case sessionLoadedMsg:
 		if msg.err != nil {
 			return m, nil
@@ -863,6 +877,10 @@ func (m deckModel) handleKey(msg bubbletea.KeyMsg) (bubbletea.Model, bubbletea.C
 		if m.view == viewOverview || m.view == viewAnalytics {
 			return m.cyclePeriod()
 		}
+	case "+", "-", "!", "0":
+		if m.view == viewSession {
+			return m.rateSelected(messageRatings[msg.String()])
+		}
 	case "r":
 		if m.view == viewSession {
 			if m.replayActive {
@@ -885,6 +903,31 @@ func (m deckModel) handleKey(msg bubbletea.KeyMsg) (bubbletea.Model, bubbletea.C
 	return m, nil
 }
 
+// messageRatings maps the session view's rating keys to ratings.
+var messageRatings = map[string]storage.Rating{
+	"+": storage.RatingGood,
+	"-": storage.RatingBad,
+	"!": storage.RatingFailure,
+	"0": "",
+}
+
+// rateSelected rates the selected message, or the last message of the
+// selected group, and reloads the session to show it.
+func (m deckModel) rateSelected(rating storage.Rating) (bubbletea.Model, bubbletea.Cmd) {
+	rater, ok := m.query.(deck.Rater)
+	if !ok {
+		return m, nil
+	}
+	msg := m.selectedMessage()
+	if msg == nil {
+		return m, nil
+	}
+	hash, author := msg.Hash, m.author
+	return m, func() bubbletea.Msg {
+		return messageRatedMsg{err: rater.RateMessage(context.Background(), hash, author, rating)}
+	}
+}
+
 func (m deckModel) handleModalKey(msg bubbletea.KeyMsg) (bubbletea.Model, bubbletea.Cmd) {
 	switch msg.String() {
 	case "ctrl+c", "q":
@@ -1842,7 +1885,11 @@ func (m deckModel) viewSession() string {
 	breadcrumb += deckMutedStyle.Render(" > ") + deckTitleStyle.Render(m.detail.Summary.Label)
 	headerRight := deckMutedStyle.Render(fmt.Sprintf("%s · %s %s", m.detail.Summary.ID, statusDot, m.detail.Summary.Status))
 	if len(m.detail.SubSessions) > 1 {
-		headerRight = deckMutedStyle.Render(fmt.Sprintf("%d sessions · %s %s", len(m.detail.SubSessions), statusDot, m.detail.Summary.Status))
+		sessions := fmt.Sprintf("%d sessions", len(m.detail.SubSessions))
+		if subagents := countSubagentSessions(m.detail.SubSessions); subagents > 0 {
+			sessions += fmt.Sprintf(" (%d subagent)", subagents)
+		}
+		headerRight = deckMutedStyle.Render(fmt.Sprintf("%s · %s %s", sessions, statusDot, m.detail.Summary.Status))
 	}
 	header := renderHeaderLine(m.width, breadcrumb, headerRight)
 	lines := make([]string, 0, 30)
@@ -2246,6 +2293,22 @@ func (m deckModel) renderAnalyticsTabBar(width int) string {
 	return renderHeaderLine(width, tabBar, hint)
 }
 
+// latestHeadroom returns the tightest rate-limit headroom on the most recent
+// day with rate-limit data. Points are ordered by date.
+func latestHeadroom(points []deck.HeadroomPoint) (deck.HeadroomPoint, bool) {
+	if len(points) == 0 {
+		return deck.HeadroomPoint{}, false
+	}
+
+	latest := points[len(points)-1]
+	for _, point := range points {
+		if point.Date == latest.Date && point.Headroom < latest.Headroom {
+			latest = point
+		}
+	}
+	return latest, true
+}
+
 // renderAnalyticsSectionHeader renders "LABEL ────────────────" like the web's section-header.
 func renderAnalyticsSectionHeader(label string, width int) string {
 	rendered := deckMutedStyle.Bold(true).Render(strings.ToUpper(label))
@@ -2270,6 +2333,20 @@ func (m deckModel) renderAnalyticsSummaryCards(a *deck.AnalyticsOverview, width
 		{label: "AVG DURATION", value: formatDurationMinutes(avgDuration), sub: "per session avg"},
 		{label: "MODELS TRACKED", value: strconv.Itoa(modelCount), sub: fmt.Sprintf("%d providers", len(a.ProviderBreakdown))},
 	}
+	if a.CacheHits+a.CacheMisses > 0 {
+		cards = append(cards, metricCard{
+			label: "CACHE HIT RATE",
+			value: formatPercent(a.CacheHitRate),
+			sub:   fmt.Sprintf("%d of %d requests", a.CacheHits, a.CacheHits+a.CacheMisses),
+		})
+	}
+	if point, ok := latestHeadroom(a.RateLimitHeadroom); ok {
+		cards = append(cards, metricCard{
+			label: "RATE LIMIT HEADROOM",
+			value: formatPercent(point.Headroom),
+			sub:   fmt.Sprintf("%s %s on %s", point.Provider, point.Metric, point.Date),
+		})
+	}
 
 	cols := len(cards)
 	gap := 3
@@ -3578,9 +3655,10 @@ func loadOverviewCmd(query deck.Querier, filters deck.Filters) bubbletea.Cmd {
 	}
 }
 
-func computeMetricsCmd(sessions []deck.SessionSummary) bubbletea.Cmd {
+func computeMetricsCmd(sessions []deck.SessionSummary, embeddings *deck.EmbeddingSummary) bubbletea.Cmd {
 	return func() bubbletea.Msg {
 		stats := summarizeSessions(sessions)
+		addEmbeddingCosts(&stats, embeddings)
 		return metricsReadyMsg{stats: stats}
 	}
 }
@@ -4611,6 +4689,9 @@ func (m deckModel) renderMessageDetailPane(width, height int) []string {
 		if group.Count > 1 {
 			contentLines = append(contentLines, deckMutedStyle.Render(fmt.Sprintf("Messages: %d", group.Count)))
 		}
+		if line := annotationLine(m.selectedMessage()); line != "" {
+			contentLines = append(contentLines, line)
+		}
 		contentLines = append(contentLines, "")
 
 		contentLines = append(contentLines, deckMutedStyle.Render("Tokens: ")+fmt.Sprintf(
@@ -4625,6 +4706,9 @@ func (m deckModel) renderMessageDetailPane(width, height int) []string {
 			formatCost(group.OutputCost),
 			deckAccentStyle.Render(formatCost(group.TotalCost)),
 		))
+		if line := subagentLine(m.selectedMessage()); line != "" {
+			contentLines = append(contentLines, line)
+		}
 		contentLines = append(contentLines, "")
 
 		if len(group.ToolCalls) > 0 {
@@ -4698,6 +4782,9 @@ func (m deckModel) renderMessageDetailPane(width, height int) []string {
 		timeInfo += fmt.Sprintf("  (+%s)", formatDuration(msg.Delta))
 	}
 	contentLines = append(contentLines, timeInfo)
+	if line := annotationLine(&msg); line != "" {
+		contentLines = append(contentLines, line)
+	}
 	contentLines = append(contentLines, "")
 
 	// Token + cost breakdown (inline to save vertical space)
@@ -4705,6 +4792,9 @@ func (m deckModel) renderMessageDetailPane(width, height int) []string {
 		fmt.Sprintf("In %s  Out %s  Total %s", formatTokensDetail(msg.InputTokens), formatTokensDetail(msg.OutputTokens), formatTokensDetail(msg.TotalTokens)))
 	contentLines = append(contentLines, deckMutedStyle.Render("Cost:   ")+
 		fmt.Sprintf("In %s  Out %s  Total %s", formatCost(msg.InputCost), formatCost(msg.OutputCost), deckAccentStyle.Render(formatCost(msg.TotalCost))))
+	if line := subagentLine(&msg); line != "" {
+		contentLines = append(contentLines, line)
+	}
 	contentLines = append(contentLines, "")
 
 	// Tools
@@ -4828,6 +4918,23 @@ func summarizeSessions(sessions []deck.SessionSummary) deckOverviewStats {
 	return stats
 }
 
+// addEmbeddingCosts folds embedding call costs into the overview stats so
+// retrieval spend shows up alongside chat spend.
+func addEmbeddingCosts(stats *deckOverviewStats, embeddings *deck.EmbeddingSummary) {
+	if embeddings == nil {
+		return
+	}
+	stats.TotalCost += embeddings.TotalCost
+	for model, cost := range embeddings.CostByModel {
+		modelCost := stats.CostByModel[model]
+		modelCost.Model = model
+		modelCost.InputTokens += cost.InputTokens
+		modelCost.InputCost += cost.InputCost
+		modelCost.TotalCost += cost.TotalCost
+		stats.CostByModel[model] = modelCost
+	}
+}
+
 func (m deckModel) selectedSessions() []deck.SessionSummary {
 	if m.overview == nil || len(m.overview.Sessions) == 0 {
 		return nil
@@ -5020,6 +5127,70 @@ func (m deckModel) selectedGroup() *deck.SessionMessageGroup {
 	return &groups[index]
 }
 
+// selectedMessage returns the message under the cursor. For a group it
+// returns the group's last message.
+func (m deckModel) selectedMessage() *deck.SessionMessage {
+	if m.detail == nil {
+		return nil
+	}
+	if group := m.selectedGroup(); group != nil {
+		if group.EndIndex <= 0 || group.EndIndex > len(m.detail.Messages) {
+			return nil
+		}
+		return &m.detail.Messages[group.EndIndex-1]
+	}
+	messages := m.sortedMessages()
+	if len(messages) == 0 {
+		return nil
+	}
+	return &messages[clamp(m.messageCursor, len(messages)-1)]
+}
+
+// annotationLine renders a message's rating and labels, or "" when it has
+// neither.
+func annotationLine(msg *deck.SessionMessage) string {
+	if msg == nil || (msg.Rating == "" && len(msg.Labels) == 0) {
+		return ""
+	}
+	var parts []string
+	if msg.Rating != "" {
+		style := lipgloss.NewStyle().Foreground(colorGreen)
+		if msg.Rating != storage.RatingGood {
+			style = lipgloss.NewStyle().Foreground(colorRed)
+		}
+		parts = append(parts, deckMutedStyle.Render("Rating: ")+style.Render(string(msg.Rating)))
+	}
+	if len(msg.Labels) > 0 {
+		parts = append(parts, deckMutedStyle.Render("Labels: ")+strings.Join(msg.Labels, ", "))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// countSubagentSessions returns how many of a group's sessions were spawned
+// by a subagent tool call.
+func countSubagentSessions(sessions []deck.SessionSummary) int {
+	count := 0
+	for _, session := range sessions {
+		if session.ParentHash != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// subagentLine renders the number and total cost of the subagents a message
+// spawned, or "" when it spawned none.
+func subagentLine(msg *deck.SessionMessage) string {
+	if msg == nil || len(msg.Subagents) == 0 {
+		return ""
+	}
+	noun := "subagent"
+	if len(msg.Subagents) > 1 {
+		noun = "subagents"
+	}
+	return deckMutedStyle.Render("Spawned: ") + fmt.Sprintf("%d %s  Cost %s", len(msg.Subagents), noun, deckAccentStyle.Render(formatCost(msg.SubagentCost)))
+}
+
 func isSelectedGroup(selected *deck.SessionMessageGroup, candidate *deck.SessionMessageGroup) bool {
 	if selected == nil || candidate == nil {
 		return false
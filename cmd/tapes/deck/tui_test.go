@@ -71,6 +71,24 @@ var _ = Describe("Deck TUI helpers", func() {
 		})
 	})
 
+	Describe("addEmbeddingCosts", func() {
+		It("folds embedding spend into the totals without counting sessions", func() {
+			stats := summarizeSessions([]deck.SessionSummary{{ID: "s1", Model: "m1", TotalCost: 0.30}})
+			addEmbeddingCosts(&stats, &deck.EmbeddingSummary{
+				Calls:     3,
+				TotalCost: 0.02,
+				CostByModel: map[string]deck.ModelCost{
+					"text-embedding-3-small": {Model: "text-embedding-3-small", InputTokens: 1_000_000, InputCost: 0.02, TotalCost: 0.02},
+				},
+			})
+
+			Expect(stats.TotalSessions).To(Equal(1))
+			Expect(stats.TotalCost).To(BeNumerically("~", 0.32, 0.0001))
+			Expect(stats.CostByModel["text-embedding-3-small"].TotalCost).To(BeNumerically("~", 0.02, 0.0001))
+			Expect(stats.CostByModel["text-embedding-3-small"].SessionCount).To(BeZero())
+		})
+	})
+
 	Describe("selectedSessions", func() {
 		It("returns all sessions", func() {
 			sessions := []deck.SessionSummary{{ID: "s1"}, {ID: "s2"}, {ID: "s3"}}
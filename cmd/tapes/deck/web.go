@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	deckweb "github.com/papercomputeco/tapes/web/deck"
 )
 
@@ -87,6 +88,43 @@ func runDeckWeb(ctx context.Context, query deck.Querier, filters deck.Filters, p
 		writeJSON(w, detail)
 	})
 
+	// POST /api/message/<hash>/rating rates a message as the local user.
+	mux.HandleFunc("/api/message/", func(w http.ResponseWriter, r *http.Request) {
+		hash, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/api/message/"), "/rating")
+		if !ok || hash == "" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		rater, ok := query.(deck.Rater)
+		if !ok {
+			http.Error(w, "rating is not supported", http.StatusNotImplemented)
+			return
+		}
+
+		var req struct {
+			Rating string `json:"rating"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid JSON body", http.StatusBadRequest)
+			return
+		}
+		rating, err := storage.ParseRating(req.Rating)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := rater.RateMessage(r.Context(), hash, storage.DefaultAuthor(), rating); err != nil {
+			writeJSONError(w, err)
+			return
+		}
+		writeJSON(w, map[string]string{"hash": hash, "rating": string(rating)})
+	})
+
 	// Facet endpoints — real data when extractor is configured, empty stubs otherwise.
 	mux.HandleFunc("/api/facets", func(w http.ResponseWriter, r *http.Request) {
 		if facets == nil || facets.extractor == nil {
@@ -189,6 +227,30 @@ func applyWebFilters(base deck.Filters, r *http.Request) (deck.Filters, error) {
 	if value := strings.TrimSpace(query.Get("project")); value != "" {
 		filters.Project = value
 	}
+	if value := strings.TrimSpace(query.Get("rating")); value != "" {
+		rating, err := storage.ParseRating(value)
+		if err != nil {
+			return filters, err
+		}
+		filters.Rating = rating
+	}
+	if value := strings.TrimSpace(query.Get("label")); value != "" {
+		filters.Label = value
+	}
+	if values := query["tag"]; len(values) > 0 {
+		tags, err := storage.ParseTags(values)
+		if err != nil {
+			return filters, err
+		}
+		filters.Tags = tags
+	}
+	if values := query["param"]; len(values) > 0 {
+		params, err := storage.ParseTags(values)
+		if err != nil {
+			return filters, err
+		}
+		filters.Params = params
+	}
 	if value := strings.TrimSpace(query.Get("since")); value != "" {
 		duration, err := parseSince(value)
 		if err != nil {
@@ -0,0 +1,241 @@
+// Package diffcmder provides the diff subcommand for comparing two
+// conversation branches in the DAG.
+package diffcmder
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/api"
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/logger"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+)
+
+type diffCommander struct {
+	hashA     string
+	hashB     string
+	width     int
+	maxLines  int
+	apiTarget string
+	debug     bool
+
+	logger *zap.Logger
+}
+
+const diffLongDesc string = `Compare two conversation branches.
+
+Fetches the branches ending at the two given hashes from the API server,
+finds their fork point (lowest common ancestor) and renders the turns after
+it side by side. Changes in role, content, model and token usage are
+highlighted per turn. Hashes may be abbreviated to any unique prefix, or be
+a tag, a branch or HEAD.
+
+Examples:
+  tapes diff abc123def456 789abc012def
+  tapes diff main HEAD
+  tapes diff abc123def456 789abc012def --width 160`
+
+const diffShortDesc string = "Compare two conversation branches"
+
+var (
+	sideAStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("203"))
+	sideBStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("82"))
+	changedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+	dividerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+)
+
+func NewDiffCmd() *cobra.Command {
+	cmder := &diffCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "diff <hashA> <hashB>",
+		Short: diffShortDesc,
+		Long:  diffLongDesc,
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			configDir, _ := cmd.Flags().GetString("config-dir")
+			cfger, err := config.NewConfiger(configDir)
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			cfg, err := cfger.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			if !cmd.Flags().Changed("api-target") {
+				cmder.apiTarget = cfg.Client.APITarget
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hashA, cmder.hashB = args[0], args[1]
+
+			var err error
+			cmder.debug, err = cmd.Flags().GetBool("debug")
+			if err != nil {
+				return fmt.Errorf("could not get debug flag: %w", err)
+			}
+
+			return cmder.run()
+		},
+	}
+
+	defaults := config.NewDefaultConfig()
+	cmd.Flags().StringVarP(&cmder.apiTarget, "api-target", "a", defaults.Client.APITarget, "Tapes API server URL")
+	cmd.Flags().IntVarP(&cmder.width, "width", "w", 120, "Total width of the side-by-side output")
+	cmd.Flags().IntVar(&cmder.maxLines, "max-lines", 8, "Maximum content lines shown per turn (0 for no limit)")
+
+	return cmd
+}
+
+func (c *diffCommander) run() error {
+	c.logger = logger.NewLogger(c.debug)
+	defer func() { _ = c.logger.Sync() }()
+
+	c.logger.Debug("diffing branches",
+		zap.String("a", c.hashA),
+		zap.String("b", c.hashB),
+		zap.String("api_target", c.apiTarget),
+	)
+
+	hashA, err := dagapi.ResolveHead(c.hashA)
+	if err != nil {
+		return err
+	}
+	hashB, err := dagapi.ResolveHead(c.hashB)
+	if err != nil {
+		return err
+	}
+
+	diff, err := dagapi.Diff(c.apiTarget, hashA, hashB)
+	if err != nil {
+		return fmt.Errorf("fetching diff: %w", err)
+	}
+
+	Render(os.Stdout, diff, c.width, c.maxLines)
+	return nil
+}
+
+// Render writes a side-by-side rendering of diff to w: a summary of the
+// shared prefix, then one row per divergent turn with branch A on the left
+// and branch B on the right.
+func Render(w io.Writer, diff *api.DiffResponse, width, maxLines int) {
+	colWidth := max((width-3)/2, 20)
+
+	fmt.Fprintf(w, "\n%s %s\n", cliui.HeaderStyle.Render("A:"), sideAStyle.Render(diff.AHash))
+	fmt.Fprintf(w, "%s %s\n", cliui.HeaderStyle.Render("B:"), sideBStyle.Render(diff.BHash))
+
+	if diff.AncestorHash == "" {
+		fmt.Fprintf(w, "%s\n\n", cliui.DimStyle.Render("The branches do not share a root."))
+	} else {
+		fmt.Fprintf(w, "%s %s %s\n\n",
+			cliui.DimStyle.Render("Forked after"),
+			cliui.HashStyle.Render(diff.AncestorHash),
+			cliui.DimStyle.Render(fmt.Sprintf("(%d shared turns)", len(diff.Shared))),
+		)
+	}
+
+	if len(diff.Turns) == 0 {
+		fmt.Fprintln(w, cliui.DimStyle.Render("The branches are identical."))
+		return
+	}
+
+	messages := make(map[string]api.HistoryMessage, len(diff.A)+len(diff.B))
+	for _, side := range [][]api.HistoryMessage{diff.A, diff.B} {
+		for _, msg := range side {
+			messages[msg.Hash] = msg
+		}
+	}
+
+	divider := dividerStyle.Render(strings.Repeat("─", colWidth*2+3))
+	for _, turn := range diff.Turns {
+		fmt.Fprintf(w, "%s %s\n", cliui.HeaderStyle.Render(fmt.Sprintf("Turn %d", turn.Depth)), turnSummary(turn))
+
+		left := renderCell(messages, turn.AHash, turn, sideAStyle, colWidth, maxLines)
+		right := renderCell(messages, turn.BHash, turn, sideBStyle, colWidth, maxLines)
+		height := max(lipgloss.Height(left), lipgloss.Height(right))
+		separator := dividerStyle.Render(strings.TrimSuffix(strings.Repeat(" │ \n", height), "\n"))
+		fmt.Fprintln(w, lipgloss.JoinHorizontal(lipgloss.Top, left, separator, right))
+		fmt.Fprintln(w, divider)
+	}
+}
+
+// turnSummary lists what changed between the two sides of a turn.
+func turnSummary(turn api.DiffTurn) string {
+	if turn.AHash == "" || turn.BHash == "" {
+		return changedStyle.Render("only on one branch")
+	}
+
+	var changes []string
+	if turn.RoleChanged {
+		changes = append(changes, "role")
+	}
+	if turn.ContentChanged {
+		changes = append(changes, "content")
+	}
+	if turn.ModelChanged {
+		changes = append(changes, "model")
+	}
+	if turn.UsageChanged {
+		changes = append(changes, "usage")
+	}
+	if len(changes) == 0 {
+		return cliui.DimStyle.Render("unchanged")
+	}
+	return changedStyle.Render(strings.Join(changes, ", ") + " changed")
+}
+
+// renderCell renders one side of a turn as a fixed-width column.
+func renderCell(messages map[string]api.HistoryMessage, hash string, turn api.DiffTurn, side lipgloss.Style, width, maxLines int) string {
+	cell := lipgloss.NewStyle().Width(width)
+
+	msg, ok := messages[hash]
+	if !ok {
+		return cell.Render(cliui.DimStyle.Render("(no turn)"))
+	}
+
+	header := []string{
+		highlight(cliui.RoleStyle, turn.RoleChanged, "["+msg.Role+"]"),
+		highlight(cliui.DimStyle, turn.ModelChanged, msg.Model),
+	}
+	if msg.Usage != nil {
+		header = append(header, highlight(cliui.DimStyle, turn.UsageChanged,
+			fmt.Sprintf("%d→%d tok", msg.Usage.PromptTokens, msg.Usage.CompletionTokens)))
+	}
+
+	bucket := merkle.Bucket{Content: msg.Content}
+	text := bucket.ExtractText()
+	if text == "" {
+		text = "(no text content)"
+	}
+	body := cliui.PreviewStyle
+	if turn.ContentChanged {
+		body = side
+	}
+	content := lipgloss.NewStyle().Width(width).Render(text)
+	if lines := strings.Split(content, "\n"); maxLines > 0 && len(lines) > maxLines {
+		content = strings.Join(lines[:maxLines], "\n") + "\n…"
+	}
+
+	return cell.Render(strings.Join(header, " ") + "\n" + body.Render(content))
+}
+
+// highlight renders text in the changed style when changed is set, and in
+// style otherwise.
+func highlight(style lipgloss.Style, changed bool, text string) string {
+	if changed {
+		return changedStyle.Render(text)
+	}
+	return style.Render(text)
+}
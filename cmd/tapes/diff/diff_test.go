@@ -0,0 +1,70 @@
+package diffcmder_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/api"
+	diffcmder "github.com/papercomputeco/tapes/cmd/tapes/diff"
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+func textMessage(hash, role, text string) api.HistoryMessage {
+	return api.HistoryMessage{
+		Hash:    hash,
+		Role:    role,
+		Content: []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:   "test-model",
+	}
+}
+
+var _ = Describe("NewDiffCmd", func() {
+	It("creates a command with the correct use string", func() {
+		cmd := diffcmder.NewDiffCmd()
+		Expect(cmd.Use).To(Equal("diff <hashA> <hashB>"))
+	})
+
+	It("requires exactly two hashes", func() {
+		cmd := diffcmder.NewDiffCmd()
+		Expect(cmd.Args(cmd, []string{"abc123"})).To(HaveOccurred())
+		Expect(cmd.Args(cmd, []string{"abc123", "def456"})).To(Succeed())
+	})
+})
+
+var _ = Describe("Render", func() {
+	It("renders both sides of each divergent turn", func() {
+		diff := &api.DiffResponse{
+			AHash:        "aaa",
+			BHash:        "bbb",
+			AncestorHash: "root",
+			Shared:       []api.HistoryMessage{textMessage("root", "user", "Hello")},
+			A:            []api.HistoryMessage{textMessage("aaa", "assistant", "A joke")},
+			B:            []api.HistoryMessage{textMessage("bbb", "assistant", "A fact")},
+			Turns:        []api.DiffTurn{{Depth: 1, AHash: "aaa", BHash: "bbb", ContentChanged: true}},
+		}
+
+		var out bytes.Buffer
+		diffcmder.Render(&out, diff, 80, 8)
+		Expect(out.String()).To(ContainSubstring("Forked after"))
+		Expect(out.String()).To(ContainSubstring("1 shared turns"))
+		Expect(out.String()).To(ContainSubstring("content changed"))
+		Expect(out.String()).To(MatchRegexp(`A joke\s+│ .*A fact`))
+	})
+
+	It("marks turns present on only one branch", func() {
+		diff := &api.DiffResponse{
+			AHash: "aaa",
+			BHash: "root",
+			A:     []api.HistoryMessage{textMessage("aaa", "assistant", "A joke")},
+			Turns: []api.DiffTurn{{Depth: 1, AHash: "aaa", RoleChanged: true, ContentChanged: true}},
+		}
+
+		var out bytes.Buffer
+		diffcmder.Render(&out, diff, 80, 8)
+		Expect(out.String()).To(ContainSubstring("do not share a root"))
+		Expect(out.String()).To(ContainSubstring("only on one branch"))
+		Expect(out.String()).To(ContainSubstring("(no turn)"))
+	})
+})
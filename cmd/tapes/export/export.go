@@ -0,0 +1,169 @@
+// Package exportcmder provides the export subcommand for writing portable
+// session bundles.
+package exportcmder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/bundle"
+	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+const exportLongDesc string = `Export conversations to a portable bundle.
+
+A bundle holds the selected nodes and all of their ancestors, with their
+metadata, inlined images and tool outputs, tags and branches, signatures
+and session facets. It can be loaded into any database with "tapes import",
+without a server, which makes it easy to attach a session to a bug report.
+
+Select conversations by hash, ref or HEAD (the default), or with filters
+that match the latest turn of each conversation. Bundles are written to
+stdout unless --output is given, and are gzip-compressed with --gzip or
+when the output file ends in .gz.
+
+Examples:
+  tapes export -o session.jsonl.gz            Export the current checkout
+  tapes export abc123 def456 -o bug.jsonl     Export two conversations
+  tapes export --project tapes -o tapes.jsonl.gz
+  tapes export --meta session=bug-42 > bug.jsonl
+  tapes export --all -o everything.jsonl.gz`
+
+const exportShortDesc string = "Export conversations to a portable bundle"
+
+type exportCommander struct {
+	hashes     []string
+	sqlitePath string
+	output     string
+	compress   bool
+	all        bool
+	meta       []string
+	filter     bundle.Filter
+}
+
+// NewExportCmd creates the export cobra command.
+func NewExportCmd() *cobra.Command {
+	cmder := &exportCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "export [hash...]",
+		Short: exportShortDesc,
+		Long:  exportLongDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hashes = args
+			return cmder.run(cmd.Context(), cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database")
+	cmd.Flags().StringVarP(&cmder.output, "output", "o", "", "Write the bundle to a file instead of stdout")
+	cmd.Flags().BoolVar(&cmder.compress, "gzip", false, "Compress the bundle (default when the output ends in .gz)")
+	cmd.Flags().BoolVar(&cmder.all, "all", false, "Export every conversation")
+	cmd.Flags().StringVar(&cmder.filter.Project, "project", "", "Export conversations recorded for a project")
+	cmd.Flags().StringVar(&cmder.filter.Model, "model", "", "Export conversations whose latest turn used a model")
+	cmd.Flags().StringVar(&cmder.filter.Provider, "provider", "", "Export conversations whose latest turn used a provider")
+	cmd.Flags().StringVar(&cmder.filter.Agent, "agent", "", "Export conversations recorded for an agent")
+	cmd.Flags().StringSliceVar(&cmder.meta, "meta", nil, "Export conversations with session metadata key=value (repeatable)")
+
+	return cmd
+}
+
+func (c *exportCommander) run(ctx context.Context, cmd *cobra.Command) error {
+	for _, kv := range c.meta {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid --meta %q: use key=value", kv)
+		}
+		if c.filter.Metadata == nil {
+			c.filter.Metadata = map[string]string{}
+		}
+		c.filter.Metadata[key] = value
+	}
+
+	filtered := c.all || !c.filter.IsZero()
+	if filtered && len(c.hashes) > 0 {
+		return errors.New("hashes cannot be combined with --all or filters")
+	}
+
+	dbPath, err := sqlitepath.ResolveSQLitePath(c.sqlitePath)
+	if err != nil {
+		return fmt.Errorf("could not resolve database: %w", err)
+	}
+
+	driver, err := sqlite.NewDriver(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open database %s: %w", dbPath, err)
+	}
+	defer driver.Close()
+
+	hashes, err := c.selectHashes(ctx, driver, filtered)
+	if err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return errors.New("no conversations match")
+	}
+
+	b, err := bundle.Collect(ctx, driver, hashes, deck.NewEntFacetStore(driver.Client))
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = cmd.OutOrStdout()
+	if c.output != "" && c.output != "-" {
+		f, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("creating bundle: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	compress := c.compress || strings.HasSuffix(c.output, ".gz")
+	if err := b.Write(w, compress); err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+
+	if c.output != "" && c.output != "-" {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d nodes in %d conversations to %s\n",
+			b.Manifest.Nodes, len(b.Manifest.Leaves), c.output)
+	}
+	return nil
+}
+
+// selectHashes resolves the hashes to export: the filtered leaves, the
+// given hashes, or HEAD.
+func (c *exportCommander) selectHashes(ctx context.Context, driver storage.Driver, filtered bool) ([]string, error) {
+	if filtered {
+		return bundle.Select(ctx, driver, c.filter)
+	}
+
+	args := c.hashes
+	if len(args) == 0 {
+		args = []string{storage.HeadRef}
+	}
+
+	hashes := make([]string, 0, len(args))
+	for _, arg := range args {
+		hash, err := dagapi.ResolveHead(arg)
+		if err != nil {
+			return nil, err
+		}
+		hash, err = storage.ResolveHash(ctx, driver, hash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,13 @@
+package exportcmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestExportCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Export Commander Suite")
+}
@@ -0,0 +1,109 @@
+package exportcmder
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/bundle"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+func exportTestBucket(role, text string) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:    "test-model",
+		Provider: "test",
+	}
+}
+
+var _ = Describe("Export Command", func() {
+	var (
+		ctx    context.Context
+		dir    string
+		dbPath string
+
+		root, reply, fork *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		dbPath = filepath.Join(dir, "tapes.sqlite")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+
+		root = merkle.NewNode(exportTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(exportTestBucket("assistant", "Hi there!"), root, merkle.NodeMeta{Project: "tapes"})
+		fork = merkle.NewNode(exportTestBucket("assistant", "Goodbye!"), root, merkle.NodeMeta{Project: "other"})
+		for _, n := range []*merkle.Node{root, reply, fork} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	execute := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := NewExportCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append(args, "--sqlite", dbPath))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	readBundle := func(path string) *bundle.Bundle {
+		f, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer f.Close()
+		b, err := bundle.Read(f)
+		Expect(err).NotTo(HaveOccurred())
+		return b
+	}
+
+	It("exports the ancestry of the given hashes to a compressed file", func() {
+		path := filepath.Join(dir, "session.jsonl.gz")
+		out, err := execute(reply.Hash[:8], "-o", path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Exported 2 nodes in 1 conversations"))
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data[:2]).To(Equal([]byte{0x1f, 0x8b}))
+
+		b := readBundle(path)
+		Expect(b.Manifest.Leaves).To(Equal([]string{reply.Hash}))
+	})
+
+	It("exports conversations matching filters", func() {
+		path := filepath.Join(dir, "other.jsonl")
+		_, err := execute("--project", "other", "-o", path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readBundle(path).Manifest.Leaves).To(Equal([]string{fork.Hash}))
+
+		_, err = execute("--all", "-o", path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readBundle(path).Manifest.Nodes).To(Equal(3))
+	})
+
+	It("writes to stdout by default", func() {
+		out, err := execute(root.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(HavePrefix(`{"type":"manifest"`))
+	})
+
+	It("rejects hashes combined with filters", func() {
+		_, err := execute(root.Hash, "--all")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,67 @@
+package gccmder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+const gcLongDesc string = `Garbage-collect the blob store of a SQLite database.
+
+Large images and tool outputs are stored once as content-addressed
+blobs and referenced from the nodes that contain them. Blobs that are
+no longer referenced by any node are deleted.
+
+Examples:
+  tapes gc
+  tapes gc --sqlite ~/.tapes/tapes.db`
+
+const gcShortDesc string = "Delete unreferenced blobs"
+
+type gcCommander struct {
+	sqlitePath string
+}
+
+func NewGCCmd() *cobra.Command {
+	cmder := &gcCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: gcShortDesc,
+		Long:  gcLongDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmder.run(cmd.Context(), cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database")
+
+	return cmd
+}
+
+func (c *gcCommander) run(ctx context.Context, cmd *cobra.Command) error {
+	dbPath, err := sqlitepath.ResolveSQLitePath(c.sqlitePath)
+	if err != nil {
+		return fmt.Errorf("could not resolve database: %w", err)
+	}
+
+	driver, err := sqlite.NewDriver(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open database %s: %w", dbPath, err)
+	}
+	defer driver.Close()
+
+	removed, err := driver.CollectBlobs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not collect blobs: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Removed %d unreferenced blobs from %s\n", removed, dbPath)
+
+	return nil
+}
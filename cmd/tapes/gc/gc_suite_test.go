@@ -0,0 +1,13 @@
+package gccmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGCCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GC Commander Suite")
+}
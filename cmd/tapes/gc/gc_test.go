@@ -0,0 +1,59 @@
+package gccmder
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+var _ = Describe("GC Command", func() {
+	It("deletes blobs whose nodes are gone", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.sqlite")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		kept := merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "user",
+			Content:  []llm.ContentBlock{{Type: "tool_result", ToolResultID: "call_1", ToolOutput: strings.Repeat("a", 8192)}},
+			Model:    "test-model",
+			Provider: "test",
+		}, nil)
+		dropped := merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "user",
+			Content:  []llm.ContentBlock{{Type: "tool_result", ToolResultID: "call_2", ToolOutput: strings.Repeat("b", 8192)}},
+			Model:    "test-model",
+			Provider: "test",
+		}, nil)
+		for _, n := range []*merkle.Node{kept, dropped} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(driver.Client.Node.DeleteOneID(dropped.Hash).Exec(ctx)).To(Succeed())
+		driver.Close()
+
+		var out bytes.Buffer
+		cmd := NewGCCmd()
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"--sqlite", dbPath})
+		Expect(cmd.ExecuteContext(ctx)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Removed 1 unreferenced blobs"))
+
+		driver, err = sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+		got, err := driver.Get(ctx, kept.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Bucket).To(Equal(kept.Bucket))
+	})
+})
@@ -0,0 +1,13 @@
+package importcmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestImportCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Import Commander Suite")
+}
@@ -0,0 +1,101 @@
+package importcmder
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/bundle"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+var _ = Describe("Import Command", func() {
+	var (
+		ctx        context.Context
+		dir        string
+		bundlePath string
+		reply      *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		bundlePath = filepath.Join(dir, "session.jsonl")
+
+		source := inmemory.NewDriver()
+		root := merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "user",
+			Content:  []llm.ContentBlock{{Type: "text", Text: "Hello"}},
+			Model:    "test-model",
+			Provider: "test",
+		}, nil)
+		reply = merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "assistant",
+			Content:  []llm.ContentBlock{{Type: "text", Text: "Hi there!"}},
+			Model:    "test-model",
+			Provider: "test",
+		}, root)
+		for _, n := range []*merkle.Node{root, reply} {
+			_, err := source.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(source.PutRef(ctx, &storage.Ref{Name: "bug", Hash: reply.Hash, Kind: storage.RefTag})).To(Succeed())
+
+		b, err := bundle.Collect(ctx, source, []string{reply.Hash}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(b.Write(&buf, false)).To(Succeed())
+		Expect(os.WriteFile(bundlePath, buf.Bytes(), 0o644)).To(Succeed())
+	})
+
+	execute := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := NewImportCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	It("imports nodes and refs into the target database", func() {
+		dbPath := filepath.Join(dir, "target.sqlite")
+		out, err := execute(bundlePath, "--sqlite", dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Imported 2 new nodes (0 already existed)"))
+
+		out, err = execute(bundlePath, "--sqlite", dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Imported 0 new nodes (2 already existed)"))
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+		ref, err := driver.Ref(ctx, "bug")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref.Hash).To(Equal(reply.Hash))
+	})
+
+	It("rejects modified bundles without storing anything", func() {
+		data, err := os.ReadFile(bundlePath)
+		Expect(err).NotTo(HaveOccurred())
+		tampered := strings.Replace(string(data), "Hi there!", "Hi there?", 1)
+		Expect(os.WriteFile(bundlePath, []byte(tampered), 0o644)).To(Succeed())
+
+		dbPath := filepath.Join(dir, "target.sqlite")
+		_, err = execute(bundlePath, "--sqlite", dbPath)
+		Expect(err).To(MatchError(ContainSubstring("invalid bundle")))
+		Expect(dbPath).NotTo(BeAnExistingFile())
+	})
+})
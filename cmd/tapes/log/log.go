@@ -0,0 +1,162 @@
+// Package logcmder provides the log subcommand for walking the ancestry of a
+// node in the conversation DAG.
+package logcmder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/api"
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/dotdir"
+	"github.com/papercomputeco/tapes/pkg/logger"
+)
+
+type logCommander struct {
+	hash      string
+	maxCount  int
+	apiTarget string
+	debug     bool
+
+	logger *zap.Logger
+}
+
+const logLongDesc string = `Show the conversation leading up to a node.
+
+Walks the ancestry of the given hash from the node back to its root, one
+line per turn with its role, model, token usage and a preview of its content.
+Hashes may be abbreviated to any unique prefix, or be the name of a tag or
+branch.
+
+If no hash is provided, the log starts at HEAD, the current checkout.
+
+Examples:
+  tapes log abc123def456   Show the conversation ending at a node
+  tapes log abc123         Same, using an abbreviated hash
+  tapes log -n 5           Show the last 5 turns of the current checkout`
+
+const logShortDesc string = "Show the ancestry of a conversation node"
+
+// previewLen bounds the content preview of each log line.
+const previewLen = 60
+
+func NewLogCmd() *cobra.Command {
+	cmder := &logCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "log [hash]",
+		Short: logShortDesc,
+		Long:  logLongDesc,
+		Args:  cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			configDir, _ := cmd.Flags().GetString("config-dir")
+			cfger, err := config.NewConfiger(configDir)
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			cfg, err := cfger.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			if !cmd.Flags().Changed("api-target") {
+				cmder.apiTarget = cfg.Client.APITarget
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				cmder.hash = args[0]
+			}
+
+			var err error
+			cmder.debug, err = cmd.Flags().GetBool("debug")
+			if err != nil {
+				return fmt.Errorf("could not get debug flag: %w", err)
+			}
+
+			return cmder.run()
+		},
+	}
+
+	defaults := config.NewDefaultConfig()
+	cmd.Flags().StringVarP(&cmder.apiTarget, "api-target", "a", defaults.Client.APITarget, "Tapes API server URL")
+	cmd.Flags().IntVarP(&cmder.maxCount, "max-count", "n", 0, "Limit the number of turns shown (0 for all)")
+
+	return cmd
+}
+
+func (c *logCommander) run() error {
+	c.logger = logger.NewLogger(c.debug)
+	defer func() { _ = c.logger.Sync() }()
+
+	if c.hash == "" {
+		state, err := dotdir.NewManager().LoadCheckoutState("")
+		if err != nil {
+			return fmt.Errorf("loading checkout state: %w", err)
+		}
+		if state == nil {
+			return errors.New("no hash provided and no checkout state; provide a hash or run 'tapes checkout <hash>' first")
+		}
+		c.hash = state.Head()
+	}
+
+	hash, err := dagapi.ResolveHead(c.hash)
+	if err != nil {
+		return err
+	}
+	c.hash = hash
+
+	c.logger.Debug("fetching log",
+		zap.String("hash", c.hash),
+		zap.String("api_target", c.apiTarget),
+	)
+
+	history, err := dagapi.History(c.apiTarget, c.hash)
+	if err != nil {
+		return fmt.Errorf("fetching history: %w", err)
+	}
+
+	Render(os.Stdout, history, c.maxCount)
+	return nil
+}
+
+// Render writes one line per message of history to w, newest first, like
+// git log --oneline. A positive maxCount limits the number of lines.
+func Render(w io.Writer, history *api.HistoryResponse, maxCount int) {
+	shown := 0
+	for i := len(history.Messages) - 1; i >= 0; i-- {
+		if maxCount > 0 && shown == maxCount {
+			break
+		}
+		shown++
+
+		msg := history.Messages[i]
+		line := fmt.Sprintf("%s %s",
+			cliui.HashStyle.Render(dagapi.ShortHash(msg.Hash)),
+			cliui.RoleStyle.Render(fmt.Sprintf("%-11s", "["+msg.Role+"]")),
+		)
+		if msg.Model != "" {
+			line += " " + cliui.DimStyle.Render(msg.Model)
+		}
+		if msg.Usage != nil {
+			line += " " + cliui.DimStyle.Render(fmt.Sprintf("%d→%d tok", msg.Usage.PromptTokens, msg.Usage.CompletionTokens))
+		}
+		if preview := dagapi.Preview(msg.Content, previewLen); preview != "" {
+			line += " " + cliui.PreviewStyle.Render(preview)
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	if hidden := len(history.Messages) - shown; hidden > 0 {
+		fmt.Fprintln(w, cliui.DimStyle.Render(fmt.Sprintf("... %d earlier turns", hidden)))
+	}
+}
@@ -0,0 +1,62 @@
+package logcmder_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/api"
+	logcmder "github.com/papercomputeco/tapes/cmd/tapes/log"
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+var _ = Describe("NewLogCmd", func() {
+	It("accepts an optional hash", func() {
+		cmd := logcmder.NewLogCmd()
+		Expect(cmd.Use).To(Equal("log [hash]"))
+		Expect(cmd.Args(cmd, []string{})).To(Succeed())
+		Expect(cmd.Args(cmd, []string{"abc123"})).To(Succeed())
+		Expect(cmd.Args(cmd, []string{"abc123", "def456"})).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Render", func() {
+	history := &api.HistoryResponse{
+		Messages: []api.HistoryMessage{
+			{Hash: "1111111111111111", Role: "user", Content: []llm.ContentBlock{{Type: "text", Text: "Hello"}}},
+			{
+				Hash:    "2222222222222222",
+				Role:    "assistant",
+				Model:   "gpt-4o",
+				Content: []llm.ContentBlock{{Type: "text", Text: "Hi\nthere!"}},
+				Usage:   &llm.Usage{PromptTokens: 10, CompletionTokens: 3},
+			},
+		},
+		HeadHash: "2222222222222222",
+		Depth:    2,
+	}
+
+	It("writes one line per turn, newest first", func() {
+		var out bytes.Buffer
+		logcmder.Render(&out, history, 0)
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring("222222222222 [assistant]"))
+		Expect(lines[0]).To(ContainSubstring("gpt-4o"))
+		Expect(lines[0]).To(ContainSubstring("10→3 tok"))
+		Expect(lines[0]).To(ContainSubstring("Hi there!"))
+		Expect(lines[1]).To(ContainSubstring("111111111111 [user]"))
+	})
+
+	It("limits the number of turns", func() {
+		var out bytes.Buffer
+		logcmder.Render(&out, history, 1)
+
+		Expect(out.String()).To(ContainSubstring("222222222222"))
+		Expect(out.String()).NotTo(ContainSubstring("111111111111"))
+		Expect(out.String()).To(ContainSubstring("1 earlier turns"))
+	})
+})
@@ -2,18 +2,22 @@ package mergecmder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
 
 const mergeLongDesc string = `Merge one or more source SQLite databases into a target.
 
 Content-addressing makes this a simple union: nodes that already
-exist in the target are skipped (deduped by hash).
+exist in the target are skipped (deduped by hash). Tags and branches
+are merged too: new refs are created and branches are fast-forwarded,
+while refs that have diverged keep the target's node.
 
 Examples:
   tapes merge source1.sqlite source2.sqlite
@@ -85,9 +89,26 @@ func (c *mergeCommander) run(ctx context.Context, cmd *cobra.Command, sources []
 
 		totalNew += srcNew
 		totalDuped += srcDuped
+
+		refs, err := source.Refs(ctx)
+		if err != nil {
+			source.Close()
+			return fmt.Errorf("could not list refs from %s: %w", srcPath, err)
+		}
 		source.Close()
 
 		fmt.Fprintf(cmd.OutOrStdout(), "  %s: %d new, %d already existed\n", srcPath, srcNew, srcDuped)
+
+		for _, ref := range refs {
+			_, err := storage.SyncRef(ctx, target, ref)
+			var conflict storage.RefConflictError
+			switch {
+			case errors.As(err, &conflict):
+				fmt.Fprintf(cmd.OutOrStdout(), "    kept %s at %s: diverged from %s\n", ref.Name, conflict.Current, srcPath)
+			case err != nil:
+				return fmt.Errorf("could not merge ref %s: %w", ref.Name, err)
+			}
+		}
 	}
 
 	fmt.Fprintf(cmd.OutOrStdout(), "Merged %d new nodes from %d sources (%d already existed) into %s\n",
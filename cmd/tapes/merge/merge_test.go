@@ -10,6 +10,7 @@ import (
 
 	"github.com/papercomputeco/tapes/pkg/merkle"
 	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
 
@@ -152,4 +153,45 @@ var _ = Describe("Merge Command", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(nodes).To(HaveLen(2))
 	})
+
+	It("merges refs and keeps diverged branches at the target's node", func() {
+		root := makeNode("user", "shared root", nil)
+		srcReply := makeNode("assistant", "source reply", root)
+		dstReply := makeNode("assistant", "target reply", root)
+
+		src, err := sqlite.NewDriver(ctx, srcPath)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = src.Put(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = src.Put(ctx, srcReply)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.PutRef(ctx, &storage.Ref{Name: "main", Hash: srcReply.Hash, Kind: storage.RefBranch})).To(Succeed())
+		Expect(src.PutRef(ctx, &storage.Ref{Name: "v1", Hash: root.Hash, Kind: storage.RefTag})).To(Succeed())
+		src.Close()
+
+		dst, err := sqlite.NewDriver(ctx, dstPath)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dst.Put(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dst.Put(ctx, dstReply)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dst.PutRef(ctx, &storage.Ref{Name: "main", Hash: dstReply.Hash, Kind: storage.RefBranch})).To(Succeed())
+		dst.Close()
+
+		cmd := NewMergeCmd()
+		cmd.SetArgs([]string{"--sqlite", dstPath, srcPath})
+		Expect(cmd.ExecuteContext(ctx)).To(Succeed())
+
+		dst, err = sqlite.NewDriver(ctx, dstPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer dst.Close()
+
+		main, err := dst.Ref(ctx, "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(main.Hash).To(Equal(dstReply.Hash))
+
+		tag, err := dst.Ref(ctx, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tag.Hash).To(Equal(root.Hash))
+	})
 })
@@ -11,8 +11,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/papercomputeco/tapes/api"
 	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
 
@@ -22,6 +24,10 @@ Reads all nodes from the local SQLite database and POSTs them
 to the remote server's /dag/nodes endpoint. Content-addressing
 ensures duplicates are automatically skipped on the server side.
 
+Tags and branches are pushed after the nodes. New refs are created
+and branches are fast-forwarded; refs that have diverged on the
+server are reported and left untouched.
+
 Examples:
   tapes push http://192.168.1.42:6061
   tapes push --sqlite ~/.tapes/tapes.db http://localhost:6061`
@@ -106,6 +112,24 @@ func (c *pushCommander) run(ctx context.Context, cmd *cobra.Command, serverURL s
 	fmt.Fprintf(cmd.OutOrStdout(), "Pushed %d new nodes (%d already existed, %d errors)\n",
 		totalNew, totalDup, totalErr)
 
+	refs, err := driver.Refs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list local refs: %w", err)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	resp, err := c.postRefs(serverURL, refs)
+	if err != nil {
+		return fmt.Errorf("pushing refs failed: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Pushed %d refs (%d unchanged, %d errors)\n",
+		resp.Updated, resp.Unchanged, resp.Errors)
+	for _, name := range resp.Conflicts {
+		fmt.Fprintf(cmd.OutOrStdout(), "  rejected %s: diverged from the server's ref\n", name)
+	}
+
 	return nil
 }
 
@@ -133,3 +157,28 @@ func (c *pushCommander) postBatch(serverURL string, nodes []*merkle.Node) (*push
 
 	return &result, nil
 }
+
+func (c *pushCommander) postRefs(serverURL string, refs []*storage.Ref) (*api.SyncRefsResponse, error) {
+	body, err := json.Marshal(refs)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal refs: %w", err)
+	}
+
+	resp, err := http.Post(serverURL+"/refs", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("server returned %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result api.SyncRefsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -13,6 +13,7 @@ import (
 	tapesapi "github.com/papercomputeco/tapes/api"
 	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
@@ -126,4 +127,34 @@ var _ = Describe("Push Command", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(nodes).To(HaveLen(1))
 	})
+
+	It("pushes tags and branches after the nodes", func() {
+		local, err := sqlite.NewDriver(ctx, localPath)
+		Expect(err).NotTo(HaveOccurred())
+		nodeA := makeNode("user", "hello from ref push test", nil)
+		nodeB := makeNode("assistant", "hi back from ref push test", nodeA)
+		_, err = local.Put(ctx, nodeA)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = local.Put(ctx, nodeB)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(local.PutRef(ctx, &storage.Ref{Name: "main", Hash: nodeB.Hash, Kind: storage.RefBranch})).To(Succeed())
+		Expect(local.PutRef(ctx, &storage.Ref{Name: "v1", Hash: nodeA.Hash, Kind: storage.RefTag})).To(Succeed())
+		local.Close()
+
+		addr, serverDriver, cleanup := startServer()
+		defer cleanup()
+
+		cmd := NewPushCmd()
+		cmd.SetArgs([]string{"--sqlite", localPath, addr})
+		Expect(cmd.ExecuteContext(ctx)).To(Succeed())
+
+		main, err := serverDriver.Ref(ctx, "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(main.Hash).To(Equal(nodeB.Hash))
+		Expect(main.Kind).To(Equal(storage.RefBranch))
+
+		tag, err := serverDriver.Ref(ctx, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tag.Hash).To(Equal(nodeA.Hash))
+	})
 })
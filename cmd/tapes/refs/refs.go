@@ -0,0 +1,239 @@
+// Package refscmder provides the tag and branch subcommands for naming
+// nodes of the conversation DAG.
+package refscmder
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/api"
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/dotdir"
+	"github.com/papercomputeco/tapes/pkg/logger"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+type refCommander struct {
+	kind      storage.RefKind
+	name      string
+	hash      string
+	delete    bool
+	force     bool
+	apiTarget string
+	debug     bool
+
+	logger *zap.Logger
+}
+
+const tagLongDesc string = `Create, list or delete tags.
+
+A tag is a fixed name for a node, like a git tag. Tags are stored in the
+database next to the nodes, never change node hashes, and are synced by
+"tapes push" and "tapes merge". A tag can be used anywhere a hash is
+accepted, including the API.
+
+The hash may be abbreviated, name another ref, or be HEAD (the default),
+the current checkout.
+
+Examples:
+  tapes tag                        List tags
+  tapes tag good-answer            Tag the current checkout
+  tapes tag good-answer abc123     Tag a node
+  tapes tag -f good-answer def456  Move an existing tag
+  tapes tag -D good-answer         Delete a tag`
+
+const tagShortDesc string = "Create, list or delete tags"
+
+const branchLongDesc string = `Create, list or delete named branches.
+
+A branch is a name for a node that advances as new turns are recorded
+under it, like a git branch following new commits. When a branch is checked
+out with "tapes checkout <branch>", each "tapes chat" session resumes from
+its latest turn. Branches are stored in the database next to the nodes,
+never change node hashes, and are synced by "tapes push" and "tapes merge".
+A branch can be used anywhere a hash is accepted, including the API.
+
+The hash may be abbreviated, name another ref, or be HEAD (the default),
+the current checkout.
+
+Examples:
+  tapes branch                   List branches
+  tapes branch experiment        Start a branch at the current checkout
+  tapes branch experiment abc123 Start a branch at a node
+  tapes branch -f main def456    Move an existing branch
+  tapes branch -D experiment     Delete a branch`
+
+const branchShortDesc string = "Create, list or delete named branches"
+
+// NewTagCmd creates the tag cobra command.
+func NewTagCmd() *cobra.Command {
+	return newRefCmd(storage.RefTag, "tag [name] [hash]", tagShortDesc, tagLongDesc)
+}
+
+// NewBranchCmd creates the branch cobra command.
+func NewBranchCmd() *cobra.Command {
+	return newRefCmd(storage.RefBranch, "branch [name] [hash]", branchShortDesc, branchLongDesc)
+}
+
+func newRefCmd(kind storage.RefKind, use, short, long string) *cobra.Command {
+	cmder := &refCommander{kind: kind}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		Args:  cobra.MaximumNArgs(2),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			configDir, _ := cmd.Flags().GetString("config-dir")
+			cfger, err := config.NewConfiger(configDir)
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			cfg, err := cfger.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			if !cmd.Flags().Changed("api-target") {
+				cmder.apiTarget = cfg.Client.APITarget
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hash = storage.HeadRef
+			if len(args) > 0 {
+				cmder.name = args[0]
+			}
+			if len(args) > 1 {
+				cmder.hash = args[1]
+			}
+
+			var err error
+			cmder.debug, err = cmd.Flags().GetBool("debug")
+			if err != nil {
+				return fmt.Errorf("could not get debug flag: %w", err)
+			}
+
+			return cmder.run()
+		},
+	}
+
+	defaults := config.NewDefaultConfig()
+	cmd.Flags().StringVarP(&cmder.apiTarget, "api-target", "a", defaults.Client.APITarget, "Tapes API server URL")
+	cmd.Flags().BoolVarP(&cmder.delete, "delete", "D", false, fmt.Sprintf("Delete the %s", kind))
+	cmd.Flags().BoolVarP(&cmder.force, "force", "f", false, fmt.Sprintf("Move the %s if it already exists", kind))
+
+	return cmd
+}
+
+func (c *refCommander) run() error {
+	c.logger = logger.NewLogger(c.debug)
+	defer func() { _ = c.logger.Sync() }()
+
+	switch {
+	case c.name == "" && c.delete:
+		return fmt.Errorf("a %s name is required to delete", c.kind)
+	case c.name == "":
+		return c.list()
+	case c.delete:
+		return c.remove()
+	default:
+		return c.create()
+	}
+}
+
+func (c *refCommander) list() error {
+	refs, err := dagapi.Refs(c.apiTarget)
+	if err != nil {
+		return fmt.Errorf("listing refs: %w", err)
+	}
+
+	var current string
+	if c.kind == storage.RefBranch {
+		state, err := dotdir.NewManager().LoadCheckoutState("")
+		if err != nil {
+			return fmt.Errorf("loading checkout state: %w", err)
+		}
+		if state != nil {
+			current = state.Branch
+		}
+	}
+
+	Render(os.Stdout, refs, c.kind, current)
+	return nil
+}
+
+func (c *refCommander) create() error {
+	if err := storage.ValidateRefName(c.name); err != nil {
+		return err
+	}
+
+	hash, err := dagapi.ResolveHead(c.hash)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("setting ref",
+		zap.String("name", c.name),
+		zap.String("kind", string(c.kind)),
+		zap.String("hash", hash),
+		zap.String("api_target", c.apiTarget),
+	)
+
+	ref, err := dagapi.SetRef(c.apiTarget, c.name, api.SetRefRequest{Hash: hash, Kind: c.kind, Force: c.force})
+	if err != nil {
+		return fmt.Errorf("setting %s: %w", c.kind, err)
+	}
+
+	fmt.Printf("%s %s at %s\n", c.kind, cliui.NameStyle.Render(ref.Name), cliui.HashStyle.Render(dagapi.ShortHash(ref.Hash)))
+	return nil
+}
+
+func (c *refCommander) remove() error {
+	ref, err := dagapi.Ref(c.apiTarget, c.name)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", c.kind, err)
+	}
+	if ref == nil || ref.Kind != c.kind {
+		return fmt.Errorf("%s %s not found", c.kind, c.name)
+	}
+
+	if err := dagapi.DeleteRef(c.apiTarget, c.name); err != nil {
+		return fmt.Errorf("deleting %s: %w", c.kind, err)
+	}
+
+	fmt.Printf("Deleted %s %s (was %s)\n", c.kind, c.name, dagapi.ShortHash(ref.Hash))
+	return nil
+}
+
+// Render writes the refs of kind to w, one per line, marking the current
+// branch with an asterisk.
+func Render(w io.Writer, refs []*storage.Ref, kind storage.RefKind, current string) {
+	var shown []*storage.Ref
+	width := 0
+	for _, ref := range refs {
+		if ref.Kind == kind {
+			shown = append(shown, ref)
+			width = max(width, len(ref.Name))
+		}
+	}
+
+	for _, ref := range shown {
+		marker := "  "
+		if ref.Name == current {
+			marker = cliui.MatchedStyle.Render("* ")
+		}
+		fmt.Fprintf(w, "%s%s %s\n", marker, cliui.NameStyle.Render(fmt.Sprintf("%-*s", width, ref.Name)), cliui.HashStyle.Render(dagapi.ShortHash(ref.Hash)))
+	}
+
+	if len(shown) == 0 {
+		fmt.Fprintf(w, "No %ss found.\n", kind)
+	}
+}
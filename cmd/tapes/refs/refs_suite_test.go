@@ -0,0 +1,13 @@
+package refscmder_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRefs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Refs Command Suite")
+}
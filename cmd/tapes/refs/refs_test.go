@@ -0,0 +1,57 @@
+package refscmder_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	refscmder "github.com/papercomputeco/tapes/cmd/tapes/refs"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+var _ = Describe("NewTagCmd", func() {
+	It("accepts an optional name and hash", func() {
+		cmd := refscmder.NewTagCmd()
+		Expect(cmd.Use).To(Equal("tag [name] [hash]"))
+		Expect(cmd.Args(cmd, []string{})).To(Succeed())
+		Expect(cmd.Args(cmd, []string{"v1", "abc123"})).To(Succeed())
+		Expect(cmd.Args(cmd, []string{"v1", "abc123", "extra"})).To(HaveOccurred())
+	})
+
+	It("has --delete and --force flags", func() {
+		cmd := refscmder.NewTagCmd()
+		Expect(cmd.Flags().Lookup("delete")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("force")).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("NewBranchCmd", func() {
+	It("creates a command with the correct use string", func() {
+		cmd := refscmder.NewBranchCmd()
+		Expect(cmd.Use).To(Equal("branch [name] [hash]"))
+	})
+})
+
+var _ = Describe("Render", func() {
+	refs := []*storage.Ref{
+		{Name: "experiment", Hash: "1111111111111111", Kind: storage.RefBranch},
+		{Name: "main", Hash: "2222222222222222", Kind: storage.RefBranch},
+		{Name: "v1", Hash: "3333333333333333", Kind: storage.RefTag},
+	}
+
+	It("lists refs of one kind and marks the current branch", func() {
+		var out bytes.Buffer
+		refscmder.Render(&out, refs, storage.RefBranch, "main")
+
+		Expect(out.String()).To(ContainSubstring("experiment"))
+		Expect(out.String()).To(MatchRegexp(`\* main\s+222222222222`))
+		Expect(out.String()).NotTo(ContainSubstring("v1"))
+	})
+
+	It("reports when there are no refs of the kind", func() {
+		var out bytes.Buffer
+		refscmder.Render(&out, refs[:2], storage.RefTag, "")
+		Expect(out.String()).To(ContainSubstring("No tags found."))
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/papercomputeco/tapes/api"
 	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/logger"
 	"github.com/papercomputeco/tapes/pkg/merkle"
 	"github.com/papercomputeco/tapes/pkg/storage"
@@ -19,6 +20,7 @@ import (
 
 type apiCommander struct {
 	listen     string
+	tls        listener.TLSConfig
 	debug      bool
 	sqlitePath string
 	logger     *zap.Logger
@@ -50,6 +52,15 @@ func NewAPICmd() *cobra.Command {
 			if !cmd.Flags().Changed("listen") {
 				cmder.listen = cfg.API.Listen
 			}
+			if !cmd.Flags().Changed("tls-cert") {
+				cmder.tls.CertFile = cfg.API.TLSCert
+			}
+			if !cmd.Flags().Changed("tls-key") {
+				cmder.tls.KeyFile = cfg.API.TLSKey
+			}
+			if !cmd.Flags().Changed("tls-client-ca") {
+				cmder.tls.ClientCAFile = cfg.API.TLSClientCA
+			}
 			if !cmd.Flags().Changed("sqlite") {
 				cmder.sqlitePath = cfg.Storage.SQLitePath
 			}
@@ -67,7 +78,10 @@ func NewAPICmd() *cobra.Command {
 	}
 
 	defaults := config.NewDefaultConfig()
-	cmd.Flags().StringVarP(&cmder.listen, "listen", "l", defaults.API.Listen, "Address for API server to listen on")
+	cmd.Flags().StringVarP(&cmder.listen, "listen", "l", defaults.API.Listen, "Address for API server to listen on (host:port or unix:/path/to.sock)")
+	cmd.Flags().StringVar(&cmder.tls.CertFile, "tls-cert", "", "Path to a PEM certificate to serve the API over HTTPS")
+	cmd.Flags().StringVar(&cmder.tls.KeyFile, "tls-key", "", "Path to the PEM private key for --tls-cert")
+	cmd.Flags().StringVar(&cmder.tls.ClientCAFile, "tls-client-ca", "", "Path to a PEM CA bundle; clients must present a certificate it signed (mTLS)")
 	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database (default: in-memory)")
 
 	return cmd
@@ -91,6 +105,7 @@ func (c *apiCommander) run() error {
 
 	config := api.Config{
 		ListenAddr: c.listen,
+		TLS:        c.tls,
 	}
 
 	server, err := api.NewServer(config, driver, dagLoader, c.logger)
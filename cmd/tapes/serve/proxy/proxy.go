@@ -4,14 +4,19 @@ package proxycmder
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/papercomputeco/tapes/pkg/ca"
 	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/credentials"
 	embeddingutils "github.com/papercomputeco/tapes/pkg/embeddings/utils"
 	"github.com/papercomputeco/tapes/pkg/git"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/logger"
+	"github.com/papercomputeco/tapes/pkg/signing"
 	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
@@ -20,12 +25,27 @@ import (
 )
 
 type proxyCommander struct {
-	listen       string
-	upstream     string
-	providerType string
-	debug        bool
-	sqlitePath   string
-	project      string
+	listen        string
+	tls           listener.TLSConfig
+	forwardListen string
+	caDir         string
+	upstream      string
+	providerType  string
+	debug         bool
+	sqlitePath    string
+	project       string
+	routes        []proxy.Route
+	compat        []proxy.CompatProvider
+	cache         proxy.CacheConfig
+	upstreams     []config.UpstreamConfig
+	healthCheck   time.Duration
+
+	captureEmbeddingInput bool
+
+	forwardPassthrough bool
+
+	sign      bool
+	configDir string
 
 	vectorStoreProvider string
 	vectorStoreTarget   string
@@ -44,6 +64,12 @@ configured upstream URL, recording request/response conversation turns.
 
 Supported provider types: anthropic, openai, ollama
 
+With --forward-listen, the proxy also runs an HTTPS_PROXY-compatible forward
+proxy. Traffic to known LLM API hosts is decrypted with certificates from the
+local CA (see "tapes ca install") and recorded. Other traffic is refused
+unless --forward-passthrough is set. The forward proxy does not authenticate
+clients, so keep it on loopback (the default for a bare :port).
+
 Optionally configure vector storage and embeddings of text content for "tapes search"
 agentic functionality.`
 
@@ -71,12 +97,60 @@ func NewProxyCmd() *cobra.Command {
 			if !cmd.Flags().Changed("listen") {
 				cmder.listen = cfg.Proxy.Listen
 			}
+			if !cmd.Flags().Changed("tls-cert") {
+				cmder.tls.CertFile = cfg.Proxy.TLSCert
+			}
+			if !cmd.Flags().Changed("tls-key") {
+				cmder.tls.KeyFile = cfg.Proxy.TLSKey
+			}
+			if !cmd.Flags().Changed("tls-client-ca") {
+				cmder.tls.ClientCAFile = cfg.Proxy.TLSClientCA
+			}
+			if !cmd.Flags().Changed("forward-listen") {
+				cmder.forwardListen = cfg.Proxy.ForwardListen
+			}
+			if !cmd.Flags().Changed("forward-passthrough") {
+				cmder.forwardPassthrough = cfg.Proxy.ForwardPassthrough
+			}
+			if cmder.forwardListen != "" {
+				cmder.caDir, err = ca.Dir(configDir)
+				if err != nil {
+					return err
+				}
+			}
 			if !cmd.Flags().Changed("upstream") {
 				cmder.upstream = cfg.Proxy.Upstream
 			}
 			if !cmd.Flags().Changed("provider") {
 				cmder.providerType = cfg.Proxy.Provider
 			}
+			cmder.routes, err = proxy.RoutesFromConfig(cfg.Proxy.Routes)
+			if err != nil {
+				return err
+			}
+			cmder.compat, err = proxy.CompatProvidersFromConfig(cfg.Proxy.Providers)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("cache") {
+				cmder.cache.Enabled = cfg.Proxy.Cache
+			}
+			if !cmd.Flags().Changed("capture-embedding-input") {
+				cmder.captureEmbeddingInput = cfg.Proxy.CaptureEmbeddingInput
+			}
+			if !cmd.Flags().Changed("sign") {
+				cmder.sign = cfg.Proxy.Sign
+			}
+			cmder.configDir = configDir
+			cmder.cache.TTL, err = cfg.Proxy.CacheTTLDuration()
+			if err != nil {
+				return err
+			}
+			cmder.upstreams = cfg.Proxy.Upstreams
+			cmder.healthCheck, err = cfg.Proxy.HealthCheckDuration()
+			if err != nil {
+				return err
+			}
 			if !cmd.Flags().Changed("sqlite") {
 				cmder.sqlitePath = cfg.Storage.SQLitePath
 			}
@@ -115,9 +189,14 @@ func NewProxyCmd() *cobra.Command {
 	}
 
 	defaults := config.NewDefaultConfig()
-	cmd.Flags().StringVarP(&cmder.listen, "listen", "l", defaults.Proxy.Listen, "Address for proxy to listen on")
+	cmd.Flags().StringVarP(&cmder.listen, "listen", "l", defaults.Proxy.Listen, "Address for proxy to listen on (host:port or unix:/path/to.sock)")
+	cmd.Flags().StringVar(&cmder.tls.CertFile, "tls-cert", "", "Path to a PEM certificate to serve the proxy over HTTPS")
+	cmd.Flags().StringVar(&cmder.tls.KeyFile, "tls-key", "", "Path to the PEM private key for --tls-cert")
+	cmd.Flags().StringVar(&cmder.tls.ClientCAFile, "tls-client-ca", "", "Path to a PEM CA bundle; clients must present a certificate it signed (mTLS)")
+	cmd.Flags().StringVar(&cmder.forwardListen, "forward-listen", "", "Address for the HTTPS_PROXY-compatible forward proxy to listen on; a bare :port binds to 127.0.0.1 (disabled if empty)")
+	cmd.Flags().BoolVar(&cmder.forwardPassthrough, "forward-passthrough", false, "Let the forward proxy tunnel traffic to hosts it does not intercept (any client that can reach it can relay through it)")
 	cmd.Flags().StringVarP(&cmder.upstream, "upstream", "u", defaults.Proxy.Upstream, "Upstream LLM provider URL")
-	cmd.Flags().StringVarP(&cmder.providerType, "provider", "p", defaults.Proxy.Provider, "LLM provider type (anthropic, openai, ollama)")
+	cmd.Flags().StringVarP(&cmder.providerType, "provider", "p", defaults.Proxy.Provider, "LLM provider type (anthropic, openai, ollama, bedrock)")
 	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database (default: in-memory)")
 	cmd.Flags().StringVar(&cmder.vectorStoreProvider, "vector-store-provider", defaults.VectorStore.Provider, "Vector store provider type (e.g., chroma, sqlite)")
 	cmd.Flags().StringVar(&cmder.vectorStoreTarget, "vector-store-target", defaults.VectorStore.Target, "Vector store URL (e.g., http://localhost:8000)")
@@ -125,6 +204,9 @@ func NewProxyCmd() *cobra.Command {
 	cmd.Flags().StringVar(&cmder.embeddingTarget, "embedding-target", defaults.Embedding.Target, "Embedding provider URL")
 	cmd.Flags().StringVar(&cmder.embeddingModel, "embedding-model", defaults.Embedding.Model, "Embedding model name (e.g., nomic-embed-text)")
 	cmd.Flags().StringVar(&cmder.project, "project", "", "Project name to tag sessions (default: auto-detect from git)")
+	cmd.Flags().BoolVar(&cmder.cache.Enabled, "cache", false, "Serve recorded responses for identical deterministic requests")
+	cmd.Flags().BoolVar(&cmder.captureEmbeddingInput, "capture-embedding-input", false, "Store the input texts of embedding calls, not just counts and usage")
+	cmd.Flags().BoolVar(&cmder.sign, "sign", false, "Sign every recorded response with the key in credentials.toml")
 
 	return cmd
 }
@@ -141,9 +223,40 @@ func (c *proxyCommander) run() error {
 
 	config := proxy.Config{
 		ListenAddr:   c.listen,
+		TLS:          c.tls,
 		UpstreamURL:  c.upstream,
 		ProviderType: c.providerType,
 		Project:      c.project,
+		Routes:       c.routes,
+		Cache:        c.cache,
+
+		CompatProviders: c.compat,
+
+		CaptureEmbeddingInput: c.captureEmbeddingInput,
+
+		Upstreams:           proxy.UpstreamsFromConfig(c.upstreams),
+		HealthCheckInterval: c.healthCheck,
+
+		ForwardListenAddr:  c.forwardListen,
+		ForwardPassthrough: c.forwardPassthrough,
+	}
+
+	if c.forwardListen != "" {
+		config.CA, _, err = ca.LoadOrCreate(c.caDir)
+		if err != nil {
+			return fmt.Errorf("loading certificate authority: %w", err)
+		}
+	}
+
+	if c.sign {
+		creds, err := credentials.NewManager(c.configDir)
+		if err != nil {
+			return fmt.Errorf("loading credentials: %w", err)
+		}
+		config.Signer, _, err = signing.LoadOrCreateKey(creds)
+		if err != nil {
+			return fmt.Errorf("loading signing key: %w", err)
+		}
 	}
 
 	if c.vectorStoreTarget != "" {
@@ -191,7 +304,21 @@ func (c *proxyCommander) run() error {
 		zap.String("provider", c.providerType),
 	)
 
-	return p.Run()
+	if c.forwardListen == "" {
+		return p.Run()
+	}
+
+	errChan := make(chan error, 2)
+	go func() {
+		errChan <- p.Run()
+	}()
+	go func() {
+		if err := p.RunForward(); err != nil {
+			errChan <- fmt.Errorf("forward proxy error: %w", err)
+		}
+	}()
+
+	return <-errChan
 }
 
 func (c *proxyCommander) newStorageDriver() (storage.Driver, error) {
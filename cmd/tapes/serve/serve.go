@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -15,12 +16,16 @@ import (
 	"github.com/papercomputeco/tapes/api"
 	apicmder "github.com/papercomputeco/tapes/cmd/tapes/serve/api"
 	proxycmder "github.com/papercomputeco/tapes/cmd/tapes/serve/proxy"
+	"github.com/papercomputeco/tapes/pkg/ca"
 	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/credentials"
 	"github.com/papercomputeco/tapes/pkg/dotdir"
 	embeddingutils "github.com/papercomputeco/tapes/pkg/embeddings/utils"
 	"github.com/papercomputeco/tapes/pkg/git"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/logger"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
 	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
@@ -29,12 +34,28 @@ import (
 )
 
 type ServeCommander struct {
-	proxyListen string
-	apiListen   string
-	upstream    string
-	debug       bool
-	sqlitePath  string
-	project     string
+	proxyListen   string
+	proxyTLS      listener.TLSConfig
+	apiTLS        listener.TLSConfig
+	forwardListen string
+	caDir         string
+	apiListen     string
+	upstream      string
+	debug         bool
+	sqlitePath    string
+	project       string
+	routes        []proxy.Route
+	compat        []proxy.CompatProvider
+	cache         proxy.CacheConfig
+	upstreams     []config.UpstreamConfig
+	healthCheck   time.Duration
+
+	captureEmbeddingInput bool
+
+	forwardPassthrough bool
+
+	sign      bool
+	configDir string
 
 	providerType string
 
@@ -91,6 +112,36 @@ func NewServeCmd() *cobra.Command {
 			if !cmd.Flags().Changed("proxy-listen") {
 				cmder.proxyListen = cfg.Proxy.Listen
 			}
+			if !cmd.Flags().Changed("proxy-tls-cert") {
+				cmder.proxyTLS.CertFile = cfg.Proxy.TLSCert
+			}
+			if !cmd.Flags().Changed("proxy-tls-key") {
+				cmder.proxyTLS.KeyFile = cfg.Proxy.TLSKey
+			}
+			if !cmd.Flags().Changed("proxy-tls-client-ca") {
+				cmder.proxyTLS.ClientCAFile = cfg.Proxy.TLSClientCA
+			}
+			if !cmd.Flags().Changed("api-tls-cert") {
+				cmder.apiTLS.CertFile = cfg.API.TLSCert
+			}
+			if !cmd.Flags().Changed("api-tls-key") {
+				cmder.apiTLS.KeyFile = cfg.API.TLSKey
+			}
+			if !cmd.Flags().Changed("api-tls-client-ca") {
+				cmder.apiTLS.ClientCAFile = cfg.API.TLSClientCA
+			}
+			if !cmd.Flags().Changed("forward-listen") {
+				cmder.forwardListen = cfg.Proxy.ForwardListen
+			}
+			if !cmd.Flags().Changed("forward-passthrough") {
+				cmder.forwardPassthrough = cfg.Proxy.ForwardPassthrough
+			}
+			if cmder.forwardListen != "" {
+				cmder.caDir, err = ca.Dir(configDir)
+				if err != nil {
+					return err
+				}
+			}
 			if !cmd.Flags().Changed("api-listen") {
 				cmder.apiListen = cfg.API.Listen
 			}
@@ -100,6 +151,33 @@ func NewServeCmd() *cobra.Command {
 			if !cmd.Flags().Changed("provider") {
 				cmder.providerType = cfg.Proxy.Provider
 			}
+			cmder.routes, err = proxy.RoutesFromConfig(cfg.Proxy.Routes)
+			if err != nil {
+				return err
+			}
+			cmder.compat, err = proxy.CompatProvidersFromConfig(cfg.Proxy.Providers)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("cache") {
+				cmder.cache.Enabled = cfg.Proxy.Cache
+			}
+			if !cmd.Flags().Changed("capture-embedding-input") {
+				cmder.captureEmbeddingInput = cfg.Proxy.CaptureEmbeddingInput
+			}
+			if !cmd.Flags().Changed("sign") {
+				cmder.sign = cfg.Proxy.Sign
+			}
+			cmder.configDir = configDir
+			cmder.cache.TTL, err = cfg.Proxy.CacheTTLDuration()
+			if err != nil {
+				return err
+			}
+			cmder.upstreams = cfg.Proxy.Upstreams
+			cmder.healthCheck, err = cfg.Proxy.HealthCheckDuration()
+			if err != nil {
+				return err
+			}
 			if !cmd.Flags().Changed("sqlite") {
 				if cfg.Storage.SQLitePath != "" {
 					cmder.sqlitePath = cfg.Storage.SQLitePath
@@ -148,10 +226,18 @@ func NewServeCmd() *cobra.Command {
 	}
 
 	defaults := config.NewDefaultConfig()
-	cmd.Flags().StringVarP(&cmder.proxyListen, "proxy-listen", "p", defaults.Proxy.Listen, "Address for proxy to listen on")
-	cmd.Flags().StringVarP(&cmder.apiListen, "api-listen", "a", defaults.API.Listen, "Address for API server to listen on")
+	cmd.Flags().StringVarP(&cmder.proxyListen, "proxy-listen", "p", defaults.Proxy.Listen, "Address for proxy to listen on (host:port or unix:/path/to.sock)")
+	cmd.Flags().StringVar(&cmder.proxyTLS.CertFile, "proxy-tls-cert", "", "Path to a PEM certificate to serve the proxy over HTTPS")
+	cmd.Flags().StringVar(&cmder.proxyTLS.KeyFile, "proxy-tls-key", "", "Path to the PEM private key for --proxy-tls-cert")
+	cmd.Flags().StringVar(&cmder.proxyTLS.ClientCAFile, "proxy-tls-client-ca", "", "Path to a PEM CA bundle; proxy clients must present a certificate it signed (mTLS)")
+	cmd.Flags().StringVar(&cmder.forwardListen, "forward-listen", "", "Address for the HTTPS_PROXY-compatible forward proxy to listen on; a bare :port binds to 127.0.0.1 (disabled if empty)")
+	cmd.Flags().BoolVar(&cmder.forwardPassthrough, "forward-passthrough", false, "Let the forward proxy tunnel traffic to hosts it does not intercept (any client that can reach it can relay through it)")
+	cmd.Flags().StringVarP(&cmder.apiListen, "api-listen", "a", defaults.API.Listen, "Address for API server to listen on (host:port or unix:/path/to.sock)")
+	cmd.Flags().StringVar(&cmder.apiTLS.CertFile, "api-tls-cert", "", "Path to a PEM certificate to serve the API over HTTPS")
+	cmd.Flags().StringVar(&cmder.apiTLS.KeyFile, "api-tls-key", "", "Path to the PEM private key for --api-tls-cert")
+	cmd.Flags().StringVar(&cmder.apiTLS.ClientCAFile, "api-tls-client-ca", "", "Path to a PEM CA bundle; API clients must present a certificate it signed (mTLS)")
 	cmd.Flags().StringVarP(&cmder.upstream, "upstream", "u", defaults.Proxy.Upstream, "Upstream LLM provider URL")
-	cmd.Flags().StringVar(&cmder.providerType, "provider", defaults.Proxy.Provider, "LLM provider type (anthropic, openai, ollama)")
+	cmd.Flags().StringVar(&cmder.providerType, "provider", defaults.Proxy.Provider, "LLM provider type (anthropic, openai, ollama, bedrock)")
 	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database (e.g., ./tapes.sqlite, in-memory)")
 	cmd.Flags().StringVar(&cmder.vectorStoreProvider, "vector-store-provider", defaults.VectorStore.Provider, "Vector store provider type (e.g., chroma, sqlite)")
 	cmd.Flags().StringVar(&cmder.vectorStoreTarget, "vector-store-target", defaults.VectorStore.Target, "Vector store target filepath for sqlite or URL for vector store service (e.g., http://localhost:8000, ./db.sqlite)")
@@ -160,6 +246,9 @@ func NewServeCmd() *cobra.Command {
 	cmd.Flags().StringVar(&cmder.embeddingModel, "embedding-model", defaults.Embedding.Model, "Embedding model name (e.g., nomic-embed-text)")
 	cmd.Flags().UintVar(&cmder.embeddingDimensions, "embedding-dimensions", defaults.Embedding.Dimensions, "Embedding dimensionality.")
 	cmd.Flags().StringVar(&cmder.project, "project", "", "Project name to tag sessions (default: auto-detect from git)")
+	cmd.Flags().BoolVar(&cmder.cache.Enabled, "cache", false, "Serve recorded responses for identical deterministic requests")
+	cmd.Flags().BoolVar(&cmder.captureEmbeddingInput, "capture-embedding-input", false, "Store the input texts of embedding calls, not just counts and usage")
+	cmd.Flags().BoolVar(&cmder.sign, "sign", false, "Sign every recorded response with the key in credentials.toml")
 
 	cmd.AddCommand(apicmder.NewAPICmd())
 	cmd.AddCommand(proxycmder.NewProxyCmd())
@@ -186,9 +275,40 @@ func (c *ServeCommander) run() error {
 
 	proxyConfig := proxy.Config{
 		ListenAddr:   c.proxyListen,
+		TLS:          c.proxyTLS,
 		UpstreamURL:  c.upstream,
 		ProviderType: c.providerType,
 		Project:      c.project,
+		Routes:       c.routes,
+		Cache:        c.cache,
+
+		CompatProviders: c.compat,
+
+		CaptureEmbeddingInput: c.captureEmbeddingInput,
+
+		Upstreams:           proxy.UpstreamsFromConfig(c.upstreams),
+		HealthCheckInterval: c.healthCheck,
+
+		ForwardListenAddr:  c.forwardListen,
+		ForwardPassthrough: c.forwardPassthrough,
+	}
+
+	if c.forwardListen != "" {
+		proxyConfig.CA, _, err = ca.LoadOrCreate(c.caDir)
+		if err != nil {
+			return fmt.Errorf("loading certificate authority: %w", err)
+		}
+	}
+
+	if c.sign {
+		creds, err := credentials.NewManager(c.configDir)
+		if err != nil {
+			return fmt.Errorf("loading credentials: %w", err)
+		}
+		proxyConfig.Signer, _, err = signing.LoadOrCreateKey(creds)
+		if err != nil {
+			return fmt.Errorf("loading signing key: %w", err)
+		}
 	}
 
 	proxyConfig.VectorDriver, err = vectorutils.NewVectorDriver(&vectorutils.NewVectorDriverOpts{
@@ -236,6 +356,7 @@ func (c *ServeCommander) run() error {
 	// Create API server
 	apiConfig := api.Config{
 		ListenAddr:   c.apiListen,
+		TLS:          c.apiTLS,
 		VectorDriver: proxyConfig.VectorDriver,
 		Embedder:     proxyConfig.Embedder,
 	}
@@ -249,7 +370,7 @@ func (c *ServeCommander) run() error {
 	)
 
 	// Channel to capture errors from goroutines
-	errChan := make(chan error, 2)
+	errChan := make(chan error, 3)
 
 	// Start proxy in goroutine
 	go func() {
@@ -258,6 +379,18 @@ func (c *ServeCommander) run() error {
 		}
 	}()
 
+	// Start forward proxy in goroutine
+	if c.forwardListen != "" {
+		c.logger.Info("starting forward proxy",
+			zap.String("forward_addr", c.forwardListen),
+		)
+		go func() {
+			if err := p.RunForward(); err != nil {
+				errChan <- fmt.Errorf("forward proxy error: %w", err)
+			}
+		}()
+	}
+
 	// Start API server in goroutine
 	go func() {
 		if err := apiServer.Run(); err != nil {
@@ -0,0 +1,203 @@
+// Package showcmder provides the show subcommand for displaying a single node
+// of the conversation DAG in full.
+package showcmder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/logger"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+)
+
+type showCommander struct {
+	hash      string
+	apiTarget string
+	debug     bool
+
+	logger *zap.Logger
+}
+
+const showLongDesc string = `Show a conversation node in full.
+
+Renders the node's metadata (parent, role, model, stop reason, token usage,
+session metadata) followed by all of its content blocks, including tool
+calls with their inputs and tool results. Hashes may be abbreviated to any
+unique prefix, or be a tag, a branch or HEAD.
+
+Examples:
+  tapes show abc123def456
+  tapes show abc123
+  tapes show HEAD`
+
+const showShortDesc string = "Show a conversation node"
+
+func NewShowCmd() *cobra.Command {
+	cmder := &showCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "show <hash>",
+		Short: showShortDesc,
+		Long:  showLongDesc,
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			configDir, _ := cmd.Flags().GetString("config-dir")
+			cfger, err := config.NewConfiger(configDir)
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			cfg, err := cfger.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+
+			if !cmd.Flags().Changed("api-target") {
+				cmder.apiTarget = cfg.Client.APITarget
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hash = args[0]
+
+			var err error
+			cmder.debug, err = cmd.Flags().GetBool("debug")
+			if err != nil {
+				return fmt.Errorf("could not get debug flag: %w", err)
+			}
+
+			return cmder.run()
+		},
+	}
+
+	defaults := config.NewDefaultConfig()
+	cmd.Flags().StringVarP(&cmder.apiTarget, "api-target", "a", defaults.Client.APITarget, "Tapes API server URL")
+
+	return cmd
+}
+
+func (c *showCommander) run() error {
+	c.logger = logger.NewLogger(c.debug)
+	defer func() { _ = c.logger.Sync() }()
+
+	c.logger.Debug("showing node",
+		zap.String("hash", c.hash),
+		zap.String("api_target", c.apiTarget),
+	)
+
+	hash, err := dagapi.ResolveHead(c.hash)
+	if err != nil {
+		return err
+	}
+
+	node, err := dagapi.Node(c.apiTarget, hash)
+	if err != nil {
+		return fmt.Errorf("fetching node: %w", err)
+	}
+
+	Render(os.Stdout, node)
+	return nil
+}
+
+// Render writes the node's metadata and content blocks to w.
+func Render(w io.Writer, node *merkle.Node) {
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(w, "%s %s\n", cliui.DimStyle.Render(fmt.Sprintf("%-10s", name)), value)
+		}
+	}
+
+	fmt.Fprintf(w, "%s %s\n", cliui.HeaderStyle.Render(fmt.Sprintf("%-10s", "node")), cliui.HashStyle.Render(node.Hash))
+	if node.ParentHash != nil {
+		field("parent", cliui.HashStyle.Render(*node.ParentHash))
+	} else {
+		field("parent", cliui.DimStyle.Render("(root)"))
+	}
+	field("role", node.Bucket.Role)
+	model := node.Bucket.Model
+	if model != "" && node.Bucket.Provider != "" {
+		model += " " + cliui.DimStyle.Render("("+node.Bucket.Provider+")")
+	}
+	field("model", model)
+	if node.RequestedModel != "" && node.RequestedModel != node.Bucket.Model {
+		field("requested", node.RequestedModel)
+	}
+	field("agent", node.Bucket.AgentName)
+	field("project", node.Project)
+	field("stop", node.StopReason)
+	field("usage", formatUsage(node.Usage))
+	for _, key := range slices.Sorted(maps.Keys(node.Metadata)) {
+		field("metadata", key+"="+node.Metadata[key])
+	}
+
+	for _, block := range node.Bucket.Content {
+		fmt.Fprintln(w)
+		renderBlock(w, block)
+	}
+}
+
+// renderBlock writes a single content block.
+func renderBlock(w io.Writer, block llm.ContentBlock) {
+	switch block.Type {
+	case "tool_use":
+		fmt.Fprintf(w, "%s %s %s\n",
+			cliui.TagStyle.Render("▸ tool call"),
+			cliui.NameStyle.Render(block.ToolName),
+			cliui.DimStyle.Render(block.ToolUseID),
+		)
+		if len(block.ToolInput) > 0 {
+			input, err := json.MarshalIndent(block.ToolInput, "  ", "  ")
+			if err == nil {
+				fmt.Fprintf(w, "  %s\n", input)
+			}
+		}
+	case "tool_result":
+		label := "◂ tool result"
+		if block.IsError {
+			label += " (error)"
+		}
+		fmt.Fprintf(w, "%s %s\n", cliui.TagStyle.Render(label), cliui.DimStyle.Render(block.ToolResultID))
+		fmt.Fprintln(w, indent(block.ToolOutput))
+	case "image":
+		source := block.ImageURL
+		if source == "" || strings.HasPrefix(source, "data:") {
+			source = "inline"
+		}
+		fmt.Fprintln(w, cliui.TagStyle.Render(fmt.Sprintf("[image %s %s]", block.MediaType, source)))
+	default:
+		if block.Text != "" {
+			fmt.Fprintln(w, block.Text)
+		} else {
+			fmt.Fprintln(w, cliui.DimStyle.Render("["+block.Type+"]"))
+		}
+	}
+}
+
+// formatUsage renders token counts, or "" for nodes without usage.
+func formatUsage(u *llm.Usage) string {
+	if u == nil {
+		return ""
+	}
+	usage := fmt.Sprintf("%d prompt + %d completion = %d tokens", u.PromptTokens, u.CompletionTokens, u.TotalTokens)
+	if u.CacheReadInputTokens > 0 || u.CacheCreationInputTokens > 0 {
+		usage += fmt.Sprintf(" (cache read %d, write %d)", u.CacheReadInputTokens, u.CacheCreationInputTokens)
+	}
+	return usage
+}
+
+// indent prefixes every line of text with two spaces.
+func indent(text string) string {
+	return "  " + strings.ReplaceAll(text, "\n", "\n  ")
+}
@@ -0,0 +1,57 @@
+package showcmder_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	showcmder "github.com/papercomputeco/tapes/cmd/tapes/show"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+)
+
+var _ = Describe("NewShowCmd", func() {
+	It("requires exactly one hash", func() {
+		cmd := showcmder.NewShowCmd()
+		Expect(cmd.Use).To(Equal("show <hash>"))
+		Expect(cmd.Args(cmd, []string{})).To(HaveOccurred())
+		Expect(cmd.Args(cmd, []string{"abc123"})).To(Succeed())
+	})
+})
+
+var _ = Describe("Render", func() {
+	It("renders metadata and every content block", func() {
+		parent := merkle.NewNode(merkle.Bucket{Type: "message", Role: "user"}, nil)
+		node := merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "assistant",
+			Model:    "claude-sonnet-4",
+			Provider: "anthropic",
+			Content: []llm.ContentBlock{
+				{Type: "text", Text: "Let me check."},
+				{Type: "tool_use", ToolUseID: "toolu_1", ToolName: "read_file", ToolInput: map[string]any{"path": "main.go"}},
+				{Type: "tool_result", ToolResultID: "toolu_1", ToolOutput: "package main\n", IsError: true},
+			},
+		}, parent, merkle.NodeMeta{
+			StopReason: "tool_use",
+			Usage:      &llm.Usage{PromptTokens: 12, CompletionTokens: 8, TotalTokens: 20},
+			Metadata:   map[string]string{"session": "abc"},
+		})
+
+		var out bytes.Buffer
+		showcmder.Render(&out, node)
+
+		Expect(out.String()).To(ContainSubstring(node.Hash))
+		Expect(out.String()).To(ContainSubstring(parent.Hash))
+		Expect(out.String()).To(ContainSubstring("claude-sonnet-4 (anthropic)"))
+		Expect(out.String()).To(ContainSubstring("tool_use"))
+		Expect(out.String()).To(ContainSubstring("12 prompt + 8 completion = 20 tokens"))
+		Expect(out.String()).To(ContainSubstring("session=abc"))
+		Expect(out.String()).To(ContainSubstring("Let me check."))
+		Expect(out.String()).To(ContainSubstring("▸ tool call read_file toolu_1"))
+		Expect(out.String()).To(ContainSubstring(`"path": "main.go"`))
+		Expect(out.String()).To(ContainSubstring("◂ tool result (error) toolu_1"))
+		Expect(out.String()).To(ContainSubstring("  package main"))
+	})
+})
@@ -0,0 +1,131 @@
+// Package signcmder provides the sign subcommand for signing recorded
+// conversations.
+package signcmder
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/credentials"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+const signLongDesc string = `Sign a conversation with your ed25519 key.
+
+Each node's hash covers its parent's hash, so a signature over a node
+vouches for the whole conversation leading up to it. The chain is verified
+before signing, and the signature is stored in the database next to the
+nodes. Use "tapes verify" to check signatures and produce inclusion proofs.
+
+The signing key is generated on first use and stored in credentials.toml.
+Share the public key printed by --public-key with anyone who verifies your
+signatures.
+
+The hash may be abbreviated, name a ref, or be HEAD (the default), the
+current checkout.
+
+Examples:
+  tapes sign                   Sign the current checkout
+  tapes sign abc123            Sign a node
+  tapes sign --public-key      Print the public signing key`
+
+const signShortDesc string = "Sign a conversation"
+
+type signCommander struct {
+	hash       string
+	sqlitePath string
+	configDir  string
+	publicKey  bool
+}
+
+// NewSignCmd creates the sign cobra command.
+func NewSignCmd() *cobra.Command {
+	cmder := &signCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "sign [hash]",
+		Short: signShortDesc,
+		Long:  signLongDesc,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hash = storage.HeadRef
+			if len(args) > 0 {
+				cmder.hash = args[0]
+			}
+			cmder.configDir, _ = cmd.Flags().GetString("config-dir")
+			return cmder.run(cmd.Context(), cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database")
+	cmd.Flags().BoolVar(&cmder.publicKey, "public-key", false, "Print the public signing key and exit")
+
+	return cmd
+}
+
+func (c *signCommander) run(ctx context.Context, cmd *cobra.Command) error {
+	mgr, err := credentials.NewManager(c.configDir)
+	if err != nil {
+		return fmt.Errorf("loading credentials: %w", err)
+	}
+
+	key, created, err := signing.LoadOrCreateKey(mgr)
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Generated signing key in %s\n", mgr.GetTarget())
+	}
+
+	if c.publicKey {
+		pub, _ := key.Public().(ed25519.PublicKey)
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", signing.KeyID(pub), signing.EncodePublicKey(pub))
+		return nil
+	}
+
+	dbPath, err := sqlitepath.ResolveSQLitePath(c.sqlitePath)
+	if err != nil {
+		return fmt.Errorf("could not resolve database: %w", err)
+	}
+
+	driver, err := sqlite.NewDriver(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open database %s: %w", dbPath, err)
+	}
+	defer driver.Close()
+
+	hash, err := dagapi.ResolveHead(c.hash)
+	if err != nil {
+		return err
+	}
+	hash, err = storage.ResolveHash(ctx, driver, hash)
+	if err != nil {
+		return err
+	}
+
+	ancestry, err := driver.Ancestry(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("loading conversation: %w", err)
+	}
+	if err := merkle.VerifyAncestry(ancestry); err != nil {
+		return fmt.Errorf("refusing to sign a tampered conversation: %w", err)
+	}
+
+	sig := signing.Sign(key, hash)
+	if err := driver.PutSignature(ctx, sig); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Signed %s (%d turns) with key %s\n",
+		cliui.HashStyle.Render(dagapi.ShortHash(hash)), len(ancestry), sig.KeyID)
+	return nil
+}
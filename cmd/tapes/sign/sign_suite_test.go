@@ -0,0 +1,13 @@
+package signcmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSignCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sign Commander Suite")
+}
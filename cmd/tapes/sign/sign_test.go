@@ -0,0 +1,88 @@
+package signcmder
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+var _ = Describe("Sign Command", func() {
+	var (
+		ctx       context.Context
+		dbPath    string
+		configDir string
+		root      *merkle.Node
+		reply     *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir := GinkgoT().TempDir()
+		dbPath = filepath.Join(dir, "tapes.sqlite")
+		configDir = filepath.Join(dir, ".tapes")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+
+		root = merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "user",
+			Content:  []llm.ContentBlock{{Type: "text", Text: "Hello"}},
+			Model:    "test-model",
+			Provider: "test",
+		}, nil)
+		reply = merkle.NewNode(merkle.Bucket{
+			Type:     "message",
+			Role:     "assistant",
+			Content:  []llm.ContentBlock{{Type: "text", Text: "Hi there!"}},
+			Model:    "test-model",
+			Provider: "test",
+		}, root)
+		for _, n := range []*merkle.Node{root, reply} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	execute := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := NewSignCmd()
+		cmd.PersistentFlags().String("config-dir", "", "Override path to .tapes/ config directory")
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append(args, "--config-dir", configDir))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	It("signs a node with a generated key", func() {
+		out, err := execute(reply.Hash[:8], "--sqlite", dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Generated signing key"))
+		Expect(out).To(ContainSubstring("2 turns"))
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		defer driver.Close()
+
+		sigs, err := driver.Signatures(ctx, reply.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sigs).To(HaveLen(1))
+		Expect(signing.Verify(sigs[0])).To(Succeed())
+	})
+
+	It("prints the public key", func() {
+		out, err := execute("--public-key")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`[0-9a-f]{16} \S+=\n`))
+	})
+})
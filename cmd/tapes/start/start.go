@@ -81,6 +81,11 @@ type startConfig struct {
 	OllamaUpstream      string
 	OpenCodeProvider    string
 	Project             string
+	Routes              []proxy.Route
+	CompatProviders     []proxy.CompatProvider
+	Cache               proxy.CacheConfig
+	Upstreams           []config.UpstreamConfig
+	HealthCheckInterval time.Duration
 }
 
 func NewStartCmd() *cobra.Command {
@@ -394,6 +399,14 @@ func (c *startCommander) runServices(ctx context.Context, manager *start.Manager
 		UpstreamURL:  startCfg.DefaultUpstream,
 		ProviderType: startCfg.DefaultProvider,
 		Project:      startCfg.Project,
+		Routes:       startCfg.Routes,
+		Cache:        startCfg.Cache,
+
+		CompatProviders: startCfg.CompatProviders,
+
+		Upstreams:           proxy.UpstreamsFromConfig(startCfg.Upstreams),
+		HealthCheckInterval: startCfg.HealthCheckInterval,
+
 		AgentRoutes: map[string]proxy.AgentRoute{
 			agentClaude:   {ProviderType: "anthropic", UpstreamURL: "https://api.anthropic.com"},
 			agentOpenCode: openCodeRoute,
@@ -404,6 +417,13 @@ func (c *startCommander) runServices(ctx context.Context, manager *start.Manager
 			"openai":    "https://api.openai.com/v1",
 			"ollama":    startCfg.OllamaUpstream,
 		},
+		AgentPID: func(agentName string) int {
+			state, err := manager.LoadState()
+			if err != nil || state == nil {
+				return 0
+			}
+			return state.AgentPID(agentName)
+		},
 		VectorDriver: vectorDriver,
 		Embedder:     embedder,
 	}
@@ -673,6 +693,26 @@ func (c *startCommander) loadConfig() (*startConfig, error) {
 		project = cfg.Proxy.Project
 	}
 
+	healthCheckInterval, err := cfg.Proxy.HealthCheckDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := proxy.RoutesFromConfig(cfg.Proxy.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	compat, err := proxy.CompatProvidersFromConfig(cfg.Proxy.Providers)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheTTL, err := cfg.Proxy.CacheTTLDuration()
+	if err != nil {
+		return nil, err
+	}
+
 	return &startConfig{
 		SQLitePath:          sqlitePath,
 		VectorStoreProvider: cfg.VectorStore.Provider,
@@ -686,6 +726,11 @@ func (c *startCommander) loadConfig() (*startConfig, error) {
 		OllamaUpstream:      resolveOllamaUpstream(cfg.Proxy.Provider, cfg.Proxy.Upstream),
 		OpenCodeProvider:    cfg.OpenCode.Provider,
 		Project:             project,
+		Routes:              routes,
+		CompatProviders:     compat,
+		Cache:               proxy.CacheConfig{Enabled: cfg.Proxy.Cache, TTL: cacheTTL},
+		Upstreams:           cfg.Proxy.Upstreams,
+		HealthCheckInterval: healthCheckInterval,
 	}, nil
 }
 
@@ -52,6 +52,9 @@ func runStatus() error {
 	}
 
 	fmt.Printf("Checked out: %s\n", state.Hash)
+	if state.Branch != "" {
+		fmt.Printf("Branch:      %s\n", state.Branch)
+	}
 	fmt.Printf("Messages:    %d\n", len(state.Messages))
 	fmt.Println()
 
@@ -4,21 +4,34 @@ package tapescmder
 import (
 	"github.com/spf13/cobra"
 
+	annotatecmder "github.com/papercomputeco/tapes/cmd/tapes/annotate"
 	authcmder "github.com/papercomputeco/tapes/cmd/tapes/auth"
+	branchescmder "github.com/papercomputeco/tapes/cmd/tapes/branches"
+	cacmder "github.com/papercomputeco/tapes/cmd/tapes/ca"
 	chatcmder "github.com/papercomputeco/tapes/cmd/tapes/chat"
 	checkoutcmder "github.com/papercomputeco/tapes/cmd/tapes/checkout"
 	configcmder "github.com/papercomputeco/tapes/cmd/tapes/config"
+	datasetcmder "github.com/papercomputeco/tapes/cmd/tapes/dataset"
 	deckcmder "github.com/papercomputeco/tapes/cmd/tapes/deck"
+	diffcmder "github.com/papercomputeco/tapes/cmd/tapes/diff"
+	exportcmder "github.com/papercomputeco/tapes/cmd/tapes/export"
+	gccmder "github.com/papercomputeco/tapes/cmd/tapes/gc"
+	importcmder "github.com/papercomputeco/tapes/cmd/tapes/import"
 	initcmder "github.com/papercomputeco/tapes/cmd/tapes/init"
+	logcmder "github.com/papercomputeco/tapes/cmd/tapes/log"
 	mergecmder "github.com/papercomputeco/tapes/cmd/tapes/merge"
 	pushcmder "github.com/papercomputeco/tapes/cmd/tapes/push"
+	refscmder "github.com/papercomputeco/tapes/cmd/tapes/refs"
 	searchcmder "github.com/papercomputeco/tapes/cmd/tapes/search"
 	seedcmder "github.com/papercomputeco/tapes/cmd/tapes/seed"
 	servecmder "github.com/papercomputeco/tapes/cmd/tapes/serve"
+	showcmder "github.com/papercomputeco/tapes/cmd/tapes/show"
+	signcmder "github.com/papercomputeco/tapes/cmd/tapes/sign"
 	skillcmder "github.com/papercomputeco/tapes/cmd/tapes/skill"
 	startcmder "github.com/papercomputeco/tapes/cmd/tapes/start"
 	statuscmder "github.com/papercomputeco/tapes/cmd/tapes/status"
 	synccmder "github.com/papercomputeco/tapes/cmd/tapes/sync"
+	verifycmder "github.com/papercomputeco/tapes/cmd/tapes/verify"
 	versioncmder "github.com/papercomputeco/tapes/cmd/version"
 )
 
@@ -34,11 +47,30 @@ Run services using:
 Experimental: Chat through the proxy:
   tapes chat               Start an interactive chat session
   tapes checkout <hash>    Checkout a conversation point
+  tapes checkout <branch>  Checkout a branch that follows new turns
   tapes checkout           Clear checkout state, start fresh
   tapes status             Show current checkout state
   tapes init                         Initialize a local .tapes directory
   tapes init --preset <preset|url>   Initialize with a provider preset or remote config
 
+Browse conversations:
+  tapes log [hash]         Show the turns leading up to a node
+  tapes show <hash>        Show a node's full content
+  tapes branches [root]    List conversation branches as a tree
+  tapes diff <a> <b>       Compare two conversation branches
+  tapes tag <name>         Name a node with a fixed tag
+  tapes branch <name>      Start a named branch that follows new turns
+
+Audit conversations:
+  tapes sign [hash]        Sign a conversation with your ed25519 key
+  tapes verify [hash]      Verify hash chains, signatures and inclusion proofs
+  tapes annotate [hash]    Rate, label and comment on a node
+
+Share conversations:
+  tapes export [hash...]   Export conversations to a portable bundle
+  tapes import <bundle>    Import a bundle of conversations
+  tapes dataset export     Export conversations as a fine-tuning dataset
+
 Search sessions:
   tapes search         Search sessions using semantic similarity
 
@@ -67,20 +99,34 @@ func NewTapesCmd() *cobra.Command {
 
 	// Add subcommands
 	cmd.AddCommand(synccmder.NewSyncCmd())
+	cmd.AddCommand(annotatecmder.NewAnnotateCmd())
+	cmd.AddCommand(refscmder.NewBranchCmd())
+	cmd.AddCommand(branchescmder.NewBranchesCmd())
+	cmd.AddCommand(cacmder.NewCACmd())
 	cmd.AddCommand(chatcmder.NewChatCmd())
 	cmd.AddCommand(checkoutcmder.NewCheckoutCmd())
 	cmd.AddCommand(configcmder.NewConfigCmd())
+	cmd.AddCommand(datasetcmder.NewDatasetCmd())
 	cmd.AddCommand(deckcmder.NewDeckCmd())
+	cmd.AddCommand(diffcmder.NewDiffCmd())
+	cmd.AddCommand(exportcmder.NewExportCmd())
+	cmd.AddCommand(gccmder.NewGCCmd())
 	cmd.AddCommand(authcmder.NewAuthCmd())
+	cmd.AddCommand(importcmder.NewImportCmd())
 	cmd.AddCommand(initcmder.NewInitCmd())
+	cmd.AddCommand(logcmder.NewLogCmd())
 	cmd.AddCommand(mergecmder.NewMergeCmd())
 	cmd.AddCommand(pushcmder.NewPushCmd())
 	cmd.AddCommand(searchcmder.NewSearchCmd())
 	cmd.AddCommand(seedcmder.NewSeedCmd())
 	cmd.AddCommand(servecmder.NewServeCmd())
+	cmd.AddCommand(showcmder.NewShowCmd())
+	cmd.AddCommand(signcmder.NewSignCmd())
 	cmd.AddCommand(skillcmder.NewSkillCmd())
 	cmd.AddCommand(startcmder.NewStartCmd())
 	cmd.AddCommand(statuscmder.NewStatusCmd())
+	cmd.AddCommand(refscmder.NewTagCmd())
+	cmd.AddCommand(verifycmder.NewVerifyCmd())
 	cmd.AddCommand(versioncmder.NewVersionCmd())
 
 	return cmd
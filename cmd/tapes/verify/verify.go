@@ -0,0 +1,205 @@
+// Package verifycmder provides the verify subcommand for checking that
+// recorded conversations were not tampered with.
+package verifycmder
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/papercomputeco/tapes/cmd/tapes/dagapi"
+	"github.com/papercomputeco/tapes/cmd/tapes/sqlitepath"
+	"github.com/papercomputeco/tapes/pkg/cliui"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+const verifyLongDesc string = `Verify that a node is part of a signed, untampered conversation.
+
+The hash chain from the node back to its root is recomputed, and every
+signed conversation that contains the node is checked: its chain must
+verify and its signature must be valid. Verification fails when any hash
+does not match its content or when no signature covers the node.
+
+With --key, only signatures by the given public keys (as printed by
+"tapes sign --public-key") count. With --output, the signatures are written
+with inclusion proofs that link the node to each signed turn; the proof
+file can be checked later with --proof, without access to the database.
+
+The hash may be abbreviated, name a ref, or be HEAD (the default), the
+current checkout.
+
+Examples:
+  tapes verify                          Verify the current checkout
+  tapes verify abc123 --key <pubkey>    Require a signature by a trusted key
+  tapes verify abc123 -o proof.json     Write inclusion proofs
+  tapes verify --proof proof.json       Check a proof file offline`
+
+const verifyShortDesc string = "Verify signatures and hash chains"
+
+type verifyCommander struct {
+	hash       string
+	sqlitePath string
+	keys       []string
+	output     string
+	proof      string
+
+	trusted []ed25519.PublicKey
+}
+
+// NewVerifyCmd creates the verify cobra command.
+func NewVerifyCmd() *cobra.Command {
+	cmder := &verifyCommander{}
+
+	cmd := &cobra.Command{
+		Use:   "verify [hash]",
+		Short: verifyShortDesc,
+		Long:  verifyLongDesc,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmder.hash = storage.HeadRef
+			if len(args) > 0 {
+				cmder.hash = args[0]
+			}
+			return cmder.run(cmd.Context(), cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&cmder.sqlitePath, "sqlite", "s", "", "Path to SQLite database")
+	cmd.Flags().StringSliceVar(&cmder.keys, "key", nil, "Trusted public key (repeatable)")
+	cmd.Flags().StringVarP(&cmder.output, "output", "o", "", "Write inclusion proofs to a file")
+	cmd.Flags().StringVar(&cmder.proof, "proof", "", "Verify a proof file instead of the database")
+
+	return cmd
+}
+
+func (c *verifyCommander) run(ctx context.Context, cmd *cobra.Command) error {
+	for _, key := range c.keys {
+		pub, err := signing.DecodePublicKey(key)
+		if err != nil {
+			return err
+		}
+		c.trusted = append(c.trusted, pub)
+	}
+
+	if c.proof != "" {
+		return c.verifyProofFile(cmd)
+	}
+
+	dbPath, err := sqlitepath.ResolveSQLitePath(c.sqlitePath)
+	if err != nil {
+		return fmt.Errorf("could not resolve database: %w", err)
+	}
+
+	driver, err := sqlite.NewDriver(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open database %s: %w", dbPath, err)
+	}
+	defer driver.Close()
+
+	hash, err := dagapi.ResolveHead(c.hash)
+	if err != nil {
+		return err
+	}
+	hash, err = storage.ResolveHash(ctx, driver, hash)
+	if err != nil {
+		return err
+	}
+
+	attestations, err := signing.Attest(ctx, driver, hash)
+	if err != nil {
+		return fmt.Errorf("verification failed: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Hash chain of %s verified\n", cliui.HashStyle.Render(dagapi.ShortHash(hash)))
+
+	attestations = c.filter(attestations)
+	if err := c.report(cmd, hash, attestations); err != nil {
+		return err
+	}
+
+	if c.output != "" {
+		data, err := json.MarshalIndent(attestations, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding proofs: %w", err)
+		}
+		if err := os.WriteFile(c.output, data, 0o644); err != nil {
+			return fmt.Errorf("writing proofs: %w", err)
+		}
+		fmt.Fprintf(out, "Wrote %d proofs to %s\n", len(attestations), c.output)
+	}
+
+	return nil
+}
+
+func (c *verifyCommander) verifyProofFile(cmd *cobra.Command) error {
+	data, err := os.ReadFile(c.proof)
+	if err != nil {
+		return fmt.Errorf("reading proofs: %w", err)
+	}
+
+	var attestations []*signing.Attestation
+	if err := json.Unmarshal(data, &attestations); err != nil {
+		return fmt.Errorf("parsing proofs: %w", err)
+	}
+	if len(attestations) == 0 {
+		return fmt.Errorf("no proofs in %s", c.proof)
+	}
+
+	hash := attestations[0].Proof.Hash
+	for _, attestation := range attestations {
+		if err := attestation.Verify(); err != nil {
+			return fmt.Errorf("verification failed: %w", err)
+		}
+		if attestation.Proof.Hash != hash {
+			return fmt.Errorf("proofs in %s are for different nodes", c.proof)
+		}
+	}
+
+	return c.report(cmd, hash, c.filter(attestations))
+}
+
+// filter keeps the attestations signed by a trusted key, or all of them when
+// no keys were given.
+func (c *verifyCommander) filter(attestations []*signing.Attestation) []*signing.Attestation {
+	if len(c.trusted) == 0 {
+		return attestations
+	}
+
+	var kept []*signing.Attestation
+	for _, attestation := range attestations {
+		for _, pub := range c.trusted {
+			if pub.Equal(ed25519.PublicKey(attestation.Signature.PublicKey)) {
+				kept = append(kept, attestation)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+func (c *verifyCommander) report(cmd *cobra.Command, hash string, attestations []*signing.Attestation) error {
+	if len(attestations) == 0 {
+		if len(c.trusted) > 0 {
+			return fmt.Errorf("no trusted signature covers %s", dagapi.ShortHash(hash))
+		}
+		return fmt.Errorf("no signature covers %s", dagapi.ShortHash(hash))
+	}
+
+	out := cmd.OutOrStdout()
+	for _, attestation := range attestations {
+		fmt.Fprintf(out, "Signed by %s at %s (%d turns later) on %s\n",
+			attestation.Signature.KeyID,
+			cliui.HashStyle.Render(dagapi.ShortHash(attestation.Signature.Hash)),
+			len(attestation.Proof.Path),
+			attestation.Signature.CreatedAt.Format("2006-01-02 15:04:05"),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package verifycmder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVerifyCommander(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Verify Commander Suite")
+}
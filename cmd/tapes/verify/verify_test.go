@@ -0,0 +1,106 @@
+package verifycmder
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+func verifyTestBucket(role, text string) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:    "test-model",
+		Provider: "test",
+	}
+}
+
+var _ = Describe("Verify Command", func() {
+	var (
+		ctx    context.Context
+		dir    string
+		dbPath string
+		key    ed25519.PrivateKey
+		driver *sqlite.Driver
+
+		root, reply, fork *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		dbPath = filepath.Join(dir, "tapes.sqlite")
+
+		var err error
+		driver, err = sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		root = merkle.NewNode(verifyTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(verifyTestBucket("assistant", "Hi there!"), root)
+		fork = merkle.NewNode(verifyTestBucket("assistant", "Goodbye!"), root)
+		for _, n := range []*merkle.Node{root, reply, fork} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		key, err = signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(driver.PutSignature(ctx, signing.Sign(key, reply.Hash))).To(Succeed())
+	})
+
+	execute := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := NewVerifyCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	publicKey := func(k ed25519.PrivateKey) string {
+		pub, _ := k.Public().(ed25519.PublicKey)
+		return signing.EncodePublicKey(pub)
+	}
+
+	It("verifies nodes included in a signed conversation", func() {
+		out, err := execute(root.Hash, "--sqlite", dbPath, "--key", publicKey(key))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Hash chain of"))
+		Expect(out).To(ContainSubstring("Signed by " + signing.KeyID(key.Public().(ed25519.PublicKey))))
+	})
+
+	It("fails when no signature covers the node", func() {
+		_, err := execute(fork.Hash, "--sqlite", dbPath)
+		Expect(err).To(MatchError(ContainSubstring("no signature covers")))
+	})
+
+	It("fails when only untrusted keys signed", func() {
+		other, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = execute(root.Hash, "--sqlite", dbPath, "--key", publicKey(other))
+		Expect(err).To(MatchError(ContainSubstring("no trusted signature")))
+	})
+
+	It("writes proofs that verify offline", func() {
+		proofPath := filepath.Join(dir, "proof.json")
+		_, err := execute(root.Hash, "--sqlite", dbPath, "-o", proofPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := execute("--proof", proofPath, "--key", publicKey(key))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("Signed by"))
+	})
+})
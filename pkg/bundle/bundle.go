@@ -0,0 +1,402 @@
+// Package bundle reads and writes portable session bundles.
+//
+// A bundle is a self-describing, newline-delimited JSON stream that holds
+// the ancestry closure of a set of nodes: every selected node and all of its
+// ancestors, so the conversations can be loaded into any store without
+// access to the original database. The first record is a manifest listing
+// the bundle's roots and leaves; it is followed by the nodes, parents before
+// children, and by the refs, signatures and session facets that belong to
+// them. Large image and tool output payloads kept in a driver's blob store
+// are inlined in their content blocks, so every node's hash can be verified
+// from the bundle alone.
+//
+// Bundles may be gzip-compressed; Read detects compression automatically.
+package bundle
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// Version is the bundle format version written by this package.
+const Version = 1
+
+// Record types, one per line of a bundle.
+const (
+	RecordManifest  = "manifest"
+	RecordNode      = "node"
+	RecordRef       = "ref"
+	RecordSignature = "signature"
+	RecordFacet     = "facet"
+)
+
+// maxLineSize bounds a single record, which may hold inlined images.
+const maxLineSize = 256 << 20
+
+// Manifest describes the contents of a bundle.
+type Manifest struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Roots are the nodes without a parent.
+	Roots []string `json:"roots"`
+
+	// Leaves are the nodes without children in the bundle.
+	Leaves []string `json:"leaves"`
+
+	Nodes      int `json:"nodes"`
+	Refs       int `json:"refs"`
+	Signatures int `json:"signatures"`
+	Facets     int `json:"facets"`
+}
+
+// Record is a single line of a bundle. Exactly one field besides Type is set.
+type Record struct {
+	Type      string             `json:"type"`
+	Manifest  *Manifest          `json:"manifest,omitempty"`
+	Node      *merkle.Node       `json:"node,omitempty"`
+	Ref       *storage.Ref       `json:"ref,omitempty"`
+	Signature *storage.Signature `json:"signature,omitempty"`
+	Facet     *deck.SessionFacet `json:"facet,omitempty"`
+}
+
+// Bundle is the decoded contents of a bundle.
+type Bundle struct {
+	Manifest Manifest
+
+	// Nodes are ordered parents before children.
+	Nodes      []*merkle.Node
+	Refs       []*storage.Ref
+	Signatures []*storage.Signature
+	Facets     []*deck.SessionFacet
+}
+
+// Collect builds a bundle holding hashes and all of their ancestors. Refs
+// and signatures are included when the driver stores them, and the facets
+// of the bundle's leaves are included when facets is not nil.
+func Collect(ctx context.Context, driver storage.Driver, hashes []string, facets deck.FacetStore) (*Bundle, error) {
+	nodes := map[string]*merkle.Node{}
+	depths := map[string]int{}
+	for _, hash := range hashes {
+		if _, ok := nodes[hash]; ok {
+			continue
+		}
+		ancestry, err := driver.Ancestry(ctx, hash)
+		if err != nil {
+			return nil, fmt.Errorf("loading ancestry of %s: %w", hash, err)
+		}
+		for i, node := range ancestry {
+			nodes[node.Hash] = node
+			depths[node.Hash] = len(ancestry) - 1 - i
+		}
+	}
+
+	b := &Bundle{}
+	for _, node := range nodes {
+		b.Nodes = append(b.Nodes, node)
+	}
+	slices.SortFunc(b.Nodes, func(x, y *merkle.Node) int {
+		if d := depths[x.Hash] - depths[y.Hash]; d != 0 {
+			return d
+		}
+		return strings.Compare(x.Hash, y.Hash)
+	})
+
+	if refs, ok := driver.(storage.RefStore); ok {
+		all, err := refs.Refs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing refs: %w", err)
+		}
+		for _, ref := range all {
+			if _, ok := nodes[ref.Hash]; ok {
+				b.Refs = append(b.Refs, ref)
+			}
+		}
+	}
+
+	if sigs, ok := driver.(storage.SignatureStore); ok {
+		for _, node := range b.Nodes {
+			found, err := sigs.Signatures(ctx, node.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("listing signatures of %s: %w", node.Hash, err)
+			}
+			b.Signatures = append(b.Signatures, found...)
+		}
+	}
+
+	b.Manifest = newManifest(b)
+
+	if facets != nil {
+		for _, leaf := range b.Manifest.Leaves {
+			facet, err := facets.GetFacet(ctx, leaf)
+			if err != nil {
+				// Most sessions have no extracted facets.
+				continue
+			}
+			b.Facets = append(b.Facets, facet)
+		}
+		b.Manifest.Facets = len(b.Facets)
+	}
+
+	return b, nil
+}
+
+// newManifest describes the nodes, refs and signatures of b.
+func newManifest(b *Bundle) Manifest {
+	m := Manifest{
+		Version:    Version,
+		CreatedAt:  time.Now().UTC(),
+		Roots:      []string{},
+		Leaves:     []string{},
+		Nodes:      len(b.Nodes),
+		Refs:       len(b.Refs),
+		Signatures: len(b.Signatures),
+		Facets:     len(b.Facets),
+	}
+
+	parents := map[string]bool{}
+	for _, node := range b.Nodes {
+		if node.ParentHash != nil {
+			parents[*node.ParentHash] = true
+		}
+	}
+	for _, node := range b.Nodes {
+		if node.ParentHash == nil {
+			m.Roots = append(m.Roots, node.Hash)
+		}
+		if !parents[node.Hash] {
+			m.Leaves = append(m.Leaves, node.Hash)
+		}
+	}
+	slices.Sort(m.Roots)
+	slices.Sort(m.Leaves)
+	return m
+}
+
+// Write encodes b to w, gzip-compressed when compress is set.
+func (b *Bundle) Write(w io.Writer, compress bool) error {
+	if compress {
+		zw := gzip.NewWriter(w)
+		if err := b.Write(zw, false); err != nil {
+			return err
+		}
+		return zw.Close()
+	}
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	manifest := b.Manifest
+	records := []Record{{Type: RecordManifest, Manifest: &manifest}}
+	for _, node := range b.Nodes {
+		records = append(records, Record{Type: RecordNode, Node: node})
+	}
+	for _, ref := range b.Refs {
+		records = append(records, Record{Type: RecordRef, Ref: ref})
+	}
+	for _, sig := range b.Signatures {
+		records = append(records, Record{Type: RecordSignature, Signature: sig})
+	}
+	for _, facet := range b.Facets {
+		records = append(records, Record{Type: RecordFacet, Facet: facet})
+	}
+
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("encoding %s record: %w", record.Type, err)
+		}
+	}
+	return bw.Flush()
+}
+
+// Read decodes and verifies a bundle. Compressed bundles are detected
+// automatically. Read fails if any node's hash does not match its content,
+// if a node's parent is missing from the bundle, if a signature is invalid,
+// or if the contents do not match the manifest.
+func Read(r io.Reader) (*Bundle, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("opening compressed bundle: %w", err)
+		}
+		defer zr.Close()
+		br = bufio.NewReader(zr)
+	}
+
+	scanner := bufio.NewScanner(br)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	b := &Bundle{}
+	var manifest *Manifest
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if manifest == nil && record.Type != RecordManifest {
+			return nil, errors.New("bundle does not start with a manifest")
+		}
+
+		switch {
+		case record.Type == RecordManifest && record.Manifest != nil && manifest == nil:
+			manifest = record.Manifest
+			if manifest.Version != Version {
+				return nil, fmt.Errorf("unsupported bundle version %d", manifest.Version)
+			}
+		case record.Type == RecordNode && record.Node != nil:
+			b.Nodes = append(b.Nodes, record.Node)
+		case record.Type == RecordRef && record.Ref != nil:
+			b.Refs = append(b.Refs, record.Ref)
+		case record.Type == RecordSignature && record.Signature != nil:
+			b.Signatures = append(b.Signatures, record.Signature)
+		case record.Type == RecordFacet && record.Facet != nil:
+			b.Facets = append(b.Facets, record.Facet)
+		default:
+			return nil, fmt.Errorf("line %d: invalid %q record", line, record.Type)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading bundle: %w", err)
+	}
+	if manifest == nil {
+		return nil, errors.New("bundle is empty")
+	}
+
+	b.Manifest = *manifest
+	if err := b.Verify(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Verify checks that every node's hash matches its content, that nodes
+// follow their parents, that refs and signatures belong to nodes in the
+// bundle, that signatures are valid, and that the contents match the
+// manifest.
+func (b *Bundle) Verify() error {
+	seen := map[string]bool{}
+	for _, node := range b.Nodes {
+		if err := node.Verify(); err != nil {
+			return err
+		}
+		if node.ParentHash != nil && !seen[*node.ParentHash] {
+			return fmt.Errorf("node %s: parent %s is missing or out of order", node.Hash, *node.ParentHash)
+		}
+		seen[node.Hash] = true
+	}
+
+	for _, ref := range b.Refs {
+		if !seen[ref.Hash] {
+			return fmt.Errorf("ref %s points at %s, which is not in the bundle", ref.Name, ref.Hash)
+		}
+	}
+	for _, sig := range b.Signatures {
+		if !seen[sig.Hash] {
+			return fmt.Errorf("signature of %s, which is not in the bundle", sig.Hash)
+		}
+		if err := signing.Verify(sig); err != nil {
+			return err
+		}
+	}
+
+	want := newManifest(b)
+	m := b.Manifest
+	switch {
+	case m.Nodes != want.Nodes, m.Refs != want.Refs, m.Signatures != want.Signatures, m.Facets != want.Facets:
+		return errors.New("bundle contents do not match its manifest counts")
+	case !slices.Equal(m.Roots, want.Roots):
+		return errors.New("bundle roots do not match its manifest")
+	case !slices.Equal(m.Leaves, want.Leaves):
+		return errors.New("bundle leaves do not match its manifest")
+	}
+	return nil
+}
+
+// ImportStats summarizes an import.
+type ImportStats struct {
+	New        int
+	Existing   int
+	Refs       int
+	Signatures int
+	Facets     int
+
+	// Conflicts names the refs that diverged from the store's and were
+	// left untouched.
+	Conflicts []string
+}
+
+// Import stores the bundle's nodes, refs, signatures and facets. Nodes that
+// already exist are skipped, and refs are applied with storage.SyncRef so
+// diverged refs keep the store's node. Refs and signatures are skipped when
+// the driver does not store them, and facets when facets is nil.
+func (b *Bundle) Import(ctx context.Context, driver storage.Driver, facets deck.FacetStore) (*ImportStats, error) {
+	stats := &ImportStats{}
+	for _, node := range b.Nodes {
+		isNew, err := driver.Put(ctx, node)
+		if err != nil {
+			return nil, fmt.Errorf("storing node %s: %w", node.Hash, err)
+		}
+		if isNew {
+			stats.New++
+		} else {
+			stats.Existing++
+		}
+	}
+
+	if _, ok := driver.(storage.RefStore); ok {
+		for _, ref := range b.Refs {
+			changed, err := storage.SyncRef(ctx, driver, ref)
+			var conflict storage.RefConflictError
+			switch {
+			case errors.As(err, &conflict):
+				stats.Conflicts = append(stats.Conflicts, ref.Name)
+			case err != nil:
+				return nil, fmt.Errorf("storing ref %s: %w", ref.Name, err)
+			case changed:
+				stats.Refs++
+			}
+		}
+	}
+
+	if sigs, ok := driver.(storage.SignatureStore); ok {
+		for _, sig := range b.Signatures {
+			if err := sigs.PutSignature(ctx, sig); err != nil {
+				return nil, fmt.Errorf("storing signature of %s: %w", sig.Hash, err)
+			}
+			stats.Signatures++
+		}
+	}
+
+	if facets != nil {
+		for _, facet := range b.Facets {
+			if err := facets.SaveFacet(ctx, facet); err != nil {
+				return nil, fmt.Errorf("storing facet of %s: %w", facet.SessionID, err)
+			}
+			stats.Facets++
+		}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,175 @@
+package bundle_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/bundle"
+	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+func bundleTestBucket(role, text string) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:    "test-model",
+		Provider: "test-provider",
+	}
+}
+
+var _ = Describe("Bundle", func() {
+	var (
+		ctx    context.Context
+		source *sqlite.Driver
+
+		root, screenshot, reply, fork *merkle.Node
+		image                         []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		source, err = sqlite.NewDriver(ctx, filepath.Join(GinkgoT().TempDir(), "source.sqlite"))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(source.Close)
+
+		image = bytes.Repeat([]byte{0x89, 'P', 'N', 'G'}, 4096)
+		root = merkle.NewNode(bundleTestBucket("user", "Hello"), nil)
+		screenshot = merkle.NewNode(merkle.Bucket{
+			Type: "message",
+			Role: "user",
+			Content: []llm.ContentBlock{
+				{Type: "text", Text: "what is in this screenshot?"},
+				{Type: "image", MediaType: "image/png", ImageBase64: base64.StdEncoding.EncodeToString(image)},
+			},
+			Model:    "test-model",
+			Provider: "test-provider",
+		}, root)
+		reply = merkle.NewNode(bundleTestBucket("assistant", "A stack trace."), screenshot, merkle.NodeMeta{
+			Project:  "tapes",
+			Usage:    &llm.Usage{PromptTokens: 10, CompletionTokens: 3},
+			Metadata: map[string]string{"session": "bug-42"},
+		})
+		fork = merkle.NewNode(bundleTestBucket("assistant", "Goodbye!"), root, merkle.NodeMeta{Project: "other"})
+		for _, n := range []*merkle.Node{root, screenshot, reply, fork} {
+			_, err := source.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("round-trips the ancestry closure with blobs, refs and signatures", func() {
+		Expect(source.PutRef(ctx, &storage.Ref{Name: "bug", Hash: reply.Hash, Kind: storage.RefTag})).To(Succeed())
+		Expect(source.PutRef(ctx, &storage.Ref{Name: "other", Hash: fork.Hash, Kind: storage.RefTag})).To(Succeed())
+		key, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source.PutSignature(ctx, signing.Sign(key, reply.Hash))).To(Succeed())
+
+		b, err := bundle.Collect(ctx, source, []string{reply.Hash}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.Manifest.Roots).To(Equal([]string{root.Hash}))
+		Expect(b.Manifest.Leaves).To(Equal([]string{reply.Hash}))
+		Expect(b.Nodes).To(HaveLen(3))
+		Expect(b.Refs).To(HaveLen(1))
+		Expect(b.Signatures).To(HaveLen(1))
+
+		var buf bytes.Buffer
+		Expect(b.Write(&buf, true)).To(Succeed())
+
+		read, err := bundle.Read(&buf)
+		Expect(err).NotTo(HaveOccurred())
+
+		target := inmemory.NewDriver()
+		stats, err := read.Import(ctx, target, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.New).To(Equal(3))
+		Expect(stats.Refs).To(Equal(1))
+		Expect(stats.Signatures).To(Equal(1))
+
+		got, err := target.Get(ctx, screenshot.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Bucket.Content[1].ImageBase64).To(Equal(base64.StdEncoding.EncodeToString(image)))
+
+		got, err = target.Get(ctx, reply.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Project).To(Equal("tapes"))
+		Expect(got.Metadata).To(HaveKeyWithValue("session", "bug-42"))
+
+		attestations, err := signing.Attest(ctx, target, root.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attestations).To(HaveLen(1))
+	})
+
+	It("carries session facets", func() {
+		facets := deck.NewEntFacetStore(source.Client)
+		Expect(facets.SaveFacet(ctx, &deck.SessionFacet{SessionID: reply.Hash, BriefSummary: "Debugged a crash"})).To(Succeed())
+
+		b, err := bundle.Collect(ctx, source, []string{reply.Hash}, facets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.Facets).To(HaveLen(1))
+
+		var buf bytes.Buffer
+		Expect(b.Write(&buf, false)).To(Succeed())
+		read, err := bundle.Read(&buf)
+		Expect(err).NotTo(HaveOccurred())
+
+		target, err := sqlite.NewDriver(ctx, filepath.Join(GinkgoT().TempDir(), "target.sqlite"))
+		Expect(err).NotTo(HaveOccurred())
+		defer target.Close()
+		targetFacets := deck.NewEntFacetStore(target.Client)
+
+		_, err = read.Import(ctx, target, targetFacets)
+		Expect(err).NotTo(HaveOccurred())
+
+		facet, err := targetFacets.GetFacet(ctx, reply.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(facet.BriefSummary).To(Equal("Debugged a crash"))
+	})
+
+	It("rejects tampered nodes", func() {
+		b, err := bundle.Collect(ctx, source, []string{reply.Hash}, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		var buf bytes.Buffer
+		Expect(b.Write(&buf, false)).To(Succeed())
+		tampered := strings.Replace(buf.String(), "A stack trace.", "All good.", 1)
+
+		_, err = bundle.Read(strings.NewReader(tampered))
+		Expect(err).To(MatchError(ContainSubstring("content hashes to")))
+	})
+
+	It("rejects bundles missing a parent", func() {
+		b, err := bundle.Collect(ctx, source, []string{reply.Hash}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		b.Nodes = b.Nodes[1:]
+
+		Expect(b.Verify()).To(MatchError(ContainSubstring("is missing")))
+	})
+
+	It("selects leaves by filter", func() {
+		hashes, err := bundle.Select(ctx, source, bundle.Filter{Project: "tapes"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(Equal([]string{reply.Hash}))
+
+		hashes, err = bundle.Select(ctx, source, bundle.Filter{Metadata: map[string]string{"session": "bug-41"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(BeEmpty())
+
+		hashes, err = bundle.Select(ctx, source, bundle.Filter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(ConsistOf(reply.Hash, fork.Hash))
+	})
+})
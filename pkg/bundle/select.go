@@ -0,0 +1,59 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// Filter selects conversations by the attributes recorded on their leaves.
+// Empty fields match every leaf.
+type Filter struct {
+	Project  string
+	Model    string
+	Provider string
+	Agent    string
+
+	// Metadata must all be present on the leaf with equal values.
+	Metadata map[string]string
+}
+
+// IsZero reports whether f matches every leaf.
+func (f Filter) IsZero() bool {
+	return f.Project == "" && f.Model == "" && f.Provider == "" && f.Agent == "" && len(f.Metadata) == 0
+}
+
+// Match reports whether node satisfies f.
+func (f Filter) Match(node *merkle.Node) bool {
+	switch {
+	case f.Project != "" && node.Project != f.Project,
+		f.Model != "" && node.Bucket.Model != f.Model,
+		f.Provider != "" && node.Bucket.Provider != f.Provider,
+		f.Agent != "" && node.Bucket.AgentName != f.Agent:
+		return false
+	}
+	for key, value := range f.Metadata {
+		if node.Metadata[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Select returns the hashes of the leaves matching f.
+func Select(ctx context.Context, driver storage.Driver, f Filter) ([]string, error) {
+	leaves, err := driver.Leaves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing leaves: %w", err)
+	}
+
+	var hashes []string
+	for _, leaf := range leaves {
+		if f.Match(leaf) {
+			hashes = append(hashes, leaf.Hash)
+		}
+	}
+	return hashes, nil
+}
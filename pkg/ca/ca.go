@@ -0,0 +1,267 @@
+// Package ca manages the local certificate authority used by the proxy's
+// forward mode to terminate TLS for intercepted LLM API hosts.
+//
+// The CA is generated once and persisted in the .tapes/ directory. Clients
+// must trust its certificate (see "tapes ca install") before their HTTPS
+// traffic can be intercepted.
+package ca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/dotdir"
+)
+
+const (
+	// CertFile is the name of the CA certificate file clients must trust.
+	CertFile = "ca.pem"
+
+	// keyFile is the name of the CA private key file.
+	keyFile = "ca-key.pem"
+
+	caValidity   = 10 * 365 * 24 * time.Hour
+	leafValidity = 397 * 24 * time.Hour
+
+	// leafRenewBefore re-issues cached leaf certificates ahead of expiry.
+	leafRenewBefore = 24 * time.Hour
+)
+
+// Authority is a local certificate authority that issues leaf certificates
+// for intercepted hosts. Issued certificates are cached in memory.
+type Authority struct {
+	cert    *x509.Certificate
+	key     crypto.Signer
+	certPEM []byte
+
+	mu     sync.Mutex
+	leaves map[string]*tls.Certificate
+}
+
+// Dir returns the directory holding the CA: the resolved .tapes/ target for
+// configDir, or ~/.tapes/ when no target exists yet.
+func Dir(configDir string) (string, error) {
+	dir, err := dotdir.NewManager().Target(configDir)
+	if err != nil {
+		return "", fmt.Errorf("resolving target dir: %w", err)
+	}
+	if dir != "" {
+		return dir, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error getting home directory: %w", err)
+	}
+	return filepath.Join(home, ".tapes"), nil
+}
+
+// Load reads the CA stored in dir.
+func Load(dir string) (*Authority, error) {
+	certPEM, err := os.ReadFile(filepath.Join(dir, CertFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(dir, keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading CA key: %w", err)
+	}
+
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA key pair: %w", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA certificate: %w", err)
+	}
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key cannot sign certificates")
+	}
+
+	return newAuthority(cert, key, certPEM), nil
+}
+
+// LoadOrCreate reads the CA stored in dir, generating and persisting a new one
+// if none exists. Reports whether a new CA was created.
+func LoadOrCreate(dir string) (*Authority, bool, error) {
+	if _, err := os.Stat(filepath.Join(dir, CertFile)); err == nil {
+		a, err := Load(dir)
+		return a, false, err
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, false, fmt.Errorf("checking CA certificate: %w", err)
+	}
+
+	a, keyPEM, err := generate()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, false, fmt.Errorf("creating CA directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, keyFile), keyPEM, 0o600); err != nil {
+		return nil, false, fmt.Errorf("writing CA key: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, CertFile), a.certPEM, 0o644); err != nil {
+		return nil, false, fmt.Errorf("writing CA certificate: %w", err)
+	}
+
+	return a, true, nil
+}
+
+// generate creates a new self-signed CA and returns it with its PEM-encoded
+// private key.
+func generate() (*Authority, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating CA key: %w", err)
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   "tapes local CA",
+			Organization: []string{"tapes"},
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating CA certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing CA certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding CA key: %w", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return newAuthority(cert, key, certPEM), keyPEM, nil
+}
+
+func newAuthority(cert *x509.Certificate, key crypto.Signer, certPEM []byte) *Authority {
+	return &Authority{
+		cert:    cert,
+		key:     key,
+		certPEM: certPEM,
+		leaves:  make(map[string]*tls.Certificate),
+	}
+}
+
+// CertPEM returns the PEM-encoded CA certificate.
+func (a *Authority) CertPEM() []byte {
+	return a.certPEM
+}
+
+// CertPool returns a pool containing only the CA certificate.
+func (a *Authority) CertPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(a.cert)
+	return pool
+}
+
+// LeafCertificate returns a certificate for host signed by the CA, issuing
+// and caching one if needed.
+func (a *Authority) LeafCertificate(host string) (*tls.Certificate, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if leaf, ok := a.leaves[host]; ok && time.Until(leaf.Leaf.NotAfter) > leafRenewBefore {
+		return leaf, nil
+	}
+
+	leaf, err := a.issue(host)
+	if err != nil {
+		return nil, err
+	}
+	a.leaves[host] = leaf
+	return leaf, nil
+}
+
+func (a *Authority) issue(host string) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating key for %s: %w", host, err)
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	notAfter := now.Add(leafValidity)
+	if notAfter.After(a.cert.NotAfter) {
+		notAfter = a.cert.NotAfter
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: host},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{host}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	if err != nil {
+		return nil, fmt.Errorf("issuing certificate for %s: %w", host, err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate for %s: %w", host, err)
+	}
+
+	return &tls.Certificate{
+		Certificate: [][]byte{der, a.cert.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+func randomSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("generating serial number: %w", err)
+	}
+	return serial, nil
+}
@@ -0,0 +1,58 @@
+package ca_test
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/ca"
+)
+
+var _ = Describe("Authority", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("creates a CA once and loads it afterwards", func() {
+		first, created, err := ca.LoadOrCreate(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeTrue())
+
+		info, err := os.Stat(filepath.Join(dir, "ca-key.pem"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+		second, created, err := ca.LoadOrCreate(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(second.CertPEM()).To(Equal(first.CertPEM()))
+	})
+
+	It("issues leaf certificates that verify against the CA", func() {
+		authority, _, err := ca.LoadOrCreate(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		leaf, err := authority.LeafCertificate("api.anthropic.com")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = leaf.Leaf.Verify(x509.VerifyOptions{
+			DNSName: "api.anthropic.com",
+			Roots:   authority.CertPool(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		again, err := authority.LeafCertificate("api.anthropic.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(BeIdenticalTo(leaf))
+	})
+
+	It("returns an error when no CA exists", func() {
+		_, err := ca.Load(dir)
+		Expect(err).To(HaveOccurred())
+	})
+})
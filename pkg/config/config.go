@@ -69,7 +69,20 @@ func ValidConfigKeys() []string {
 		"proxy.provider",
 		"proxy.upstream",
 		"proxy.listen",
+		"proxy.health_check_interval",
+		"proxy.cache",
+		"proxy.cache_ttl",
+		"proxy.capture_embedding_input",
+		"proxy.sign",
+		"proxy.forward_listen",
+		"proxy.forward_passthrough",
+		"proxy.tls_cert",
+		"proxy.tls_key",
+		"proxy.tls_client_ca",
 		"api.listen",
+		"api.tls_cert",
+		"api.tls_key",
+		"api.tls_client_ca",
 		"client.proxy_target",
 		"client.api_target",
 		"vector_store.provider",
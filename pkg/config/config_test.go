@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -130,6 +131,108 @@ dimensions = 1024
 			Expect(cfg.Embedding.Dimensions).To(Equal(uint(1024)))
 		})
 
+		It("loads proxy routing rules", func() {
+			data := `version = 0
+
+[proxy]
+provider = "anthropic"
+
+[[proxy.routes]]
+name = "haiku-local"
+model = "claude-haiku-*"
+rewrite_model = "qwen3:8b"
+provider = "ollama"
+upstream = "http://localhost:11434"
+
+[[proxy.routes]]
+agent = "codex"
+max_request_bytes = 2048
+`
+			err := os.WriteFile(filepath.Join(tmpDir, "config.toml"), []byte(data), 0o600)
+			Expect(err).NotTo(HaveOccurred())
+
+			c, err := config.NewConfiger(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := c.LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Proxy.Routes).To(HaveLen(2))
+			Expect(cfg.Proxy.Routes[0].Name).To(Equal("haiku-local"))
+			Expect(cfg.Proxy.Routes[0].Model).To(Equal("claude-haiku-*"))
+			Expect(cfg.Proxy.Routes[0].RewriteModel).To(Equal("qwen3:8b"))
+			Expect(cfg.Proxy.Routes[0].Provider).To(Equal("ollama"))
+			Expect(cfg.Proxy.Routes[1].Agent).To(Equal("codex"))
+			Expect(cfg.Proxy.Routes[1].MaxRequestBytes).To(Equal(2048))
+		})
+
+		It("loads proxy upstream pools", func() {
+			data := `version = 0
+
+[proxy]
+provider = "ollama"
+health_check_interval = "10s"
+
+[[proxy.upstreams]]
+provider = "ollama"
+url = "http://gpu-1:11434"
+weight = 3
+
+[[proxy.upstreams]]
+provider = "ollama"
+url = "http://gpu-2:11434"
+`
+			err := os.WriteFile(filepath.Join(tmpDir, "config.toml"), []byte(data), 0o600)
+			Expect(err).NotTo(HaveOccurred())
+
+			c, err := config.NewConfiger(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := c.LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Proxy.Upstreams).To(Equal([]config.UpstreamConfig{
+				{Provider: "ollama", URL: "http://gpu-1:11434", Weight: 3},
+				{Provider: "ollama", URL: "http://gpu-2:11434"},
+			}))
+
+			interval, err := cfg.Proxy.HealthCheckDuration()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(interval).To(Equal(10 * time.Second))
+		})
+
+		It("loads OpenAI-compatible providers", func() {
+			data := `version = 0
+
+[[proxy.providers]]
+name = "azure-east"
+preset = "azure"
+base_url = "https://east.openai.azure.com"
+
+[proxy.providers.deployments]
+chat = "gpt-4o"
+
+[[proxy.providers]]
+name = "local"
+preset = "vllm"
+`
+			err := os.WriteFile(filepath.Join(tmpDir, "config.toml"), []byte(data), 0o600)
+			Expect(err).NotTo(HaveOccurred())
+
+			c, err := config.NewConfiger(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := c.LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Proxy.Providers).To(Equal([]config.ProviderConfig{
+				{
+					Name:        "azure-east",
+					Preset:      "azure",
+					BaseURL:     "https://east.openai.azure.com",
+					Deployments: map[string]string{"chat": "gpt-4o"},
+				},
+				{Name: "local", Preset: "vllm"},
+			}))
+		})
+
 		It("returns error for malformed TOML", func() {
 			err := os.WriteFile(filepath.Join(tmpDir, "config.toml"), []byte("not valid toml [[["), 0o600)
 			Expect(err).NotTo(HaveOccurred())
@@ -280,6 +383,35 @@ provider = "openai"
 			Expect(err.Error()).To(ContainSubstring("invalid value"))
 		})
 
+		It("sets the proxy response cache keys", func() {
+			c, err := config.NewConfiger(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.SetConfigValue("proxy.cache", "true")).To(Succeed())
+			Expect(c.SetConfigValue("proxy.cache_ttl", "24h")).To(Succeed())
+			Expect(c.SetConfigValue("proxy.cache_ttl", "tomorrow")).To(MatchError(ContainSubstring("invalid value")))
+
+			cfg, err := c.LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Proxy.Cache).To(BeTrue())
+
+			ttl, err := cfg.Proxy.CacheTTLDuration()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ttl).To(Equal(24 * time.Hour))
+		})
+
+		It("sets proxy.capture_embedding_input", func() {
+			c, err := config.NewConfiger(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.SetConfigValue("proxy.capture_embedding_input", "true")).To(Succeed())
+			Expect(c.SetConfigValue("proxy.capture_embedding_input", "sometimes")).To(MatchError(ContainSubstring("invalid value")))
+
+			cfg, err := c.LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Proxy.CaptureEmbeddingInput).To(BeTrue())
+		})
+
 		It("sets client.proxy_target", func() {
 			c, err := config.NewConfiger(tmpDir)
 			Expect(err).NotTo(HaveOccurred())
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // Config represents the persistent tapes configuration stored as config.toml
@@ -29,11 +30,148 @@ type ProxyConfig struct {
 	Upstream string `toml:"upstream,omitempty"`
 	Listen   string `toml:"listen,omitempty"`
 	Project  string `toml:"project,omitempty"`
+
+	// Routes are declarative routing rules, written as [[proxy.routes]].
+	Routes []RouteConfig `toml:"routes,omitempty"`
+
+	// Upstreams lists load-balanced upstreams, written as [[proxy.upstreams]].
+	Upstreams []UpstreamConfig `toml:"upstreams,omitempty"`
+
+	// Providers declares named OpenAI-compatible providers, written as
+	// [[proxy.providers]].
+	Providers []ProviderConfig `toml:"providers,omitempty"`
+
+	// HealthCheckInterval is how often upstreams are probed (e.g. "30s").
+	HealthCheckInterval string `toml:"health_check_interval,omitempty"`
+
+	// Cache enables the exact-match response cache.
+	Cache bool `toml:"cache,omitempty"`
+
+	// CacheTTL bounds the age of cached responses (e.g. "24h"). Empty never
+	// expires entries.
+	CacheTTL string `toml:"cache_ttl,omitempty"`
+
+	// CaptureEmbeddingInput stores the input texts of recorded embedding
+	// calls. By default only counts, usage and latency are kept.
+	CaptureEmbeddingInput bool `toml:"capture_embedding_input,omitempty"`
+
+	// Sign signs every recorded leaf with the ed25519 key stored in
+	// credentials.toml, generating one on first use.
+	Sign bool `toml:"sign,omitempty"`
+
+	// ForwardListen enables the HTTPS_PROXY-compatible forward proxy on this
+	// address (e.g. "127.0.0.1:8088"). A bare ":port" binds to loopback.
+	ForwardListen string `toml:"forward_listen,omitempty"`
+
+	// ForwardPassthrough lets the forward proxy tunnel traffic to hosts it
+	// does not intercept. Anyone who can reach the forward proxy can then
+	// relay traffic through it, so keep it on loopback.
+	ForwardPassthrough bool `toml:"forward_passthrough,omitempty"`
+
+	// TLSCert and TLSKey serve the proxy over HTTPS. TLSClientCA additionally
+	// requires client certificates signed by the given CA (mTLS).
+	TLSCert     string `toml:"tls_cert,omitempty"`
+	TLSKey      string `toml:"tls_key,omitempty"`
+	TLSClientCA string `toml:"tls_client_ca,omitempty"`
+}
+
+// CacheTTLDuration parses CacheTTL. An empty TTL returns zero.
+func (p ProxyConfig) CacheTTLDuration() (time.Duration, error) {
+	if p.CacheTTL == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(p.CacheTTL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid proxy.cache_ttl: %w", err)
+	}
+	return d, nil
+}
+
+// HealthCheckDuration parses HealthCheckInterval. An empty interval returns
+// zero, meaning the proxy default.
+func (p ProxyConfig) HealthCheckDuration() (time.Duration, error) {
+	if p.HealthCheckInterval == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(p.HealthCheckInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid proxy.health_check_interval: %w", err)
+	}
+	return d, nil
+}
+
+// UpstreamConfig is one member of a provider's upstream pool. Requests to the
+// provider are spread across its members in proportion to Weight.
+type UpstreamConfig struct {
+	Provider string `toml:"provider"`
+	URL      string `toml:"url"`
+	Weight   int    `toml:"weight,omitempty"`
+}
+
+// ProviderConfig is a named OpenAI-compatible provider (Azure OpenAI, vLLM,
+// LM Studio, OpenRouter, ...). Requests sent to /providers/<name>/... are
+// parsed as OpenAI traffic and recorded under Name. Preset fills in defaults
+// for well-known servers; explicit fields override them.
+type ProviderConfig struct {
+	Name   string `toml:"name"`
+	Preset string `toml:"preset,omitempty"`
+
+	// BaseURL is the upstream base URL, including any version prefix.
+	BaseURL string `toml:"base_url,omitempty"`
+
+	// AuthHeader is "bearer" (Authorization: Bearer) or "api-key" (Azure).
+	AuthHeader string `toml:"auth_header,omitempty"`
+
+	// APIVersion is added as the api-version query parameter when the
+	// client does not send one.
+	APIVersion string `toml:"api_version,omitempty"`
+
+	// Deployments maps deployment names to the model they serve, so that
+	// recorded turns and pricing use the real model name.
+	Deployments map[string]string `toml:"deployments,omitempty"`
+}
+
+// RouteConfig is a proxy routing rule. Agent, Model and Project are glob
+// patterns matched against the incoming request; empty patterns match
+// everything. A matching rule rewrites the model to RewriteModel and sends
+// the request to Provider at Upstream, translating formats if needed.
+type RouteConfig struct {
+	Name            string `toml:"name,omitempty"`
+	Agent           string `toml:"agent,omitempty"`
+	Model           string `toml:"model,omitempty"`
+	Project         string `toml:"project,omitempty"`
+	MinRequestBytes int    `toml:"min_request_bytes,omitempty"`
+	MaxRequestBytes int    `toml:"max_request_bytes,omitempty"`
+	RewriteModel    string `toml:"rewrite_model,omitempty"`
+	Provider        string `toml:"provider,omitempty"`
+	Upstream        string `toml:"upstream,omitempty"`
+
+	// CacheTTL overrides the response cache TTL for matching requests (e.g. "1h").
+	CacheTTL string `toml:"cache_ttl,omitempty"`
+}
+
+// CacheTTLDuration parses CacheTTL. An empty TTL returns zero, meaning the
+// proxy-wide TTL.
+func (r RouteConfig) CacheTTLDuration() (time.Duration, error) {
+	if r.CacheTTL == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(r.CacheTTL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cache_ttl for route %q: %w", r.Name, err)
+	}
+	return d, nil
 }
 
 // APIConfig holds API server settings.
 type APIConfig struct {
 	Listen string `toml:"listen,omitempty"`
+
+	// TLSCert and TLSKey serve the API over HTTPS. TLSClientCA additionally
+	// requires client certificates signed by the given CA (mTLS).
+	TLSCert     string `toml:"tls_cert,omitempty"`
+	TLSKey      string `toml:"tls_key,omitempty"`
+	TLSClientCA string `toml:"tls_client_ca,omitempty"`
 }
 
 // ClientConfig holds settings for CLI commands that connect to the running
@@ -93,10 +231,122 @@ var configKeys = map[string]configKeyInfo{
 		get: func(c *Config) string { return c.Proxy.Project },
 		set: func(c *Config, v string) error { c.Proxy.Project = v; return nil },
 	},
+	"proxy.cache": {
+		get: func(c *Config) string {
+			if !c.Proxy.Cache {
+				return ""
+			}
+			return "true"
+		},
+		set: func(c *Config, v string) error {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("invalid value for proxy.cache: %w", err)
+			}
+			c.Proxy.Cache = enabled
+			return nil
+		},
+	},
+	"proxy.cache_ttl": {
+		get: func(c *Config) string { return c.Proxy.CacheTTL },
+		set: func(c *Config, v string) error {
+			if _, err := time.ParseDuration(v); err != nil {
+				return fmt.Errorf("invalid value for proxy.cache_ttl: %w", err)
+			}
+			c.Proxy.CacheTTL = v
+			return nil
+		},
+	},
+	"proxy.health_check_interval": {
+		get: func(c *Config) string { return c.Proxy.HealthCheckInterval },
+		set: func(c *Config, v string) error {
+			if _, err := time.ParseDuration(v); err != nil {
+				return fmt.Errorf("invalid value for proxy.health_check_interval: %w", err)
+			}
+			c.Proxy.HealthCheckInterval = v
+			return nil
+		},
+	},
+	"proxy.capture_embedding_input": {
+		get: func(c *Config) string {
+			if !c.Proxy.CaptureEmbeddingInput {
+				return ""
+			}
+			return "true"
+		},
+		set: func(c *Config, v string) error {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("invalid value for proxy.capture_embedding_input: %w", err)
+			}
+			c.Proxy.CaptureEmbeddingInput = enabled
+			return nil
+		},
+	},
+	"proxy.sign": {
+		get: func(c *Config) string {
+			if !c.Proxy.Sign {
+				return ""
+			}
+			return "true"
+		},
+		set: func(c *Config, v string) error {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("invalid value for proxy.sign: %w", err)
+			}
+			c.Proxy.Sign = enabled
+			return nil
+		},
+	},
+	"proxy.forward_listen": {
+		get: func(c *Config) string { return c.Proxy.ForwardListen },
+		set: func(c *Config, v string) error { c.Proxy.ForwardListen = v; return nil },
+	},
+	"proxy.forward_passthrough": {
+		get: func(c *Config) string {
+			if !c.Proxy.ForwardPassthrough {
+				return ""
+			}
+			return "true"
+		},
+		set: func(c *Config, v string) error {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("invalid value for proxy.forward_passthrough: %w", err)
+			}
+			c.Proxy.ForwardPassthrough = enabled
+			return nil
+		},
+	},
+	"proxy.tls_cert": {
+		get: func(c *Config) string { return c.Proxy.TLSCert },
+		set: func(c *Config, v string) error { c.Proxy.TLSCert = v; return nil },
+	},
+	"proxy.tls_key": {
+		get: func(c *Config) string { return c.Proxy.TLSKey },
+		set: func(c *Config, v string) error { c.Proxy.TLSKey = v; return nil },
+	},
+	"proxy.tls_client_ca": {
+		get: func(c *Config) string { return c.Proxy.TLSClientCA },
+		set: func(c *Config, v string) error { c.Proxy.TLSClientCA = v; return nil },
+	},
 	"api.listen": {
 		get: func(c *Config) string { return c.API.Listen },
 		set: func(c *Config, v string) error { c.API.Listen = v; return nil },
 	},
+	"api.tls_cert": {
+		get: func(c *Config) string { return c.API.TLSCert },
+		set: func(c *Config, v string) error { c.API.TLSCert = v; return nil },
+	},
+	"api.tls_key": {
+		get: func(c *Config) string { return c.API.TLSKey },
+		set: func(c *Config, v string) error { c.API.TLSKey = v; return nil },
+	},
+	"api.tls_client_ca": {
+		get: func(c *Config) string { return c.API.TLSClientCA },
+		set: func(c *Config, v string) error { c.API.TLSClientCA = v; return nil },
+	},
 	"client.proxy_target": {
 		get: func(c *Config) string { return c.Client.ProxyTarget },
 		set: func(c *Config, v string) error { c.Client.ProxyTarget = v; return nil },
@@ -145,6 +145,33 @@ func (m *Manager) RemoveKey(provider string) error {
 	return m.Save(creds)
 }
 
+// SetSigningKey stores the base64 encoded ed25519 signing key.
+func (m *Manager) SetSigningKey(key string) error {
+	creds, err := m.Load()
+	if err != nil {
+		return err
+	}
+
+	creds.Signing = &SigningCredential{PrivateKey: key}
+
+	return m.Save(creds)
+}
+
+// GetSigningKey returns the stored base64 encoded ed25519 signing key.
+// Returns an empty string if no key is stored.
+func (m *Manager) GetSigningKey() (string, error) {
+	creds, err := m.Load()
+	if err != nil {
+		return "", err
+	}
+
+	if creds.Signing == nil {
+		return "", nil
+	}
+
+	return creds.Signing.PrivateKey, nil
+}
+
 // ListProviders returns the names of providers that have stored credentials.
 func (m *Manager) ListProviders() ([]string, error) {
 	creds, err := m.Load()
@@ -185,6 +185,28 @@ api_key = "sk-test-key"
 		})
 	})
 
+	Describe("SetSigningKey", func() {
+		It("stores the signing key alongside provider keys", func() {
+			mgr, err := credentials.NewManager(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			key, err := mgr.GetSigningKey()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key).To(BeEmpty())
+
+			Expect(mgr.SetKey("openai", "sk-test")).To(Succeed())
+			Expect(mgr.SetSigningKey("c2lnbmluZy1rZXk=")).To(Succeed())
+
+			key, err = mgr.GetSigningKey()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key).To(Equal("c2lnbmluZy1rZXk="))
+
+			apiKey, err := mgr.GetKey("openai")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiKey).To(Equal("sk-test"))
+		})
+	})
+
 	Describe("ListProviders", func() {
 		It("returns empty list when no credentials stored", func() {
 			mgr, err := credentials.NewManager(tmpDir)
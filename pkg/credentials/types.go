@@ -4,9 +4,16 @@ package credentials
 type Credentials struct {
 	Version   int                           `toml:"version"`
 	Providers map[string]ProviderCredential `toml:"providers"`
+	Signing   *SigningCredential            `toml:"signing,omitempty"`
 }
 
 // ProviderCredential holds the API key for a single provider.
 type ProviderCredential struct {
 	APIKey string `toml:"api_key"`
 }
+
+// SigningCredential holds the ed25519 key used to sign recorded sessions.
+type SigningCredential struct {
+	// PrivateKey is the base64 encoded ed25519 private key.
+	PrivateKey string `toml:"private_key"`
+}
@@ -0,0 +1,167 @@
+// Package dataset converts recorded conversations into fine-tuning datasets.
+//
+// Each selected branch of the DAG becomes one training example holding the
+// conversation from its root to its last assistant turn. Branches that are a
+// prefix of another selected branch are dropped, so shared turns are only
+// trained on once per line of conversation. Examples are assigned to the
+// train or validation split deterministically by hash, so re-exporting a
+// growing store never moves an example between splits.
+package dataset
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// Format is an output format for examples.
+type Format string
+
+const (
+	// FormatOpenAI is the OpenAI chat fine-tuning JSONL format.
+	FormatOpenAI Format = "openai"
+
+	// FormatAnthropic is JSONL of Anthropic Messages API requests, with
+	// system prompts in a top-level "system" field.
+	FormatAnthropic Format = "anthropic"
+
+	// FormatShareGPT is the ShareGPT "conversations" JSONL format.
+	FormatShareGPT Format = "sharegpt"
+
+	// FormatParquet is a Parquet table with one row per example, holding
+	// the messages in the OpenAI format as a JSON string.
+	FormatParquet Format = "parquet"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatOpenAI, FormatAnthropic, FormatShareGPT, FormatParquet}
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(Formats, f) {
+		return "", fmt.Errorf("unknown dataset format %q (use openai, anthropic, sharegpt or parquet)", s)
+	}
+	return f, nil
+}
+
+// Extension returns the file extension for the format.
+func (f Format) Extension() string {
+	if f == FormatParquet {
+		return "parquet"
+	}
+	return "jsonl"
+}
+
+// Splits.
+const (
+	SplitTrain      = "train"
+	SplitValidation = "validation"
+)
+
+// Example is one conversation branch to train on.
+type Example struct {
+	// ID is the hash of the example's last turn.
+	ID string
+
+	// Model is the model that produced the last turn.
+	Model string
+
+	// Split is SplitTrain or SplitValidation.
+	Split string
+
+	Messages []llm.Message
+}
+
+// Build turns the conversations ending at hashes into examples. Trailing
+// turns after the last assistant response are dropped, conversations
+// without a response are skipped, and conversations that are a prefix of
+// another selected conversation are skipped. validation is the fraction of
+// examples, between 0 and 1, assigned to the validation split.
+func Build(ctx context.Context, driver storage.Driver, hashes []string, validation float64) ([]*Example, error) {
+	if validation < 0 || validation >= 1 {
+		return nil, fmt.Errorf("validation fraction %v must be in [0, 1)", validation)
+	}
+
+	// Trim every branch to its last response first, so a branch's own
+	// trailing turns don't make its response look like a shared prefix.
+	branches := map[string][]*merkle.Node{}
+	prefixes := map[string]bool{}
+	for _, hash := range hashes {
+		ancestry, err := driver.Ancestry(ctx, hash)
+		if err != nil {
+			return nil, fmt.Errorf("loading conversation %s: %w", hash, err)
+		}
+		slices.Reverse(ancestry)
+		end := lastResponse(ancestry)
+		if end < 0 {
+			continue
+		}
+		branch := ancestry[:end+1]
+		for _, node := range branch[:end] {
+			prefixes[node.Hash] = true
+		}
+		branches[branch[end].Hash] = branch
+	}
+
+	var examples []*Example
+	for hash, branch := range branches {
+		if prefixes[hash] {
+			continue
+		}
+		example := &Example{
+			ID:    hash,
+			Model: branch[len(branch)-1].Bucket.Model,
+			Split: splitFor(hash, validation),
+		}
+		for _, node := range branch {
+			example.Messages = append(example.Messages, llm.Message{Role: node.Bucket.Role, Content: node.Bucket.Content})
+		}
+		examples = append(examples, example)
+	}
+
+	slices.SortFunc(examples, func(a, b *Example) int { return strings.Compare(a.ID, b.ID) })
+	return examples, nil
+}
+
+// lastResponse returns the index of the last assistant turn in branch, or -1.
+func lastResponse(branch []*merkle.Node) int {
+	for i := len(branch) - 1; i >= 0; i-- {
+		if branch[i].Bucket.Role == "assistant" {
+			return i
+		}
+	}
+	return -1
+}
+
+// Write encodes examples to w in format.
+func Write(w io.Writer, format Format, examples []*Example) error {
+	if format == FormatParquet {
+		return writeParquet(w, examples)
+	}
+	return writeJSONL(w, format, examples)
+}
+
+// splitFor assigns hash to a split using its leading bits, which are
+// uniformly distributed for content hashes.
+func splitFor(hash string, validation float64) string {
+	if validation <= 0 {
+		return SplitTrain
+	}
+	raw, err := hex.DecodeString(hash[:min(len(hash), 16)])
+	if err != nil || len(raw) < 8 {
+		return SplitTrain
+	}
+	if float64(binary.BigEndian.Uint64(raw))/(1<<64) < validation {
+		return SplitValidation
+	}
+	return SplitTrain
+}
@@ -0,0 +1,318 @@
+package dataset_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/dataset"
+	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
+)
+
+func datasetTestBucket(role string, content ...llm.ContentBlock) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  content,
+		Model:    "test-model",
+		Provider: "test-provider",
+	}
+}
+
+func text(s string) llm.ContentBlock {
+	return llm.ContentBlock{Type: "text", Text: s}
+}
+
+func decodeLines(raw []byte) []map[string]any {
+	var out []map[string]any
+	for line := range strings.SplitSeq(strings.TrimSpace(string(raw)), "\n") {
+		var record map[string]any
+		Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+		out = append(out, record)
+	}
+	return out
+}
+
+var _ = Describe("Dataset", func() {
+	var (
+		ctx    context.Context
+		driver storage.Driver
+
+		system, ask, call, result, answer, followUp, fork *merkle.Node
+	)
+
+	put := func(nodes ...*merkle.Node) {
+		for _, n := range nodes {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		driver = inmemory.NewDriver()
+
+		system = merkle.NewNode(datasetTestBucket("system", text("You are helpful.")), nil)
+		ask = merkle.NewNode(datasetTestBucket("user", text("What is in main.go?")), system)
+		call = merkle.NewNode(datasetTestBucket("assistant",
+			text("Let me look."),
+			llm.ContentBlock{Type: "tool_use", ToolUseID: "toolu_1", ToolName: "read_file", ToolInput: map[string]any{"path": "main.go"}},
+		), ask)
+		result = merkle.NewNode(datasetTestBucket("user",
+			llm.ContentBlock{Type: "tool_result", ToolResultID: "toolu_1", ToolOutput: "package main"},
+		), call)
+		answer = merkle.NewNode(datasetTestBucket("assistant", text("It declares package main.")), result)
+		followUp = merkle.NewNode(datasetTestBucket("user", text("Thanks!")), answer)
+		fork = merkle.NewNode(datasetTestBucket("assistant", text("I can't read files.")), ask)
+		put(system, ask, call, result, answer, followUp, fork)
+	})
+
+	Describe("Build", func() {
+		It("makes one example per branch, ending on the last response", func() {
+			examples, err := dataset.Build(ctx, driver, []string{followUp.Hash, fork.Hash}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(examples).To(HaveLen(2))
+
+			byID := map[string]*dataset.Example{}
+			for _, e := range examples {
+				byID[e.ID] = e
+				Expect(e.Split).To(Equal(dataset.SplitTrain))
+				Expect(e.Model).To(Equal("test-model"))
+			}
+			Expect(byID).To(HaveKey(answer.Hash))
+			Expect(byID).To(HaveKey(fork.Hash))
+			Expect(byID[answer.Hash].Messages).To(HaveLen(5))
+			Expect(byID[fork.Hash].Messages).To(HaveLen(3))
+		})
+
+		It("drops conversations that are a prefix of another", func() {
+			examples, err := dataset.Build(ctx, driver, []string{call.Hash, answer.Hash, followUp.Hash}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(examples).To(HaveLen(1))
+			Expect(examples[0].ID).To(Equal(answer.Hash))
+		})
+
+		It("skips conversations without a response", func() {
+			examples, err := dataset.Build(ctx, driver, []string{ask.Hash}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(examples).To(BeEmpty())
+		})
+
+		It("splits deterministically by hash", func() {
+			var hashes []string
+			parent := system
+			for i := range 200 {
+				n := merkle.NewNode(datasetTestBucket("assistant", text(strings.Repeat("x", i+1))), parent)
+				put(n)
+				hashes = append(hashes, n.Hash)
+			}
+
+			first, err := dataset.Build(ctx, driver, hashes, 0.25)
+			Expect(err).NotTo(HaveOccurred())
+			again, err := dataset.Build(ctx, driver, hashes, 0.25)
+			Expect(err).NotTo(HaveOccurred())
+
+			validation := 0
+			for i, e := range first {
+				Expect(again[i].Split).To(Equal(e.Split))
+				if e.Split == dataset.SplitValidation {
+					validation++
+				}
+			}
+			Expect(validation).To(BeNumerically(">", 20))
+			Expect(validation).To(BeNumerically("<", 80))
+		})
+
+		It("rejects an out-of-range validation fraction", func() {
+			_, err := dataset.Build(ctx, driver, []string{answer.Hash}, 1)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Write", func() {
+		var examples []*dataset.Example
+
+		BeforeEach(func() {
+			var err error
+			examples, err = dataset.Build(ctx, driver, []string{answer.Hash}, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("writes OpenAI tool calls and tool messages", func() {
+			var buf bytes.Buffer
+			Expect(dataset.Write(&buf, dataset.FormatOpenAI, examples)).To(Succeed())
+
+			records := decodeLines(buf.Bytes())
+			Expect(records).To(HaveLen(1))
+			messages := records[0]["messages"].([]any)
+			Expect(messages).To(HaveLen(5))
+
+			Expect(messages[0]).To(Equal(map[string]any{"role": "system", "content": "You are helpful."}))
+			assistant := messages[2].(map[string]any)
+			Expect(assistant["content"]).To(Equal("Let me look."))
+			Expect(assistant["tool_calls"]).To(Equal([]any{map[string]any{
+				"id":   "toolu_1",
+				"type": "function",
+				"function": map[string]any{
+					"name":      "read_file",
+					"arguments": `{"path":"main.go"}`,
+				},
+			}}))
+			Expect(messages[3]).To(Equal(map[string]any{"role": "tool", "tool_call_id": "toolu_1", "content": "package main"}))
+		})
+
+		It("writes Anthropic messages with a top-level system prompt", func() {
+			var buf bytes.Buffer
+			Expect(dataset.Write(&buf, dataset.FormatAnthropic, examples)).To(Succeed())
+
+			record := decodeLines(buf.Bytes())[0]
+			Expect(record["system"]).To(Equal("You are helpful."))
+			messages := record["messages"].([]any)
+			Expect(messages).To(HaveLen(4))
+
+			blocks := messages[1].(map[string]any)["content"].([]any)
+			Expect(blocks[1]).To(Equal(map[string]any{
+				"type":  "tool_use",
+				"id":    "toolu_1",
+				"name":  "read_file",
+				"input": map[string]any{"path": "main.go"},
+			}))
+			results := messages[2].(map[string]any)
+			Expect(results["role"]).To(Equal("user"))
+			Expect(results["content"]).To(Equal([]any{map[string]any{
+				"type":        "tool_result",
+				"tool_use_id": "toolu_1",
+				"content":     "package main",
+			}}))
+		})
+
+		It("writes ShareGPT function calls and observations", func() {
+			var buf bytes.Buffer
+			Expect(dataset.Write(&buf, dataset.FormatShareGPT, examples)).To(Succeed())
+
+			turns := decodeLines(buf.Bytes())[0]["conversations"].([]any)
+			var from []string
+			for _, t := range turns {
+				from = append(from, t.(map[string]any)["from"].(string))
+			}
+			Expect(from).To(Equal([]string{"system", "human", "gpt", "function_call", "observation", "gpt"}))
+			Expect(turns[3].(map[string]any)["value"]).To(MatchJSON(`{"name":"read_file","arguments":{"path":"main.go"}}`))
+		})
+
+		It("writes a Parquet file", func() {
+			var buf bytes.Buffer
+			Expect(dataset.Write(&buf, dataset.FormatParquet, examples)).To(Succeed())
+
+			table, err := readParquet(buf.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.rows).To(Equal(int64(len(examples))))
+			Expect(table.columns).To(HaveKeyWithValue("id", []string{answer.Hash}))
+			Expect(table.columns).To(HaveKeyWithValue("split", []string{dataset.SplitTrain}))
+			Expect(table.columns).To(HaveKeyWithValue("model", []string{examples[0].Model}))
+
+			var jsonl bytes.Buffer
+			Expect(dataset.Write(&jsonl, dataset.FormatOpenAI, examples)).To(Succeed())
+			expected, err := json.Marshal(decodeLines(jsonl.Bytes())[0]["messages"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.columns["messages"]).To(HaveLen(1))
+			Expect(table.columns["messages"][0]).To(MatchJSON(expected))
+		})
+	})
+
+	It("parses formats", func() {
+		f, err := dataset.ParseFormat("ShareGPT")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(Equal(dataset.FormatShareGPT))
+		Expect(f.Extension()).To(Equal("jsonl"))
+		Expect(dataset.FormatParquet.Extension()).To(Equal("parquet"))
+
+		_, err = dataset.ParseFormat("csv")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Select", func() {
+	It("selects the conversations of sessions matching deck and facet filters", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		var leaves []*merkle.Node
+		for _, project := range []string{"alpha", "beta"} {
+			ask := merkle.NewNode(datasetTestBucket("user", text("hello from "+project)), nil, merkle.NodeMeta{Project: project})
+			reply := merkle.NewNode(datasetTestBucket("assistant", text("hi "+project)), ask, merkle.NodeMeta{Project: project})
+			for _, n := range []*merkle.Node{ask, reply} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			leaves = append(leaves, reply)
+		}
+
+		query, closeFn, err := deck.NewQuery(ctx, dbPath, deck.DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		hashes, err := dataset.Select(ctx, query, deck.Filters{Project: "beta"}, nil, dataset.FacetFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(Equal([]string{leaves[1].Hash}))
+
+		overview, err := query.Overview(ctx, deck.Filters{Project: "alpha"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Sessions).To(HaveLen(1))
+		facets := deck.NewEntFacetStore(query.EntClient())
+		Expect(facets.SaveFacet(ctx, &deck.SessionFacet{SessionID: overview.Sessions[0].ID, Outcome: "fully_achieved"})).To(Succeed())
+
+		hashes, err = dataset.Select(ctx, query, deck.Filters{}, facets, dataset.FacetFilter{Outcome: "fully_achieved"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(Equal([]string{leaves[0].Hash}))
+	})
+})
+
+var _ = Describe("Annotated", func() {
+	It("keeps only the branches containing an annotated node", func() {
+		ctx := context.Background()
+		driver, err := sqlite.NewDriver(ctx, filepath.Join(GinkgoT().TempDir(), "tapes.db"))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		ask := merkle.NewNode(datasetTestBucket("user", text("pick a number")), nil)
+		good := merkle.NewNode(datasetTestBucket("assistant", text("seven")), ask)
+		bad := merkle.NewNode(datasetTestBucket("assistant", text("banana")), ask)
+		for _, n := range []*merkle.Node{ask, good, bad} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(driver.PutAnnotation(ctx, &storage.Annotation{Hash: good.Hash, Author: "alice", Rating: storage.RatingGood})).To(Succeed())
+
+		hashes, err := dataset.Annotated(ctx, driver, []string{good.Hash, bad.Hash}, storage.AnnotationFilter{Rating: storage.RatingGood})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(Equal([]string{good.Hash}))
+
+		hashes, err = dataset.Annotated(ctx, driver, []string{good.Hash, bad.Hash}, storage.AnnotationFilter{Rating: storage.RatingBad})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(BeEmpty())
+	})
+})
+
+var _ = Describe("FacetFilter", func() {
+	It("requires facets only when set", func() {
+		Expect(dataset.FacetFilter{}.Match(nil)).To(BeTrue())
+		f := dataset.FacetFilter{SessionType: "single_task"}
+		Expect(f.Match(nil)).To(BeFalse())
+		Expect(f.Match(&deck.SessionFacet{SessionType: "single_task"})).To(BeTrue())
+		Expect(f.Match(&deck.SessionFacet{SessionType: "exploration"})).To(BeFalse())
+	})
+})
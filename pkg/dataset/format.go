@@ -0,0 +1,305 @@
+package dataset
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// writeJSONL writes one JSON object per example.
+func writeJSONL(w io.Writer, format Format, examples []*Example) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, example := range examples {
+		var record any
+		switch format {
+		case FormatOpenAI:
+			record = openAIRecord{Messages: openAIMessages(example.Messages)}
+		case FormatAnthropic:
+			record = anthropicExample(example)
+		case FormatShareGPT:
+			record = shareGPTRecord{Conversations: shareGPTTurns(example.Messages)}
+		default:
+			return fmt.Errorf("unknown dataset format %q", format)
+		}
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("encoding example %s: %w", example.ID, err)
+		}
+	}
+	return bw.Flush()
+}
+
+// OpenAI chat fine-tuning format.
+
+type openAIRecord struct {
+	Messages []openAIMessage `json:"messages"`
+}
+
+type openAIMessage struct {
+	Role       string           `json:"role"`
+	Content    any              `json:"content"`
+	ToolCalls  []openAIToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string           `json:"tool_call_id,omitempty"`
+}
+
+type openAIPart struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text,omitempty"`
+	ImageURL *openAIImageURL `json:"image_url,omitempty"`
+}
+
+type openAIImageURL struct {
+	URL string `json:"url"`
+}
+
+type openAIToolCall struct {
+	ID       string             `json:"id"`
+	Type     string             `json:"type"`
+	Function openAIFunctionCall `json:"function"`
+}
+
+type openAIFunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// openAIMessages converts messages to the OpenAI shape, where tool calls
+// hang off the assistant message and each tool result is its own "tool"
+// message.
+func openAIMessages(messages []llm.Message) []openAIMessage {
+	var out []openAIMessage
+	for _, msg := range messages {
+		var (
+			parts     []openAIPart
+			hasImage  bool
+			toolCalls []openAIToolCall
+			results   []openAIMessage
+		)
+		for _, block := range msg.Content {
+			switch block.Type {
+			case "text":
+				parts = append(parts, openAIPart{Type: "text", Text: block.Text})
+			case "image":
+				if url := imageURL(block); url != "" {
+					parts = append(parts, openAIPart{Type: "image_url", ImageURL: &openAIImageURL{URL: url}})
+					hasImage = true
+				}
+			case "tool_use":
+				toolCalls = append(toolCalls, openAIToolCall{
+					ID:   block.ToolUseID,
+					Type: "function",
+					Function: openAIFunctionCall{
+						Name:      block.ToolName,
+						Arguments: toolArguments(block.ToolInput),
+					},
+				})
+			case "tool_result":
+				results = append(results, openAIMessage{
+					Role:       "tool",
+					Content:    block.ToolOutput,
+					ToolCallID: block.ToolResultID,
+				})
+			}
+		}
+
+		out = append(out, results...)
+		if len(parts) == 0 && len(toolCalls) == 0 {
+			continue
+		}
+
+		role := msg.Role
+		if role == "tool" {
+			// Tool output without a tool_result block has no call to answer.
+			role = "user"
+		}
+		om := openAIMessage{Role: role, ToolCalls: toolCalls}
+		switch {
+		case hasImage:
+			om.Content = parts
+		case len(parts) > 0:
+			om.Content = joinText(parts)
+		}
+		out = append(out, om)
+	}
+	return out
+}
+
+// Anthropic Messages format.
+
+type anthropicRecord struct {
+	Model    string             `json:"model,omitempty"`
+	System   string             `json:"system,omitempty"`
+	Messages []anthropicMessage `json:"messages"`
+}
+
+type anthropicMessage struct {
+	Role    string           `json:"role"`
+	Content []anthropicBlock `json:"content"`
+}
+
+type anthropicBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text,omitempty"`
+
+	Source *anthropicSource `json:"source,omitempty"`
+
+	ID    string         `json:"id,omitempty"`
+	Name  string         `json:"name,omitempty"`
+	Input map[string]any `json:"input,omitempty"`
+
+	ToolUseID string `json:"tool_use_id,omitempty"`
+	Content   string `json:"content,omitempty"`
+	IsError   bool   `json:"is_error,omitempty"`
+}
+
+type anthropicSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type,omitempty"`
+	Data      string `json:"data,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// anthropicExample converts an example to the Anthropic shape: system text
+// is lifted to the top level, tool results are sent by the user, and
+// consecutive turns from the same role are merged so roles alternate.
+func anthropicExample(example *Example) anthropicRecord {
+	record := anthropicRecord{Model: example.Model}
+	var system []string
+	for _, msg := range example.Messages {
+		if msg.Role == "system" {
+			system = append(system, msg.GetText())
+			continue
+		}
+
+		var blocks []anthropicBlock
+		for _, block := range msg.Content {
+			switch block.Type {
+			case "text":
+				blocks = append(blocks, anthropicBlock{Type: "text", Text: block.Text})
+			case "image":
+				source := &anthropicSource{Type: "base64", MediaType: block.MediaType, Data: block.ImageBase64}
+				if block.ImageBase64 == "" {
+					if block.ImageURL == "" {
+						continue
+					}
+					source = &anthropicSource{Type: "url", URL: block.ImageURL}
+				}
+				blocks = append(blocks, anthropicBlock{Type: "image", Source: source})
+			case "tool_use":
+				input := block.ToolInput
+				if input == nil {
+					input = map[string]any{}
+				}
+				blocks = append(blocks, anthropicBlock{Type: "tool_use", ID: block.ToolUseID, Name: block.ToolName, Input: input})
+			case "tool_result":
+				blocks = append(blocks, anthropicBlock{
+					Type:      "tool_result",
+					ToolUseID: block.ToolResultID,
+					Content:   block.ToolOutput,
+					IsError:   block.IsError,
+				})
+			}
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+
+		role := msg.Role
+		if role == "tool" {
+			role = "user"
+		}
+		if n := len(record.Messages); n > 0 && record.Messages[n-1].Role == role {
+			record.Messages[n-1].Content = append(record.Messages[n-1].Content, blocks...)
+			continue
+		}
+		record.Messages = append(record.Messages, anthropicMessage{Role: role, Content: blocks})
+	}
+	record.System = strings.Join(system, "\n\n")
+	return record
+}
+
+// ShareGPT format.
+
+type shareGPTRecord struct {
+	Conversations []shareGPTTurn `json:"conversations"`
+}
+
+type shareGPTTurn struct {
+	From  string `json:"from"`
+	Value string `json:"value"`
+}
+
+// shareGPTTurns converts messages to ShareGPT turns. Tool calls become
+// "function_call" turns holding a JSON call and tool results become
+// "observation" turns. Images have no ShareGPT form and are dropped.
+func shareGPTTurns(messages []llm.Message) []shareGPTTurn {
+	from := map[string]string{
+		"system":    "system",
+		"user":      "human",
+		"assistant": "gpt",
+		"tool":      "human",
+	}
+
+	var out []shareGPTTurn
+	for _, msg := range messages {
+		var text []string
+		var calls, observations []shareGPTTurn
+		for _, block := range msg.Content {
+			switch block.Type {
+			case "text":
+				text = append(text, block.Text)
+			case "tool_use":
+				call, _ := json.Marshal(struct {
+					Name      string         `json:"name"`
+					Arguments map[string]any `json:"arguments"`
+				}{block.ToolName, block.ToolInput})
+				calls = append(calls, shareGPTTurn{From: "function_call", Value: string(call)})
+			case "tool_result":
+				observations = append(observations, shareGPTTurn{From: "observation", Value: block.ToolOutput})
+			}
+		}
+
+		out = append(out, observations...)
+		if len(text) > 0 {
+			out = append(out, shareGPTTurn{From: from[msg.Role], Value: strings.Join(text, "\n")})
+		}
+		out = append(out, calls...)
+	}
+	return out
+}
+
+// imageURL returns the image as a URL, inlining base64 data as a data URL.
+func imageURL(block llm.ContentBlock) string {
+	if block.ImageBase64 != "" {
+		mediaType := block.MediaType
+		if mediaType == "" {
+			mediaType = "image/png"
+		}
+		return "data:" + mediaType + ";base64," + block.ImageBase64
+	}
+	return block.ImageURL
+}
+
+// toolArguments encodes tool input as the JSON string OpenAI expects.
+func toolArguments(input map[string]any) string {
+	if input == nil {
+		return "{}"
+	}
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return "{}"
+	}
+	return string(raw)
+}
+
+func joinText(parts []openAIPart) string {
+	text := make([]string, 0, len(parts))
+	for _, part := range parts {
+		text = append(text, part.Text)
+	}
+	return strings.Join(text, "\n")
+}
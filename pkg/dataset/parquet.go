@@ -0,0 +1,303 @@
+package dataset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// The Parquet writer below covers exactly what a dataset needs: a single row
+// group of required UTF-8 string columns, each stored as uncompressed,
+// PLAIN-encoded v1 data pages of at most parquetPageSize bytes. Metadata is
+// Thrift compact-protocol encoded as described in
+// https://github.com/apache/parquet-format.
+
+const parquetMagic = "PAR1"
+
+// parquetPageSize is the size, in bytes, at which a column's data page is
+// closed and a new one started. A single larger value gets a page of its own.
+const parquetPageSize = 1 << 20
+
+// Parquet enum values.
+const (
+	parquetByteArray    = 6 // Type.BYTE_ARRAY
+	parquetRequired     = 0 // FieldRepetitionType.REQUIRED
+	parquetUTF8         = 0 // ConvertedType.UTF8
+	parquetPlain        = 0 // Encoding.PLAIN
+	parquetRLE          = 3 // Encoding.RLE
+	parquetUncompressed = 0 // CompressionCodec.UNCOMPRESSED
+	parquetDataPage     = 0 // PageType.DATA_PAGE
+)
+
+// parquetColumns are the columns of a Parquet dataset, in order.
+var parquetColumns = []string{"id", "split", "model", "messages"}
+
+// writeParquet writes examples as a Parquet table with id, split, model and
+// messages columns. messages holds the OpenAI chat messages as JSON.
+func writeParquet(w io.Writer, examples []*Example) error {
+	columns := make([][]string, len(parquetColumns))
+	for _, example := range examples {
+		messages, err := json.Marshal(openAIMessages(example.Messages))
+		if err != nil {
+			return fmt.Errorf("encoding example %s: %w", example.ID, err)
+		}
+		columns[0] = append(columns[0], example.ID)
+		columns[1] = append(columns[1], example.Split)
+		columns[2] = append(columns[2], example.Model)
+		columns[3] = append(columns[3], string(messages))
+	}
+
+	cw := &countingWriter{w: w}
+	if _, err := io.WriteString(cw, parquetMagic); err != nil {
+		return err
+	}
+
+	chunks := make([]parquetChunk, len(columns))
+	for i, values := range columns {
+		chunks[i] = parquetChunk{
+			name:   parquetColumns[i],
+			offset: cw.n,
+			values: int64(len(values)),
+		}
+
+		var page bytes.Buffer
+		count := 0
+		flush := func() error {
+			if page.Len() > math.MaxInt32 {
+				return fmt.Errorf("parquet column %s: value of %d bytes is too large", parquetColumns[i], page.Len())
+			}
+			if _, err := cw.Write(parquetPageHeader(page.Len(), count)); err != nil {
+				return err
+			}
+			if _, err := cw.Write(page.Bytes()); err != nil {
+				return err
+			}
+			page.Reset()
+			count = 0
+			return nil
+		}
+		for _, v := range values {
+			if count > 0 && page.Len()+4+len(v) > parquetPageSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			_ = binary.Write(&page, binary.LittleEndian, uint32(len(v)))
+			page.WriteString(v)
+			count++
+		}
+		if count > 0 || len(values) == 0 {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		chunks[i].size = cw.n - chunks[i].offset
+	}
+
+	footer := parquetFooter(chunks, int64(len(examples)))
+	if _, err := cw.Write(footer); err != nil {
+		return err
+	}
+	if err := binary.Write(cw, binary.LittleEndian, uint32(len(footer))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(cw, parquetMagic)
+	return err
+}
+
+// parquetPageHeader encodes the PageHeader of a PLAIN data page of size bytes
+// holding count values.
+func parquetPageHeader(size, count int) []byte {
+	var header thriftWriter
+	header.i32(1, parquetDataPage)
+	header.i32(2, int32(size))
+	header.i32(3, int32(size))
+	header.beginStruct(5)
+	header.i32(1, int32(count))
+	header.i32(2, parquetPlain)
+	header.i32(3, parquetRLE)
+	header.i32(4, parquetRLE)
+	header.endStruct()
+	header.stop()
+	return header.buf.Bytes()
+}
+
+// countingWriter tracks the number of bytes written, which locate the column
+// chunks in the footer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// parquetChunk locates one column's data in the file.
+type parquetChunk struct {
+	name   string
+	offset int64
+	size   int64
+	values int64
+}
+
+// parquetFooter encodes the FileMetaData for chunks.
+func parquetFooter(chunks []parquetChunk, rows int64) []byte {
+	var t thriftWriter
+	t.i32(1, 1) // version
+
+	t.beginList(2, thriftStruct, len(chunks)+1)
+	t.binary(4, "schema")
+	t.i32(5, int32(len(chunks)))
+	t.stop()
+	for _, chunk := range chunks {
+		t.i32(1, parquetByteArray)
+		t.i32(3, parquetRequired)
+		t.binary(4, chunk.name)
+		t.i32(6, parquetUTF8)
+		t.stop()
+	}
+	t.endList()
+
+	t.i64(3, rows)
+
+	var total int64
+	for _, chunk := range chunks {
+		total += chunk.size
+	}
+	t.beginList(4, thriftStruct, 1)
+	t.beginList(1, thriftStruct, len(chunks))
+	for _, chunk := range chunks {
+		t.i64(2, chunk.offset)
+		t.beginStruct(3)
+		t.i32(1, parquetByteArray)
+		t.beginList(2, thriftI32, 2)
+		t.listI32(parquetPlain)
+		t.listI32(parquetRLE)
+		t.endList()
+		t.beginList(3, thriftBinary, 1)
+		t.listBinary(chunk.name)
+		t.endList()
+		t.i32(4, parquetUncompressed)
+		t.i64(5, chunk.values)
+		t.i64(6, chunk.size)
+		t.i64(7, chunk.size)
+		t.i64(9, chunk.offset)
+		t.endStruct()
+		t.stop()
+	}
+	t.endList()
+	t.i64(2, total)
+	t.i64(3, rows)
+	t.stop()
+	t.endList()
+
+	t.binary(6, "tapes")
+	t.stop()
+	return t.buf.Bytes()
+}
+
+// Thrift compact protocol type codes.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes Thrift compact-protocol structs. Field IDs are
+// delta-encoded against the previous field of the enclosing struct, so the
+// writer keeps the last field ID of each open struct on a stack. Struct list
+// elements are written as a sequence of fields terminated by stop.
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16
+	cur  int16
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	if delta := id - t.cur; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.varint(zigzag(int64(id)))
+	}
+	t.cur = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(zigzag(int64(v)))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(zigzag(v))
+}
+
+func (t *thriftWriter) binary(id int16, v string) {
+	t.field(id, thriftBinary)
+	t.listBinary(v)
+}
+
+func (t *thriftWriter) beginStruct(id int16) {
+	t.field(id, thriftStruct)
+	t.push()
+}
+
+func (t *thriftWriter) endStruct() {
+	t.stop()
+	t.pop()
+}
+
+// beginList opens a list field. Struct elements each start a fresh field
+// sequence, which stop resets.
+func (t *thriftWriter) beginList(id int16, elem byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elem)
+	} else {
+		t.buf.WriteByte(0xf0 | elem)
+		t.varint(uint64(size))
+	}
+	t.push()
+}
+
+func (t *thriftWriter) endList() { t.pop() }
+
+func (t *thriftWriter) listI32(v int32) { t.varint(zigzag(int64(v))) }
+
+func (t *thriftWriter) listBinary(v string) {
+	t.varint(uint64(len(v)))
+	t.buf.WriteString(v)
+}
+
+// stop terminates the current struct's fields.
+func (t *thriftWriter) stop() {
+	t.buf.WriteByte(0)
+	t.cur = 0
+}
+
+func (t *thriftWriter) push() {
+	t.last = append(t.last, t.cur)
+	t.cur = 0
+}
+
+func (t *thriftWriter) pop() {
+	t.cur = t.last[len(t.last)-1]
+	t.last = t.last[:len(t.last)-1]
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	t.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
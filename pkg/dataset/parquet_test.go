@@ -0,0 +1,243 @@
+package dataset_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/dataset"
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// parquetTable is a Parquet file decoded by readParquet.
+type parquetTable struct {
+	rows    int64
+	columns map[string][]string
+	pages   map[string]int
+}
+
+// readParquet decodes a Parquet file of required, uncompressed, PLAIN-encoded
+// BYTE_ARRAY columns, following the footer's row groups, column chunks and
+// page headers rather than assuming the writer's layout.
+func readParquet(raw []byte) (*parquetTable, error) {
+	if len(raw) < 12 || string(raw[:4]) != "PAR1" || string(raw[len(raw)-4:]) != "PAR1" {
+		return nil, errors.New("missing PAR1 magic")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(raw[len(raw)-8:]))
+	footerStart := len(raw) - 8 - footerLen
+	if footerStart < 4 {
+		return nil, fmt.Errorf("footer length %d out of range", footerLen)
+	}
+
+	meta, err := (&thriftReader{buf: raw[footerStart : len(raw)-8]}).structure()
+	if err != nil {
+		return nil, fmt.Errorf("decoding footer: %w", err)
+	}
+
+	table := &parquetTable{
+		rows:    meta[3].(int64),
+		columns: map[string][]string{},
+		pages:   map[string]int{},
+	}
+	for _, rg := range meta[4].([]any) {
+		for _, cc := range rg.(map[int16]any)[1].([]any) {
+			cm := cc.(map[int16]any)[3].(map[int16]any)
+			if codec := cm[4].(int64); codec != 0 {
+				return nil, fmt.Errorf("unsupported codec %d", codec)
+			}
+			name := string(cm[3].([]any)[0].([]byte))
+			values := cm[5].(int64)
+			pos := int(cm[9].(int64))
+			end := pos + int(cm[7].(int64))
+			if end > footerStart {
+				return nil, fmt.Errorf("column %s overruns the footer", name)
+			}
+
+			for pos < end {
+				r := &thriftReader{buf: raw[pos:end]}
+				header, err := r.structure()
+				if err != nil {
+					return nil, fmt.Errorf("decoding page header of %s: %w", name, err)
+				}
+				pos += r.pos
+				size := int(header[3].(int64))
+				count := int(header[5].(map[int16]any)[1].(int64))
+				if pos+size > end {
+					return nil, fmt.Errorf("page of %s overruns its column chunk", name)
+				}
+
+				page := raw[pos : pos+size]
+				for range count {
+					if len(page) < 4 {
+						return nil, fmt.Errorf("truncated value in %s", name)
+					}
+					n := int(binary.LittleEndian.Uint32(page))
+					if 4+n > len(page) {
+						return nil, fmt.Errorf("truncated value in %s", name)
+					}
+					table.columns[name] = append(table.columns[name], string(page[4:4+n]))
+					page = page[4+n:]
+				}
+				if len(page) != 0 {
+					return nil, fmt.Errorf("%d trailing bytes in a page of %s", len(page), name)
+				}
+				pos += size
+				table.pages[name]++
+			}
+			if int64(len(table.columns[name])) != values {
+				return nil, fmt.Errorf("column %s has %d values, metadata says %d", name, len(table.columns[name]), values)
+			}
+		}
+	}
+	return table, nil
+}
+
+// thriftReader decodes Thrift compact-protocol structs into maps keyed by
+// field ID.
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) readByte() (byte, error) {
+	if r.pos >= len(r.buf) {
+		return 0, errors.New("unexpected end of data")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errors.New("bad varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) zigzag() (int64, error) {
+	v, err := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *thriftReader) structure() (map[int16]any, error) {
+	fields := map[int16]any{}
+	var id int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == 0 {
+			return fields, nil
+		}
+		if delta := int16(b >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := r.zigzag()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		if fields[id], err = r.value(b & 0x0f); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *thriftReader) value(typ byte) (any, error) {
+	switch typ {
+	case 1, 2: // boolean true, false
+		return typ == 1, nil
+	case 5, 6: // i32, i64
+		return r.zigzag()
+	case 8: // binary
+		n, err := r.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if r.pos+int(n) > len(r.buf) {
+			return nil, errors.New("binary overruns data")
+		}
+		v := r.buf[r.pos : r.pos+int(n)]
+		r.pos += int(n)
+		return v, nil
+	case 9: // list
+		b, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		size := int(b >> 4)
+		if size == 15 {
+			n, err := r.uvarint()
+			if err != nil {
+				return nil, err
+			}
+			size = int(n)
+		}
+		list := make([]any, 0, size)
+		for range size {
+			v, err := r.value(b & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case 12: // struct
+		return r.structure()
+	default:
+		return nil, fmt.Errorf("unsupported thrift type %d", typ)
+	}
+}
+
+var _ = Describe("Parquet", func() {
+	It("splits large columns across bounded pages", func() {
+		var examples []*dataset.Example
+		for i := range 5 {
+			examples = append(examples, &dataset.Example{
+				ID:    fmt.Sprintf("example-%d", i),
+				Model: "test-model",
+				Split: dataset.SplitTrain,
+				Messages: []llm.Message{
+					llm.NewTextMessage("user", strings.Repeat("x", 400<<10)),
+					llm.NewTextMessage("assistant", fmt.Sprintf("answer %d", i)),
+				},
+			})
+		}
+
+		var buf bytes.Buffer
+		Expect(dataset.Write(&buf, dataset.FormatParquet, examples)).To(Succeed())
+
+		table, err := readParquet(buf.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(table.rows).To(Equal(int64(5)))
+		Expect(table.pages["messages"]).To(BeNumerically(">", 1))
+		Expect(table.pages["id"]).To(Equal(1))
+		Expect(table.columns["id"]).To(Equal([]string{"example-0", "example-1", "example-2", "example-3", "example-4"}))
+
+		var messages []map[string]any
+		Expect(json.Unmarshal([]byte(table.columns["messages"][4]), &messages)).To(Succeed())
+		Expect(messages).To(HaveLen(2))
+		Expect(messages[1]["content"]).To(Equal("answer 4"))
+	})
+
+	It("writes an empty table", func() {
+		var buf bytes.Buffer
+		Expect(dataset.Write(&buf, dataset.FormatParquet, nil)).To(Succeed())
+
+		table, err := readParquet(buf.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(table.rows).To(BeZero())
+		Expect(table.columns).To(BeEmpty())
+	})
+})
@@ -0,0 +1,108 @@
+package dataset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/papercomputeco/tapes/pkg/deck"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+)
+
+// Sessions lists deck sessions and the conversations they are made of.
+// *deck.Query satisfies it.
+type Sessions interface {
+	Overview(ctx context.Context, filters deck.Filters) (*deck.Overview, error)
+	SessionLeaves(ctx context.Context, sessionID string) ([]string, error)
+}
+
+// FacetFilter restricts sessions by their extracted facets. Empty fields
+// match anything; sessions without facets never match a non-empty filter.
+type FacetFilter struct {
+	Outcome      string
+	GoalCategory string
+	SessionType  string
+}
+
+// IsZero reports whether the filter matches every session.
+func (f FacetFilter) IsZero() bool {
+	return f == FacetFilter{}
+}
+
+// Match reports whether facet satisfies the filter.
+func (f FacetFilter) Match(facet *deck.SessionFacet) bool {
+	if f.IsZero() {
+		return true
+	}
+	if facet == nil {
+		return false
+	}
+	return (f.Outcome == "" || facet.Outcome == f.Outcome) &&
+		(f.GoalCategory == "" || facet.GoalCategory == f.GoalCategory) &&
+		(f.SessionType == "" || facet.SessionType == f.SessionType)
+}
+
+// Select returns the leaf hashes of every conversation in the sessions
+// matching filters and, when facets is non-nil, ff.
+func Select(ctx context.Context, sessions Sessions, filters deck.Filters, facets deck.FacetStore, ff FacetFilter) ([]string, error) {
+	overview, err := sessions.Overview(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	var hashes []string
+	for _, session := range overview.Sessions {
+		if !ff.IsZero() {
+			if facets == nil {
+				return nil, fmt.Errorf("facet filters need a facet store")
+			}
+			facet, err := facets.GetFacet(ctx, session.ID)
+			if err != nil && !ent.IsNotFound(err) {
+				return nil, fmt.Errorf("loading facets for %s: %w", session.ID, err)
+			}
+			if !ff.Match(facet) {
+				continue
+			}
+		}
+
+		leaves, err := sessions.SessionLeaves(ctx, session.ID)
+		if err != nil {
+			return nil, fmt.Errorf("loading session %s: %w", session.ID, err)
+		}
+		hashes = append(hashes, leaves...)
+	}
+	return hashes, nil
+}
+
+// Annotated keeps the conversations ending at hashes that contain a node
+// with an annotation matching filter. Deck filters match whole sessions;
+// this narrows a session to the branches that were actually rated.
+func Annotated(ctx context.Context, driver storage.Driver, hashes []string, filter storage.AnnotationFilter) ([]string, error) {
+	store, ok := driver.(storage.AnnotationStore)
+	if !ok {
+		return nil, fmt.Errorf("storage driver does not support annotations")
+	}
+	annotations, err := store.ListAnnotations(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	annotated := make(map[string]bool, len(annotations))
+	for _, a := range annotations {
+		annotated[a.Hash] = true
+	}
+
+	var kept []string
+	for _, hash := range hashes {
+		ancestry, err := driver.Ancestry(ctx, hash)
+		if err != nil {
+			return nil, fmt.Errorf("loading conversation %s: %w", hash, err)
+		}
+		for _, node := range ancestry {
+			if annotated[node.Hash] {
+				kept = append(kept, hash)
+				break
+			}
+		}
+	}
+	return kept, nil
+}
@@ -0,0 +1,116 @@
+package deck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	entdriver "github.com/papercomputeco/tapes/pkg/storage/ent/driver"
+)
+
+// Rater is implemented by queriers that can rate session messages. The TUI
+// and web deck check for it before offering rating keybindings.
+type Rater interface {
+	// RateMessage sets author's rating of the message with hash, keeping
+	// any labels and notes. An empty rating clears it.
+	RateMessage(ctx context.Context, hash, author string, rating storage.Rating) error
+}
+
+// Ensure Query implements Rater
+var _ Rater = (*Query)(nil)
+
+func (q *Query) RateMessage(ctx context.Context, hash, author string, rating storage.Rating) error {
+	store := &entdriver.EntDriver{Client: q.client}
+
+	existing, err := store.Annotations(ctx, hash)
+	if err != nil {
+		return err
+	}
+	a := &storage.Annotation{Hash: hash, Author: author}
+	if i := slices.IndexFunc(existing, func(e *storage.Annotation) bool { return e.Author == author }); i >= 0 {
+		a = existing[i]
+	}
+	a.Rating = rating
+
+	if a.Rating == "" && len(a.Labels) == 0 && a.Notes == "" {
+		return store.DeleteAnnotation(ctx, hash, author)
+	}
+	return store.PutAnnotation(ctx, a)
+}
+
+// attachAnnotations sets the rating and labels of each message from its
+// annotations. When authors disagree, the most recently updated rating wins.
+func (q *Query) attachAnnotations(ctx context.Context, messages []SessionMessage) error {
+	hashes := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		hashes = append(hashes, msg.Hash)
+	}
+
+	rows, err := q.client.Annotation.Query().
+		Where(annotation.HashIn(hashes...)).
+		Order(ent.Asc(annotation.FieldUpdatedAt), ent.Asc(annotation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("load annotations: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	byHash := make(map[string][]*ent.Annotation, len(rows))
+	for _, row := range rows {
+		byHash[row.Hash] = append(byHash[row.Hash], row)
+	}
+	for i := range messages {
+		for _, row := range byHash[messages[i].Hash] {
+			if row.Rating != "" {
+				messages[i].Rating = storage.Rating(row.Rating)
+			}
+			for _, label := range row.Labels {
+				if !slices.Contains(messages[i].Labels, label) {
+					messages[i].Labels = append(messages[i].Labels, label)
+				}
+			}
+		}
+		slices.Sort(messages[i].Labels)
+	}
+	return nil
+}
+
+// annotatedHashes returns the hashes of nodes annotated with the filters'
+// rating and label, or nil when the filters don't ask for either.
+func (q *Query) annotatedHashes(ctx context.Context, filters Filters) (map[string]bool, error) {
+	if filters.Rating == "" && filters.Label == "" {
+		return nil, nil
+	}
+
+	store := &entdriver.EntDriver{Client: q.client}
+	annotations, err := store.ListAnnotations(ctx, storage.AnnotationFilter{
+		Rating: filters.Rating,
+		Label:  filters.Label,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string]bool, len(annotations))
+	for _, a := range annotations {
+		hashes[a.Hash] = true
+	}
+	return hashes, nil
+}
+
+// groupHasAnnotated reports whether any node of the group is in hashes.
+func groupHasAnnotated(group *sessionGroup, hashes map[string]bool) bool {
+	for _, member := range group.members {
+		for _, n := range member.nodes {
+			if hashes[n.ID] {
+				return true
+			}
+		}
+	}
+	return false
+}
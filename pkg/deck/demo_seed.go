@@ -76,6 +76,12 @@ func SeedDemo(ctx context.Context, path string, overwrite bool) (int, int, error
 		messageCount += len(session.Messages)
 	}
 
+	// Sessions are inserted directly with their demo timestamps, so their
+	// traversal columns are backfilled afterwards.
+	if err := driver.Materialize(ctx); err != nil {
+		return 0, 0, err
+	}
+
 	return len(sessions), messageCount, nil
 }
 
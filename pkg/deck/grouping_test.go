@@ -67,6 +67,21 @@ var _ = Describe("Session grouping", func() {
 			Expect(groups[0].summary.TotalCost).To(BeNumerically("~", 1.25, 0.001))
 		})
 
+		It("groups candidates sharing an explicit session ID regardless of label", func() {
+			run := map[string]string{"session": "run-7"}
+			candidates := []sessionCandidate{
+				{summary: SessionSummary{ID: "a", Label: "fix bug", StartTime: now, EndTime: now.Add(5 * time.Minute), Status: StatusCompleted, Metadata: run}},
+				{summary: SessionSummary{ID: "b", Label: "add feature", StartTime: now.Add(10 * time.Minute), EndTime: now.Add(15 * time.Minute), Status: StatusCompleted, Metadata: map[string]string{"session": "run-7", "arm": "b"}}},
+				{summary: SessionSummary{ID: "c", Label: "fix bug", StartTime: now.Add(20 * time.Minute), EndTime: now.Add(25 * time.Minute), Status: StatusCompleted}},
+			}
+
+			groups := groupSessionCandidates(candidates)
+			Expect(groups).To(HaveLen(2))
+			Expect(groups[0].members).To(HaveLen(2))
+			Expect(groups[0].summary.Metadata).To(Equal(map[string]string{"session": "run-7", "arm": "b"}))
+			Expect(run).To(HaveLen(1), "group metadata must not alias member metadata")
+		})
+
 		It("summarizes status with failed taking priority", func() {
 			candidates := []sessionCandidate{
 				{summary: SessionSummary{ID: "a", Label: "task", StartTime: now, EndTime: now.Add(5 * time.Minute), Status: StatusCompleted}},
@@ -50,6 +50,11 @@ func DefaultPricing() PricingTable {
 		"o4-mini":      {Input: 1.10, Output: 4.40, CacheRead: 0.275, CacheWrite: 1.10},
 		"o1":           {Input: 15.00, Output: 60.00, CacheRead: 7.50, CacheWrite: 15.00},
 
+		// OpenAI embeddings
+		"text-embedding-3-small": {Input: 0.02},
+		"text-embedding-3-large": {Input: 0.13},
+		"text-embedding-ada-002": {Input: 0.10},
+
 		// DeepSeek
 		"deepseek-r1": {Input: 0.55, Output: 2.19, CacheRead: 0.14},
 	}
@@ -76,6 +81,26 @@ func LoadPricing(path string) (PricingTable, error) {
 	return pricing, nil
 }
 
+// PricingForProviderModel looks up pricing for a model served by a named
+// provider. A "provider/model" entry (e.g. "openrouter/gpt-4o") takes
+// precedence over the model's own entry, so OpenAI-compatible providers can
+// be priced separately from the vendor they proxy. Vendor-prefixed model
+// names used by routers (e.g. "openai/gpt-4o") fall back to the bare model.
+func PricingForProviderModel(pricing PricingTable, provider, model string) (Pricing, bool) {
+	if provider != "" {
+		if price, ok := pricing[strings.ToLower(provider)+"/"+normalizeModel(model)]; ok {
+			return price, true
+		}
+	}
+	if price, ok := PricingForModel(pricing, model); ok {
+		return price, true
+	}
+	if _, bare, ok := strings.Cut(model, "/"); ok {
+		return PricingForModel(pricing, bare)
+	}
+	return Pricing{}, false
+}
+
 func PricingForModel(pricing PricingTable, model string) (Pricing, bool) {
 	normalized := normalizeModel(model)
 	price, ok := pricing[normalized]
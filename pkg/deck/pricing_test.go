@@ -198,3 +198,33 @@ var _ = Describe("PricingForModel", func() {
 		Expect(ok).To(BeFalse())
 	})
 })
+
+var _ = Describe("PricingForProviderModel", func() {
+	pricing := DefaultPricing()
+
+	It("prefers provider-specific entries", func() {
+		table := PricingTable{
+			"gpt-4o":       pricing["gpt-4o"],
+			"azure/gpt-4o": {Input: 1.00, Output: 2.00},
+		}
+
+		p, ok := PricingForProviderModel(table, "azure", "gpt-4o-2024-08-06")
+		Expect(ok).To(BeTrue())
+		Expect(p.Input).To(Equal(1.00))
+
+		p, ok = PricingForProviderModel(table, "openai", "gpt-4o")
+		Expect(ok).To(BeTrue())
+		Expect(p).To(Equal(pricing["gpt-4o"]))
+	})
+
+	It("resolves vendor-prefixed model names", func() {
+		p, ok := PricingForProviderModel(pricing, "openrouter", "openai/gpt-4o")
+		Expect(ok).To(BeTrue())
+		Expect(p).To(Equal(pricing["gpt-4o"]))
+	})
+
+	It("reports unknown models", func() {
+		_, ok := PricingForProviderModel(pricing, "vllm", "my-org/custom-model")
+		Expect(ok).To(BeFalse())
+	})
+})
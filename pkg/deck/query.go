@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,8 +16,14 @@ import (
 	"time"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	entdriver "github.com/papercomputeco/tapes/pkg/storage/ent/driver"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
 
@@ -29,6 +36,19 @@ const (
 	sessionCacheTTL     = 10 * time.Second
 	messageGroupWindow  = 5 * time.Second
 	maxGroupedTextChars = 4000
+
+	// sessionMetadataKey is the metadata key the proxy records the
+	// X-Tapes-Session header under.
+	sessionMetadataKey = "session"
+
+	// sessionBatchSize bounds the roots or hashes per query when loading
+	// sessions, keeping them under the database's bound parameter limit.
+	sessionBatchSize = 500
+
+	// sessionPageNodes bounds the nodes loaded per page when building
+	// session summaries. A conversation larger than a page gets a page of
+	// its own.
+	sessionPageNodes = 5000
 )
 
 // Querier is an interface for querying session data.
@@ -42,6 +62,7 @@ type Querier interface {
 
 type Query struct {
 	client  *ent.Client
+	driver  *entdriver.EntDriver
 	pricing PricingTable
 	cache   sessionCache
 }
@@ -65,7 +86,7 @@ func NewQuery(ctx context.Context, dbPath string, pricing PricingTable) (*Query,
 		return driver.Close()
 	}
 
-	return &Query{client: driver.Client, pricing: pricing}, closeFn, nil
+	return &Query{client: driver.Client, driver: driver.EntDriver, pricing: pricing}, closeFn, nil
 }
 
 // userLabelCandidate holds pre-extracted label text from a user-role node,
@@ -78,7 +99,10 @@ type sessionCandidate struct {
 	summary    SessionSummary
 	modelCosts map[string]ModelCost
 	status     string
-	nodes      []*ent.Node
+	toolCalls  map[string]int
+	toolErrors map[string]int
+	// nodes is the session's root-first chain, without message content.
+	nodes []*ent.Node
 }
 
 type sessionGroup struct {
@@ -94,6 +118,33 @@ type sessionCache struct {
 	loadedAt   time.Time
 }
 
+// sessionNodes loads the nodes matching ps in conversation order, with the
+// columns session summaries are built from.
+func (q *Query) sessionNodes(ctx context.Context, ps ...predicate.Node) ([]*ent.Node, error) {
+	return q.client.Node.Query().
+		Where(ps...).
+		Order(ent.Asc(node.FieldRootHash), ent.Asc(node.FieldDepth)).
+		Select(
+			node.FieldParentHash, node.FieldRole, node.FieldContent,
+			node.FieldModel, node.FieldProvider, node.FieldAgentName,
+			node.FieldStopReason, node.FieldPromptTokens, node.FieldCompletionTokens,
+			node.FieldTotalTokens, node.FieldCacheCreationInputTokens,
+			node.FieldCacheReadInputTokens, node.FieldProject, node.FieldCreatedAt,
+			node.FieldCacheKey, node.FieldCacheHits, node.FieldGenerationParams,
+			node.FieldProviderRequestID, node.FieldRateLimits, node.FieldRootHash,
+			node.FieldDepth, node.FieldIsLeaf,
+		).
+		All(ctx)
+}
+
+// rootSize is the number of nodes and leaves stored under a conversation
+// root, aggregated in SQL to plan the pages sessions are loaded in.
+type rootSize struct {
+	RootHash string `json:"root_hash"`
+	Nodes    int    `json:"nodes"`
+	Leaves   int    `json:"leaves"`
+}
+
 func (q *Query) loadSessionCandidates(ctx context.Context, allowCache bool) ([]sessionCandidate, error) {
 	if allowCache {
 		if cached := q.cachedSessionCandidates(); cached != nil {
@@ -101,51 +152,238 @@ func (q *Query) loadSessionCandidates(ctx context.Context, allowCache bool) ([]s
 		}
 	}
 
-	// Bulk-load all nodes in a single query and build ancestry chains
-	// in memory. This replaces the previous N+1 pattern where each leaf
-	// called loadAncestry with individual parent queries.
-	allNodes, err := q.client.Node.Query().Select(
-		node.FieldParentHash, node.FieldRole, node.FieldContent,
-		node.FieldModel, node.FieldProvider, node.FieldAgentName,
-		node.FieldStopReason, node.FieldPromptTokens, node.FieldCompletionTokens,
-		node.FieldTotalTokens, node.FieldCacheCreationInputTokens,
-		node.FieldCacheReadInputTokens, node.FieldProject, node.FieldCreatedAt,
-	).All(ctx)
+	// Size every conversation tree with a GROUP BY over root_hash, then load
+	// the trees a page at a time through the (root_hash, depth) index so only
+	// one page of message content is held while summaries are built.
+	var sizes []rootSize
+	err := q.client.Node.Query().
+		Where(node.RootHashNotNil()).
+		GroupBy(node.FieldRootHash).
+		Aggregate(
+			ent.As(ent.Count(), "nodes"),
+			ent.As(ent.Sum(node.FieldIsLeaf), "leaves"),
+		).
+		Scan(ctx, &sizes)
 	if err != nil {
-		return nil, fmt.Errorf("load nodes: %w", err)
+		return nil, fmt.Errorf("size conversations: %w", err)
 	}
 
-	byID := make(map[string]*ent.Node, len(allNodes))
-	hasChildren := make(map[string]bool)
-	for _, n := range allNodes {
-		byID[n.ID] = n
-		if n.ParentHash != nil && *n.ParentHash != "" {
-			hasChildren[*n.ParentHash] = true
+	var pages [][]string
+	var page []string
+	pageNodes, leaves := 0, 0
+	for _, size := range sizes {
+		if len(page) > 0 && (pageNodes+size.Nodes > sessionPageNodes || len(page) == sessionBatchSize) {
+			pages = append(pages, page)
+			page, pageNodes = nil, 0
+		}
+		page = append(page, size.RootHash)
+		pageNodes += size.Nodes
+		leaves += size.Leaves
+	}
+	if len(page) > 0 {
+		pages = append(pages, page)
+	}
+
+	candidates := make([]sessionCandidate, 0, leaves)
+	byID := make(map[string]*ent.Node)
+	var links []*ent.NodeLink
+	load := func(ps predicate.Node) error {
+		pageCandidates, pageLinks, err := q.sessionPage(ctx, ps, byID)
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, pageCandidates...)
+		links = append(links, pageLinks...)
+		return nil
+	}
+	for _, roots := range pages {
+		if err := load(node.RootHashIn(roots...)); err != nil {
+			return nil, err
+		}
+	}
+	// Nodes stored while their parent was missing have no root yet.
+	if err := load(node.RootHashIsNil()); err != nil {
+		return nil, err
+	}
+
+	// Links can join conversations in different pages, so they are resolved
+	// once every page is loaded.
+	logical, spawnedBy := logicalRoots(links, byID)
+	for i := range candidates {
+		opening := openingNode(candidates[i].nodes).ID
+		candidates[i].summary.LogicalRoot = logical[opening]
+		candidates[i].summary.ParentHash = spawnedBy[opening]
+	}
+
+	q.storeSessionCandidates(candidates)
+	return candidates, nil
+}
+
+// sessionPage builds a candidate for every leaf among the nodes matching ps,
+// which must hold whole conversation trees, and returns them with the
+// continues and subagent links ending in those nodes. Once the summaries are
+// built the nodes' message content is released and the nodes are added to
+// byID.
+func (q *Query) sessionPage(ctx context.Context, ps predicate.Node, byID map[string]*ent.Node) ([]sessionCandidate, []*ent.NodeLink, error) {
+	nodes, err := q.sessionNodes(ctx, ps)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load nodes: %w", err)
+	}
+
+	pageByID := make(map[string]*ent.Node, len(nodes))
+	hashes := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		pageByID[n.ID] = n
+		hashes = append(hashes, n.ID)
+	}
+
+	metadataByHash := make(map[string]map[string]string)
+	var links []*ent.NodeLink
+	for batch := range slices.Chunk(hashes, sessionBatchSize) {
+		metadataRows, err := q.client.NodeMetadata.Query().
+			Where(nodemetadata.NodeHashIn(batch...)).
+			Select(nodemetadata.FieldNodeHash, nodemetadata.FieldKey, nodemetadata.FieldValue).
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load node metadata: %w", err)
+		}
+		for _, m := range metadataRows {
+			if metadataByHash[m.NodeHash] == nil {
+				metadataByHash[m.NodeHash] = map[string]string{}
+			}
+			metadataByHash[m.NodeHash][m.Key] = m.Value
 		}
+
+		linkRows, err := q.client.NodeLink.Query().
+			Where(
+				nodelink.ToHashIn(batch...),
+				nodelink.KindIn(string(storage.EdgeContinues), string(storage.EdgeSubagent)),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load conversation links: %w", err)
+		}
+		links = append(links, linkRows...)
 	}
 
-	candidates := make([]sessionCandidate, 0)
-	for _, n := range allNodes {
-		if hasChildren[n.ID] {
+	var candidates []sessionCandidate
+	for _, n := range nodes {
+		if !n.IsLeaf {
 			continue
 		}
 
-		chain := buildAncestryChain(n, byID)
+		chain := buildAncestryChain(n, pageByID)
 		summary, modelCosts, status, err := q.buildSessionSummaryFromNodes(chain)
 		if err != nil {
 			continue
 		}
+		summary.Metadata = chainMetadata(chain, metadataByHash)
+		toolCalls, toolErrors := chainToolUsage(chain)
 
 		candidates = append(candidates, sessionCandidate{
 			summary:    summary,
 			modelCosts: modelCosts,
 			status:     status,
+			toolCalls:  toolCalls,
+			toolErrors: toolErrors,
 			nodes:      chain,
 		})
 	}
 
-	q.storeSessionCandidates(candidates)
-	return candidates, nil
+	for _, n := range nodes {
+		n.Content = nil
+		byID[n.ID] = n
+	}
+	return candidates, links, nil
+}
+
+// chainToolUsage counts the tool calls made along a root-first chain, and
+// separately those made by nodes that carry a tool error.
+func chainToolUsage(chain []*ent.Node) (map[string]int, map[string]int) {
+	calls, errs := map[string]int{}, map[string]int{}
+	for _, n := range chain {
+		blocks, _ := parseContentBlocks(n.Content)
+		tools := extractToolCalls(blocks)
+		for _, tool := range tools {
+			calls[tool]++
+		}
+		if blocksHaveToolError(blocks) {
+			for _, tool := range tools {
+				errs[tool]++
+			}
+		}
+	}
+	return calls, errs
+}
+
+// logicalRoots maps the opening node of every conversation linked by
+// continues or subagent edges to the opening node of the outermost
+// conversation it belongs to, so that a session restarted after context
+// compaction groups with the one it continues and a subagent's conversation
+// groups with the session that spawned it. It also maps each subagent's
+// opening node to the hash of the node whose tool call spawned it.
+func logicalRoots(links []*ent.NodeLink, byID map[string]*ent.Node) (map[string]string, map[string]string) {
+	parents := make(map[string]string, len(links))
+	spawnedBy := make(map[string]string)
+	for _, link := range links {
+		from, to := byID[link.FromHash], byID[link.ToHash]
+		if from == nil || to == nil {
+			continue
+		}
+		switch storage.EdgeKind(link.Kind) {
+		case storage.EdgeContinues:
+			parents[openingNode(buildAncestryChain(from, byID)).ID] = openingNode(buildAncestryChain(to, byID)).ID
+		case storage.EdgeSubagent:
+			child := openingNode(buildAncestryChain(to, byID)).ID
+			parents[child] = openingNode(buildAncestryChain(from, byID)).ID
+			spawnedBy[child] = link.FromHash
+		}
+	}
+
+	roots := make(map[string]string, len(parents))
+	for root := range parents {
+		first := root
+		seen := map[string]bool{root: true}
+		for {
+			next, ok := parents[first]
+			if !ok || seen[next] {
+				break
+			}
+			seen[next] = true
+			first = next
+		}
+		roots[root] = first
+		roots[first] = first
+	}
+	return roots, spawnedBy
+}
+
+// openingNode returns the node a root-first chain's conversation is known
+// by: its first node that is not a system message, since conversations with
+// the same system prompt share their root.
+func openingNode(chain []*ent.Node) *ent.Node {
+	for _, n := range chain {
+		if !storage.IsSystemRole(n.Role) {
+			return n
+		}
+	}
+	return chain[0]
+}
+
+// chainMetadata merges the metadata recorded on a root-first chain, with
+// later nodes overriding earlier ones.
+func chainMetadata(chain []*ent.Node, byHash map[string]map[string]string) map[string]string {
+	var merged map[string]string
+	for _, n := range chain {
+		if len(byHash[n.ID]) == 0 {
+			continue
+		}
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		maps.Copy(merged, byHash[n.ID])
+	}
+	return merged
 }
 
 // buildAncestryChain walks from a leaf to root using the in-memory node map,
@@ -221,7 +459,9 @@ func groupSessionCandidates(candidates []sessionCandidate) []*sessionGroup {
 		key := sessionGroupKey(candidate.summary)
 		group := byKey[key]
 
-		if group == nil || candidate.summary.StartTime.Sub(group.summary.EndTime) > groupWindow {
+		// Continuations and subagents are linked explicitly, so they group however long the
+		// agent paused before compacting.
+		if group == nil || (candidate.summary.LogicalRoot == "" && candidate.summary.StartTime.Sub(group.summary.EndTime) > groupWindow) {
 			groupID := makeGroupID(key, candidate.summary.StartTime)
 			group = &sessionGroup{
 				summary: SessionSummary{
@@ -242,6 +482,10 @@ func groupSessionCandidates(candidates []sessionCandidate) []*sessionGroup {
 					ToolCalls:    candidate.summary.ToolCalls,
 					MessageCount: candidate.summary.MessageCount,
 					SessionCount: 1,
+					Metadata:     maps.Clone(candidate.summary.Metadata),
+					Params:       maps.Clone(candidate.summary.Params),
+
+					LogicalRoot: candidate.summary.LogicalRoot,
 				},
 				modelCosts:   copyModelCosts(candidate.modelCosts),
 				statusCounts: map[string]int{candidate.summary.Status: 1},
@@ -265,6 +509,16 @@ func groupSessionCandidates(candidates []sessionCandidate) []*sessionGroup {
 		group.summary.SessionCount++
 		group.statusCounts[candidate.summary.Status]++
 		mergeModelCosts(group.modelCosts, candidate.modelCosts)
+		if len(candidate.summary.Metadata) > 0 {
+			if group.summary.Metadata == nil {
+				group.summary.Metadata = map[string]string{}
+			}
+			maps.Copy(group.summary.Metadata, candidate.summary.Metadata)
+		}
+		if len(candidate.summary.Params) > 0 {
+			// Sorted by start time, so the latest member's settings win.
+			group.summary.Params = maps.Clone(candidate.summary.Params)
+		}
 	}
 
 	for _, group := range groups {
@@ -299,6 +553,14 @@ func summarizeGroupStatus(counts map[string]int) string {
 }
 
 func sessionGroupKey(summary SessionSummary) string {
+	// An explicit session ID from the X-Tapes-Session header takes precedence
+	// over the label heuristic.
+	if session := summary.Metadata[sessionMetadataKey]; session != "" {
+		return "session:" + session
+	}
+	if summary.LogicalRoot != "" {
+		return "linked:" + summary.LogicalRoot
+	}
 	label := normalizeSessionLabel(summary.Label)
 	if label == "" {
 		label = summary.ID
@@ -371,6 +633,21 @@ func findGroupByID(groups []*sessionGroup, sessionID string) *sessionGroup {
 	return best
 }
 
+// sessionLeaf returns the leaf node of a session, accepting abbreviated
+// hashes.
+func (q *Query) sessionLeaf(ctx context.Context, sessionID string) (*ent.Node, error) {
+	hash, err := storage.ResolveHash(ctx, &entdriver.EntDriver{Client: q.client}, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	leaf, err := q.client.Node.Get(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+	return leaf, nil
+}
+
 func isGroupID(sessionID string) bool {
 	return strings.HasPrefix(sessionID, groupIDPrefix)
 }
@@ -416,6 +693,11 @@ func (q *Query) Overview(ctx context.Context, filters Filters) (*Overview, error
 		return nil, err
 	}
 
+	annotated, err := q.annotatedHashes(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
 	groups := groupSessionCandidates(candidates)
 	overview := &Overview{
 		Sessions:    make([]SessionSummary, 0, len(groups)),
@@ -427,6 +709,9 @@ func (q *Query) Overview(ctx context.Context, filters Filters) (*Overview, error
 		if !matchesFilters(summary, filters) {
 			continue
 		}
+		if annotated != nil && !groupHasAnnotated(group, annotated) {
+			continue
+		}
 
 		overview.Sessions = append(overview.Sessions, summary)
 
@@ -463,25 +748,137 @@ func (q *Query) Overview(ctx context.Context, filters Filters) (*Overview, error
 		overview.SuccessRate = float64(overview.Completed) / float64(total)
 	}
 
+	embeddings, err := q.embeddingSummary(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	if embeddings != nil {
+		overview.Embeddings = embeddings
+		overview.TotalCost += embeddings.TotalCost
+		for model, cost := range embeddings.CostByModel {
+			aggregate := overview.CostByModel[model]
+			aggregate.Model = model
+			aggregate.InputTokens += cost.InputTokens
+			aggregate.InputCost += cost.InputCost
+			aggregate.TotalCost += cost.TotalCost
+			overview.CostByModel[model] = aggregate
+		}
+	}
+
 	SortSessions(overview.Sessions, filters.Sort, filters.SortDir)
 
 	return overview, nil
 }
 
+// embeddingSummary aggregates the embedding calls matching filters. Embedding
+// calls are not part of any session, so session-only filters (status, tags,
+// params, annotations) exclude them entirely. Returns nil when there are none.
+func (q *Query) embeddingSummary(ctx context.Context, filters Filters) (*EmbeddingSummary, error) {
+	if filters.Status != "" || filters.Session != "" || len(filters.Tags) > 0 || len(filters.Params) > 0 ||
+		filters.Rating != "" || filters.Label != "" {
+		return nil, nil
+	}
+
+	query := q.client.EmbeddingCall.Query()
+	if filters.Project != "" {
+		query = query.Where(embeddingcall.Project(filters.Project))
+	}
+	if filters.From != nil {
+		query = query.Where(embeddingcall.CreatedAtGTE(*filters.From))
+	}
+	if filters.To != nil {
+		query = query.Where(embeddingcall.CreatedAtLTE(*filters.To))
+	}
+	if filters.Since > 0 {
+		query = query.Where(embeddingcall.CreatedAtGTE(time.Now().Add(-filters.Since)))
+	}
+
+	calls, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query embedding calls: %w", err)
+	}
+
+	summary := &EmbeddingSummary{CostByModel: map[string]ModelCost{}}
+	for _, call := range calls {
+		model := normalizeModel(call.Model)
+		if filters.Model != "" && model != normalizeModel(filters.Model) {
+			continue
+		}
+
+		var tokens int64
+		if call.PromptTokens != nil {
+			tokens = int64(*call.PromptTokens)
+		}
+		var cost float64
+		if pricing, ok := PricingForProviderModel(q.pricing, call.Provider, model); ok {
+			cost, _, _ = CostForTokens(pricing, tokens, 0)
+		}
+
+		summary.Calls++
+		summary.Inputs += call.InputCount
+		summary.InputTokens += tokens
+		summary.TotalCost += cost
+
+		modelCost := summary.CostByModel[model]
+		modelCost.Model = model
+		modelCost.InputTokens += tokens
+		modelCost.InputCost += cost
+		modelCost.TotalCost += cost
+		summary.CostByModel[model] = modelCost
+	}
+	if summary.Calls == 0 {
+		return nil, nil
+	}
+
+	return summary, nil
+}
+
+// SessionLeaves returns the leaf hashes of the conversations making up a
+// session: every member of a grouped session, or the session itself.
+func (q *Query) SessionLeaves(ctx context.Context, sessionID string) ([]string, error) {
+	if !isGroupID(sessionID) {
+		leaf, err := q.sessionLeaf(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		return []string{leaf.ID}, nil
+	}
+
+	candidates, err := q.loadSessionCandidates(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	target := findGroupByID(groupSessionCandidates(candidates), sessionID)
+	if target == nil {
+		return nil, fmt.Errorf("get session group: %s", sessionID)
+	}
+
+	leaves := make([]string, 0, len(target.members))
+	for _, member := range target.members {
+		leaves = append(leaves, member.summary.ID)
+	}
+	return leaves, nil
+}
+
 func (q *Query) SessionDetail(ctx context.Context, sessionID string) (*SessionDetail, error) {
 	if isGroupID(sessionID) {
 		return q.groupSessionDetail(ctx, sessionID)
 	}
 
-	leaf, err := q.client.Node.Get(ctx, sessionID)
+	leaf, err := q.sessionLeaf(ctx, sessionID)
 	if err != nil {
-		return nil, fmt.Errorf("get session: %w", err)
+		return nil, err
 	}
 
 	nodes, err := q.loadAncestry(ctx, leaf)
 	if err != nil {
 		return nil, err
 	}
+	nodes, err = q.withBlobs(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
 
 	summary, _, _, err := q.buildSessionSummaryFromNodes(nodes)
 	if err != nil {
@@ -489,6 +886,12 @@ func (q *Query) SessionDetail(ctx context.Context, sessionID string) (*SessionDe
 	}
 
 	messages, toolFrequency := q.buildSessionMessages(nodes)
+	if err := q.attachAnnotations(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := q.attachSubagents(ctx, messages); err != nil {
+		return nil, err
+	}
 	grouped := buildGroupedMessages(messages)
 	detail := &SessionDetail{
 		Summary:         summary,
@@ -512,8 +915,17 @@ func (q *Query) groupSessionDetail(ctx context.Context, sessionID string) (*Sess
 		return nil, fmt.Errorf("get session group: %s", sessionID)
 	}
 
-	nodes := groupNodes(target.members)
+	nodes, err := q.groupNodes(ctx, target.members)
+	if err != nil {
+		return nil, err
+	}
 	messages, toolFrequency := q.buildSessionMessages(nodes)
+	if err := q.attachAnnotations(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := q.attachSubagents(ctx, messages); err != nil {
+		return nil, err
+	}
 	grouped := buildGroupedMessages(messages)
 
 	subSessions := make([]SessionSummary, 0, len(target.members))
@@ -535,7 +947,63 @@ func (q *Query) groupSessionDetail(ctx context.Context, sessionID string) (*Sess
 	return detail, nil
 }
 
-func groupNodes(members []sessionCandidate) []*ent.Node {
+// withBlobs returns copies of nodes with the large images and tool outputs
+// their content column references restored from the blob table. Copies keep
+// the cached session nodes free of payloads.
+func (q *Query) withBlobs(ctx context.Context, nodes []*ent.Node) ([]*ent.Node, error) {
+	resolved := make([]*ent.Node, len(nodes))
+	var blocks []map[string]any
+	for i, n := range nodes {
+		c := *n
+		c.Content = make([]map[string]any, len(n.Content))
+		for j, block := range n.Content {
+			c.Content[j] = maps.Clone(block)
+			blocks = append(blocks, c.Content[j])
+		}
+		resolved[i] = &c
+	}
+	if err := q.driver.ResolveBlobs(ctx, blocks...); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// groupNodes loads the nodes of a group's members with their message
+// content and blob payloads, in creation order.
+func (q *Query) groupNodes(ctx context.Context, members []sessionCandidate) ([]*ent.Node, error) {
+	var hashes []string
+	for _, member := range members {
+		for _, n := range member.nodes {
+			hashes = append(hashes, n.ID)
+		}
+	}
+	slices.Sort(hashes)
+	hashes = slices.Compact(hashes)
+
+	byID := make(map[string]*ent.Node, len(hashes))
+	for batch := range slices.Chunk(hashes, sessionBatchSize) {
+		nodes, err := q.sessionNodes(ctx, node.IDIn(batch...))
+		if err != nil {
+			return nil, fmt.Errorf("load group nodes: %w", err)
+		}
+		for _, n := range nodes {
+			byID[n.ID] = n
+		}
+	}
+
+	sorted := sortedGroupNodes(members)
+	nodes := make([]*ent.Node, 0, len(sorted))
+	for _, n := range sorted {
+		if loaded := byID[n.ID]; loaded != nil {
+			nodes = append(nodes, loaded)
+		}
+	}
+	return q.withBlobs(ctx, nodes)
+}
+
+// sortedGroupNodes concatenates the chains of a group's members in creation
+// order.
+func sortedGroupNodes(members []sessionCandidate) []*ent.Node {
 	total := 0
 	for _, member := range members {
 		total += len(member.nodes)
@@ -593,6 +1061,9 @@ func (q *Query) buildSessionMessages(nodes []*ent.Node) ([]SessionMessage, map[s
 			ToolCalls:    toolCalls,
 			Text:         text,
 		})
+		if node.ProviderRequestID != nil {
+			messages[len(messages)-1].ProviderRequestID = *node.ProviderRequestID
+		}
 	}
 
 	return messages, toolFrequency
@@ -786,7 +1257,7 @@ func (q *Query) buildSessionSummaryFromNodes(nodes []*ent.Node) (SessionSummary,
 			continue
 		}
 
-		pricing, ok := PricingForModel(q.pricing, model)
+		pricing, ok := PricingForProviderModel(q.pricing, n.Provider, model)
 		if !ok {
 			continue
 		}
@@ -831,6 +1302,15 @@ func (q *Query) buildSessionSummaryFromNodes(nodes []*ent.Node) (SessionSummary,
 		}
 	}
 
+	// Report the generation parameters of the most recent response
+	var params map[string]string
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if len(nodes[i].GenerationParams) > 0 {
+			params = flattenParams(nodes[i].GenerationParams)
+			break
+		}
+	}
+
 	summary := SessionSummary{
 		ID:           nodes[len(nodes)-1].ID,
 		Label:        label,
@@ -849,11 +1329,55 @@ func (q *Query) buildSessionSummaryFromNodes(nodes []*ent.Node) (SessionSummary,
 		ToolCalls:    toolCalls,
 		MessageCount: len(nodes),
 		SessionCount: 1,
+		Params:       params,
 	}
 
 	return summary, modelCosts, status, nil
 }
 
+// flattenParams renders stored generation parameters as flat key/value
+// strings for display and filtering. Provider-specific settings are lifted to
+// the top level and nested objects use dotted keys, so an Anthropic thinking
+// budget becomes "thinking.budget_tokens".
+func flattenParams(params map[string]any) map[string]string {
+	flat := map[string]string{}
+
+	var walk func(prefix string, value any)
+	walk = func(prefix string, value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			for k, child := range v {
+				if prefix == "" && k == "extra" {
+					walk("", child)
+					continue
+				}
+				key := k
+				if prefix != "" {
+					key = prefix + "." + k
+				}
+				walk(key, child)
+			}
+		case []any:
+			parts := make([]string, 0, len(v))
+			for _, item := range v {
+				parts = append(parts, fmt.Sprint(item))
+			}
+			flat[prefix] = strings.Join(parts, ",")
+		case float64:
+			flat[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+		case nil:
+		default:
+			flat[prefix] = fmt.Sprint(v)
+		}
+	}
+	walk("", params)
+
+	if len(flat) == 0 {
+		return nil
+	}
+	return flat
+}
+
 func (q *Query) loadAncestry(ctx context.Context, leaf *ent.Node) ([]*ent.Node, error) {
 	nodes := []*ent.Node{}
 	current := leaf
@@ -882,7 +1406,7 @@ func (q *Query) costForNode(node *ent.Node, t nodeTokens) (float64, float64, flo
 		return 0, 0, 0
 	}
 
-	pricing, ok := PricingForModel(q.pricing, model)
+	pricing, ok := PricingForProviderModel(q.pricing, node.Provider, model)
 	if !ok {
 		return 0, 0, 0
 	}
@@ -1189,6 +1713,9 @@ func matchesFilters(summary SessionSummary, filters Filters) bool {
 	if filters.Project != "" && summary.Project != filters.Project {
 		return false
 	}
+	if !storage.MatchesTags(summary.Metadata, filters.Tags) || !storage.MatchesTags(summary.Params, filters.Params) {
+		return false
+	}
 	if filters.From != nil && summary.EndTime.Before(*filters.From) {
 		return false
 	}
@@ -1247,6 +1774,11 @@ func (q *Query) AnalyticsOverview(ctx context.Context, filters Filters) (*Analyt
 		return nil, err
 	}
 
+	annotated, err := q.annotatedHashes(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
 	groups := groupSessionCandidates(candidates)
 	analytics := &AnalyticsOverview{
 		ProviderBreakdown: map[string]int{},
@@ -1258,12 +1790,16 @@ func (q *Query) AnalyticsOverview(ctx context.Context, filters Filters) (*Analyt
 	dayMap := map[string]*DayActivity{}
 	modelMap := map[string]*modelAccumulator{}
 	var filteredSummaries []SessionSummary
+	var filteredNodes []*ent.Node
 
 	for _, group := range groups {
 		summary := group.summary
 		if !matchesFilters(summary, filters) {
 			continue
 		}
+		if annotated != nil && !groupHasAnnotated(group, annotated) {
+			continue
+		}
 
 		filteredSummaries = append(filteredSummaries, summary)
 		analytics.TotalSessions++
@@ -1285,20 +1821,18 @@ func (q *Query) AnalyticsOverview(ctx context.Context, filters Filters) (*Analyt
 		sessionTools := map[string]bool{}
 		provider := ""
 		for _, member := range group.members {
-			for _, n := range member.nodes {
-				blocks, _ := parseContentBlocks(n.Content)
-				for _, tool := range extractToolCalls(blocks) {
-					if _, ok := toolGlobal[tool]; !ok {
-						toolGlobal[tool] = &ToolMetric{Name: tool}
-					}
-					toolGlobal[tool].Count++
-					sessionTools[tool] = true
-				}
-				if blocksHaveToolError(blocks) {
-					for _, tool := range extractToolCalls(blocks) {
-						toolErrors[tool]++
-					}
+			filteredNodes = append(filteredNodes, member.nodes...)
+			for tool, count := range member.toolCalls {
+				if _, ok := toolGlobal[tool]; !ok {
+					toolGlobal[tool] = &ToolMetric{Name: tool}
 				}
+				toolGlobal[tool].Count += count
+				sessionTools[tool] = true
+			}
+			for tool, count := range member.toolErrors {
+				toolErrors[tool] += count
+			}
+			for _, n := range member.nodes {
 				if n.Provider != "" {
 					analytics.ProviderBreakdown[n.Provider]++
 					if provider == "" {
@@ -1337,6 +1871,13 @@ func (q *Query) AnalyticsOverview(ctx context.Context, filters Filters) (*Analyt
 		analytics.AvgDurationNs /= int64(analytics.TotalSessions)
 	}
 
+	analytics.CacheHits, analytics.CacheMisses = cacheStats(filteredNodes)
+	if total := analytics.CacheHits + analytics.CacheMisses; total > 0 {
+		analytics.CacheHitRate = float64(analytics.CacheHits) / float64(total)
+	}
+
+	analytics.RateLimitHeadroom = rateLimitHeadroom(filteredNodes)
+
 	// Build top tools sorted by count
 	for name, metric := range toolGlobal {
 		metric.ErrorCount = toolErrors[name]
@@ -1400,15 +1941,19 @@ func (q *Query) SessionAnalytics(ctx context.Context, sessionID string) (*Sessio
 		return q.groupSessionAnalytics(ctx, sessionID)
 	}
 
-	leaf, err := q.client.Node.Get(ctx, sessionID)
+	leaf, err := q.sessionLeaf(ctx, sessionID)
 	if err != nil {
-		return nil, fmt.Errorf("get session: %w", err)
+		return nil, err
 	}
 
 	nodes, err := q.loadAncestry(ctx, leaf)
 	if err != nil {
 		return nil, err
 	}
+	nodes, err = q.withBlobs(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
 
 	return buildSessionAnalytics(sessionID, nodes), nil
 }
@@ -1425,7 +1970,10 @@ func (q *Query) groupSessionAnalytics(ctx context.Context, sessionID string) (*S
 		return nil, fmt.Errorf("get session group: %s", sessionID)
 	}
 
-	nodes := groupNodes(target.members)
+	nodes, err := q.groupNodes(ctx, target.members)
+	if err != nil {
+		return nil, err
+	}
 	return buildSessionAnalytics(sessionID, nodes), nil
 }
 
@@ -1582,6 +2130,25 @@ func buildCostBucketsFromSummaries(summaries []SessionSummary) []Bucket {
 	return buckets
 }
 
+// cacheStats counts proxy response cache hits and misses across nodes.
+// Every response recorded under a cache key was a miss once; each replay of
+// it is a hit. Nodes shared between sessions are counted once.
+func cacheStats(nodes []*ent.Node) (int, int) {
+	seen := make(map[string]bool, len(nodes))
+	hits, misses := 0, 0
+	for _, n := range nodes {
+		if seen[n.ID] {
+			continue
+		}
+		seen[n.ID] = true
+		if n.CacheKey != nil {
+			misses++
+		}
+		hits += n.CacheHits
+	}
+	return hits, misses
+}
+
 func determineStatus(leaf *ent.Node, hasToolError, hasGitActivity bool) string {
 	if hasToolError {
 		return StatusFailed
@@ -1614,3 +2181,99 @@ func determineStatus(leaf *ent.Node, hasToolError, hasGitActivity bool) string {
 
 	return StatusUnknown
 }
+
+// rateLimitHeadroom reduces the rate-limit headers recorded on nodes to the
+// lowest remaining quota per day, provider and limit, ordered by date.
+func rateLimitHeadroom(nodes []*ent.Node) []HeadroomPoint {
+	seen := make(map[string]bool, len(nodes))
+	lowest := map[string]HeadroomPoint{}
+	for _, n := range nodes {
+		if seen[n.ID] || len(n.RateLimits) == 0 {
+			continue
+		}
+		seen[n.ID] = true
+
+		date := n.CreatedAt.Format("2006-01-02")
+		for metric, quota := range parseRateLimits(n.RateLimits) {
+			if quota.limit <= 0 {
+				continue
+			}
+			point := HeadroomPoint{
+				Date:      date,
+				Provider:  n.Provider,
+				Metric:    metric,
+				Remaining: quota.remaining,
+				Limit:     quota.limit,
+				Headroom:  float64(quota.remaining) / float64(quota.limit),
+			}
+			key := date + "|" + n.Provider + "|" + metric
+			if current, ok := lowest[key]; !ok || point.Headroom < current.Headroom {
+				lowest[key] = point
+			}
+		}
+	}
+
+	points := make([]HeadroomPoint, 0, len(lowest))
+	for _, point := range lowest {
+		points = append(points, point)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].Date != points[j].Date {
+			return points[i].Date < points[j].Date
+		}
+		if points[i].Provider != points[j].Provider {
+			return points[i].Provider < points[j].Provider
+		}
+		return points[i].Metric < points[j].Metric
+	})
+	return points
+}
+
+type rateLimitQuota struct {
+	limit     int64
+	remaining int64
+}
+
+// parseRateLimits extracts limit/remaining pairs from recorded rate-limit
+// headers. Anthropic names them "anthropic-ratelimit-<metric>-<limit|remaining>"
+// and OpenAI "x-ratelimit-<limit|remaining>-<metric>".
+func parseRateLimits(headers map[string]string) map[string]rateLimitQuota {
+	quotas := map[string]rateLimitQuota{}
+	for name, value := range headers {
+		var metric, kind string
+		switch {
+		case strings.HasPrefix(name, "anthropic-ratelimit-"):
+			rest := strings.TrimPrefix(name, "anthropic-ratelimit-")
+			idx := strings.LastIndex(rest, "-")
+			if idx < 0 {
+				continue
+			}
+			metric, kind = rest[:idx], rest[idx+1:]
+		case strings.HasPrefix(name, "x-ratelimit-"):
+			rest := strings.TrimPrefix(name, "x-ratelimit-")
+			var ok bool
+			kind, metric, ok = strings.Cut(rest, "-")
+			if !ok {
+				continue
+			}
+		default:
+			continue
+		}
+
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			continue
+		}
+		quota := quotas[metric]
+		switch kind {
+		case "limit":
+			quota.limit = n
+		case "remaining":
+			quota.remaining = n
+		default:
+			continue
+		}
+		quotas[metric] = quota
+	}
+	return quotas
+}
@@ -1,13 +1,19 @@
 package deck
 
 import (
+	"context"
+	"path/filepath"
 	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
 
 var _ = Describe("Session labels", func() {
@@ -204,3 +210,458 @@ var _ = Describe("Analytics helper functions", func() {
 		})
 	})
 })
+
+var _ = Describe("cacheStats", func() {
+	It("counts cached responses as misses and replays as hits, once per node", func() {
+		key := "abc"
+		cached := &ent.Node{ID: "resp-1", CacheKey: &key, CacheHits: 3}
+		nodes := []*ent.Node{
+			{ID: "user-1"},
+			cached,
+			{ID: "resp-2", CacheKey: &key},
+			// Shared prefix nodes appear in several sessions.
+			cached,
+		}
+
+		hits, misses := cacheStats(nodes)
+		Expect(hits).To(Equal(3))
+		Expect(misses).To(Equal(2))
+	})
+})
+
+var _ = Describe("Session metadata", func() {
+	It("merges chain metadata with later nodes overriding earlier ones", func() {
+		chain := []*ent.Node{{ID: "root"}, {ID: "mid"}, {ID: "leaf"}}
+		byHash := map[string]map[string]string{
+			"mid":  {"session": "run-1", "arm": "a"},
+			"leaf": {"arm": "b"},
+		}
+
+		Expect(chainMetadata(chain, byHash)).To(Equal(map[string]string{"session": "run-1", "arm": "b"}))
+		Expect(chainMetadata(chain[:1], byHash)).To(BeNil())
+	})
+
+	It("filters sessions by tag", func() {
+		summary := SessionSummary{Metadata: map[string]string{"ticket": "ENG-42", "arm": "b"}}
+
+		Expect(matchesFilters(summary, Filters{Tags: map[string]string{"ticket": "ENG-42"}})).To(BeTrue())
+		Expect(matchesFilters(summary, Filters{Tags: map[string]string{"ticket": "ENG-43"}})).To(BeFalse())
+		Expect(matchesFilters(SessionSummary{}, Filters{Tags: map[string]string{"arm": "b"}})).To(BeFalse())
+	})
+})
+
+var _ = Describe("Generation params", func() {
+	It("flattens stored params with provider settings lifted to the top level", func() {
+		flat := flattenParams(map[string]any{
+			"temperature": 0.7,
+			"max_tokens":  float64(16000),
+			"stop":        []any{"END", "STOP"},
+			"extra": map[string]any{
+				"reasoning_effort": "high",
+				"thinking":         map[string]any{"type": "enabled", "budget_tokens": float64(10000)},
+			},
+		})
+
+		Expect(flat).To(Equal(map[string]string{
+			"temperature":            "0.7",
+			"max_tokens":             "16000",
+			"stop":                   "END,STOP",
+			"reasoning_effort":       "high",
+			"thinking.type":          "enabled",
+			"thinking.budget_tokens": "10000",
+		}))
+		Expect(flattenParams(map[string]any{})).To(BeNil())
+	})
+
+	It("filters sessions by generation params", func() {
+		summary := SessionSummary{Params: map[string]string{"reasoning_effort": "high", "temperature": "0"}}
+
+		Expect(matchesFilters(summary, Filters{Params: map[string]string{"reasoning_effort": "high"}})).To(BeTrue())
+		Expect(matchesFilters(summary, Filters{Params: map[string]string{"reasoning_effort": "low"}})).To(BeFalse())
+	})
+})
+
+var _ = Describe("rateLimitHeadroom", func() {
+	day := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	It("keeps the lowest headroom per day, provider and limit", func() {
+		nodes := []*ent.Node{
+			{ID: "a", Provider: "anthropic", CreatedAt: day, RateLimits: map[string]string{
+				"anthropic-ratelimit-tokens-limit":     "10000",
+				"anthropic-ratelimit-tokens-remaining": "8000",
+				"anthropic-ratelimit-tokens-reset":     "2026-03-02T10:01:00Z",
+			}},
+			{ID: "b", Provider: "anthropic", CreatedAt: day.Add(time.Hour), RateLimits: map[string]string{
+				"anthropic-ratelimit-tokens-limit":     "10000",
+				"anthropic-ratelimit-tokens-remaining": "2500",
+			}},
+			{ID: "c", Provider: "openai", CreatedAt: day.Add(24 * time.Hour), RateLimits: map[string]string{
+				"x-ratelimit-limit-requests":     "500",
+				"x-ratelimit-remaining-requests": "499",
+			}},
+			{ID: "d", Provider: "ollama", CreatedAt: day},
+		}
+
+		Expect(rateLimitHeadroom(nodes)).To(Equal([]HeadroomPoint{
+			{Date: "2026-03-02", Provider: "anthropic", Metric: "tokens", Remaining: 2500, Limit: 10000, Headroom: 0.25},
+			{Date: "2026-03-03", Provider: "openai", Metric: "requests", Remaining: 499, Limit: 500, Headroom: 0.998},
+		}))
+	})
+})
+
+var _ = Describe("Embedding costs", func() {
+	It("adds embedding call costs to the overview", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(driver.PutEmbeddingCall(ctx, &storage.EmbeddingCall{
+			Provider:   "openai",
+			Model:      "text-embedding-3-small",
+			InputCount: 10,
+			Usage:      &llm.Usage{PromptTokens: 500_000},
+		})).To(Succeed())
+		Expect(driver.PutEmbeddingCall(ctx, &storage.EmbeddingCall{
+			Provider:   "openai",
+			Model:      "text-embedding-3-large",
+			InputCount: 2,
+			Usage:      &llm.Usage{PromptTokens: 1_000_000},
+			CreatedAt:  time.Now().Add(-48 * time.Hour),
+		})).To(Succeed())
+		Expect(driver.Close()).To(Succeed())
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		overview, err := query.Overview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Embeddings).NotTo(BeNil())
+		Expect(overview.Embeddings.Calls).To(Equal(2))
+		Expect(overview.Embeddings.Inputs).To(Equal(12))
+		Expect(overview.Embeddings.InputTokens).To(Equal(int64(1_500_000)))
+		Expect(overview.TotalCost).To(BeNumerically("~", 0.01+0.13, 1e-9))
+		Expect(overview.CostByModel["text-embedding-3-small"].TotalCost).To(BeNumerically("~", 0.01, 1e-9))
+
+		recent, err := query.Overview(ctx, Filters{Since: 24 * time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recent.Embeddings.Calls).To(Equal(1))
+		Expect(recent.TotalCost).To(BeNumerically("~", 0.01, 1e-9))
+
+		byStatus, err := query.Overview(ctx, Filters{Status: StatusCompleted})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(byStatus.Embeddings).To(BeNil())
+	})
+})
+
+var _ = Describe("Annotations", func() {
+	It("rates messages and filters sessions by rating and label", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		var replies []*merkle.Node
+		for _, project := range []string{"alpha", "beta"} {
+			ask := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: "hello from " + project}},
+			}, nil, merkle.NodeMeta{Project: project})
+			reply := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "assistant", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: "hi " + project}},
+			}, ask, merkle.NodeMeta{Project: project})
+			for _, n := range []*merkle.Node{ask, reply} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			replies = append(replies, reply)
+		}
+		Expect(driver.PutAnnotation(ctx, &storage.Annotation{
+			Hash: replies[1].Hash, Author: "bob", Labels: []string{"hallucination"},
+		})).To(Succeed())
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		Expect(query.RateMessage(ctx, replies[0].Hash, "alice", storage.RatingGood)).To(Succeed())
+		Expect(query.RateMessage(ctx, replies[1].Hash, "bob", storage.RatingFailure)).To(Succeed())
+
+		good, err := query.Overview(ctx, Filters{Rating: storage.RatingGood})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(good.Sessions).To(HaveLen(1))
+		Expect(good.Sessions[0].Project).To(Equal("alpha"))
+
+		labelled, err := query.Overview(ctx, Filters{Label: "hallucination"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labelled.Sessions).To(HaveLen(1))
+
+		detail, err := query.SessionDetail(ctx, labelled.Sessions[0].ID)
+		Expect(err).NotTo(HaveOccurred())
+		last := detail.Messages[len(detail.Messages)-1]
+		Expect(last.Rating).To(Equal(storage.RatingFailure))
+		Expect(last.Labels).To(Equal([]string{"hallucination"}))
+
+		Expect(query.RateMessage(ctx, replies[0].Hash, "alice", "")).To(Succeed())
+		annotations, err := driver.Annotations(ctx, replies[0].Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(annotations).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Continuations", func() {
+	It("groups a conversation restarted after compaction with the one it continues", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		turn := func(prompt, reply string) (*merkle.Node, *merkle.Node) {
+			ask := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: prompt}},
+			}, nil)
+			answer := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "assistant", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: reply}},
+			}, ask)
+			for _, n := range []*merkle.Node{ask, answer} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return ask, answer
+		}
+		firstRoot, first := turn("Refactor the parser", "Started on the lexer")
+		summary, _ := turn("This session is being continued from a previous conversation.", "Finishing the parser")
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		overview, err := query.Overview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Sessions).To(HaveLen(2))
+
+		Expect(driver.PutEdge(ctx, &storage.Edge{
+			From: summary.Hash, To: first.Hash, Kind: storage.EdgeContinues, Reason: storage.ContinuesSummary,
+		})).To(Succeed())
+
+		linked, closeLinked, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeLinked)
+
+		overview, err = linked.Overview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Sessions).To(HaveLen(1))
+		Expect(overview.Sessions[0].SessionCount).To(Equal(2))
+		Expect(overview.Sessions[0].LogicalRoot).To(Equal(firstRoot.Hash))
+	})
+
+	It("groups by opening node when conversations share a system message", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		system := merkle.NewNode(merkle.Bucket{
+			Type: "message", Role: "system", Model: "test-model",
+			Content: []llm.ContentBlock{{Type: "text", Text: "You are an agent."}},
+		}, nil)
+		_, err = driver.Put(ctx, system)
+		Expect(err).NotTo(HaveOccurred())
+
+		turn := func(prompt, reply string) (*merkle.Node, *merkle.Node) {
+			ask := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: prompt}},
+			}, system)
+			answer := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "assistant", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: reply}},
+			}, ask)
+			for _, n := range []*merkle.Node{ask, answer} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return ask, answer
+		}
+		opening, first := turn("Refactor the parser", "Started on the lexer")
+		summary, _ := turn("This session is being continued from a previous conversation.", "Finishing the parser")
+		turn("Write release notes", "Drafted them")
+
+		Expect(driver.PutEdge(ctx, &storage.Edge{
+			From: summary.Hash, To: first.Hash, Kind: storage.EdgeContinues, Reason: storage.ContinuesSummary,
+		})).To(Succeed())
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		overview, err := query.Overview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Sessions).To(HaveLen(2))
+		var linked []SessionSummary
+		for _, session := range overview.Sessions {
+			if session.LogicalRoot != "" {
+				linked = append(linked, session)
+			}
+		}
+		Expect(linked).To(HaveLen(1))
+		Expect(linked[0].SessionCount).To(Equal(2))
+		Expect(linked[0].LogicalRoot).To(Equal(opening.Hash))
+	})
+})
+
+var _ = Describe("Subagents", func() {
+	It("groups a subagent's conversation with its parent and rolls up its cost", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		turn := func(prompt, reply string) (*merkle.Node, *merkle.Node) {
+			ask := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "claude-sonnet-4-5",
+				Content: []llm.ContentBlock{{Type: "text", Text: prompt}},
+			}, nil)
+			answer := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "assistant", Model: "claude-sonnet-4-5",
+				Content: []llm.ContentBlock{{Type: "text", Text: reply}},
+			}, ask, merkle.NodeMeta{Usage: &llm.Usage{PromptTokens: 1000, CompletionTokens: 500}})
+			for _, n := range []*merkle.Node{ask, answer} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return ask, answer
+		}
+		parentRoot, task := turn("Audit the repo", "Spawning an explorer")
+		subRoot, _ := turn("Find every TODO", "Found three")
+
+		Expect(driver.PutEdge(ctx, &storage.Edge{
+			From: task.Hash, To: subRoot.Hash, Kind: storage.EdgeSubagent,
+			Reason: storage.SubagentPrompt, ToolUseID: "toolu_1",
+		})).To(Succeed())
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		overview, err := query.Overview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Sessions).To(HaveLen(1))
+		session := overview.Sessions[0]
+		Expect(session.SessionCount).To(Equal(2))
+		Expect(session.LogicalRoot).To(Equal(parentRoot.Hash))
+
+		detail, err := query.SessionDetail(ctx, session.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detail.SubSessions).To(HaveLen(2))
+		Expect(detail.SubSessions[1].ParentHash).To(Equal(task.Hash))
+
+		var spawner *SessionMessage
+		for i := range detail.Messages {
+			if detail.Messages[i].Hash == task.Hash {
+				spawner = &detail.Messages[i]
+			}
+		}
+		Expect(spawner).NotTo(BeNil())
+		Expect(spawner.Subagents).To(ConsistOf(subRoot.Hash))
+		Expect(spawner.SubagentCost).To(BeNumerically(">", 0))
+		Expect(spawner.SubagentCost).To(BeNumerically("~", session.TotalCost/2, 1e-9))
+	})
+})
+
+var _ = Describe("Large payloads", func() {
+	It("shows tool output large enough for the blob table", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		output := strings.Repeat("x", 10000)
+		ask := merkle.NewNode(merkle.Bucket{
+			Type: "message", Role: "user", Model: "test-model",
+			Content: []llm.ContentBlock{{Type: "tool_result", ToolResultID: "call_1", ToolOutput: output}},
+		}, nil)
+		_, err = driver.Put(ctx, ask)
+		Expect(err).NotTo(HaveOccurred())
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		detail, err := query.SessionDetail(ctx, ask.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detail.Messages).To(HaveLen(1))
+		Expect(detail.Messages[0].Text).To(Equal(output))
+	})
+
+	It("reloads a group's message content after summarizing it", func() {
+		ctx := context.Background()
+		dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+
+		driver, err := sqlite.NewDriver(ctx, dbPath)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(driver.Close)
+
+		output := strings.Repeat("x", 10000)
+		turn := func(prompt string) (*merkle.Node, *merkle.Node) {
+			ask := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "text", Text: prompt}},
+			}, nil)
+			call := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "assistant", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "tool_use", ToolUseID: "call_1", ToolName: "Read"}},
+			}, ask)
+			result := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "test-model",
+				Content: []llm.ContentBlock{{Type: "tool_result", ToolResultID: "call_1", ToolOutput: output}},
+			}, call)
+			for _, n := range []*merkle.Node{ask, call, result} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return call, result
+		}
+		first, _ := turn("Read the config")
+		summary, _ := turn("This session is being continued from a previous conversation.")
+		Expect(driver.PutEdge(ctx, &storage.Edge{
+			From: summary.Hash, To: first.Hash, Kind: storage.EdgeContinues, Reason: storage.ContinuesSummary,
+		})).To(Succeed())
+
+		query, closeFn, err := NewQuery(ctx, dbPath, DefaultPricing())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(closeFn)
+
+		overview, err := query.Overview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(overview.Sessions).To(HaveLen(1))
+
+		analytics, err := query.AnalyticsOverview(ctx, Filters{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(analytics.TopTools).To(ConsistOf(HaveField("Count", 2)))
+
+		detail, err := query.SessionDetail(ctx, overview.Sessions[0].ID)
+		Expect(err).NotTo(HaveOccurred())
+		var outputs []string
+		for _, message := range detail.Messages {
+			if message.Text == output {
+				outputs = append(outputs, message.Hash)
+			}
+		}
+		Expect(outputs).To(HaveLen(2))
+	})
+})
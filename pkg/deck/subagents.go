@@ -0,0 +1,74 @@
+package deck
+
+import (
+	"context"
+	"slices"
+
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+)
+
+// attachSubagents sets the subagents each message spawned and their total
+// cost, so that a subagent's spend is attributed to the tool call that
+// started it.
+func (q *Query) attachSubagents(ctx context.Context, messages []SessionMessage) error {
+	candidates, err := q.loadSessionCandidates(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	// Index each subagent conversation, known by its opening node, by the
+	// node that spawned it. Branches of one conversation share their
+	// ancestry, so nodes are deduplicated.
+	children := map[string][]string{}
+	nodesByRoot := map[string]map[string]*ent.Node{}
+	for _, candidate := range candidates {
+		if len(candidate.nodes) == 0 {
+			continue
+		}
+		root := openingNode(candidate.nodes).ID
+		if nodesByRoot[root] == nil {
+			nodesByRoot[root] = map[string]*ent.Node{}
+			if parent := candidate.summary.ParentHash; parent != "" {
+				children[parent] = append(children[parent], root)
+			}
+		}
+		for _, n := range candidate.nodes {
+			nodesByRoot[root][n.ID] = n
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+
+	var cost func(root string, seen map[string]bool) float64
+	cost = func(root string, seen map[string]bool) float64 {
+		if seen[root] {
+			return 0
+		}
+		seen[root] = true
+
+		total := 0.0
+		for _, n := range nodesByRoot[root] {
+			_, _, nodeCost := q.costForNode(n, tokenCounts(n))
+			total += nodeCost
+			for _, child := range children[n.ID] {
+				total += cost(child, seen)
+			}
+		}
+		return total
+	}
+
+	for i := range messages {
+		spawned := children[messages[i].Hash]
+		if len(spawned) == 0 {
+			continue
+		}
+		slices.Sort(spawned)
+		messages[i].Subagents = spawned
+		seen := map[string]bool{}
+		for _, root := range spawned {
+			messages[i].SubagentCost += cost(root, seen)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package deck
 
-import "time"
+import (
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
 
 type Pricing struct {
 	Input      float64 `json:"input"`
@@ -27,6 +31,23 @@ type SessionSummary struct {
 	ToolCalls    int           `json:"tool_calls"`
 	MessageCount int           `json:"message_count"`
 	SessionCount int           `json:"session_count,omitempty"`
+
+	// Metadata is the session metadata (session ID, tags) recorded on the
+	// session's nodes via proxy request headers.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Params are the flattened generation parameters of the session's most
+	// recent response (e.g. "temperature", "reasoning_effort").
+	Params map[string]string `json:"params,omitempty"`
+
+	// LogicalRoot is the opening node hash of the outermost conversation of
+	// a session that spans context compactions or subagents, linked by
+	// continues and subagent edges.
+	LogicalRoot string `json:"logical_root,omitempty"`
+
+	// ParentHash is the hash of the node whose tool call spawned this
+	// conversation, if it is a subagent's.
+	ParentHash string `json:"parent_hash,omitempty"`
 }
 
 type SessionMessage struct {
@@ -43,6 +64,19 @@ type SessionMessage struct {
 	TotalCost    float64       `json:"total_cost"`
 	ToolCalls    []string      `json:"tool_calls"`
 	Text         string        `json:"text"`
+
+	// ProviderRequestID is the upstream provider's request ID (responses only).
+	ProviderRequestID string `json:"provider_request_id,omitempty"`
+
+	// Rating and Labels come from the message's annotations.
+	Rating storage.Rating `json:"rating,omitempty"`
+	Labels []string       `json:"labels,omitempty"`
+
+	// Subagents are the opening node hashes of the subagent conversations the
+	// message spawned, and SubagentCost their total cost, including any
+	// subagents they spawned in turn.
+	Subagents    []string `json:"subagents,omitempty"`
+	SubagentCost float64  `json:"subagent_cost,omitempty"`
 }
 
 type SessionMessageGroup struct {
@@ -95,6 +129,19 @@ type Overview struct {
 	Abandoned      int                  `json:"abandoned"`
 	CostByModel    map[string]ModelCost `json:"cost_by_model"`
 	PreviousPeriod *PeriodComparison    `json:"previous_period,omitempty"`
+
+	// Embeddings summarizes embedding calls in the period. Their cost is
+	// included in TotalCost and CostByModel.
+	Embeddings *EmbeddingSummary `json:"embeddings,omitempty"`
+}
+
+// EmbeddingSummary aggregates embedding calls recorded by the proxy.
+type EmbeddingSummary struct {
+	Calls       int                  `json:"calls"`
+	Inputs      int                  `json:"inputs"`
+	InputTokens int64                `json:"input_tokens"`
+	TotalCost   float64              `json:"total_cost"`
+	CostByModel map[string]ModelCost `json:"cost_by_model"`
 }
 
 type PeriodComparison struct {
@@ -116,6 +163,19 @@ type Filters struct {
 	Sort    string
 	SortDir string
 	Session string
+
+	// Tags restricts sessions to those whose metadata carries every
+	// key/value pair.
+	Tags map[string]string
+
+	// Params restricts sessions to those whose most recent generation
+	// parameters match every key/value pair.
+	Params map[string]string
+
+	// Rating and Label restrict sessions to those with a message annotated
+	// with the rating and label.
+	Rating storage.Rating
+	Label  string
 }
 
 // SessionAnalytics holds per-session computed analytics.
@@ -144,6 +204,21 @@ type AnalyticsOverview struct {
 	CostBuckets       []Bucket           `json:"cost_buckets"`
 	ModelPerformance  []ModelPerformance `json:"model_performance"`
 	ProviderBreakdown map[string]int     `json:"provider_breakdown"`
+	CacheHits         int                `json:"cache_hits"`
+	CacheMisses       int                `json:"cache_misses"`
+	CacheHitRate      float64            `json:"cache_hit_rate"`
+	RateLimitHeadroom []HeadroomPoint    `json:"rate_limit_headroom,omitempty"`
+}
+
+// HeadroomPoint is the lowest remaining rate-limit quota observed for a
+// provider and limit (e.g. "requests", "tokens") on a given day.
+type HeadroomPoint struct {
+	Date      string  `json:"date"`
+	Provider  string  `json:"provider"`
+	Metric    string  `json:"metric"`
+	Remaining int64   `json:"remaining"`
+	Limit     int64   `json:"limit"`
+	Headroom  float64 `json:"headroom"`
 }
 
 type ToolMetric struct {
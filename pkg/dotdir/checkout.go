@@ -19,11 +19,25 @@ type CheckoutState struct {
 	// Hash is the hash of the checked-out node.
 	Hash string `json:"hash"`
 
+	// Branch is the name of the checked-out branch, if any. A checkout on a
+	// branch follows the branch as new turns are recorded; a checkout of a
+	// hash or tag is detached and stays at Hash.
+	Branch string `json:"branch,omitempty"`
+
 	// Messages is the conversation history in chronological order
 	// (oldest first), up to and including the checked-out node.
 	Messages []CheckoutMessage `json:"messages"`
 }
 
+// Head returns what HEAD refers to: the checked-out branch, or the
+// checked-out hash when detached.
+func (s *CheckoutState) Head() string {
+	if s.Branch != "" {
+		return s.Branch
+	}
+	return s.Hash
+}
+
 // CheckoutMessage represents a single message in the checked-out conversation.
 type CheckoutMessage struct {
 	Role    string `json:"role"`
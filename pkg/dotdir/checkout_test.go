@@ -153,5 +153,17 @@ var _ = Describe("dotdir.Manager checkout", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(loaded).To(Equal(state))
 		})
+
+		It("keeps the checked-out branch", func() {
+			state := &dotdir.CheckoutState{Hash: "abc123def456", Branch: "main"}
+
+			err := m.SaveCheckout(state, tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			loaded, err := m.LoadCheckoutState(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.Branch).To(Equal("main"))
+			Expect(loaded.Head()).To(Equal("main"))
+		})
 	})
 })
@@ -0,0 +1,223 @@
+// Package eventstream reads and writes the AWS event-stream binary framing
+// (application/vnd.amazon.eventstream) used by Bedrock's ConverseStream and
+// InvokeModelWithResponseStream APIs.
+//
+// Like pkg/sse, the reader is a tee: it decodes messages for inspection
+// while forwarding the raw bytes verbatim to a downstream client.
+//
+// Each message is framed as:
+//
+//	total length (4) | headers length (4) | prelude CRC (4) |
+//	headers (headers length) | payload | message CRC (4)
+//
+// All integers are big-endian and both CRCs are CRC-32 (IEEE).
+package eventstream
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// ContentType is the media type of event-stream encoded responses.
+const ContentType = "application/vnd.amazon.eventstream"
+
+// Well-known header names.
+const (
+	EventTypeHeader     = ":event-type"
+	MessageTypeHeader   = ":message-type"
+	ContentTypeHeader   = ":content-type"
+	ExceptionTypeHeader = ":exception-type"
+)
+
+const (
+	preludeLen = 12
+	crcLen     = 4
+
+	// maxMessageLen bounds a single message, as the AWS SDKs do.
+	maxMessageLen = 16 * 1024 * 1024
+)
+
+// Header value types.
+const (
+	typeBoolTrue = iota
+	typeBoolFalse
+	typeByte
+	typeShort
+	typeInt
+	typeLong
+	typeBytes
+	typeString
+	typeTimestamp
+	typeUUID
+)
+
+// ErrChecksum is returned when a message's prelude or message CRC does not
+// match its contents.
+var ErrChecksum = errors.New("eventstream: checksum mismatch")
+
+// Message is a single decoded event-stream message.
+type Message struct {
+	// Headers holds the message's string-valued headers. Headers of other
+	// types are skipped on decode.
+	Headers map[string]string
+
+	// Payload is the raw message payload, typically JSON.
+	Payload []byte
+}
+
+// EventType returns the :event-type header (e.g. "contentBlockDelta").
+func (m *Message) EventType() string {
+	return m.Headers[EventTypeHeader]
+}
+
+// MessageType returns the :message-type header: "event", "exception" or
+// "error".
+func (m *Message) MessageType() string {
+	return m.Headers[MessageTypeHeader]
+}
+
+// TeeReader decodes event-stream messages from a source io.Reader while
+// writing all raw bytes verbatim to a destination io.Writer.
+type TeeReader struct {
+	src io.Reader
+}
+
+// NewTeeReader returns a TeeReader that decodes messages from src and writes
+// all raw bytes through to dest.
+func NewTeeReader(src io.Reader, dest io.Writer) *TeeReader {
+	return &TeeReader{src: io.TeeReader(src, dest)}
+}
+
+// Next returns the next message in the stream. It blocks until a complete
+// message is available and returns nil, nil when the source is exhausted.
+func (r *TeeReader) Next() (*Message, error) {
+	prelude := make([]byte, preludeLen)
+	if _, err := io.ReadFull(r.src, prelude); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("eventstream: reading prelude: %w", err)
+	}
+
+	totalLen := binary.BigEndian.Uint32(prelude[0:4])
+	headersLen := binary.BigEndian.Uint32(prelude[4:8])
+	if crc32.ChecksumIEEE(prelude[:8]) != binary.BigEndian.Uint32(prelude[8:12]) {
+		return nil, ErrChecksum
+	}
+	if totalLen < preludeLen+crcLen || totalLen > maxMessageLen || headersLen > totalLen-preludeLen-crcLen {
+		return nil, fmt.Errorf("eventstream: invalid message length %d (headers %d)", totalLen, headersLen)
+	}
+
+	rest := make([]byte, totalLen-preludeLen)
+	if _, err := io.ReadFull(r.src, rest); err != nil {
+		return nil, fmt.Errorf("eventstream: reading message: %w", err)
+	}
+
+	body := rest[:len(rest)-crcLen]
+	crc := crc32.NewIEEE()
+	crc.Write(prelude)
+	crc.Write(body)
+	if crc.Sum32() != binary.BigEndian.Uint32(rest[len(rest)-crcLen:]) {
+		return nil, ErrChecksum
+	}
+
+	headers, err := decodeHeaders(body[:headersLen])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{Headers: headers, Payload: body[headersLen:]}, nil
+}
+
+// decodeHeaders parses the header section of a message, keeping string
+// headers and skipping the rest.
+func decodeHeaders(b []byte) (map[string]string, error) {
+	headers := make(map[string]string)
+	for len(b) > 0 {
+		nameLen := int(b[0])
+		if len(b) < 1+nameLen+1 {
+			return nil, errors.New("eventstream: truncated header")
+		}
+		name := string(b[1 : 1+nameLen])
+		valueType := b[1+nameLen]
+		b = b[2+nameLen:]
+
+		var size int
+		switch valueType {
+		case typeBoolTrue, typeBoolFalse:
+			size = 0
+		case typeByte:
+			size = 1
+		case typeShort:
+			size = 2
+		case typeInt:
+			size = 4
+		case typeLong, typeTimestamp:
+			size = 8
+		case typeUUID:
+			size = 16
+		case typeBytes, typeString:
+			if len(b) < 2 {
+				return nil, errors.New("eventstream: truncated header")
+			}
+			size = 2 + int(binary.BigEndian.Uint16(b))
+		default:
+			return nil, fmt.Errorf("eventstream: unknown header type %d for %q", valueType, name)
+		}
+		if len(b) < size {
+			return nil, errors.New("eventstream: truncated header")
+		}
+
+		if valueType == typeString {
+			headers[name] = string(b[2:size])
+		}
+		b = b[size:]
+	}
+	return headers, nil
+}
+
+// Encode renders msg in the event-stream framing. Headers are encoded as
+// strings in an unspecified order.
+func Encode(msg *Message) ([]byte, error) {
+	var headers []byte
+	for name, value := range msg.Headers {
+		if len(name) > 255 || len(value) > 65535 {
+			return nil, fmt.Errorf("eventstream: header %q too long", name)
+		}
+		headers = append(headers, byte(len(name)))
+		headers = append(headers, name...)
+		headers = append(headers, typeString)
+		headers = binary.BigEndian.AppendUint16(headers, uint16(len(value)))
+		headers = append(headers, value...)
+	}
+
+	totalLen := preludeLen + len(headers) + len(msg.Payload) + crcLen
+	if totalLen > maxMessageLen {
+		return nil, fmt.Errorf("eventstream: message of %d bytes too long", totalLen)
+	}
+
+	out := make([]byte, 0, totalLen)
+	out = binary.BigEndian.AppendUint32(out, uint32(totalLen))
+	out = binary.BigEndian.AppendUint32(out, uint32(len(headers)))
+	out = binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out))
+	out = append(out, headers...)
+	out = append(out, msg.Payload...)
+	out = binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out))
+	return out, nil
+}
+
+// EncodeEvent renders an "event" message of the given type with a JSON
+// payload, as Bedrock sends them.
+func EncodeEvent(eventType string, payload []byte) ([]byte, error) {
+	return Encode(&Message{
+		Headers: map[string]string{
+			EventTypeHeader:   eventType,
+			MessageTypeHeader: "event",
+			ContentTypeHeader: "application/json",
+		},
+		Payload: payload,
+	})
+}
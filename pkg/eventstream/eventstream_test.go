@@ -0,0 +1,92 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeeReader", func() {
+	var dst *bytes.Buffer
+
+	BeforeEach(func() {
+		dst = &bytes.Buffer{}
+	})
+
+	It("decodes encoded events and tees the raw bytes", func() {
+		first, err := EncodeEvent("messageStart", []byte(`{"role":"assistant"}`))
+		Expect(err).NotTo(HaveOccurred())
+		second, err := EncodeEvent("contentBlockDelta", []byte(`{"delta":{"text":"Hi"},"contentBlockIndex":0}`))
+		Expect(err).NotTo(HaveOccurred())
+		raw := append(append([]byte{}, first...), second...)
+
+		r := NewTeeReader(bytes.NewReader(raw), dst)
+
+		msg, err := r.Next()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.EventType()).To(Equal("messageStart"))
+		Expect(msg.MessageType()).To(Equal("event"))
+		Expect(string(msg.Payload)).To(Equal(`{"role":"assistant"}`))
+
+		msg, err = r.Next()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.EventType()).To(Equal("contentBlockDelta"))
+
+		msg, err = r.Next()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg).To(BeNil())
+
+		Expect(dst.Bytes()).To(Equal(raw))
+	})
+
+	It("skips non-string headers", func() {
+		var headers []byte
+		headers = append(headers, byte(len("flag")))
+		headers = append(headers, "flag"...)
+		headers = append(headers, typeBoolTrue)
+		headers = append(headers, byte(len("count")))
+		headers = append(headers, "count"...)
+		headers = append(headers, typeInt, 0, 0, 0, 7)
+		headers = append(headers, byte(len(EventTypeHeader)))
+		headers = append(headers, EventTypeHeader...)
+		headers = append(headers, typeString, 0, 4)
+		headers = append(headers, "ping"...)
+
+		r := NewTeeReader(bytes.NewReader(frame(headers, []byte("{}"))), dst)
+		msg, err := r.Next()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.Headers).To(Equal(map[string]string{EventTypeHeader: "ping"}))
+		Expect(string(msg.Payload)).To(Equal("{}"))
+	})
+
+	It("rejects corrupted messages", func() {
+		raw, err := EncodeEvent("messageStop", []byte(`{"stopReason":"end_turn"}`))
+		Expect(err).NotTo(HaveOccurred())
+		raw[len(raw)-6] ^= 0xff
+
+		_, err = NewTeeReader(bytes.NewReader(raw), dst).Next()
+		Expect(err).To(MatchError(ErrChecksum))
+	})
+
+	It("reports truncated streams", func() {
+		raw, err := EncodeEvent("messageStop", []byte(`{}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewTeeReader(bytes.NewReader(raw[:len(raw)-2]), dst).Next()
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// frame builds a message from pre-encoded headers.
+func frame(headers, payload []byte) []byte {
+	total := preludeLen + len(headers) + len(payload) + crcLen
+	out := binary.BigEndian.AppendUint32(nil, uint32(total))
+	out = binary.BigEndian.AppendUint32(out, uint32(len(headers)))
+	out = binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out))
+	out = append(out, headers...)
+	out = append(out, payload...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out))
+}
@@ -0,0 +1,131 @@
+// Package listener opens the network listeners used by the proxy and API
+// servers: plain TCP, TLS with optional client certificate verification
+// (mTLS), and unix domain sockets.
+package listener
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+)
+
+const (
+	// unixPrefix marks a listen address as a unix domain socket path
+	// (e.g. "unix:/run/tapes/proxy.sock").
+	unixPrefix = "unix:"
+
+	// socketMode restricts unix sockets to their owner; access is granted by
+	// file permissions rather than an open port.
+	socketMode fs.FileMode = 0o600
+)
+
+// TLSConfig configures TLS for a listener.
+type TLSConfig struct {
+	// CertFile is the path to the PEM-encoded server certificate chain.
+	CertFile string
+
+	// KeyFile is the path to the PEM-encoded server private key.
+	KeyFile string
+
+	// ClientCAFile is an optional path to PEM-encoded CA certificates. When
+	// set, clients must present a certificate signed by one of them (mTLS).
+	ClientCAFile string
+}
+
+// Enabled reports whether TLS is configured. A partial configuration counts
+// as enabled so that Listen reports it instead of silently serving plain HTTP.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.ClientCAFile != ""
+}
+
+// Listen opens a listener on addr. Addresses prefixed with "unix:" listen on a
+// unix domain socket at the given path, replacing a stale socket file;
+// anything else is a TCP address. The listener serves TLS if configured.
+func Listen(addr string, tlsConfig TLSConfig) (net.Listener, error) {
+	var (
+		ln  net.Listener
+		err error
+	)
+	if path, ok := strings.CutPrefix(addr, unixPrefix); ok {
+		ln, err = listenUnix(path)
+	} else {
+		ln, err = net.Listen("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !tlsConfig.Enabled() {
+		return ln, nil
+	}
+
+	cfg, err := tlsConfig.serverConfig()
+	if err != nil {
+		ln.Close()
+		return nil, err
+	}
+	return tls.NewListener(ln, cfg), nil
+}
+
+func listenUnix(path string) (net.Listener, error) {
+	if path == "" {
+		return nil, errors.New("unix socket path is required")
+	}
+
+	// A socket file left behind by an unclean shutdown would make the
+	// listen fail; anything that is not a socket is left alone.
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode().Type() != fs.ModeSocket {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("removing stale socket %s: %w", path, err)
+		}
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, socketMode); err != nil {
+		ln.Close()
+		return nil, fmt.Errorf("setting socket permissions: %w", err)
+	}
+	return ln, nil
+}
+
+func (c TLSConfig) serverConfig() (*tls.Config, error) {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, errors.New("TLS requires both a certificate and a key")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS key pair: %w", err)
+	}
+
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if c.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", c.ClientCAFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,228 @@
+package listener_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/listener"
+)
+
+// testPKI is a throwaway CA with a server and a client certificate.
+type testPKI struct {
+	caFile     string
+	caPool     *x509.CertPool
+	serverCert string
+	serverKey  string
+	client     tls.Certificate
+}
+
+func newTestPKI() *testPKI {
+	dir := GinkgoT().TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	Expect(err).NotTo(HaveOccurred())
+	caCert, err := x509.ParseCertificate(caDER)
+	Expect(err).NotTo(HaveOccurred())
+
+	pki := &testPKI{caPool: x509.NewCertPool()}
+	pki.caPool.AddCert(caCert)
+	pki.caFile = writePEM(dir, "ca.pem", "CERTIFICATE", caDER)
+
+	issue := func(serial int64, usage x509.ExtKeyUsage) ([]byte, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "127.0.0.1"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}, caCert, &key.PublicKey, caKey)
+		Expect(err).NotTo(HaveOccurred())
+		return der, key
+	}
+
+	serverDER, serverKey := issue(2, x509.ExtKeyUsageServerAuth)
+	pki.serverCert = writePEM(dir, "server.pem", "CERTIFICATE", serverDER)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(serverKey)
+	Expect(err).NotTo(HaveOccurred())
+	pki.serverKey = writePEM(dir, "server-key.pem", "PRIVATE KEY", keyDER)
+
+	clientDER, clientKey := issue(3, x509.ExtKeyUsageClientAuth)
+	pki.client = tls.Certificate{Certificate: [][]byte{clientDER}, PrivateKey: clientKey}
+
+	return pki
+}
+
+func writePEM(dir, name, blockType string, der []byte) string {
+	path := filepath.Join(dir, name)
+	Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)).To(Succeed())
+	return path
+}
+
+// serve answers every request on ln with "ok" until the spec ends.
+func serve(ln net.Listener) {
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			io.WriteString(w, "ok")
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+	go server.Serve(ln)
+	DeferCleanup(server.Close)
+}
+
+func get(client *http.Client, url string) (string, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+var _ = Describe("Listen", func() {
+	It("listens on TCP addresses", func() {
+		ln, err := listener.Listen("127.0.0.1:0", listener.TLSConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		serve(ln)
+
+		body, err := get(http.DefaultClient, "http://"+ln.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(Equal("ok"))
+	})
+
+	Context("with a unix socket address", func() {
+		var path string
+
+		BeforeEach(func() {
+			// Socket paths are length-limited, so avoid the long default temp dir.
+			dir, err := os.MkdirTemp("", "tapes-sock")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+			path = filepath.Join(dir, "tapes.sock")
+		})
+
+		It("serves on the socket with owner-only permissions", func() {
+			ln, err := listener.Listen("unix:"+path, listener.TLSConfig{})
+			Expect(err).NotTo(HaveOccurred())
+			serve(ln)
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Type()).To(Equal(fs.ModeSocket))
+			Expect(info.Mode().Perm()).To(Equal(fs.FileMode(0o600)))
+
+			client := &http.Client{Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", path)
+				},
+			}}
+			body, err := get(client, "http://tapes/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(Equal("ok"))
+		})
+
+		It("replaces a stale socket", func() {
+			stale, err := net.Listen("unix", path)
+			Expect(err).NotTo(HaveOccurred())
+			stale.(*net.UnixListener).SetUnlinkOnClose(false)
+			stale.Close()
+
+			ln, err := listener.Listen("unix:"+path, listener.TLSConfig{})
+			Expect(err).NotTo(HaveOccurred())
+			ln.Close()
+		})
+
+		It("refuses to replace a regular file", func() {
+			Expect(os.WriteFile(path, []byte("data"), 0o600)).To(Succeed())
+
+			_, err := listener.Listen("unix:"+path, listener.TLSConfig{})
+			Expect(err).To(MatchError(ContainSubstring("not a socket")))
+		})
+	})
+
+	Context("with TLS", func() {
+		var pki *testPKI
+
+		BeforeEach(func() {
+			pki = newTestPKI()
+		})
+
+		It("serves HTTPS", func() {
+			ln, err := listener.Listen("127.0.0.1:0", listener.TLSConfig{
+				CertFile: pki.serverCert,
+				KeyFile:  pki.serverKey,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			serve(ln)
+
+			client := &http.Client{Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: pki.caPool},
+			}}
+			body, err := get(client, "https://"+ln.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(Equal("ok"))
+		})
+
+		It("requires client certificates when a client CA is set", func() {
+			ln, err := listener.Listen("127.0.0.1:0", listener.TLSConfig{
+				CertFile:     pki.serverCert,
+				KeyFile:      pki.serverKey,
+				ClientCAFile: pki.caFile,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			serve(ln)
+			url := "https://" + ln.Addr().String()
+
+			anonymous := &http.Client{Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: pki.caPool},
+			}}
+			_, err = get(anonymous, url)
+			Expect(err).To(HaveOccurred())
+
+			authenticated := &http.Client{Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: pki.caPool, Certificates: []tls.Certificate{pki.client}},
+			}}
+			body, err := get(authenticated, url)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(Equal("ok"))
+		})
+
+		It("rejects a certificate without a key", func() {
+			_, err := listener.Listen("127.0.0.1:0", listener.TLSConfig{CertFile: pki.serverCert})
+			Expect(err).To(MatchError(ContainSubstring("both a certificate and a key")))
+		})
+	})
+})
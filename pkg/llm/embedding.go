@@ -0,0 +1,31 @@
+package llm
+
+// EmbeddingRequest represents a provider-agnostic embeddings request.
+type EmbeddingRequest struct {
+	// Model requested to embed the input
+	Model string `json:"model"`
+
+	// Input texts to embed. Pre-tokenized inputs have no text and are
+	// counted in InputCount only.
+	Input []string `json:"input,omitempty"`
+
+	// InputCount is the number of inputs in the request.
+	InputCount int `json:"input_count"`
+}
+
+// EmbeddingResponse represents a provider-agnostic embeddings response. The
+// vectors themselves are not kept.
+type EmbeddingResponse struct {
+	// Model that produced the embeddings
+	Model string `json:"model"`
+
+	// Count is the number of embeddings returned.
+	Count int `json:"count"`
+
+	// Dimensions is the length of each embedding, zero if unknown (e.g.
+	// base64-encoded vectors).
+	Dimensions int `json:"dimensions,omitempty"`
+
+	// Token usage and timing metrics
+	Usage *Usage `json:"usage,omitempty"`
+}
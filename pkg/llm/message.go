@@ -55,3 +55,49 @@ func (m *Message) GetText() string {
 	}
 	return b.String()
 }
+
+// PairToolResults converts text-only "tool" role messages, as sent by
+// providers that don't reference tool call IDs (e.g. Ollama), into
+// tool_result blocks bound in order to the tool_use IDs of the preceding
+// assistant message. Serializers for formats that require explicit IDs use
+// it so translated tool results still reference their calls.
+func PairToolResults(messages []Message) []Message {
+	out := make([]Message, 0, len(messages))
+	var pending []string
+
+	for _, msg := range messages {
+		switch msg.Role {
+		case "assistant":
+			pending = pending[:0]
+			for _, block := range msg.Content {
+				if block.Type == "tool_use" && block.ToolUseID != "" {
+					pending = append(pending, block.ToolUseID)
+				}
+			}
+		case "tool":
+			if len(pending) > 0 && !hasToolResult(msg) {
+				msg = Message{
+					Role: msg.Role,
+					Content: []ContentBlock{{
+						Type:         "tool_result",
+						ToolResultID: pending[0],
+						ToolOutput:   msg.GetText(),
+					}},
+				}
+				pending = pending[1:]
+			}
+		}
+		out = append(out, msg)
+	}
+
+	return out
+}
+
+func hasToolResult(msg Message) bool {
+	for _, block := range msg.Content {
+		if block.Type == "tool_result" {
+			return true
+		}
+	}
+	return false
+}
@@ -43,33 +43,7 @@ func (p *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 			// Parse as array of content blocks
 			for _, item := range content {
 				if block, ok := item.(map[string]any); ok {
-					cb := llm.ContentBlock{}
-					if t, ok := block["type"].(string); ok {
-						cb.Type = t
-					}
-					if text, ok := block["text"].(string); ok {
-						cb.Text = text
-					}
-					if source, ok := block["source"].(map[string]any); ok {
-						if mt, ok := source["media_type"].(string); ok {
-							cb.MediaType = mt
-						}
-						if data, ok := source["data"].(string); ok {
-							cb.ImageBase64 = data
-						}
-					}
-
-					// Tool use
-					if id, ok := block["id"].(string); ok {
-						cb.ToolUseID = id
-					}
-					if name, ok := block["name"].(string); ok {
-						cb.ToolName = name
-					}
-					if input, ok := block["input"].(map[string]any); ok {
-						cb.ToolInput = input
-					}
-					converted.Content = append(converted.Content, cb)
+					converted.Content = append(converted.Content, parseContentBlock(block))
 				}
 			}
 		}
@@ -87,12 +61,87 @@ func (p *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		TopK:        req.TopK,
 		Stop:        req.Stop,
 		Stream:      req.Stream,
+		ToolChoice:  req.ToolChoice,
 		RawRequest:  payload,
 	}
+	for _, tool := range req.Tools {
+		result.Tools = append(result.Tools, llm.Tool(tool))
+	}
+
+	// Preserve Anthropic-specific fields
+	if req.Thinking != nil {
+		result.Extra = map[string]any{"thinking": req.Thinking}
+	}
 
 	return result, nil
 }
 
+// parseContentBlock converts a single request content block into the
+// internal format.
+func parseContentBlock(block map[string]any) llm.ContentBlock {
+	cb := llm.ContentBlock{}
+	if t, ok := block["type"].(string); ok {
+		cb.Type = t
+	}
+	if text, ok := block["text"].(string); ok {
+		cb.Text = text
+	}
+	if source, ok := block["source"].(map[string]any); ok {
+		if mt, ok := source["media_type"].(string); ok {
+			cb.MediaType = mt
+		}
+		if data, ok := source["data"].(string); ok {
+			cb.ImageBase64 = data
+		}
+		if url, ok := source["url"].(string); ok {
+			cb.ImageURL = url
+		}
+	}
+
+	// Tool use
+	if id, ok := block["id"].(string); ok {
+		cb.ToolUseID = id
+	}
+	if name, ok := block["name"].(string); ok {
+		cb.ToolName = name
+	}
+	if input, ok := block["input"].(map[string]any); ok {
+		cb.ToolInput = input
+	}
+
+	// Tool result
+	if cb.Type == "tool_result" {
+		cb.ToolResultID, _ = block["tool_use_id"].(string)
+		cb.ToolOutput = parseToolResultContent(block["content"])
+		cb.IsError, _ = block["is_error"].(bool)
+	}
+
+	return cb
+}
+
+// parseToolResultContent flattens tool_result content, which is either a
+// string or an array of text blocks.
+func parseToolResultContent(content any) string {
+	switch value := content.(type) {
+	case string:
+		return value
+	case []any:
+		var builder strings.Builder
+		for _, item := range value {
+			block, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if text, ok := block["text"].(string); ok {
+				builder.WriteString(text)
+			}
+		}
+		return builder.String()
+	default:
+		return ""
+	}
+}
+
 func parseAnthropicSystem(system any) string {
 	if system == nil {
 		return ""
@@ -176,6 +225,75 @@ func (p *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 	return result, nil
 }
 
-func (p *Provider) ParseStreamChunk(_ []byte) (*llm.StreamChunk, error) {
-	panic("not implemented")
+// ParseStreamChunk converts the data payload of a single Messages API SSE
+// event into the internal format. Tool input arrives as partial JSON
+// fragments; a fragment only populates ToolInput once it is a complete
+// JSON object.
+func (p *Provider) ParseStreamChunk(payload []byte) (*llm.StreamChunk, error) {
+	var event anthropicStreamEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	chunk := &llm.StreamChunk{Index: event.Index}
+	switch event.Type {
+	case "message_start":
+		if event.Message == nil {
+			return nil, nil
+		}
+		chunk.Model = event.Message.Model
+		chunk.Message.Role = event.Message.Role
+		if u := event.Message.Usage; u != nil {
+			prompt := u.InputTokens + u.CacheCreationInputTokens + u.CacheReadInputTokens
+			chunk.Usage = &llm.Usage{
+				PromptTokens:             prompt,
+				CacheCreationInputTokens: u.CacheCreationInputTokens,
+				CacheReadInputTokens:     u.CacheReadInputTokens,
+			}
+		}
+	case "content_block_start":
+		if event.ContentBlock == nil {
+			return nil, nil
+		}
+		cb := llm.ContentBlock{Type: event.ContentBlock.Type, Text: event.ContentBlock.Text}
+		if cb.Type == "tool_use" {
+			cb.ToolUseID = event.ContentBlock.ID
+			cb.ToolName = event.ContentBlock.Name
+			if len(event.ContentBlock.Input) > 0 {
+				cb.ToolInput = event.ContentBlock.Input
+			}
+		}
+		chunk.Message = llm.Message{Role: "assistant", Content: []llm.ContentBlock{cb}}
+	case "content_block_delta":
+		if event.Delta == nil {
+			return nil, nil
+		}
+		switch event.Delta.Type {
+		case "text_delta":
+			chunk.Message = llm.NewTextMessage("assistant", event.Delta.Text)
+		case "input_json_delta":
+			cb := llm.ContentBlock{Type: "tool_use"}
+			var input map[string]any
+			if err := json.Unmarshal([]byte(event.Delta.PartialJSON), &input); err == nil {
+				cb.ToolInput = input
+			}
+			chunk.Message = llm.Message{Role: "assistant", Content: []llm.ContentBlock{cb}}
+		default:
+			return nil, nil
+		}
+	case "message_delta":
+		if event.Delta != nil {
+			chunk.StopReason = event.Delta.StopReason
+		}
+		if event.Usage != nil {
+			chunk.Usage = &llm.Usage{CompletionTokens: event.Usage.OutputTokens}
+		}
+	case "message_stop":
+		chunk.Done = true
+	default:
+		// ping, content_block_stop and unknown events carry no content
+		return nil, nil
+	}
+
+	return chunk, nil
 }
@@ -140,6 +140,22 @@ var _ = Describe("Anthropic Provider", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(req.Stop).To(ConsistOf("END", "STOP"))
 			})
+
+			It("preserves the thinking configuration in Extra", func() {
+				payload := []byte(`{
+					"model": "claude-sonnet-4-5",
+					"max_tokens": 16000,
+					"thinking": {"type": "enabled", "budget_tokens": 10000},
+					"messages": [{"role": "user", "content": "Hello"}]
+				}`)
+
+				req, err := p.ParseRequest(payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(req.Extra).To(HaveKeyWithValue("thinking", map[string]any{
+					"type":          "enabled",
+					"budget_tokens": float64(10000),
+				}))
+			})
 		})
 
 		Context("with streaming flag", func() {
@@ -343,4 +359,71 @@ var _ = Describe("Anthropic Provider", func() {
 			})
 		})
 	})
+
+	Describe("ParseRequest tool results", func() {
+		It("parses tool_result blocks with string and block content", func() {
+			payload := []byte(`{
+				"model": "claude-3-sonnet-20240229",
+				"max_tokens": 1024,
+				"messages": [
+					{
+						"role": "user",
+						"content": [
+							{"type": "tool_result", "tool_use_id": "toolu_1", "content": "file contents"},
+							{"type": "tool_result", "tool_use_id": "toolu_2", "is_error": true, "content": [{"type": "text", "text": "not found"}]}
+						]
+					}
+				]
+			}`)
+
+			req, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Messages[0].Content).To(HaveLen(2))
+			Expect(req.Messages[0].Content[0].ToolResultID).To(Equal("toolu_1"))
+			Expect(req.Messages[0].Content[0].ToolOutput).To(Equal("file contents"))
+			Expect(req.Messages[0].Content[1].ToolResultID).To(Equal("toolu_2"))
+			Expect(req.Messages[0].Content[1].ToolOutput).To(Equal("not found"))
+			Expect(req.Messages[0].Content[1].IsError).To(BeTrue())
+		})
+	})
+
+	Describe("ParseStreamChunk", func() {
+		It("parses message_start model and input usage", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"type":"message_start","message":{"model":"claude-opus-4-6","role":"assistant","usage":{"input_tokens":10,"cache_read_input_tokens":5}}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Model).To(Equal("claude-opus-4-6"))
+			Expect(chunk.Usage.PromptTokens).To(Equal(15))
+		})
+
+		It("parses text deltas", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hel"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Message.GetText()).To(Equal("Hel"))
+		})
+
+		It("leaves partial tool input fragments unset", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{\"pa"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Index).To(Equal(1))
+			Expect(chunk.Message.Content[0].Type).To(Equal("tool_use"))
+			Expect(chunk.Message.Content[0].ToolInput).To(BeNil())
+		})
+
+		It("parses the stop reason and marks message_stop as done", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":7}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.StopReason).To(Equal("end_turn"))
+			Expect(chunk.Usage.CompletionTokens).To(Equal(7))
+
+			chunk, err = p.ParseStreamChunk([]byte(`{"type":"message_stop"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Done).To(BeTrue())
+		})
+
+		It("skips ping events", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"type":"ping"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk).To(BeNil())
+		})
+	})
 })
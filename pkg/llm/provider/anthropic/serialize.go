@@ -0,0 +1,281 @@
+package anthropic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// defaultMaxTokens is used when a translated request carries no max_tokens,
+// since the Messages API requires it.
+const defaultMaxTokens = 4096
+
+// SerializeRequest renders a ChatRequest in Anthropic's Messages API format.
+// System messages are hoisted into the top-level system prompt and tool
+// results are sent as user turns, as the Messages API expects.
+func (p *Provider) SerializeRequest(req *llm.ChatRequest) ([]byte, error) {
+	system := req.System
+	messages := make([]anthropicMessage, 0, len(req.Messages))
+	for _, msg := range llm.PairToolResults(req.Messages) {
+		role := msg.Role
+		switch role {
+		case "system":
+			if text := msg.GetText(); text != "" {
+				if system != "" {
+					system += "\n"
+				}
+				system += text
+			}
+			continue
+		case "tool":
+			role = "user"
+		}
+
+		blocks := make([]map[string]any, 0, len(msg.Content))
+		for _, cb := range msg.Content {
+			if block := serializeContentBlock(cb); block != nil {
+				blocks = append(blocks, block)
+			}
+		}
+
+		// The Messages API expects alternating turns, so fold consecutive
+		// messages from the same role (e.g. several OpenAI tool results).
+		if n := len(messages); n > 0 && messages[n-1].Role == role {
+			if prev, ok := messages[n-1].Content.([]map[string]any); ok {
+				messages[n-1].Content = append(prev, blocks...)
+				continue
+			}
+		}
+		messages = append(messages, anthropicMessage{Role: role, Content: blocks})
+	}
+
+	out := anthropicRequest{
+		Model:       req.Model,
+		Messages:    messages,
+		MaxTokens:   defaultMaxTokens,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+		TopK:        req.TopK,
+		Stop:        req.Stop,
+		Stream:      req.Stream,
+		ToolChoice:  req.ToolChoice,
+	}
+	for _, tool := range req.Tools {
+		out.Tools = append(out.Tools, anthropicTool(tool))
+	}
+	if system != "" {
+		out.System = system
+	}
+	if req.MaxTokens != nil && *req.MaxTokens > 0 {
+		out.MaxTokens = *req.MaxTokens
+	}
+	if thinking, ok := req.Extra["thinking"].(map[string]any); ok {
+		out.Thinking = thinking
+	}
+
+	return json.Marshal(out)
+}
+
+// SerializeResponse renders a ChatResponse in Anthropic's Messages API format.
+func (p *Provider) SerializeResponse(resp *llm.ChatResponse) ([]byte, error) {
+	content := make([]map[string]any, 0, len(resp.Message.Content))
+	for _, cb := range resp.Message.Content {
+		if block := serializeContentBlock(cb); block != nil {
+			content = append(content, block)
+		}
+	}
+
+	out := map[string]any{
+		"id":            responseID(resp),
+		"type":          "message",
+		"role":          "assistant",
+		"model":         resp.Model,
+		"content":       content,
+		"stop_reason":   stopReason(resp.StopReason),
+		"stop_sequence": nil,
+	}
+
+	usage := anthropicUsage{}
+	if resp.Usage != nil {
+		// PromptTokens includes cache tokens (see ParseResponse), so strip
+		// them back out for the wire format.
+		usage.InputTokens = resp.Usage.PromptTokens - resp.Usage.CacheCreationInputTokens - resp.Usage.CacheReadInputTokens
+		usage.OutputTokens = resp.Usage.CompletionTokens
+		usage.CacheCreationInputTokens = resp.Usage.CacheCreationInputTokens
+		usage.CacheReadInputTokens = resp.Usage.CacheReadInputTokens
+	}
+	out["usage"] = usage
+
+	return json.Marshal(out)
+}
+
+// serializeContentBlock converts a single content block to its Anthropic
+// wire shape. Returns nil for blocks with nothing to send.
+func serializeContentBlock(cb llm.ContentBlock) map[string]any {
+	switch cb.Type {
+	case "text":
+		return map[string]any{"type": "text", "text": cb.Text}
+	case "image":
+		return map[string]any{"type": "image", "source": imageSource(cb)}
+	case "tool_use":
+		input := cb.ToolInput
+		if input == nil {
+			input = map[string]any{}
+		}
+		return map[string]any{
+			"type":  "tool_use",
+			"id":    cb.ToolUseID,
+			"name":  cb.ToolName,
+			"input": input,
+		}
+	case "tool_result":
+		block := map[string]any{
+			"type":        "tool_result",
+			"tool_use_id": cb.ToolResultID,
+			"content":     cb.ToolOutput,
+		}
+		if cb.IsError {
+			block["is_error"] = true
+		}
+		return block
+	case "":
+		return nil
+	default:
+		if cb.Text == "" {
+			return nil
+		}
+		return map[string]any{"type": "text", "text": cb.Text}
+	}
+}
+
+// imageSource builds an Anthropic image source, unpacking data URLs into
+// base64 sources so that OpenAI-style inline images survive translation.
+func imageSource(cb llm.ContentBlock) map[string]any {
+	if cb.ImageBase64 != "" {
+		mediaType := cb.MediaType
+		if mediaType == "" {
+			mediaType = "image/png"
+		}
+		return map[string]any{"type": "base64", "media_type": mediaType, "data": cb.ImageBase64}
+	}
+
+	if rest, ok := strings.CutPrefix(cb.ImageURL, "data:"); ok {
+		if header, data, ok := strings.Cut(rest, ","); ok {
+			mediaType := strings.TrimSuffix(header, ";base64")
+			return map[string]any{"type": "base64", "media_type": mediaType, "data": data}
+		}
+	}
+
+	return map[string]any{"type": "url", "url": cb.ImageURL}
+}
+
+// stopReason maps stop reasons from other providers onto Anthropic's vocabulary.
+func stopReason(reason string) string {
+	switch reason {
+	case "stop", "":
+		return "end_turn"
+	case "length":
+		return "max_tokens"
+	case "tool_calls", "function_call":
+		return "tool_use"
+	default:
+		return reason
+	}
+}
+
+func responseID(resp *llm.ChatResponse) string {
+	if id, ok := resp.Extra["id"].(string); ok && strings.HasPrefix(id, "msg_") {
+		return id
+	}
+	return fmt.Sprintf("msg_%d", time.Now().UnixNano())
+}
+
+// StreamContentType is the Content-Type of Messages API streams.
+func (p *Provider) StreamContentType() string {
+	return "text/event-stream"
+}
+
+// SerializeStream renders a complete response as a Messages API event stream:
+// message_start, one start/delta/stop triple per content block, then
+// message_delta and message_stop.
+func (p *Provider) SerializeStream(resp *llm.ChatResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	usage := anthropicUsage{}
+	if resp.Usage != nil {
+		usage.InputTokens = resp.Usage.PromptTokens - resp.Usage.CacheCreationInputTokens - resp.Usage.CacheReadInputTokens
+		usage.CacheCreationInputTokens = resp.Usage.CacheCreationInputTokens
+		usage.CacheReadInputTokens = resp.Usage.CacheReadInputTokens
+	}
+
+	events := []map[string]any{{
+		"type": "message_start",
+		"message": map[string]any{
+			"id":            responseID(resp),
+			"type":          "message",
+			"role":          "assistant",
+			"model":         resp.Model,
+			"content":       []any{},
+			"stop_reason":   nil,
+			"stop_sequence": nil,
+			"usage":         usage,
+		},
+	}}
+
+	index := 0
+	for _, cb := range resp.Message.Content {
+		block := serializeContentBlock(cb)
+		if block == nil {
+			continue
+		}
+
+		var delta map[string]any
+		switch block["type"] {
+		case "text":
+			delta = map[string]any{"type": "text_delta", "text": block["text"]}
+			block["text"] = ""
+		case "tool_use":
+			input, err := json.Marshal(block["input"])
+			if err != nil {
+				return nil, err
+			}
+			delta = map[string]any{"type": "input_json_delta", "partial_json": string(input)}
+			block["input"] = map[string]any{}
+		default:
+			continue
+		}
+
+		events = append(events,
+			map[string]any{"type": "content_block_start", "index": index, "content_block": block},
+			map[string]any{"type": "content_block_delta", "index": index, "delta": delta},
+			map[string]any{"type": "content_block_stop", "index": index},
+		)
+		index++
+	}
+
+	outputTokens := 0
+	if resp.Usage != nil {
+		outputTokens = resp.Usage.CompletionTokens
+	}
+	events = append(events,
+		map[string]any{
+			"type":  "message_delta",
+			"delta": map[string]any{"stop_reason": stopReason(resp.StopReason), "stop_sequence": nil},
+			"usage": map[string]any{"output_tokens": outputTokens},
+		},
+		map[string]any{"type": "message_stop"},
+	)
+
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, "event: %s\ndata: %s\n\n", event["type"], data)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -1,5 +1,7 @@
 package anthropic
 
+import "github.com/papercomputeco/tapes/pkg/llm"
+
 // anthropicRequest represents Anthropic's request format.
 type anthropicRequest struct {
 	Model       string             `json:"model"`
@@ -11,6 +13,16 @@ type anthropicRequest struct {
 	TopK        *int               `json:"top_k,omitempty"`
 	Stop        []string           `json:"stop_sequences,omitempty"`
 	Stream      *bool              `json:"stream,omitempty"`
+	Thinking    map[string]any     `json:"thinking,omitempty"`
+	Tools       []anthropicTool    `json:"tools,omitempty"`
+	ToolChoice  *llm.ToolChoice    `json:"tool_choice,omitempty"`
+}
+
+// anthropicTool represents a tool definition in Anthropic's format.
+type anthropicTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	InputSchema map[string]any `json:"input_schema,omitempty"`
 }
 
 // anthropicMessage represents a message in Anthropic's format.
@@ -55,3 +67,21 @@ type anthropicUsage struct {
 	CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
 	CacheReadInputTokens     int `json:"cache_read_input_tokens"`
 }
+
+// anthropicStreamEvent is the data payload of a Messages API SSE event.
+type anthropicStreamEvent struct {
+	Type         string                 `json:"type"`
+	Index        int                    `json:"index"`
+	Message      *anthropicResponse     `json:"message,omitempty"`
+	ContentBlock *anthropicContentBlock `json:"content_block,omitempty"`
+	Delta        *anthropicStreamDelta  `json:"delta,omitempty"`
+	Usage        *anthropicUsage        `json:"usage,omitempty"`
+}
+
+// anthropicStreamDelta is the delta of a content_block_delta or message_delta event.
+type anthropicStreamDelta struct {
+	Type        string `json:"type,omitempty"`
+	Text        string `json:"text,omitempty"`
+	PartialJSON string `json:"partial_json,omitempty"`
+	StopReason  string `json:"stop_reason,omitempty"`
+}
@@ -0,0 +1,322 @@
+// Package bedrock parses AWS Bedrock runtime traffic: the Converse and
+// ConverseStream APIs, and InvokeModel / InvokeModelWithResponseStream for
+// Anthropic models, whose bodies use the Messages API format.
+package bedrock
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider/anthropic"
+)
+
+// Bedrock runtime operations, the last segment of /model/{modelId}/{operation}.
+const (
+	opConverse       = "converse"
+	opConverseStream = "converse-stream"
+	opInvoke         = "invoke"
+	opInvokeStream   = "invoke-with-response-stream"
+)
+
+// Provider implements the Provider interface for the AWS Bedrock runtime.
+type Provider struct {
+	// anthropic parses InvokeModel bodies of Anthropic models.
+	anthropic *anthropic.Provider
+}
+
+// New
+func New() *Provider { return &Provider{anthropic: anthropic.New()} }
+
+// Name
+func (p *Provider) Name() string {
+	return "bedrock"
+}
+
+// DefaultStreaming is false - Bedrock streams only on the *-stream operations,
+// which ParseRequestPath detects.
+func (p *Provider) DefaultStreaming() bool {
+	return false
+}
+
+// ParseRequestPath sets the model and streaming mode of a request from its
+// URL path, /model/{modelId}/{operation}, since Bedrock request bodies
+// carry neither.
+func (p *Provider) ParseRequestPath(path string, req *llm.ChatRequest) {
+	modelID, operation, ok := parsePath(path)
+	if !ok {
+		return
+	}
+	if req.Model == "" {
+		req.Model = modelID
+	}
+
+	switch operation {
+	case opConverse, opInvoke:
+		stream := false
+		req.Stream = &stream
+	case opConverseStream, opInvokeStream:
+		stream := true
+		req.Stream = &stream
+	}
+}
+
+// parsePath splits a /model/{modelId}/{operation} path. Model IDs may be
+// URL-encoded ARNs, so the operation is taken from the last segment.
+func parsePath(path string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(path, "/model/")
+	if !ok {
+		return "", "", false
+	}
+	i := strings.LastIndex(rest, "/")
+	if i <= 0 {
+		return "", "", false
+	}
+
+	modelID, err := url.PathUnescape(rest[:i])
+	if err != nil {
+		modelID = rest[:i]
+	}
+	return modelID, rest[i+1:], true
+}
+
+// bodyProbe distinguishes Converse bodies from model-native InvokeModel
+// bodies.
+type bodyProbe struct {
+	AnthropicVersion string          `json:"anthropic_version"`
+	Output           json.RawMessage `json:"output"`
+	Type             string          `json:"type"`
+}
+
+func (p *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
+	var probe bodyProbe
+	if err := json.Unmarshal(payload, &probe); err != nil {
+		return nil, err
+	}
+	if probe.AnthropicVersion != "" {
+		req, err := p.anthropic.ParseRequest(payload)
+		if err != nil {
+			return nil, err
+		}
+		if req.Extra == nil {
+			req.Extra = map[string]any{}
+		}
+		req.Extra["anthropic_version"] = probe.AnthropicVersion
+		return req, nil
+	}
+
+	var req converseRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+
+	messages := make([]llm.Message, 0, len(req.Messages))
+	for _, msg := range req.Messages {
+		messages = append(messages, llm.Message{Role: msg.Role, Content: parseContent(msg.Content)})
+	}
+
+	system := make([]string, 0, len(req.System))
+	for _, block := range req.System {
+		if block.Text != "" {
+			system = append(system, block.Text)
+		}
+	}
+
+	result := &llm.ChatRequest{
+		Messages:   messages,
+		System:     strings.Join(system, "\n"),
+		RawRequest: payload,
+	}
+	if cfg := req.InferenceConfig; cfg != nil {
+		result.MaxTokens = cfg.MaxTokens
+		result.Temperature = cfg.Temperature
+		result.TopP = cfg.TopP
+		result.Stop = cfg.StopSequences
+	}
+
+	if cfg := req.ToolConfig; cfg != nil {
+		for _, tool := range cfg.Tools {
+			if tool.ToolSpec == nil {
+				continue
+			}
+			result.Tools = append(result.Tools, llm.Tool{
+				Name:        tool.ToolSpec.Name,
+				Description: tool.ToolSpec.Description,
+				InputSchema: tool.ToolSpec.InputSchema.JSON,
+			})
+		}
+		if choice := cfg.ToolChoice; choice != nil {
+			switch {
+			case choice.Auto != nil:
+				result.ToolChoice = &llm.ToolChoice{Type: "auto"}
+			case choice.Any != nil:
+				result.ToolChoice = &llm.ToolChoice{Type: "any"}
+			case choice.Tool != nil:
+				result.ToolChoice = &llm.ToolChoice{Type: "tool", Name: choice.Tool.Name}
+			}
+		}
+	}
+
+	// Preserve Bedrock-specific fields
+	if len(req.AdditionalModelRequestFields) > 0 {
+		result.Extra = map[string]any{"additionalModelRequestFields": req.AdditionalModelRequestFields}
+	}
+
+	return result, nil
+}
+
+// parseContent converts Converse content blocks into the internal format.
+func parseContent(blocks []converseContentBlock) []llm.ContentBlock {
+	content := make([]llm.ContentBlock, 0, len(blocks))
+	for _, block := range blocks {
+		switch {
+		case block.ToolUse != nil:
+			content = append(content, llm.ContentBlock{
+				Type:      "tool_use",
+				ToolUseID: block.ToolUse.ToolUseID,
+				ToolName:  block.ToolUse.Name,
+				ToolInput: block.ToolUse.Input,
+			})
+		case block.ToolResult != nil:
+			content = append(content, llm.ContentBlock{
+				Type:         "tool_result",
+				ToolResultID: block.ToolResult.ToolUseID,
+				ToolOutput:   toolResultText(block.ToolResult.Content),
+				IsError:      block.ToolResult.Status == "error",
+			})
+		case block.Image != nil:
+			content = append(content, llm.ContentBlock{
+				Type:        "image",
+				ImageBase64: block.Image.Source.Bytes,
+				MediaType:   "image/" + block.Image.Format,
+			})
+		case block.Text != "":
+			content = append(content, llm.ContentBlock{Type: "text", Text: block.Text})
+		}
+	}
+	return content
+}
+
+// toolResultText flattens tool result content, rendering JSON results as
+// their encoding.
+func toolResultText(content []converseToolResultContent) string {
+	var builder strings.Builder
+	for _, c := range content {
+		switch {
+		case c.Text != "":
+			builder.WriteString(c.Text)
+		case c.JSON != nil:
+			if data, err := json.Marshal(c.JSON); err == nil {
+				builder.Write(data)
+			}
+		}
+	}
+	return builder.String()
+}
+
+func (p *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
+	var probe bodyProbe
+	if err := json.Unmarshal(payload, &probe); err != nil {
+		return nil, err
+	}
+	if probe.Output == nil && probe.Type != "" {
+		return p.anthropic.ParseResponse(payload)
+	}
+
+	var resp converseResponse
+	if err := json.Unmarshal(payload, &resp); err != nil {
+		return nil, err
+	}
+
+	message := llm.Message{Role: "assistant"}
+	if resp.Output != nil && resp.Output.Message != nil {
+		message.Role = resp.Output.Message.Role
+		message.Content = parseContent(resp.Output.Message.Content)
+	}
+
+	result := &llm.ChatResponse{
+		Message:     message,
+		Done:        true,
+		StopReason:  resp.StopReason,
+		Usage:       parseUsage(resp.Usage),
+		CreatedAt:   time.Now(),
+		RawResponse: payload,
+	}
+	if resp.Metrics != nil {
+		result.Extra = map[string]any{"latency_ms": resp.Metrics.LatencyMs}
+	}
+
+	return result, nil
+}
+
+// parseUsage converts Converse usage. Like the Anthropic provider, prompt
+// tokens include cached input tokens.
+func parseUsage(u *converseUsage) *llm.Usage {
+	if u == nil {
+		return nil
+	}
+	prompt := u.InputTokens + u.CacheReadInputTokens + u.CacheWriteInputTokens
+	return &llm.Usage{
+		PromptTokens:             prompt,
+		CompletionTokens:         u.OutputTokens,
+		TotalTokens:              prompt + u.OutputTokens,
+		CacheCreationInputTokens: u.CacheWriteInputTokens,
+		CacheReadInputTokens:     u.CacheReadInputTokens,
+	}
+}
+
+// ParseStreamChunk converts a single event-stream event into the internal
+// format. The payload is the event wrapped in an object keyed by its
+// :event-type header, e.g. {"contentBlockDelta": {...}}, the shape the AWS
+// SDKs use for the ConverseStream output union. "chunk" events of
+// InvokeModelWithResponseStream carry a base64-encoded Messages API event,
+// which is parsed by the Anthropic provider.
+func (p *Provider) ParseStreamChunk(payload []byte) (*llm.StreamChunk, error) {
+	var event converseStreamEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case event.Chunk != nil:
+		return p.anthropic.ParseStreamChunk(event.Chunk.Bytes)
+	case event.MessageStart != nil:
+		return &llm.StreamChunk{Message: llm.Message{Role: event.MessageStart.Role}}, nil
+	case event.ContentBlockStart != nil:
+		toolUse := event.ContentBlockStart.Start.ToolUse
+		if toolUse == nil {
+			return nil, nil
+		}
+		cb := llm.ContentBlock{Type: "tool_use", ToolUseID: toolUse.ToolUseID, ToolName: toolUse.Name}
+		return &llm.StreamChunk{
+			Index:   event.ContentBlockStart.ContentBlockIndex,
+			Message: llm.Message{Role: "assistant", Content: []llm.ContentBlock{cb}},
+		}, nil
+	case event.ContentBlockDelta != nil:
+		delta := event.ContentBlockDelta.Delta
+		chunk := &llm.StreamChunk{Index: event.ContentBlockDelta.ContentBlockIndex}
+		switch {
+		case delta.ToolUse != nil:
+			cb := llm.ContentBlock{Type: "tool_use"}
+			var input map[string]any
+			if err := json.Unmarshal([]byte(delta.ToolUse.Input), &input); err == nil {
+				cb.ToolInput = input
+			}
+			chunk.Message = llm.Message{Role: "assistant", Content: []llm.ContentBlock{cb}}
+		case delta.Text != "":
+			chunk.Message = llm.NewTextMessage("assistant", delta.Text)
+		default:
+			return nil, nil
+		}
+		return chunk, nil
+	case event.MessageStop != nil:
+		return &llm.StreamChunk{StopReason: event.MessageStop.StopReason, Done: true}, nil
+	case event.Metadata != nil:
+		return &llm.StreamChunk{Usage: parseUsage(event.Metadata.Usage), Done: true}, nil
+	default:
+		// contentBlockStop and unknown events carry no content
+		return nil, nil
+	}
+}
@@ -0,0 +1,279 @@
+package bedrock_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/eventstream"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider/bedrock"
+)
+
+func intPtr(i int) *int { return &i }
+
+// wrapEvent renders an event-stream message the way the proxy hands it to
+// ParseStreamChunk.
+func wrapEvent(msg *eventstream.Message) []byte {
+	return []byte(`{"` + msg.EventType() + `":` + string(msg.Payload) + `}`)
+}
+
+var _ = Describe("Bedrock Provider", func() {
+	var p *bedrock.Provider
+
+	BeforeEach(func() {
+		p = bedrock.New()
+	})
+
+	Describe("Name", func() {
+		It("returns 'bedrock'", func() {
+			Expect(p.Name()).To(Equal("bedrock"))
+		})
+	})
+
+	Describe("ParseRequestPath", func() {
+		It("takes the model and streaming mode from the path", func() {
+			req := &llm.ChatRequest{}
+			p.ParseRequestPath("/model/anthropic.claude-3-5-haiku-20241022-v1%3A0/converse-stream", req)
+			Expect(req.Model).To(Equal("anthropic.claude-3-5-haiku-20241022-v1:0"))
+			Expect(*req.Stream).To(BeTrue())
+
+			req = &llm.ChatRequest{}
+			p.ParseRequestPath("/model/arn:aws:bedrock:us-east-1:123456789012:inference-profile%2Fus.amazon.nova-pro-v1:0/converse", req)
+			Expect(req.Model).To(Equal("arn:aws:bedrock:us-east-1:123456789012:inference-profile/us.amazon.nova-pro-v1:0"))
+			Expect(*req.Stream).To(BeFalse())
+		})
+
+		It("ignores other paths", func() {
+			req := &llm.ChatRequest{}
+			p.ParseRequestPath("/v1/messages", req)
+			Expect(req.Model).To(BeEmpty())
+			Expect(req.Stream).To(BeNil())
+		})
+	})
+
+	Describe("ParseRequest", func() {
+		It("parses Converse requests", func() {
+			payload := []byte(`{
+				"system": [{"text": "Be brief."}],
+				"messages": [
+					{"role": "user", "content": [
+						{"text": "What's in this image?"},
+						{"image": {"format": "png", "source": {"bytes": "iVBORw0KGgo="}}}
+					]},
+					{"role": "assistant", "content": [
+						{"toolUse": {"toolUseId": "t1", "name": "read_file", "input": {"path": "a.txt"}}}
+					]},
+					{"role": "user", "content": [
+						{"toolResult": {"toolUseId": "t1", "content": [{"json": {"color": "red"}}], "status": "error"}}
+					]}
+				],
+				"inferenceConfig": {"maxTokens": 256, "temperature": 0.2, "stopSequences": ["END"]},
+				"additionalModelRequestFields": {"top_k": 5}
+			}`)
+
+			req, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.System).To(Equal("Be brief."))
+			Expect(*req.MaxTokens).To(Equal(256))
+			Expect(*req.Temperature).To(Equal(0.2))
+			Expect(req.Stop).To(Equal([]string{"END"}))
+			Expect(req.Extra).To(HaveKey("additionalModelRequestFields"))
+
+			Expect(req.Messages).To(HaveLen(3))
+			Expect(req.Messages[0].Content).To(Equal([]llm.ContentBlock{
+				{Type: "text", Text: "What's in this image?"},
+				{Type: "image", ImageBase64: "iVBORw0KGgo=", MediaType: "image/png"},
+			}))
+			Expect(req.Messages[1].Content[0]).To(Equal(llm.ContentBlock{
+				Type: "tool_use", ToolUseID: "t1", ToolName: "read_file", ToolInput: map[string]any{"path": "a.txt"},
+			}))
+			Expect(req.Messages[2].Content[0]).To(Equal(llm.ContentBlock{
+				Type: "tool_result", ToolResultID: "t1", ToolOutput: `{"color":"red"}`, IsError: true,
+			}))
+		})
+
+		It("parses InvokeModel requests in the Messages API format", func() {
+			payload := []byte(`{
+				"anthropic_version": "bedrock-2023-05-31",
+				"max_tokens": 100,
+				"messages": [{"role": "user", "content": "Hi"}]
+			}`)
+
+			req, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Messages[0].GetText()).To(Equal("Hi"))
+			Expect(*req.MaxTokens).To(Equal(100))
+			Expect(req.Extra).To(HaveKeyWithValue("anthropic_version", "bedrock-2023-05-31"))
+		})
+	})
+
+	Describe("ParseResponse", func() {
+		It("parses Converse responses", func() {
+			payload := []byte(`{
+				"output": {"message": {"role": "assistant", "content": [{"text": "Blue."}]}},
+				"stopReason": "end_turn",
+				"usage": {"inputTokens": 10, "outputTokens": 2, "totalTokens": 17, "cacheReadInputTokens": 5},
+				"metrics": {"latencyMs": 321}
+			}`)
+
+			resp, err := p.ParseResponse(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Message.Role).To(Equal("assistant"))
+			Expect(resp.Message.GetText()).To(Equal("Blue."))
+			Expect(resp.StopReason).To(Equal("end_turn"))
+			Expect(resp.Usage.PromptTokens).To(Equal(15))
+			Expect(resp.Usage.CacheReadInputTokens).To(Equal(5))
+			Expect(resp.Usage.CompletionTokens).To(Equal(2))
+			Expect(resp.Extra).To(HaveKeyWithValue("latency_ms", int64(321)))
+		})
+
+		It("parses InvokeModel responses in the Messages API format", func() {
+			payload := []byte(`{
+				"id": "msg_1", "type": "message", "role": "assistant",
+				"model": "claude-3-5-haiku-20241022",
+				"content": [{"type": "text", "text": "Hello"}],
+				"stop_reason": "end_turn",
+				"usage": {"input_tokens": 3, "output_tokens": 1}
+			}`)
+
+			resp, err := p.ParseResponse(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Message.GetText()).To(Equal("Hello"))
+			Expect(resp.Model).To(Equal("claude-3-5-haiku-20241022"))
+		})
+	})
+
+	Describe("ParseStreamChunk", func() {
+		It("parses ConverseStream events", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"contentBlockDelta":{"delta":{"text":"Hi"},"contentBlockIndex":0}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Message.GetText()).To(Equal("Hi"))
+
+			chunk, err = p.ParseStreamChunk([]byte(`{"contentBlockStart":{"start":{"toolUse":{"toolUseId":"t1","name":"ls"}},"contentBlockIndex":1}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Index).To(Equal(1))
+			Expect(chunk.Message.Content[0].ToolName).To(Equal("ls"))
+
+			chunk, err = p.ParseStreamChunk([]byte(`{"messageStop":{"stopReason":"tool_use"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.StopReason).To(Equal("tool_use"))
+
+			chunk, err = p.ParseStreamChunk([]byte(`{"metadata":{"usage":{"inputTokens":4,"outputTokens":6,"totalTokens":10},"metrics":{"latencyMs":80}}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Usage.PromptTokens).To(Equal(4))
+			Expect(chunk.Usage.CompletionTokens).To(Equal(6))
+
+			chunk, err = p.ParseStreamChunk([]byte(`{"contentBlockStop":{"contentBlockIndex":0}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk).To(BeNil())
+		})
+
+		It("decodes InvokeModelWithResponseStream chunks", func() {
+			event := base64.StdEncoding.EncodeToString([]byte(`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hey"}}`))
+			chunk, err := p.ParseStreamChunk([]byte(`{"chunk":{"bytes":"` + event + `"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Message.GetText()).To(Equal("Hey"))
+		})
+	})
+
+	Describe("Serialization", func() {
+		response := func() *llm.ChatResponse {
+			return &llm.ChatResponse{
+				Message: llm.Message{Role: "assistant", Content: []llm.ContentBlock{
+					{Type: "text", Text: "A red square."},
+					{Type: "tool_use", ToolUseID: "t2", ToolName: "write_file", ToolInput: map[string]any{"path": "b.txt"}},
+				}},
+				StopReason: "tool_calls",
+				Usage:      &llm.Usage{PromptTokens: 40, CompletionTokens: 12, TotalTokens: 52},
+			}
+		}
+
+		It("round-trips requests", func() {
+			original := &llm.ChatRequest{
+				System:    "Be brief.",
+				MaxTokens: intPtr(64),
+				Messages: []llm.Message{
+					{Role: "user", Content: []llm.ContentBlock{{Type: "text", Text: "List files."}}},
+					{Role: "assistant", Content: []llm.ContentBlock{{Type: "tool_use", ToolUseID: "t1", ToolName: "ls", ToolInput: map[string]any{}}}},
+					{Role: "tool", Content: []llm.ContentBlock{{Type: "tool_result", ToolResultID: "t1", ToolOutput: "a.txt"}}},
+				},
+			}
+
+			payload, err := p.SerializeRequest(original)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(payload).NotTo(ContainSubstring(`"model"`))
+
+			parsed, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.System).To(Equal("Be brief."))
+			Expect(*parsed.MaxTokens).To(Equal(64))
+			Expect(parsed.Messages[2].Role).To(Equal("user"))
+			Expect(parsed.Messages[2].Content).To(Equal(original.Messages[2].Content))
+		})
+
+		It("round-trips responses", func() {
+			payload, err := p.SerializeResponse(response())
+			Expect(err).NotTo(HaveOccurred())
+
+			var wire map[string]any
+			Expect(json.Unmarshal(payload, &wire)).To(Succeed())
+			Expect(wire).To(HaveKeyWithValue("stopReason", "tool_use"))
+
+			parsed, err := p.ParseResponse(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Message.Content).To(Equal(response().Message.Content))
+			Expect(parsed.Usage.PromptTokens).To(Equal(40))
+			Expect(parsed.Usage.CompletionTokens).To(Equal(12))
+		})
+
+		It("round-trips streamed responses through the event-stream encoding", func() {
+			stream, err := p.SerializeStream(response())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.StreamContentType()).To(Equal(eventstream.ContentType))
+
+			var text string
+			var tool llm.ContentBlock
+			var usage *llm.Usage
+			var stopReason string
+
+			r := eventstream.NewTeeReader(bytes.NewReader(stream), &bytes.Buffer{})
+			for {
+				msg, err := r.Next()
+				Expect(err).NotTo(HaveOccurred())
+				if msg == nil {
+					break
+				}
+				chunk, err := p.ParseStreamChunk(wrapEvent(msg))
+				Expect(err).NotTo(HaveOccurred())
+				if chunk == nil {
+					continue
+				}
+				text += chunk.Message.GetText()
+				for _, cb := range chunk.Message.Content {
+					if cb.ToolUseID != "" {
+						tool = cb
+					} else if cb.ToolInput != nil {
+						tool.ToolInput = cb.ToolInput
+					}
+				}
+				if chunk.Usage != nil {
+					usage = chunk.Usage
+				}
+				if chunk.StopReason != "" {
+					stopReason = chunk.StopReason
+				}
+			}
+
+			Expect(text).To(Equal("A red square."))
+			Expect(tool.ToolName).To(Equal("write_file"))
+			Expect(tool.ToolInput).To(Equal(map[string]any{"path": "b.txt"}))
+			Expect(stopReason).To(Equal("tool_use"))
+			Expect(usage.PromptTokens).To(Equal(40))
+			Expect(usage.CompletionTokens).To(Equal(12))
+		})
+	})
+})
@@ -0,0 +1,261 @@
+package bedrock
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/papercomputeco/tapes/pkg/eventstream"
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// SerializeRequest renders a ChatRequest as a Converse request body. The
+// model is not part of the body; it belongs in the /model/{modelId}/converse
+// path. System messages are hoisted into the system blocks and tool results
+// are sent as user turns, as Converse expects.
+func (p *Provider) SerializeRequest(req *llm.ChatRequest) ([]byte, error) {
+	var system []converseSystemBlock
+	if req.System != "" {
+		system = append(system, converseSystemBlock{Text: req.System})
+	}
+
+	messages := make([]converseMessage, 0, len(req.Messages))
+	for _, msg := range llm.PairToolResults(req.Messages) {
+		role := msg.Role
+		switch role {
+		case "system":
+			if text := msg.GetText(); text != "" {
+				system = append(system, converseSystemBlock{Text: text})
+			}
+			continue
+		case "tool":
+			role = "user"
+		}
+
+		blocks := serializeContent(msg.Content)
+
+		// Converse expects alternating turns, so fold consecutive messages
+		// from the same role.
+		if n := len(messages); n > 0 && messages[n-1].Role == role {
+			messages[n-1].Content = append(messages[n-1].Content, blocks...)
+			continue
+		}
+		messages = append(messages, converseMessage{Role: role, Content: blocks})
+	}
+
+	out := converseRequest{
+		Messages: messages,
+		System:   system,
+	}
+	if req.MaxTokens != nil || req.Temperature != nil || req.TopP != nil || len(req.Stop) > 0 {
+		out.InferenceConfig = &inferenceConfig{
+			MaxTokens:     req.MaxTokens,
+			Temperature:   req.Temperature,
+			TopP:          req.TopP,
+			StopSequences: req.Stop,
+		}
+	}
+	if len(req.Tools) > 0 {
+		out.ToolConfig = serializeToolConfig(req.Tools, req.ToolChoice)
+	}
+	if fields, ok := req.Extra["additionalModelRequestFields"].(map[string]any); ok {
+		out.AdditionalModelRequestFields = fields
+	}
+
+	return json.Marshal(out)
+}
+
+// serializeToolConfig converts tool definitions and a tool choice to
+// Converse's toolConfig. Converse has no choice of none, so it falls back to
+// auto.
+func serializeToolConfig(tools []llm.Tool, choice *llm.ToolChoice) *toolConfig {
+	cfg := &toolConfig{Tools: make([]converseTool, 0, len(tools))}
+	for _, tool := range tools {
+		spec := &toolSpec{Name: tool.Name, Description: tool.Description}
+		spec.InputSchema.JSON = tool.InputSchema
+		if spec.InputSchema.JSON == nil {
+			spec.InputSchema.JSON = map[string]any{"type": "object"}
+		}
+		cfg.Tools = append(cfg.Tools, converseTool{ToolSpec: spec})
+	}
+
+	if choice != nil {
+		switch choice.Type {
+		case "any":
+			cfg.ToolChoice = &toolChoice{Any: &struct{}{}}
+		case "tool":
+			cfg.ToolChoice = &toolChoice{Tool: &specificToolChoice{Name: choice.Name}}
+		default:
+			cfg.ToolChoice = &toolChoice{Auto: &struct{}{}}
+		}
+	}
+	return cfg
+}
+
+// serializeContent converts content blocks to their Converse wire shape,
+// dropping blocks with nothing to send.
+func serializeContent(content []llm.ContentBlock) []converseContentBlock {
+	blocks := make([]converseContentBlock, 0, len(content))
+	for _, cb := range content {
+		switch cb.Type {
+		case "tool_use":
+			input := cb.ToolInput
+			if input == nil {
+				input = map[string]any{}
+			}
+			blocks = append(blocks, converseContentBlock{ToolUse: &converseToolUse{
+				ToolUseID: cb.ToolUseID,
+				Name:      cb.ToolName,
+				Input:     input,
+			}})
+		case "tool_result":
+			result := &converseToolResult{
+				ToolUseID: cb.ToolResultID,
+				Content:   []converseToolResultContent{{Text: cb.ToolOutput}},
+			}
+			if cb.IsError {
+				result.Status = "error"
+			}
+			blocks = append(blocks, converseContentBlock{ToolResult: result})
+		case "image":
+			if image := serializeImage(cb); image != nil {
+				blocks = append(blocks, converseContentBlock{Image: image})
+			}
+		default:
+			if cb.Text != "" {
+				blocks = append(blocks, converseContentBlock{Text: cb.Text})
+			}
+		}
+	}
+	return blocks
+}
+
+// serializeImage builds a Converse image from base64 data or a data URL.
+// Converse does not accept remote image URLs, so those are dropped.
+func serializeImage(cb llm.ContentBlock) *converseImage {
+	mediaType, data := cb.MediaType, cb.ImageBase64
+	if data == "" {
+		rest, ok := strings.CutPrefix(cb.ImageURL, "data:")
+		if !ok {
+			return nil
+		}
+		header, encoded, ok := strings.Cut(rest, ",")
+		if !ok {
+			return nil
+		}
+		mediaType, data = strings.TrimSuffix(header, ";base64"), encoded
+	}
+
+	format := strings.TrimPrefix(mediaType, "image/")
+	if format == "" {
+		format = "png"
+	}
+	return &converseImage{Format: format, Source: converseImageSource{Bytes: data}}
+}
+
+// stopReason maps stop reasons from other providers onto Converse's vocabulary.
+func stopReason(reason string) string {
+	switch reason {
+	case "stop", "":
+		return "end_turn"
+	case "length":
+		return "max_tokens"
+	case "tool_calls", "function_call":
+		return "tool_use"
+	default:
+		return reason
+	}
+}
+
+// serializeUsage renders usage in Converse's format, stripping cached
+// tokens back out of the prompt tokens (see parseUsage).
+func serializeUsage(u *llm.Usage) converseUsage {
+	if u == nil {
+		return converseUsage{}
+	}
+	input := u.PromptTokens - u.CacheCreationInputTokens - u.CacheReadInputTokens
+	return converseUsage{
+		InputTokens:           input,
+		OutputTokens:          u.CompletionTokens,
+		TotalTokens:           u.PromptTokens + u.CompletionTokens,
+		CacheReadInputTokens:  u.CacheReadInputTokens,
+		CacheWriteInputTokens: u.CacheCreationInputTokens,
+	}
+}
+
+// SerializeResponse renders a ChatResponse as a Converse response body.
+func (p *Provider) SerializeResponse(resp *llm.ChatResponse) ([]byte, error) {
+	usage := serializeUsage(resp.Usage)
+	return json.Marshal(converseResponse{
+		Output: &converseOutput{Message: &converseMessage{
+			Role:    "assistant",
+			Content: serializeContent(resp.Message.Content),
+		}},
+		StopReason: stopReason(resp.StopReason),
+		Usage:      &usage,
+		Metrics:    &converseMetrics{},
+	})
+}
+
+// StreamContentType is the Content-Type of ConverseStream responses.
+func (p *Provider) StreamContentType() string {
+	return eventstream.ContentType
+}
+
+// SerializeStream renders a complete response as a ConverseStream event
+// stream: messageStart, the events of each content block, messageStop and
+// metadata.
+func (p *Provider) SerializeStream(resp *llm.ChatResponse) ([]byte, error) {
+	type event struct {
+		eventType string
+		payload   any
+	}
+
+	events := []event{{"messageStart", messageStartEvent{Role: "assistant"}}}
+	for i, block := range serializeContent(resp.Message.Content) {
+		switch {
+		case block.ToolUse != nil:
+			input, err := json.Marshal(block.ToolUse.Input)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events,
+				event{"contentBlockStart", contentBlockStartEvent{
+					Start:             contentBlockStart{ToolUse: &converseToolUse{ToolUseID: block.ToolUse.ToolUseID, Name: block.ToolUse.Name}},
+					ContentBlockIndex: i,
+				}},
+				event{"contentBlockDelta", contentBlockDeltaEvent{
+					Delta:             contentBlockDelta{ToolUse: &toolUseDelta{Input: string(input)}},
+					ContentBlockIndex: i,
+				}},
+			)
+		case block.Text != "":
+			events = append(events, event{"contentBlockDelta", contentBlockDeltaEvent{
+				Delta:             contentBlockDelta{Text: block.Text},
+				ContentBlockIndex: i,
+			}})
+		default:
+			continue
+		}
+		events = append(events, event{"contentBlockStop", contentBlockStopEvent{ContentBlockIndex: i}})
+	}
+
+	usage := serializeUsage(resp.Usage)
+	events = append(events,
+		event{"messageStop", messageStopEvent{StopReason: stopReason(resp.StopReason)}},
+		event{"metadata", metadataEvent{Usage: &usage, Metrics: &converseMetrics{}}},
+	)
+
+	var out []byte
+	for _, ev := range events {
+		payload, err := json.Marshal(ev.payload)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := eventstream.EncodeEvent(ev.eventType, payload)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, encoded...)
+	}
+	return out, nil
+}
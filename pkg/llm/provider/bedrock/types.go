@@ -0,0 +1,178 @@
+package bedrock
+
+// converseRequest represents a Converse / ConverseStream request body. The
+// model ID is carried by the URL path, not the body.
+type converseRequest struct {
+	Messages        []converseMessage     `json:"messages"`
+	System          []converseSystemBlock `json:"system,omitempty"`
+	InferenceConfig *inferenceConfig      `json:"inferenceConfig,omitempty"`
+	ToolConfig      *toolConfig           `json:"toolConfig,omitempty"`
+
+	AdditionalModelRequestFields map[string]any `json:"additionalModelRequestFields,omitempty"`
+}
+
+// converseMessage represents a message in the Converse format.
+type converseMessage struct {
+	Role    string                 `json:"role"`
+	Content []converseContentBlock `json:"content"`
+}
+
+// converseContentBlock is a union: exactly one field is set.
+type converseContentBlock struct {
+	Text       string              `json:"text,omitempty"`
+	Image      *converseImage      `json:"image,omitempty"`
+	ToolUse    *converseToolUse    `json:"toolUse,omitempty"`
+	ToolResult *converseToolResult `json:"toolResult,omitempty"`
+}
+
+type converseSystemBlock struct {
+	Text string `json:"text,omitempty"`
+}
+
+type converseImage struct {
+	// Format is the image format, e.g. "png" or "jpeg".
+	Format string              `json:"format"`
+	Source converseImageSource `json:"source"`
+}
+
+type converseImageSource struct {
+	// Bytes is the base64-encoded image.
+	Bytes string `json:"bytes"`
+}
+
+type converseToolUse struct {
+	ToolUseID string         `json:"toolUseId"`
+	Name      string         `json:"name"`
+	Input     map[string]any `json:"input"`
+}
+
+type converseToolResult struct {
+	ToolUseID string                      `json:"toolUseId"`
+	Content   []converseToolResultContent `json:"content"`
+	Status    string                      `json:"status,omitempty"`
+}
+
+type converseToolResultContent struct {
+	Text string `json:"text,omitempty"`
+	JSON any    `json:"json,omitempty"`
+}
+
+type inferenceConfig struct {
+	MaxTokens     *int     `json:"maxTokens,omitempty"`
+	Temperature   *float64 `json:"temperature,omitempty"`
+	TopP          *float64 `json:"topP,omitempty"`
+	StopSequences []string `json:"stopSequences,omitempty"`
+}
+
+// toolConfig is the tool definitions and tool choice of a Converse request.
+type toolConfig struct {
+	Tools      []converseTool `json:"tools"`
+	ToolChoice *toolChoice    `json:"toolChoice,omitempty"`
+}
+
+type converseTool struct {
+	ToolSpec *toolSpec `json:"toolSpec,omitempty"`
+}
+
+type toolSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	InputSchema struct {
+		JSON map[string]any `json:"json"`
+	} `json:"inputSchema"`
+}
+
+// toolChoice is a union: exactly one field is set.
+type toolChoice struct {
+	Auto *struct{}           `json:"auto,omitempty"`
+	Any  *struct{}           `json:"any,omitempty"`
+	Tool *specificToolChoice `json:"tool,omitempty"`
+}
+
+type specificToolChoice struct {
+	Name string `json:"name"`
+}
+
+// converseResponse represents a Converse response body.
+type converseResponse struct {
+	Output     *converseOutput  `json:"output,omitempty"`
+	StopReason string           `json:"stopReason,omitempty"`
+	Usage      *converseUsage   `json:"usage,omitempty"`
+	Metrics    *converseMetrics `json:"metrics,omitempty"`
+}
+
+type converseOutput struct {
+	Message *converseMessage `json:"message,omitempty"`
+}
+
+type converseUsage struct {
+	InputTokens           int `json:"inputTokens"`
+	OutputTokens          int `json:"outputTokens"`
+	TotalTokens           int `json:"totalTokens"`
+	CacheReadInputTokens  int `json:"cacheReadInputTokens,omitempty"`
+	CacheWriteInputTokens int `json:"cacheWriteInputTokens,omitempty"`
+}
+
+type converseMetrics struct {
+	LatencyMs int64 `json:"latencyMs"`
+}
+
+// converseStreamEvent is a union of the ConverseStream events, keyed by the
+// event's :event-type header, plus the "chunk" event of
+// InvokeModelWithResponseStream. See Provider.ParseStreamChunk.
+type converseStreamEvent struct {
+	MessageStart      *messageStartEvent      `json:"messageStart,omitempty"`
+	ContentBlockStart *contentBlockStartEvent `json:"contentBlockStart,omitempty"`
+	ContentBlockDelta *contentBlockDeltaEvent `json:"contentBlockDelta,omitempty"`
+	ContentBlockStop  *contentBlockStopEvent  `json:"contentBlockStop,omitempty"`
+	MessageStop       *messageStopEvent       `json:"messageStop,omitempty"`
+	Metadata          *metadataEvent          `json:"metadata,omitempty"`
+	Chunk             *chunkEvent             `json:"chunk,omitempty"`
+}
+
+type messageStartEvent struct {
+	Role string `json:"role"`
+}
+
+type contentBlockStartEvent struct {
+	Start             contentBlockStart `json:"start"`
+	ContentBlockIndex int               `json:"contentBlockIndex"`
+}
+
+type contentBlockStart struct {
+	ToolUse *converseToolUse `json:"toolUse,omitempty"`
+}
+
+type contentBlockDeltaEvent struct {
+	Delta             contentBlockDelta `json:"delta"`
+	ContentBlockIndex int               `json:"contentBlockIndex"`
+}
+
+type contentBlockDelta struct {
+	Text    string        `json:"text,omitempty"`
+	ToolUse *toolUseDelta `json:"toolUse,omitempty"`
+}
+
+// toolUseDelta carries a fragment of the tool input JSON.
+type toolUseDelta struct {
+	Input string `json:"input"`
+}
+
+type contentBlockStopEvent struct {
+	ContentBlockIndex int `json:"contentBlockIndex"`
+}
+
+type messageStopEvent struct {
+	StopReason string `json:"stopReason"`
+}
+
+type metadataEvent struct {
+	Usage   *converseUsage   `json:"usage,omitempty"`
+	Metrics *converseMetrics `json:"metrics,omitempty"`
+}
+
+// chunkEvent wraps a model-native stream event of InvokeModelWithResponseStream.
+type chunkEvent struct {
+	// Bytes is the base64-encoded model event, decoded by encoding/json.
+	Bytes []byte `json:"bytes"`
+}
@@ -0,0 +1,35 @@
+package provider
+
+import "github.com/papercomputeco/tapes/pkg/llm/provider/openai"
+
+// Compatible is an OpenAI-compatible provider (Azure OpenAI, vLLM, LM Studio,
+// OpenRouter, ...). It parses the OpenAI wire format but is recorded under its
+// own name, so its traffic and pricing stay distinguishable from OpenAI's.
+type Compatible struct {
+	*openai.Provider
+	name string
+}
+
+// NewCompatible creates an OpenAI-compatible provider named name.
+func NewCompatible(name string) *Compatible {
+	return &Compatible{Provider: openai.New(), name: name}
+}
+
+// Name returns the configured provider name.
+func (c *Compatible) Name() string {
+	return c.name
+}
+
+// Format returns the wire format the provider speaks.
+func (c *Compatible) Format() string {
+	return OpenAI
+}
+
+// Format returns the wire format spoken by p: the underlying format of an
+// OpenAI-compatible provider, or the name of a built-in provider.
+func Format(p Provider) string {
+	if f, ok := p.(interface{ Format() string }); ok {
+		return f.Format()
+	}
+	return p.Name()
+}
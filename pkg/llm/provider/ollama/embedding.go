@@ -0,0 +1,85 @@
+package ollama
+
+import (
+	"encoding/json"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// ollamaEmbeddingRequest covers both /api/embed ("input") and the legacy
+// /api/embeddings ("prompt") request formats.
+type ollamaEmbeddingRequest struct {
+	Model  string `json:"model"`
+	Input  any    `json:"input,omitempty"` // string or []string
+	Prompt string `json:"prompt,omitempty"`
+}
+
+// ollamaEmbeddingResponse covers both /api/embed ("embeddings") and the
+// legacy /api/embeddings ("embedding") response formats.
+type ollamaEmbeddingResponse struct {
+	Model           string      `json:"model"`
+	Embeddings      [][]float64 `json:"embeddings,omitempty"`
+	Embedding       []float64   `json:"embedding,omitempty"`
+	TotalDuration   int64       `json:"total_duration,omitempty"`
+	PromptEvalCount int         `json:"prompt_eval_count,omitempty"`
+}
+
+// IsEmbeddingPath matches /api/embed and the legacy /api/embeddings.
+func (o *Provider) IsEmbeddingPath(path string) bool {
+	return path == "/api/embed" || path == "/api/embeddings"
+}
+
+func (o *Provider) ParseEmbeddingRequest(payload []byte) (*llm.EmbeddingRequest, error) {
+	var req ollamaEmbeddingRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+
+	result := &llm.EmbeddingRequest{Model: req.Model}
+	switch input := req.Input.(type) {
+	case string:
+		result.Input = []string{input}
+	case []any:
+		for _, item := range input {
+			if text, ok := item.(string); ok {
+				result.Input = append(result.Input, text)
+			}
+		}
+	case nil:
+		if req.Prompt != "" {
+			result.Input = []string{req.Prompt}
+		}
+	}
+	result.InputCount = len(result.Input)
+
+	return result, nil
+}
+
+func (o *Provider) ParseEmbeddingResponse(payload []byte) (*llm.EmbeddingResponse, error) {
+	var resp ollamaEmbeddingResponse
+	if err := json.Unmarshal(payload, &resp); err != nil {
+		return nil, err
+	}
+
+	embeddings := resp.Embeddings
+	if len(embeddings) == 0 && len(resp.Embedding) > 0 {
+		embeddings = [][]float64{resp.Embedding}
+	}
+
+	result := &llm.EmbeddingResponse{
+		Model: resp.Model,
+		Count: len(embeddings),
+	}
+	if len(embeddings) > 0 {
+		result.Dimensions = len(embeddings[0])
+	}
+	if resp.PromptEvalCount > 0 || resp.TotalDuration > 0 {
+		result.Usage = &llm.Usage{
+			PromptTokens:    resp.PromptEvalCount,
+			TotalTokens:     resp.PromptEvalCount,
+			TotalDurationNs: resp.TotalDuration,
+		}
+	}
+
+	return result, nil
+}
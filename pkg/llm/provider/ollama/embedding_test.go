@@ -0,0 +1,56 @@
+package ollama_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+	"github.com/papercomputeco/tapes/pkg/llm/provider/ollama"
+)
+
+var _ = Describe("Ollama Embeddings", func() {
+	var p provider.EmbeddingParser
+
+	BeforeEach(func() {
+		p = ollama.New()
+	})
+
+	It("matches /api/embed and the legacy /api/embeddings", func() {
+		Expect(p.IsEmbeddingPath("/api/embed")).To(BeTrue())
+		Expect(p.IsEmbeddingPath("/api/embeddings")).To(BeTrue())
+		Expect(p.IsEmbeddingPath("/api/chat")).To(BeFalse())
+	})
+
+	It("parses /api/embed requests and responses", func() {
+		req, err := p.ParseEmbeddingRequest([]byte(`{"model": "nomic-embed-text", "input": ["a", "b"]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.Model).To(Equal("nomic-embed-text"))
+		Expect(req.Input).To(Equal([]string{"a", "b"}))
+		Expect(req.InputCount).To(Equal(2))
+
+		resp, err := p.ParseEmbeddingResponse([]byte(`{
+			"model": "nomic-embed-text",
+			"embeddings": [[0.1, 0.2], [0.3, 0.4]],
+			"total_duration": 14143917,
+			"prompt_eval_count": 6
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Count).To(Equal(2))
+		Expect(resp.Dimensions).To(Equal(2))
+		Expect(resp.Usage.PromptTokens).To(Equal(6))
+		Expect(resp.Usage.TotalDurationNs).To(Equal(int64(14143917)))
+	})
+
+	It("parses legacy /api/embeddings requests and responses", func() {
+		req, err := p.ParseEmbeddingRequest([]byte(`{"model": "nomic-embed-text", "prompt": "hello"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.Input).To(Equal([]string{"hello"}))
+		Expect(req.InputCount).To(Equal(1))
+
+		resp, err := p.ParseEmbeddingResponse([]byte(`{"embedding": [0.1, 0.2, 0.3]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Count).To(Equal(1))
+		Expect(resp.Dimensions).To(Equal(3))
+		Expect(resp.Usage).To(BeNil())
+	})
+})
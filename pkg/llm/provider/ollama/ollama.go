@@ -26,6 +26,10 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		return nil, err
 	}
 
+	if len(req.Messages) == 0 && req.Prompt != "" {
+		return parseGenerateRequest(&req, payload), nil
+	}
+
 	messages := make([]llm.Message, 0, len(req.Messages))
 	for _, msg := range req.Messages {
 		converted := llm.Message{
@@ -65,7 +69,63 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		Stream:     req.Stream,
 		RawRequest: payload,
 	}
+	applyRequestOptions(&req, result)
+	for _, tool := range req.Tools {
+		result.Tools = append(result.Tools, llm.Tool{
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			InputSchema: tool.Function.Parameters,
+		})
+	}
+
+	return result, nil
+}
+
+// parseGenerateRequest converts an /api/generate request into the internal
+// format: the prompt becomes a single user message, and the returned context
+// tokens it passes back link it to the response it continues.
+func parseGenerateRequest(req *ollamaRequest, payload []byte) *llm.ChatRequest {
+	content := []llm.ContentBlock{{Type: "text", Text: req.Prompt}}
+	for _, img := range req.Images {
+		content = append(content, llm.ContentBlock{
+			Type:        "image",
+			ImageBase64: img,
+		})
+	}
+
+	result := &llm.ChatRequest{
+		Model:      req.Model,
+		Messages:   []llm.Message{{Role: "user", Content: content}},
+		Stream:     req.Stream,
+		System:     req.System,
+		Completion: true,
+		Context:    req.Context,
+		RawRequest: payload,
+	}
+	applyRequestOptions(req, result)
+
+	// Preserve the prompt templating settings
+	if req.Raw != nil || req.Template != "" || req.Suffix != "" {
+		if result.Extra == nil {
+			result.Extra = make(map[string]any)
+		}
+		if req.Raw != nil {
+			result.Extra["raw"] = *req.Raw
+		}
+		if req.Template != "" {
+			result.Extra["template"] = req.Template
+		}
+		if req.Suffix != "" {
+			result.Extra["suffix"] = req.Suffix
+		}
+	}
 
+	return result
+}
+
+// applyRequestOptions maps options shared by /api/chat and /api/generate onto
+// the internal request.
+func applyRequestOptions(req *ollamaRequest, result *llm.ChatRequest) {
 	// Map options to common fields
 	if req.Options != nil {
 		result.Temperature = req.Options.Temperature
@@ -101,8 +161,6 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		}
 		result.Extra["keep_alive"] = req.KeepAlive
 	}
-
-	return result, nil
 }
 
 func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
@@ -119,6 +177,15 @@ func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 		content = append(content, llm.ContentBlock{Type: "text", Text: resp.Message.Content})
 	}
 
+	// /api/generate responses carry the completion in "response"
+	role := resp.Message.Role
+	if resp.Response != nil {
+		role = "assistant"
+		if *resp.Response != "" {
+			content = append(content, llm.ContentBlock{Type: "text", Text: *resp.Response})
+		}
+	}
+
 	// Handle images in response (if any)
 	for _, img := range resp.Message.Images {
 		content = append(content, llm.ContentBlock{
@@ -158,13 +225,14 @@ func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 	result := &llm.ChatResponse{
 		Model: resp.Model,
 		Message: llm.Message{
-			Role:    resp.Message.Role,
+			Role:    role,
 			Content: content,
 		},
 		Done:        resp.Done,
 		StopReason:  stopReason,
 		Usage:       usage,
 		CreatedAt:   resp.CreatedAt,
+		Context:     resp.Context,
 		RawResponse: payload,
 	}
 
@@ -180,6 +248,25 @@ func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 	return result, nil
 }
 
-func (o *Provider) ParseStreamChunk(_ []byte) (*llm.StreamChunk, error) {
-	panic("Not yet implemented")
+// ParseStreamChunk converts a single NDJSON line of an Ollama stream into the
+// internal format. Every line shares the shape of a full response; the final
+// line has done=true and carries the metrics.
+func (o *Provider) ParseStreamChunk(payload []byte) (*llm.StreamChunk, error) {
+	resp, err := o.ParseResponse(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &llm.StreamChunk{
+		Model:     resp.Model,
+		CreatedAt: resp.CreatedAt,
+		Message:   resp.Message,
+		Done:      resp.Done,
+		Usage:     resp.Usage,
+	}
+	if resp.Done {
+		chunk.StopReason = resp.StopReason
+	}
+
+	return chunk, nil
 }
@@ -501,4 +501,65 @@ var _ = Describe("Ollama Provider", func() {
 			Expect(req.Messages[1].Content[0].ToolName).To(Equal("get_weather"))
 		})
 	})
+
+	Describe("/api/generate", func() {
+		It("parses the prompt as a single user message", func() {
+			payload := []byte(`{
+				"model": "llama3",
+				"prompt": "Why is the sky blue?",
+				"system": "Answer briefly.",
+				"template": "{{ .Prompt }}",
+				"raw": true,
+				"suffix": "END",
+				"context": [1, 2, 3],
+				"images": ["aGVsbG8="],
+				"stream": false,
+				"options": {"temperature": 0.2}
+			}`)
+
+			req, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Completion).To(BeTrue())
+			Expect(req.Model).To(Equal("llama3"))
+			Expect(req.System).To(Equal("Answer briefly."))
+			Expect(req.Messages).To(HaveLen(1))
+			Expect(req.Messages[0].Role).To(Equal("user"))
+			Expect(req.Messages[0].GetText()).To(Equal("Why is the sky blue?"))
+			Expect(req.Messages[0].Content).To(HaveLen(2))
+			Expect(req.Messages[0].Content[1].Type).To(Equal("image"))
+			Expect(req.Context).To(Equal([]int{1, 2, 3}))
+			Expect(*req.Temperature).To(Equal(0.2))
+			Expect(req.Extra).To(HaveKeyWithValue("raw", true))
+			Expect(req.Extra).To(HaveKeyWithValue("template", "{{ .Prompt }}"))
+			Expect(req.Extra).To(HaveKeyWithValue("suffix", "END"))
+		})
+
+		It("parses the completion and returned context", func() {
+			payload := []byte(`{
+				"model": "llama3",
+				"created_at": "2024-01-15T10:30:00Z",
+				"response": "Rayleigh scattering.",
+				"done": true,
+				"done_reason": "stop",
+				"context": [1, 2, 3, 4, 5],
+				"prompt_eval_count": 12,
+				"eval_count": 4
+			}`)
+
+			resp, err := p.ParseResponse(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Message.Role).To(Equal("assistant"))
+			Expect(resp.Message.GetText()).To(Equal("Rayleigh scattering."))
+			Expect(resp.StopReason).To(Equal("stop"))
+			Expect(resp.Context).To(Equal([]int{1, 2, 3, 4, 5}))
+			Expect(resp.Usage.TotalTokens).To(Equal(16))
+		})
+
+		It("parses streamed completion chunks", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"model":"llama3","response":"Ray","done":false}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Message.Role).To(Equal("assistant"))
+			Expect(chunk.Message.GetText()).To(Equal("Ray"))
+		})
+	})
 })
@@ -0,0 +1,177 @@
+package ollama
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// SerializeRequest renders a ChatRequest in Ollama's /api/chat format.
+// Sampling parameters are moved into "options"; Ollama has no separate
+// system field, so the system prompt becomes a leading system message.
+func (o *Provider) SerializeRequest(req *llm.ChatRequest) ([]byte, error) {
+	messages := make([]ollamaMessage, 0, len(req.Messages)+1)
+	if req.System != "" {
+		messages = append(messages, ollamaMessage{Role: "system", Content: req.System})
+	}
+	for _, msg := range req.Messages {
+		messages = append(messages, serializeMessage(msg)...)
+	}
+
+	out := ollamaRequest{
+		Model:    req.Model,
+		Messages: messages,
+		Stream:   req.Stream,
+	}
+	// Ollama has no tool_choice; a choice of none withholds the tools.
+	if req.ToolChoice == nil || req.ToolChoice.Type != "none" {
+		for _, tool := range req.Tools {
+			t := ollamaTool{Type: "function"}
+			t.Function.Name = tool.Name
+			t.Function.Description = tool.Description
+			t.Function.Parameters = tool.InputSchema
+			out.Tools = append(out.Tools, t)
+		}
+	}
+	if format, ok := req.Extra["format"].(string); ok {
+		out.Format = format
+	}
+	if keepAlive, ok := req.Extra["keep_alive"].(string); ok {
+		out.KeepAlive = keepAlive
+	}
+
+	if req.Temperature != nil || req.TopP != nil || req.TopK != nil || req.Seed != nil || req.MaxTokens != nil || len(req.Stop) > 0 {
+		out.Options = &ollamaOptions{
+			Temperature: req.Temperature,
+			TopP:        req.TopP,
+			TopK:        req.TopK,
+			Seed:        req.Seed,
+			NumPredict:  req.MaxTokens,
+			Stop:        req.Stop,
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// SerializeResponse renders a ChatResponse in Ollama's /api/chat format.
+func (o *Provider) SerializeResponse(resp *llm.ChatResponse) ([]byte, error) {
+	return json.Marshal(toOllamaResponse(resp))
+}
+
+// StreamContentType is the Content-Type of Ollama streams.
+func (o *Provider) StreamContentType() string {
+	return "application/x-ndjson"
+}
+
+// SerializeStream renders a complete response as Ollama NDJSON: one line
+// carrying the message, followed by a final done=true line with the metrics.
+func (o *Provider) SerializeStream(resp *llm.ChatResponse) ([]byte, error) {
+	final := toOllamaResponse(resp)
+
+	first := ollamaResponse{
+		Model:     final.Model,
+		CreatedAt: final.CreatedAt,
+		Message:   final.Message,
+	}
+	final.Message = ollamaMessage{Role: "assistant"}
+
+	var buf bytes.Buffer
+	for _, line := range []ollamaResponse{first, final} {
+		data, err := json.Marshal(line)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+// toOllamaResponse builds the wire response for a complete ChatResponse.
+func toOllamaResponse(resp *llm.ChatResponse) ollamaResponse {
+	msgs := serializeMessage(resp.Message)
+	message := ollamaMessage{Role: "assistant"}
+	if len(msgs) > 0 {
+		message = msgs[len(msgs)-1]
+		message.Role = "assistant"
+	}
+
+	createdAt := resp.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	out := ollamaResponse{
+		Model:      resp.Model,
+		CreatedAt:  createdAt,
+		Message:    message,
+		Done:       true,
+		DoneReason: doneReason(resp.StopReason),
+	}
+	if resp.Usage != nil {
+		out.PromptEvalCount = resp.Usage.PromptTokens
+		out.EvalCount = resp.Usage.CompletionTokens
+		out.TotalDuration = resp.Usage.TotalDurationNs
+		out.PromptEvalDuration = resp.Usage.PromptDurationNs
+	}
+
+	return out
+}
+
+// serializeMessage converts a message to Ollama messages. Tool results become
+// separate "tool" role messages since Ollama has no tool_result block.
+func serializeMessage(msg llm.Message) []ollamaMessage {
+	var out []ollamaMessage
+	converted := ollamaMessage{Role: msg.Role}
+	var text strings.Builder
+	hasContent := false
+
+	for _, cb := range msg.Content {
+		switch cb.Type {
+		case "tool_result":
+			out = append(out, ollamaMessage{Role: "tool", Content: cb.ToolOutput})
+		case "tool_use":
+			tc := ollamaToolCall{ID: cb.ToolUseID}
+			tc.Function.Name = cb.ToolName
+			tc.Function.Arguments = cb.ToolInput
+			converted.ToolCalls = append(converted.ToolCalls, tc)
+			hasContent = true
+		case "image":
+			if cb.ImageBase64 != "" {
+				converted.Images = append(converted.Images, cb.ImageBase64)
+			} else if _, data, ok := strings.Cut(cb.ImageURL, ";base64,"); ok {
+				converted.Images = append(converted.Images, data)
+			}
+			hasContent = true
+		default:
+			if cb.Text != "" {
+				text.WriteString(cb.Text)
+				hasContent = true
+			}
+		}
+	}
+
+	if !hasContent && len(out) > 0 {
+		return out
+	}
+
+	converted.Content = text.String()
+	return append(out, converted)
+}
+
+// doneReason maps stop reasons from other providers onto Ollama's vocabulary.
+func doneReason(reason string) string {
+	switch reason {
+	case "end_turn", "stop_sequence", "tool_use", "tool_calls", "":
+		return "stop"
+	case "max_tokens":
+		return "length"
+	default:
+		return reason
+	}
+}
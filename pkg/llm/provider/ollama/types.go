@@ -11,6 +11,27 @@ type ollamaRequest struct {
 	Format    string          `json:"format,omitempty"`
 	KeepAlive string          `json:"keep_alive,omitempty"`
 	Options   *ollamaOptions  `json:"options,omitempty"`
+	Tools     []ollamaTool    `json:"tools,omitempty"`
+
+	// /api/generate fields
+	Prompt   string   `json:"prompt,omitempty"`
+	Suffix   string   `json:"suffix,omitempty"`
+	System   string   `json:"system,omitempty"`
+	Template string   `json:"template,omitempty"`
+	Context  []int    `json:"context,omitempty"`
+	Raw      *bool    `json:"raw,omitempty"`
+	Images   []string `json:"images,omitempty"`
+}
+
+// ollamaTool represents a function tool definition, in the same shape as
+// OpenAI's.
+type ollamaTool struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name        string         `json:"name"`
+		Description string         `json:"description,omitempty"`
+		Parameters  map[string]any `json:"parameters,omitempty"`
+	} `json:"function"`
 }
 
 type ollamaMessage struct {
@@ -50,6 +71,7 @@ type ollamaResponse struct {
 	Model              string        `json:"model"`
 	CreatedAt          time.Time     `json:"created_at"`
 	Message            ollamaMessage `json:"message"`
+	Response           *string       `json:"response,omitempty"` // /api/generate only
 	Done               bool          `json:"done"`
 	DoneReason         string        `json:"done_reason,omitempty"`
 	Context            []int         `json:"context,omitempty"`
@@ -0,0 +1,86 @@
+package openai
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// openaiEmbeddingRequest represents OpenAI's embeddings request format.
+type openaiEmbeddingRequest struct {
+	Model string `json:"model"`
+	Input any    `json:"input"` // string, []string, []int or [][]int
+}
+
+// openaiEmbeddingResponse represents OpenAI's embeddings response format.
+type openaiEmbeddingResponse struct {
+	Model string `json:"model"`
+	Data  []struct {
+		Index     int `json:"index"`
+		Embedding any `json:"embedding"` // []float or a base64 string
+	} `json:"data"`
+	Usage *openaiUsage `json:"usage,omitempty"`
+}
+
+// IsEmbeddingPath matches the embeddings endpoint with or without the /v1
+// prefix, since the upstream base URL commonly includes it.
+func (o *Provider) IsEmbeddingPath(path string) bool {
+	return strings.TrimPrefix(path, "/v1") == "/embeddings"
+}
+
+func (o *Provider) ParseEmbeddingRequest(payload []byte) (*llm.EmbeddingRequest, error) {
+	var req openaiEmbeddingRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+
+	result := &llm.EmbeddingRequest{Model: req.Model}
+	switch input := req.Input.(type) {
+	case string:
+		result.Input = []string{input}
+		result.InputCount = 1
+	case []any:
+		if len(input) == 0 {
+			break
+		}
+		// A flat array of token IDs is a single pre-tokenized input.
+		if _, ok := input[0].(float64); ok {
+			result.InputCount = 1
+			break
+		}
+		result.InputCount = len(input)
+		for _, item := range input {
+			if text, ok := item.(string); ok {
+				result.Input = append(result.Input, text)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (o *Provider) ParseEmbeddingResponse(payload []byte) (*llm.EmbeddingResponse, error) {
+	var resp openaiEmbeddingResponse
+	if err := json.Unmarshal(payload, &resp); err != nil {
+		return nil, err
+	}
+
+	result := &llm.EmbeddingResponse{
+		Model: resp.Model,
+		Count: len(resp.Data),
+	}
+	if len(resp.Data) > 0 {
+		if vector, ok := resp.Data[0].Embedding.([]any); ok {
+			result.Dimensions = len(vector)
+		}
+	}
+	if resp.Usage != nil {
+		result.Usage = &llm.Usage{
+			PromptTokens: resp.Usage.PromptTokens,
+			TotalTokens:  resp.Usage.TotalTokens,
+		}
+	}
+
+	return result, nil
+}
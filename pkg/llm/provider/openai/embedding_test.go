@@ -0,0 +1,73 @@
+package openai_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+	"github.com/papercomputeco/tapes/pkg/llm/provider/openai"
+)
+
+var _ = Describe("OpenAI Embeddings", func() {
+	var p provider.EmbeddingParser
+
+	BeforeEach(func() {
+		p = openai.New()
+	})
+
+	It("matches the embeddings path with and without /v1", func() {
+		Expect(p.IsEmbeddingPath("/v1/embeddings")).To(BeTrue())
+		Expect(p.IsEmbeddingPath("/embeddings")).To(BeTrue())
+		Expect(p.IsEmbeddingPath("/v1/chat/completions")).To(BeFalse())
+	})
+
+	It("parses string and array inputs", func() {
+		req, err := p.ParseEmbeddingRequest([]byte(`{"model": "text-embedding-3-small", "input": "hello"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.Model).To(Equal("text-embedding-3-small"))
+		Expect(req.Input).To(Equal([]string{"hello"}))
+		Expect(req.InputCount).To(Equal(1))
+
+		req, err = p.ParseEmbeddingRequest([]byte(`{"model": "text-embedding-3-small", "input": ["a", "b", "c"]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.Input).To(Equal([]string{"a", "b", "c"}))
+		Expect(req.InputCount).To(Equal(3))
+	})
+
+	It("counts pre-tokenized inputs without text", func() {
+		req, err := p.ParseEmbeddingRequest([]byte(`{"model": "m", "input": [1, 2, 3]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.InputCount).To(Equal(1))
+		Expect(req.Input).To(BeEmpty())
+
+		req, err = p.ParseEmbeddingRequest([]byte(`{"model": "m", "input": [[1, 2], [3]]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.InputCount).To(Equal(2))
+		Expect(req.Input).To(BeEmpty())
+	})
+
+	It("parses the response count, dimensions and usage", func() {
+		resp, err := p.ParseEmbeddingResponse([]byte(`{
+			"object": "list",
+			"model": "text-embedding-3-small",
+			"data": [
+				{"object": "embedding", "index": 0, "embedding": [0.1, 0.2, 0.3]},
+				{"object": "embedding", "index": 1, "embedding": [0.4, 0.5, 0.6]}
+			],
+			"usage": {"prompt_tokens": 8, "total_tokens": 8}
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Model).To(Equal("text-embedding-3-small"))
+		Expect(resp.Count).To(Equal(2))
+		Expect(resp.Dimensions).To(Equal(3))
+		Expect(resp.Usage.PromptTokens).To(Equal(8))
+		Expect(resp.Usage.TotalTokens).To(Equal(8))
+	})
+
+	It("leaves dimensions unknown for base64 embeddings", func() {
+		resp, err := p.ParseEmbeddingResponse([]byte(`{"model": "m", "data": [{"index": 0, "embedding": "AAAA"}]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Count).To(Equal(1))
+		Expect(resp.Dimensions).To(BeZero())
+	})
+})
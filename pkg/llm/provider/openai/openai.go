@@ -3,6 +3,7 @@ package openai
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
@@ -49,7 +50,10 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 					if imageURL, ok := part["image_url"].(map[string]any); ok {
 						cb.Type = "image"
 						if url, ok := imageURL["url"].(string); ok {
-							cb.ImageURL = url
+							cb.MediaType, cb.ImageBase64, ok = parseDataURL(url)
+							if !ok {
+								cb.ImageURL = url
+							}
 						}
 					}
 					converted.Content = append(converted.Content, cb)
@@ -89,6 +93,15 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		messages = append(messages, converted)
 	}
 
+	// Legacy completions send a prompt instead of messages
+	completion := len(req.Messages) == 0 && req.Prompt != nil
+	batch := false
+	if completion {
+		prompt := parsePrompt(req.Prompt)
+		messages = append(messages, llm.Message{Role: "user", Content: prompt})
+		batch = len(prompt) > 1
+	}
+
 	// Parse stop sequences
 	var stop []string
 	switch s := req.Stop.(type) {
@@ -111,11 +124,25 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		Stop:        stop,
 		Seed:        req.Seed,
 		Stream:      req.Stream,
+		Completion:  completion,
+		Batch:       batch,
+		ToolChoice:  parseToolChoice(req.ToolChoice),
 		RawRequest:  payload,
 	}
+	for _, tool := range req.Tools {
+		result.Tools = append(result.Tools, llm.Tool{
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			InputSchema: tool.Function.Parameters,
+		})
+	}
+
+	if result.MaxTokens == nil {
+		result.MaxTokens = req.MaxCompletionTokens
+	}
 
 	// Preserve OpenAI-specific fields
-	if req.FrequencyPenalty != nil || req.PresencePenalty != nil || req.ResponseFormat != nil {
+	if req.FrequencyPenalty != nil || req.PresencePenalty != nil || req.ResponseFormat != nil || req.ReasoningEffort != "" || req.N != nil {
 		result.Extra = make(map[string]any)
 		if req.FrequencyPenalty != nil {
 			result.Extra["frequency_penalty"] = *req.FrequencyPenalty
@@ -126,11 +153,92 @@ func (o *Provider) ParseRequest(payload []byte) (*llm.ChatRequest, error) {
 		if req.ResponseFormat != nil {
 			result.Extra["response_format"] = req.ResponseFormat
 		}
+		if req.ReasoningEffort != "" {
+			result.Extra["reasoning_effort"] = req.ReasoningEffort
+		}
+		if req.N != nil {
+			result.Extra["n"] = *req.N
+		}
+	}
+
+	// Preserve legacy completions settings
+	if req.Suffix != "" || req.Echo != nil || req.BestOf != nil {
+		if result.Extra == nil {
+			result.Extra = make(map[string]any)
+		}
+		if req.Suffix != "" {
+			result.Extra["suffix"] = req.Suffix
+		}
+		if req.Echo != nil {
+			result.Extra["echo"] = *req.Echo
+		}
+		if req.BestOf != nil {
+			result.Extra["best_of"] = *req.BestOf
+		}
 	}
 
 	return result, nil
 }
 
+// parseToolChoice converts OpenAI's tool_choice, either "auto", "none",
+// "required" or a named function, into the internal form.
+func parseToolChoice(choice any) *llm.ToolChoice {
+	switch c := choice.(type) {
+	case string:
+		switch c {
+		case "auto", "none":
+			return &llm.ToolChoice{Type: c}
+		case "required":
+			return &llm.ToolChoice{Type: "any"}
+		}
+	case map[string]any:
+		if fn, ok := c["function"].(map[string]any); ok {
+			if name, ok := fn["name"].(string); ok {
+				return &llm.ToolChoice{Type: "tool", Name: name}
+			}
+		}
+	}
+	return nil
+}
+
+// parsePrompt converts a legacy completions prompt into text blocks. A batch
+// of prompts yields one block per prompt; pre-tokenized prompts have no text
+// and yield none.
+func parsePrompt(prompt any) []llm.ContentBlock {
+	switch p := prompt.(type) {
+	case string:
+		return []llm.ContentBlock{{Type: "text", Text: p}}
+	case []any:
+		content := []llm.ContentBlock{}
+		for _, item := range p {
+			if text, ok := item.(string); ok {
+				content = append(content, llm.ContentBlock{Type: "text", Text: text})
+			}
+		}
+		return content
+	}
+	return []llm.ContentBlock{}
+}
+
+// parseDataURL splits a base64 data URL ("data:image/png;base64,...") into
+// its media type and payload so inline images match other providers'
+// base64 image blocks.
+func parseDataURL(url string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(url, "data:")
+	if !ok {
+		return "", "", false
+	}
+	header, data, ok := strings.Cut(rest, ",")
+	if !ok {
+		return "", "", false
+	}
+	mediaType, ok := strings.CutSuffix(header, ";base64")
+	if !ok {
+		return "", "", false
+	}
+	return mediaType, data, true
+}
+
 func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 	var resp openaiResponse
 	if err := json.Unmarshal(payload, &resp); err != nil {
@@ -147,9 +255,58 @@ func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 	}
 
 	choice := resp.Choices[0]
-	msg := choice.Message
+	var usage *llm.Usage
+	if resp.Usage != nil {
+		usage = &llm.Usage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+			TotalTokens:      resp.Usage.TotalTokens,
+		}
+		if resp.Usage.PromptTokensDetails != nil {
+			usage.CacheReadInputTokens = resp.Usage.PromptTokensDetails.CachedTokens
+		}
+	}
 
-	// Convert message content
+	result := &llm.ChatResponse{
+		Model:       resp.Model,
+		Message:     convertChoiceMessage(choice.Message, choice.Text),
+		Done:        true,
+		StopReason:  choice.FinishReason,
+		Usage:       usage,
+		CreatedAt:   time.Unix(resp.Created, 0),
+		RawResponse: payload,
+		Extra: map[string]any{
+			"id":     resp.ID,
+			"object": resp.Object,
+		},
+	}
+
+	// Multiple choices (n > 1) are kept so each can be stored as a sibling.
+	if len(resp.Choices) > 1 {
+		result.Choices = make([]llm.Choice, 0, len(resp.Choices))
+		for _, c := range resp.Choices {
+			result.Choices = append(result.Choices, llm.Choice{
+				Index:      c.Index,
+				Message:    convertChoiceMessage(c.Message, c.Text),
+				StopReason: c.FinishReason,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// convertChoiceMessage converts a response choice: a chat message, or the
+// completed text of a legacy completions choice.
+func convertChoiceMessage(msg openaiMessage, text *string) llm.Message {
+	if text != nil {
+		return llm.NewTextMessage("assistant", *text)
+	}
+	return convertResponseMessage(msg)
+}
+
+// convertResponseMessage converts an assistant message from a response choice.
+func convertResponseMessage(msg openaiMessage) llm.Message {
 	var content []llm.ContentBlock
 	switch c := msg.Content.(type) {
 	case string:
@@ -184,38 +341,69 @@ func (o *Provider) ParseResponse(payload []byte) (*llm.ChatResponse, error) {
 		}
 	}
 
-	var usage *llm.Usage
-	if resp.Usage != nil {
-		usage = &llm.Usage{
-			PromptTokens:     resp.Usage.PromptTokens,
-			CompletionTokens: resp.Usage.CompletionTokens,
-			TotalTokens:      resp.Usage.TotalTokens,
+	return llm.Message{Role: msg.Role, Content: content}
+}
+
+// ParseStreamChunk converts the data payload of a single Chat Completions SSE
+// event into the internal format. The "[DONE]" sentinel yields (nil, nil).
+// Tool call arguments stream as JSON fragments; a fragment only populates
+// ToolInput once it is a complete JSON object.
+func (o *Provider) ParseStreamChunk(payload []byte) (*llm.StreamChunk, error) {
+	if string(payload) == "[DONE]" {
+		return nil, nil
+	}
+
+	var chunk openaiStreamChunk
+	if err := json.Unmarshal(payload, &chunk); err != nil {
+		return nil, err
+	}
+
+	result := &llm.StreamChunk{
+		Model:     chunk.Model,
+		CreatedAt: time.Unix(chunk.Created, 0),
+		Message:   llm.Message{Role: "assistant"},
+	}
+
+	if len(chunk.Choices) > 0 {
+		choice := chunk.Choices[0]
+		result.Index = choice.Index
+		if choice.Delta.Role != "" {
+			result.Message.Role = choice.Delta.Role
 		}
-		if resp.Usage.PromptTokensDetails != nil {
-			usage.CacheReadInputTokens = resp.Usage.PromptTokensDetails.CachedTokens
+		if choice.Delta.Content != "" {
+			result.Message.Content = append(result.Message.Content, llm.ContentBlock{Type: "text", Text: choice.Delta.Content})
+		}
+		if choice.Text != "" {
+			result.Message.Content = append(result.Message.Content, llm.ContentBlock{Type: "text", Text: choice.Text})
+		}
+		for _, tc := range choice.Delta.ToolCalls {
+			cb := llm.ContentBlock{
+				Type:      "tool_use",
+				ToolUseID: tc.ID,
+				ToolName:  tc.Function.Name,
+			}
+			var input map[string]any
+			if err := json.Unmarshal([]byte(tc.Function.Arguments), &input); err == nil {
+				cb.ToolInput = input
+			}
+			result.Message.Content = append(result.Message.Content, cb)
+		}
+		if choice.FinishReason != nil {
+			result.StopReason = *choice.FinishReason
+			result.Done = true
 		}
 	}
 
-	result := &llm.ChatResponse{
-		Model: resp.Model,
-		Message: llm.Message{
-			Role:    msg.Role,
-			Content: content,
-		},
-		Done:        true,
-		StopReason:  choice.FinishReason,
-		Usage:       usage,
-		CreatedAt:   time.Unix(resp.Created, 0),
-		RawResponse: payload,
-		Extra: map[string]any{
-			"id":     resp.ID,
-			"object": resp.Object,
-		},
+	if chunk.Usage != nil {
+		result.Usage = &llm.Usage{
+			PromptTokens:     chunk.Usage.PromptTokens,
+			CompletionTokens: chunk.Usage.CompletionTokens,
+			TotalTokens:      chunk.Usage.TotalTokens,
+		}
+		if chunk.Usage.PromptTokensDetails != nil {
+			result.Usage.CacheReadInputTokens = chunk.Usage.PromptTokensDetails.CachedTokens
+		}
 	}
 
 	return result, nil
 }
-
-func (o *Provider) ParseStreamChunk(_ []byte) (*llm.StreamChunk, error) {
-	panic("Not yet implemented")
-}
@@ -147,6 +147,43 @@ var _ = Describe("OpenAI Provider", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(req.Extra).To(HaveKey("response_format"))
 			})
+
+			It("preserves reasoning_effort in Extra", func() {
+				payload := []byte(`{
+					"model": "o3",
+					"reasoning_effort": "high",
+					"messages": [{"role": "user", "content": "Hello"}]
+				}`)
+
+				req, err := p.ParseRequest(payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(req.Extra).To(HaveKeyWithValue("reasoning_effort", "high"))
+			})
+
+			It("preserves n in Extra", func() {
+				payload := []byte(`{
+					"model": "gpt-4",
+					"n": 3,
+					"messages": [{"role": "user", "content": "Hello"}]
+				}`)
+
+				req, err := p.ParseRequest(payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(req.Extra).To(HaveKeyWithValue("n", 3))
+			})
+
+			It("falls back to max_completion_tokens", func() {
+				payload := []byte(`{
+					"model": "o3",
+					"max_completion_tokens": 2048,
+					"messages": [{"role": "user", "content": "Hello"}]
+				}`)
+
+				req, err := p.ParseRequest(payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(req.MaxTokens).NotTo(BeNil())
+				Expect(*req.MaxTokens).To(Equal(2048))
+			})
 		})
 
 		Context("with vision/multimodal content", func() {
@@ -341,6 +378,41 @@ var _ = Describe("OpenAI Provider", func() {
 			})
 		})
 
+		Context("with multiple choices", func() {
+			It("keeps every choice with its index and finish reason", func() {
+				payload := []byte(`{
+					"id": "chatcmpl-123",
+					"object": "chat.completion",
+					"created": 1677858242,
+					"model": "gpt-4",
+					"choices": [
+						{"index": 0, "message": {"role": "assistant", "content": "Red"}, "finish_reason": "stop"},
+						{"index": 1, "message": {"role": "assistant", "content": "Blu"}, "finish_reason": "length"}
+					]
+				}`)
+
+				resp, err := p.ParseResponse(payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Message.GetText()).To(Equal("Red"))
+				Expect(resp.StopReason).To(Equal("stop"))
+				Expect(resp.Choices).To(HaveLen(2))
+				Expect(resp.Choices[1].Index).To(Equal(1))
+				Expect(resp.Choices[1].Message.GetText()).To(Equal("Blu"))
+				Expect(resp.Choices[1].StopReason).To(Equal("length"))
+			})
+
+			It("leaves Choices empty for a single choice", func() {
+				payload := []byte(`{
+					"model": "gpt-4",
+					"choices": [{"index": 0, "message": {"role": "assistant", "content": "Hi"}, "finish_reason": "stop"}]
+				}`)
+
+				resp, err := p.ParseResponse(payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Choices).To(BeEmpty())
+			})
+		})
+
 		Context("with empty choices", func() {
 			It("returns an empty response", func() {
 				payload := []byte(`{
@@ -399,4 +471,108 @@ var _ = Describe("OpenAI Provider", func() {
 			})
 		})
 	})
+
+	Describe("ParseStreamChunk", func() {
+		It("parses content deltas", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o","choices":[{"index":0,"delta":{"content":"Hi"},"finish_reason":null}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Model).To(Equal("gpt-4o"))
+			Expect(chunk.Message.GetText()).To(Equal("Hi"))
+			Expect(chunk.Done).To(BeFalse())
+		})
+
+		It("parses the finish reason and usage", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o","choices":[{"index":0,"delta":{},"finish_reason":"stop"}],"usage":{"prompt_tokens":9,"completion_tokens":3,"total_tokens":12}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Done).To(BeTrue())
+			Expect(chunk.StopReason).To(Equal("stop"))
+			Expect(chunk.Usage.TotalTokens).To(Equal(12))
+		})
+
+		It("skips the [DONE] sentinel", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`[DONE]`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk).To(BeNil())
+		})
+	})
+
+	Describe("ParseRequest data URL images", func() {
+		It("unpacks base64 data URLs", func() {
+			payload := []byte(`{
+				"model": "gpt-4o",
+				"messages": [{"role": "user", "content": [{"type": "image_url", "image_url": {"url": "data:image/jpeg;base64,/9j/4AAQ"}}]}]
+			}`)
+
+			req, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Messages[0].Content[0].Type).To(Equal("image"))
+			Expect(req.Messages[0].Content[0].MediaType).To(Equal("image/jpeg"))
+			Expect(req.Messages[0].Content[0].ImageBase64).To(Equal("/9j/4AAQ"))
+			Expect(req.Messages[0].Content[0].ImageURL).To(BeEmpty())
+		})
+	})
+
+	Describe("legacy completions", func() {
+		It("parses the prompt as a single user message", func() {
+			payload := []byte(`{
+				"model": "gpt-3.5-turbo-instruct",
+				"prompt": "Say this is a test",
+				"suffix": "!",
+				"echo": false,
+				"best_of": 2,
+				"max_tokens": 7,
+				"temperature": 0
+			}`)
+
+			req, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Completion).To(BeTrue())
+			Expect(req.Messages).To(HaveLen(1))
+			Expect(req.Messages[0].Role).To(Equal("user"))
+			Expect(req.Messages[0].GetText()).To(Equal("Say this is a test"))
+			Expect(*req.MaxTokens).To(Equal(7))
+			Expect(req.Extra).To(HaveKeyWithValue("suffix", "!"))
+			Expect(req.Extra).To(HaveKeyWithValue("echo", false))
+			Expect(req.Extra).To(HaveKeyWithValue("best_of", 2))
+		})
+
+		It("keeps each prompt of a batch as its own text block", func() {
+			req, err := p.ParseRequest([]byte(`{"model":"gpt-3.5-turbo-instruct","prompt":["one","two"]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Messages).To(HaveLen(1))
+			Expect(req.Messages[0].Content).To(HaveLen(2))
+			Expect(req.Messages[0].Content[1].Text).To(Equal("two"))
+			Expect(req.Batch).To(BeTrue())
+		})
+
+		It("does not treat chat requests as completions", func() {
+			req, err := p.ParseRequest([]byte(`{"model":"gpt-4o","messages":[{"role":"user","content":"Hi"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Completion).To(BeFalse())
+		})
+
+		It("parses text choices", func() {
+			payload := []byte(`{
+				"id": "cmpl-1",
+				"object": "text_completion",
+				"created": 1700000000,
+				"model": "gpt-3.5-turbo-instruct",
+				"choices": [{"text": "This is a test.", "index": 0, "finish_reason": "stop"}],
+				"usage": {"prompt_tokens": 5, "completion_tokens": 5, "total_tokens": 10}
+			}`)
+
+			resp, err := p.ParseResponse(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Message.Role).To(Equal("assistant"))
+			Expect(resp.Message.GetText()).To(Equal("This is a test."))
+			Expect(resp.StopReason).To(Equal("stop"))
+			Expect(resp.Usage.TotalTokens).To(Equal(10))
+		})
+
+		It("parses streamed text chunks", func() {
+			chunk, err := p.ParseStreamChunk([]byte(`{"id":"cmpl-1","object":"text_completion","created":1700000000,"model":"gpt-3.5-turbo-instruct","choices":[{"text":"This","index":0,"finish_reason":null}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chunk.Message.GetText()).To(Equal("This"))
+		})
+	})
 })
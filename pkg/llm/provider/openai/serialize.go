@@ -0,0 +1,349 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// SerializeRequest renders a ChatRequest in OpenAI's Chat Completions format.
+// A separate system prompt becomes a leading system message and tool results
+// are split out into "tool" role messages.
+func (o *Provider) SerializeRequest(req *llm.ChatRequest) ([]byte, error) {
+	messages := make([]map[string]any, 0, len(req.Messages)+1)
+	if req.System != "" {
+		messages = append(messages, map[string]any{"role": "system", "content": req.System})
+	}
+	for _, msg := range llm.PairToolResults(req.Messages) {
+		messages = append(messages, serializeMessage(msg)...)
+	}
+
+	out := map[string]any{
+		"model":    req.Model,
+		"messages": messages,
+	}
+	if req.Stream != nil {
+		out["stream"] = *req.Stream
+	}
+	if req.MaxTokens != nil {
+		out["max_tokens"] = *req.MaxTokens
+	}
+	if req.Temperature != nil {
+		out["temperature"] = *req.Temperature
+	}
+	if req.TopP != nil {
+		out["top_p"] = *req.TopP
+	}
+	if req.Seed != nil {
+		out["seed"] = *req.Seed
+	}
+	if len(req.Stop) > 0 {
+		out["stop"] = req.Stop
+	}
+	if len(req.Tools) > 0 {
+		tools := make([]openaiTool, 0, len(req.Tools))
+		for _, tool := range req.Tools {
+			t := openaiTool{Type: "function"}
+			t.Function.Name = tool.Name
+			t.Function.Description = tool.Description
+			t.Function.Parameters = tool.InputSchema
+			tools = append(tools, t)
+		}
+		out["tools"] = tools
+	}
+	if choice := serializeToolChoice(req.ToolChoice); choice != nil {
+		out["tool_choice"] = choice
+	}
+	for _, key := range []string{"frequency_penalty", "presence_penalty", "response_format", "reasoning_effort", "n"} {
+		if v, ok := req.Extra[key]; ok {
+			out[key] = v
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// SerializeResponse renders a ChatResponse in OpenAI's Chat Completions format.
+// Multi-choice responses emit every choice.
+func (o *Provider) SerializeResponse(resp *llm.ChatResponse) ([]byte, error) {
+	choices := []map[string]any{{
+		"index":         0,
+		"message":       assistantMessage(resp.Message),
+		"finish_reason": finishReason(resp.StopReason),
+	}}
+	if len(resp.Choices) > 0 {
+		choices = make([]map[string]any, 0, len(resp.Choices))
+		for _, c := range resp.Choices {
+			choices = append(choices, map[string]any{
+				"index":         c.Index,
+				"message":       assistantMessage(c.Message),
+				"finish_reason": finishReason(c.StopReason),
+			})
+		}
+	}
+
+	created := resp.CreatedAt
+	if created.IsZero() {
+		created = time.Now()
+	}
+
+	out := map[string]any{
+		"id":      responseID(resp),
+		"object":  "chat.completion",
+		"created": created.Unix(),
+		"model":   resp.Model,
+		"choices": choices,
+	}
+
+	if resp.Usage != nil {
+		usage := openaiUsage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+			TotalTokens:      resp.Usage.TotalTokens,
+		}
+		if usage.TotalTokens == 0 {
+			usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+		}
+		if resp.Usage.CacheReadInputTokens > 0 {
+			usage.PromptTokensDetails = &openaiPromptTokensDetails{CachedTokens: resp.Usage.CacheReadInputTokens}
+		}
+		out["usage"] = usage
+	}
+
+	return json.Marshal(out)
+}
+
+// serializeToolChoice maps a tool choice onto OpenAI's tool_choice values.
+func serializeToolChoice(choice *llm.ToolChoice) any {
+	if choice == nil {
+		return nil
+	}
+	switch choice.Type {
+	case "auto", "none":
+		return choice.Type
+	case "any":
+		return "required"
+	case "tool":
+		return map[string]any{
+			"type":     "function",
+			"function": map[string]any{"name": choice.Name},
+		}
+	default:
+		return nil
+	}
+}
+
+// assistantMessage renders an assistant message for a response choice.
+func assistantMessage(msg llm.Message) map[string]any {
+	message := map[string]any{"role": "assistant"}
+	text, toolCalls := splitAssistantContent(msg.Content)
+	if text != "" || len(toolCalls) == 0 {
+		message["content"] = text
+	} else {
+		message["content"] = nil
+	}
+	if len(toolCalls) > 0 {
+		message["tool_calls"] = toolCalls
+	}
+	return message
+}
+
+// serializeMessage converts a message to one or more OpenAI messages. Tool
+// results carried inside a user turn (Anthropic style) each become their own
+// "tool" message, emitted ahead of any remaining user content.
+func serializeMessage(msg llm.Message) []map[string]any {
+	var out []map[string]any
+	var parts []map[string]any
+	var toolCalls []map[string]any
+
+	for _, cb := range msg.Content {
+		switch cb.Type {
+		case "tool_result":
+			out = append(out, map[string]any{
+				"role":         "tool",
+				"tool_call_id": cb.ToolResultID,
+				"content":      cb.ToolOutput,
+			})
+		case "tool_use":
+			toolCalls = append(toolCalls, toolCall(cb))
+		case "image":
+			parts = append(parts, map[string]any{
+				"type":      "image_url",
+				"image_url": map[string]any{"url": imageURL(cb)},
+			})
+		default:
+			if cb.Text != "" {
+				parts = append(parts, map[string]any{"type": "text", "text": cb.Text})
+			}
+		}
+	}
+
+	if len(parts) == 0 && len(toolCalls) == 0 && len(out) > 0 {
+		return out
+	}
+
+	converted := map[string]any{"role": msg.Role, "content": messageContent(parts)}
+	if len(toolCalls) > 0 {
+		converted["tool_calls"] = toolCalls
+		if len(parts) == 0 {
+			converted["content"] = nil
+		}
+	}
+	return append(out, converted)
+}
+
+// messageContent collapses text-only parts into a plain string, which every
+// OpenAI-compatible server accepts; multimodal content stays as parts.
+func messageContent(parts []map[string]any) any {
+	var text strings.Builder
+	for _, part := range parts {
+		if part["type"] != "text" {
+			return parts
+		}
+		text.WriteString(part["text"].(string))
+	}
+	return text.String()
+}
+
+func splitAssistantContent(blocks []llm.ContentBlock) (string, []map[string]any) {
+	var text strings.Builder
+	var toolCalls []map[string]any
+	for _, cb := range blocks {
+		switch cb.Type {
+		case "tool_use":
+			toolCalls = append(toolCalls, toolCall(cb))
+		case "text":
+			text.WriteString(cb.Text)
+		}
+	}
+	return text.String(), toolCalls
+}
+
+func toolCall(cb llm.ContentBlock) map[string]any {
+	input := cb.ToolInput
+	if input == nil {
+		input = map[string]any{}
+	}
+	args, err := json.Marshal(input)
+	if err != nil {
+		args = []byte("{}")
+	}
+	return map[string]any{
+		"id":   cb.ToolUseID,
+		"type": "function",
+		"function": map[string]any{
+			"name":      cb.ToolName,
+			"arguments": string(args),
+		},
+	}
+}
+
+// imageURL returns the image reference for an image block, inlining base64
+// data as a data URL.
+func imageURL(cb llm.ContentBlock) string {
+	if cb.ImageBase64 == "" {
+		return cb.ImageURL
+	}
+	mediaType := cb.MediaType
+	if mediaType == "" {
+		mediaType = "image/png"
+	}
+	return "data:" + mediaType + ";base64," + cb.ImageBase64
+}
+
+// finishReason maps stop reasons from other providers onto OpenAI's vocabulary.
+func finishReason(reason string) string {
+	switch reason {
+	case "end_turn", "stop_sequence", "":
+		return "stop"
+	case "max_tokens":
+		return "length"
+	case "tool_use":
+		return "tool_calls"
+	default:
+		return reason
+	}
+}
+
+func responseID(resp *llm.ChatResponse) string {
+	if id, ok := resp.Extra["id"].(string); ok && strings.HasPrefix(id, "chatcmpl-") {
+		return id
+	}
+	return fmt.Sprintf("chatcmpl-%d", time.Now().UnixNano())
+}
+
+// StreamContentType is the Content-Type of Chat Completions streams.
+func (o *Provider) StreamContentType() string {
+	return "text/event-stream"
+}
+
+// SerializeStream renders a complete response as a Chat Completions event
+// stream: a role chunk, content and tool call chunks, a finish chunk, a
+// usage chunk, and the "[DONE]" sentinel.
+func (o *Provider) SerializeStream(resp *llm.ChatResponse) ([]byte, error) {
+	id := responseID(resp)
+	created := resp.CreatedAt
+	if created.IsZero() {
+		created = time.Now()
+	}
+
+	chunk := func(delta map[string]any, finish any) map[string]any {
+		return map[string]any{
+			"id":      id,
+			"object":  "chat.completion.chunk",
+			"created": created.Unix(),
+			"model":   resp.Model,
+			"choices": []map[string]any{{
+				"index":         0,
+				"delta":         delta,
+				"finish_reason": finish,
+			}},
+		}
+	}
+
+	text, toolCalls := splitAssistantContent(resp.Message.Content)
+	chunks := []map[string]any{chunk(map[string]any{"role": "assistant", "content": ""}, nil)}
+	if text != "" {
+		chunks = append(chunks, chunk(map[string]any{"content": text}, nil))
+	}
+	for i, tc := range toolCalls {
+		tc["index"] = i
+		chunks = append(chunks, chunk(map[string]any{"tool_calls": []map[string]any{tc}}, nil))
+	}
+	chunks = append(chunks, chunk(map[string]any{}, finishReason(resp.StopReason)))
+
+	if resp.Usage != nil {
+		total := resp.Usage.TotalTokens
+		if total == 0 {
+			total = resp.Usage.PromptTokens + resp.Usage.CompletionTokens
+		}
+		chunks = append(chunks, map[string]any{
+			"id":      id,
+			"object":  "chat.completion.chunk",
+			"created": created.Unix(),
+			"model":   resp.Model,
+			"choices": []any{},
+			"usage": openaiUsage{
+				PromptTokens:     resp.Usage.PromptTokens,
+				CompletionTokens: resp.Usage.CompletionTokens,
+				TotalTokens:      total,
+			},
+		})
+	}
+
+	var buf bytes.Buffer
+	for _, c := range chunks {
+		data, err := json.Marshal(c)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, "data: %s\n\n", data)
+	}
+	buf.WriteString("data: [DONE]\n\n")
+
+	return buf.Bytes(), nil
+}
@@ -14,6 +14,29 @@ type openaiRequest struct {
 	FrequencyPenalty *float64       `json:"frequency_penalty,omitempty"`
 	PresencePenalty  *float64       `json:"presence_penalty,omitempty"`
 	ResponseFormat   map[string]any `json:"response_format,omitempty"`
+	ReasoningEffort  string         `json:"reasoning_effort,omitempty"`
+	N                *int           `json:"n,omitempty"`
+	Tools            []openaiTool   `json:"tools,omitempty"`
+	ToolChoice       any            `json:"tool_choice,omitempty"` // string or {"type": "function", ...}
+
+	// MaxCompletionTokens supersedes MaxTokens for reasoning models.
+	MaxCompletionTokens *int `json:"max_completion_tokens,omitempty"`
+
+	// Legacy /v1/completions fields
+	Prompt any    `json:"prompt,omitempty"` // string, []string or token arrays
+	Suffix string `json:"suffix,omitempty"`
+	Echo   *bool  `json:"echo,omitempty"`
+	BestOf *int   `json:"best_of,omitempty"`
+}
+
+// openaiTool represents a function tool definition in OpenAI's format.
+type openaiTool struct {
+	Type     string `json:"type"`
+	Function struct {
+		Name        string         `json:"name"`
+		Description string         `json:"description,omitempty"`
+		Parameters  map[string]any `json:"parameters,omitempty"`
+	} `json:"function"`
 }
 
 // openaiMessage represents a message in OpenAI's format.
@@ -41,6 +64,7 @@ type openaiResponse struct {
 	Choices []struct {
 		Index        int           `json:"index"`
 		Message      openaiMessage `json:"message"`
+		Text         *string       `json:"text,omitempty"` // legacy completions only
 		FinishReason string        `json:"finish_reason"`
 	} `json:"choices"`
 	Usage *openaiUsage `json:"usage,omitempty"`
@@ -56,3 +80,30 @@ type openaiUsage struct {
 type openaiPromptTokensDetails struct {
 	CachedTokens int `json:"cached_tokens"`
 }
+
+// openaiStreamChunk represents a single chat.completion.chunk SSE payload.
+type openaiStreamChunk struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	Model   string `json:"model"`
+	Choices []struct {
+		Index int `json:"index"`
+		Delta struct {
+			Role      string `json:"role,omitempty"`
+			Content   string `json:"content,omitempty"`
+			ToolCalls []struct {
+				Index    int    `json:"index"`
+				ID       string `json:"id,omitempty"`
+				Type     string `json:"type,omitempty"`
+				Function struct {
+					Name      string `json:"name,omitempty"`
+					Arguments string `json:"arguments,omitempty"`
+				} `json:"function"`
+			} `json:"tool_calls,omitempty"`
+		} `json:"delta"`
+		Text         string  `json:"text,omitempty"` // legacy completions only
+		FinishReason *string `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *openaiUsage `json:"usage,omitempty"`
+}
@@ -7,12 +7,13 @@ import (
 )
 
 // ErrStreamingNotImplemented is returned by ParseStreamChunk when a provider
-// does not yet support streaming parsing.
+// does not support streaming parsing.
 var ErrStreamingNotImplemented = errors.New("streaming not implemented for this provider")
 
-// Provider defines the interface for LLM API parsing.
-// Each provider implementation knows how to parse its specific
-// API format into the internal representation.
+// Provider defines the interface for LLM API parsing and serialization.
+// Each provider implementation knows how to convert between its specific
+// API format and the internal representation, which lets the proxy serve a
+// client speaking one format from an upstream speaking another.
 type Provider interface {
 	// Name returns the canonical provider name (e.g., "anthropic", "openai", "ollama")
 	Name() string
@@ -34,4 +35,44 @@ type Provider interface {
 	// Returns ErrStreamingNotImplemented if the provider doesn't support streaming yet.
 	// Returns (nil, nil) if the chunk should be skipped (e.g., keep-alive, comments).
 	ParseStreamChunk(payload []byte) (*llm.StreamChunk, error)
+
+	// SerializeRequest converts an internal request into the provider's request format.
+	SerializeRequest(req *llm.ChatRequest) ([]byte, error)
+
+	// SerializeResponse converts an internal response into the provider's
+	// non-streaming response format.
+	SerializeResponse(resp *llm.ChatResponse) ([]byte, error)
+
+	// SerializeStream renders a complete internal response in the provider's
+	// streaming wire format (SSE events or NDJSON lines), ready to be written
+	// to a client that requested a streamed response.
+	SerializeStream(resp *llm.ChatResponse) ([]byte, error)
+
+	// StreamContentType is the Content-Type of SerializeStream output.
+	StreamContentType() string
+}
+
+// EmbeddingParser is implemented by providers with an embeddings API, letting
+// the proxy record embedding calls alongside chat turns.
+type EmbeddingParser interface {
+	// IsEmbeddingPath reports whether path is one of the provider's
+	// embeddings endpoints.
+	IsEmbeddingPath(path string) bool
+
+	// ParseEmbeddingRequest converts a provider-specific embeddings request
+	// into the internal format.
+	ParseEmbeddingRequest(payload []byte) (*llm.EmbeddingRequest, error)
+
+	// ParseEmbeddingResponse converts a provider-specific embeddings response
+	// into the internal format.
+	ParseEmbeddingResponse(payload []byte) (*llm.EmbeddingResponse, error)
+}
+
+// PathParser is implemented by providers whose requests carry parameters in
+// the URL path rather than the body, such as Bedrock's
+// /model/{modelId}/converse-stream.
+type PathParser interface {
+	// ParseRequestPath completes a parsed request with the parameters
+	// encoded in path.
+	ParseRequestPath(path string, req *llm.ChatRequest)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/papercomputeco/tapes/pkg/llm/provider/anthropic"
+	"github.com/papercomputeco/tapes/pkg/llm/provider/bedrock"
 	"github.com/papercomputeco/tapes/pkg/llm/provider/ollama"
 	"github.com/papercomputeco/tapes/pkg/llm/provider/openai"
 )
@@ -13,11 +14,12 @@ const (
 	Anthropic = "anthropic"
 	OpenAI    = "openai"
 	Ollama    = "ollama"
+	Bedrock   = "bedrock"
 )
 
 // SupportedProviders returns the list of all supported provider type names.
 func SupportedProviders() []string {
-	return []string{Anthropic, OpenAI, Ollama}
+	return []string{Anthropic, OpenAI, Ollama, Bedrock}
 }
 
 // New creates a new Provider instance for the given provider type.
@@ -30,6 +32,8 @@ func New(providerType string) (Provider, error) {
 		return openai.New(), nil
 	case Ollama:
 		return ollama.New(), nil
+	case Bedrock:
+		return bedrock.New(), nil
 	default:
 		return nil, fmt.Errorf("unknown provider type: %q (supported: %v)", providerType, SupportedProviders())
 	}
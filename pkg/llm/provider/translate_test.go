@@ -0,0 +1,345 @@
+package provider_test
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+)
+
+func intPtr(i int) *int { return &i }
+
+func float64Ptr(f float64) *float64 { return &f }
+
+// conversationRequest is a request exercising text, images, tool calls and
+// tool results, as parsed from an Anthropic client.
+func conversationRequest() *llm.ChatRequest {
+	return &llm.ChatRequest{
+		Model:       "claude-haiku-4-5",
+		System:      "Be brief.",
+		MaxTokens:   intPtr(256),
+		Temperature: float64Ptr(0.2),
+		Messages: []llm.Message{
+			{Role: "user", Content: []llm.ContentBlock{
+				{Type: "text", Text: "What's in this image?"},
+				{Type: "image", ImageBase64: "iVBORw0KGgo=", MediaType: "image/png"},
+			}},
+			{Role: "assistant", Content: []llm.ContentBlock{
+				{Type: "text", Text: "Let me check the file."},
+				{Type: "tool_use", ToolUseID: "call_1", ToolName: "read_file", ToolInput: map[string]any{"path": "a.txt"}},
+			}},
+			{Role: "user", Content: []llm.ContentBlock{
+				{Type: "tool_result", ToolResultID: "call_1", ToolOutput: "a red square"},
+			}},
+		},
+		Tools: []llm.Tool{{
+			Name:        "read_file",
+			Description: "Read a file from disk.",
+			InputSchema: map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"path": map[string]any{"type": "string"}},
+				"required":   []any{"path"},
+			},
+		}},
+		ToolChoice: &llm.ToolChoice{Type: "auto"},
+	}
+}
+
+// conversationResponse is a response carrying text and a tool call.
+func conversationResponse() *llm.ChatResponse {
+	return &llm.ChatResponse{
+		Model: "claude-haiku-4-5",
+		Message: llm.Message{Role: "assistant", Content: []llm.ContentBlock{
+			{Type: "text", Text: "A red square."},
+			{Type: "tool_use", ToolUseID: "call_2", ToolName: "write_file", ToolInput: map[string]any{"path": "b.txt", "lines": float64(2)}},
+		}},
+		Done:       true,
+		StopReason: "tool_use",
+		Usage:      &llm.Usage{PromptTokens: 40, CompletionTokens: 12, TotalTokens: 52},
+	}
+}
+
+func mustProvider(name string) provider.Provider {
+	p, err := provider.New(name)
+	Expect(err).NotTo(HaveOccurred())
+	return p
+}
+
+// streamPayloads splits serialized stream output into the payloads that
+// ParseStreamChunk receives: SSE data fields or NDJSON lines.
+func streamPayloads(stream []byte) [][]byte {
+	var payloads [][]byte
+	scanner := bufio.NewScanner(bytes.NewReader(stream))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "event:") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "data: ")
+		payloads = append(payloads, []byte(line))
+	}
+	return payloads
+}
+
+// accumulate folds parsed stream chunks back into a complete response.
+func accumulate(p provider.Provider, stream []byte) *llm.ChatResponse {
+	resp := &llm.ChatResponse{Message: llm.Message{Role: "assistant"}}
+	var text strings.Builder
+	var tools []llm.ContentBlock
+
+	for _, payload := range streamPayloads(stream) {
+		chunk, err := p.ParseStreamChunk(payload)
+		Expect(err).NotTo(HaveOccurred())
+		if chunk == nil {
+			continue
+		}
+		if chunk.Model != "" {
+			resp.Model = chunk.Model
+		}
+		if chunk.StopReason != "" {
+			resp.StopReason = chunk.StopReason
+		}
+		if chunk.Usage != nil {
+			if resp.Usage == nil {
+				resp.Usage = &llm.Usage{}
+			}
+			if chunk.Usage.PromptTokens > 0 {
+				resp.Usage.PromptTokens = chunk.Usage.PromptTokens
+			}
+			if chunk.Usage.CompletionTokens > 0 {
+				resp.Usage.CompletionTokens = chunk.Usage.CompletionTokens
+			}
+		}
+		resp.Done = resp.Done || chunk.Done
+
+		for _, block := range chunk.Message.Content {
+			switch block.Type {
+			case "text":
+				text.WriteString(block.Text)
+			case "tool_use":
+				// A tool call may arrive as a start block (id, name)
+				// followed by an input delta.
+				if block.ToolUseID != "" || len(tools) == 0 {
+					tools = append(tools, block)
+				} else if block.ToolInput != nil {
+					tools[len(tools)-1].ToolInput = block.ToolInput
+				}
+			}
+		}
+	}
+
+	if text.Len() > 0 {
+		resp.Message.Content = append(resp.Message.Content, llm.ContentBlock{Type: "text", Text: text.String()})
+	}
+	resp.Message.Content = append(resp.Message.Content, tools...)
+	return resp
+}
+
+var _ = Describe("Translation", func() {
+	DescribeTable("round-trips requests through a provider's own format",
+		func(name string, expectedRoles []string) {
+			p := mustProvider(name)
+			original := conversationRequest()
+
+			payload, err := p.SerializeRequest(original)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Model).To(Equal(original.Model))
+			Expect(*parsed.MaxTokens).To(Equal(256))
+			Expect(*parsed.Temperature).To(Equal(0.2))
+
+			// Providers without a separate system field carry it as a message.
+			messages := parsed.Messages
+			if parsed.System == "" {
+				Expect(messages[0].Role).To(Equal("system"))
+				Expect(messages[0].GetText()).To(Equal("Be brief."))
+				messages = messages[1:]
+			} else {
+				Expect(parsed.System).To(Equal("Be brief."))
+			}
+
+			Expect(messages).To(HaveLen(3))
+			roles := []string{messages[0].Role, messages[1].Role, messages[2].Role}
+			Expect(roles).To(Equal(expectedRoles))
+
+			Expect(messages[0].GetText()).To(Equal("What's in this image?"))
+			Expect(messages[0].Content[1].Type).To(Equal("image"))
+			Expect(messages[0].Content[1].ImageBase64).To(Equal("iVBORw0KGgo="))
+
+			Expect(messages[1].Content).To(ContainElement(original.Messages[1].Content[1]))
+			Expect(messages[1].GetText()).To(Equal("Let me check the file."))
+		},
+		Entry("anthropic", provider.Anthropic, []string{"user", "assistant", "user"}),
+		Entry("openai", provider.OpenAI, []string{"user", "assistant", "tool"}),
+		Entry("ollama", provider.Ollama, []string{"user", "assistant", "tool"}),
+	)
+
+	DescribeTable("preserves tool results and image media types",
+		func(name string) {
+			p := mustProvider(name)
+			payload, err := p.SerializeRequest(conversationRequest())
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := p.ParseRequest(payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			last := parsed.Messages[len(parsed.Messages)-1]
+			Expect(last.Content).To(Equal([]llm.ContentBlock{
+				{Type: "tool_result", ToolResultID: "call_1", ToolOutput: "a red square"},
+			}))
+
+			image := parsed.Messages[len(parsed.Messages)-3].Content[1]
+			Expect(image.MediaType).To(Equal("image/png"))
+		},
+		Entry("anthropic", provider.Anthropic),
+		Entry("openai", provider.OpenAI),
+	)
+
+	DescribeTable("round-trips tool definitions",
+		func(name string, choice, expected *llm.ToolChoice) {
+			p := mustProvider(name)
+			req := conversationRequest()
+			req.ToolChoice = choice
+
+			parsed, err := p.ParseRequest(mustSerializeRequest(p, req))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Tools).To(Equal(req.Tools))
+			Expect(parsed.ToolChoice).To(Equal(expected))
+		},
+		Entry("anthropic", provider.Anthropic, &llm.ToolChoice{Type: "tool", Name: "read_file"}, &llm.ToolChoice{Type: "tool", Name: "read_file"}),
+		Entry("openai named tool", provider.OpenAI, &llm.ToolChoice{Type: "tool", Name: "read_file"}, &llm.ToolChoice{Type: "tool", Name: "read_file"}),
+		Entry("openai required", provider.OpenAI, &llm.ToolChoice{Type: "any"}, &llm.ToolChoice{Type: "any"}),
+		Entry("openai none", provider.OpenAI, &llm.ToolChoice{Type: "none"}, &llm.ToolChoice{Type: "none"}),
+		Entry("ollama", provider.Ollama, &llm.ToolChoice{Type: "auto"}, nil),
+		Entry("bedrock", provider.Bedrock, &llm.ToolChoice{Type: "any"}, &llm.ToolChoice{Type: "any"}),
+	)
+
+	It("withholds tools from Ollama when tool use is disabled", func() {
+		p := mustProvider(provider.Ollama)
+		req := conversationRequest()
+		req.ToolChoice = &llm.ToolChoice{Type: "none"}
+
+		parsed, err := p.ParseRequest(mustSerializeRequest(p, req))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed.Tools).To(BeEmpty())
+	})
+
+	DescribeTable("round-trips reasoning settings",
+		func(name, key string, value any) {
+			p := mustProvider(name)
+			req := conversationRequest()
+			req.Extra = map[string]any{key: value}
+
+			parsed, err := p.ParseRequest(mustSerializeRequest(p, req))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Extra).To(HaveKeyWithValue(key, value))
+		},
+		Entry("anthropic thinking", provider.Anthropic, "thinking", map[string]any{"type": "enabled", "budget_tokens": float64(1024)}),
+		Entry("openai reasoning effort", provider.OpenAI, "reasoning_effort", "high"),
+	)
+
+	DescribeTable("translates requests between providers and back",
+		func(from, via string) {
+			source := mustProvider(from)
+			middle := mustProvider(via)
+
+			original, err := source.ParseRequest(mustSerializeRequest(source, conversationRequest()))
+			Expect(err).NotTo(HaveOccurred())
+
+			translated, err := middle.ParseRequest(mustSerializeRequest(middle, original))
+			Expect(err).NotTo(HaveOccurred())
+
+			back, err := source.ParseRequest(mustSerializeRequest(source, translated))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(back.Model).To(Equal(original.Model))
+			Expect(back.System).To(Equal(original.System))
+			Expect(back.Messages).To(Equal(original.Messages))
+			Expect(back.Tools).To(Equal(original.Tools))
+		},
+		Entry("anthropic via openai", provider.Anthropic, provider.OpenAI),
+		Entry("anthropic via ollama", provider.Anthropic, provider.Ollama),
+		Entry("openai via anthropic", provider.OpenAI, provider.Anthropic),
+		Entry("openai via ollama", provider.OpenAI, provider.Ollama),
+	)
+
+	DescribeTable("round-trips responses",
+		func(name string) {
+			p := mustProvider(name)
+			original := conversationResponse()
+
+			payload, err := p.SerializeResponse(original)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := p.ParseResponse(payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Model).To(Equal(original.Model))
+			Expect(parsed.Message.Content).To(Equal(original.Message.Content))
+			Expect(parsed.Usage.PromptTokens).To(Equal(40))
+			Expect(parsed.Usage.CompletionTokens).To(Equal(12))
+		},
+		Entry("anthropic", provider.Anthropic),
+		Entry("openai", provider.OpenAI),
+		Entry("ollama", provider.Ollama),
+	)
+
+	DescribeTable("round-trips streamed responses",
+		func(name, stopReason string) {
+			p := mustProvider(name)
+			original := conversationResponse()
+
+			stream, err := p.SerializeStream(original)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed := accumulate(p, stream)
+			Expect(parsed.Done).To(BeTrue())
+			Expect(parsed.Model).To(Equal(original.Model))
+			Expect(parsed.StopReason).To(Equal(stopReason))
+			Expect(parsed.Message.Content).To(Equal(original.Message.Content))
+			Expect(parsed.Usage.PromptTokens).To(Equal(40))
+			Expect(parsed.Usage.CompletionTokens).To(Equal(12))
+		},
+		Entry("anthropic", provider.Anthropic, "tool_use"),
+		Entry("openai", provider.OpenAI, "tool_calls"),
+		Entry("ollama", provider.Ollama, "stop"),
+	)
+
+	It("reports stream content types", func() {
+		Expect(mustProvider(provider.Anthropic).StreamContentType()).To(Equal("text/event-stream"))
+		Expect(mustProvider(provider.OpenAI).StreamContentType()).To(Equal("text/event-stream"))
+		Expect(mustProvider(provider.Ollama).StreamContentType()).To(Equal("application/x-ndjson"))
+	})
+
+	It("pairs ID-less tool messages with preceding tool calls", func() {
+		p := mustProvider(provider.Anthropic)
+		req := &llm.ChatRequest{
+			Model: "claude-haiku-4-5",
+			Messages: []llm.Message{
+				{Role: "assistant", Content: []llm.ContentBlock{
+					{Type: "tool_use", ToolUseID: "call_1", ToolName: "ls", ToolInput: map[string]any{}},
+				}},
+				llm.NewTextMessage("tool", "a.txt"),
+			},
+		}
+
+		parsed, err := p.ParseRequest(mustSerializeRequest(p, req))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed.Messages[1].Role).To(Equal("user"))
+		Expect(parsed.Messages[1].Content).To(Equal([]llm.ContentBlock{
+			{Type: "tool_result", ToolResultID: "call_1", ToolOutput: "a.txt"},
+		}))
+	})
+})
+
+func mustSerializeRequest(p provider.Provider, req *llm.ChatRequest) []byte {
+	payload, err := p.SerializeRequest(req)
+	Expect(err).NotTo(HaveOccurred())
+	return payload
+}
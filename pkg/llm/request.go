@@ -1,6 +1,11 @@
 package llm
 
-import "encoding/json"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+)
 
 // ChatRequest represents a provider-agnostic chat completion request.
 // This is the internal representation used by the proxy after parsing
@@ -26,10 +31,106 @@ type ChatRequest struct {
 	Stop        []string `json:"stop,omitempty"`
 	Seed        *int     `json:"seed,omitempty"`
 
+	// Tool definitions offered to the model
+	Tools []Tool `json:"tools,omitempty"`
+
+	// ToolChoice constrains which tool, if any, the model calls. Nil leaves
+	// it to the provider's default.
+	ToolChoice *ToolChoice `json:"tool_choice,omitempty"`
+
 	// Provider-specific fields that don't map to common parameters
 	Extra map[string]any `json:"extra,omitempty"`
 
+	// Completion marks a prompt-completion request (Ollama /api/generate,
+	// OpenAI /v1/completions) whose prompt was parsed into a single user
+	// message. Completion requests have no chat-format equivalent, so they
+	// are never translated to another provider or served from cache.
+	Completion bool `json:"completion,omitempty"`
+
+	// Batch marks a completion request carrying several independent
+	// prompts (OpenAI's prompt array), one text block each in its user
+	// message. Each prompt is recorded as its own conversation.
+	Batch bool `json:"batch,omitempty"`
+
+	// Context is the opaque conversation state returned by an earlier
+	// completion (Ollama's context tokens) and passed back to continue it.
+	Context []int `json:"context,omitempty"`
+
 	// RawRequest preserves the original request payload for cases where
 	// parsing is incomplete or for debugging.
 	RawRequest json.RawMessage `json:"raw_request,omitempty"`
 }
+
+// Tool is a tool definition offered to the model.
+type Tool struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// InputSchema is the JSON Schema of the tool's arguments.
+	InputSchema map[string]any `json:"input_schema,omitempty"`
+}
+
+// ToolChoice constrains tool use for a request, following Anthropic's
+// vocabulary, which the other providers' choices map onto.
+type ToolChoice struct {
+	// Type is "auto" (the model decides), "any" (the model must call some
+	// tool), "tool" (the model must call the tool Name) or "none" (the
+	// model must not call tools).
+	Type string `json:"type"`
+
+	// Name is the tool to call when Type is "tool".
+	Name string `json:"name,omitempty"`
+}
+
+// GenerationParams holds the generation parameters and provider-specific
+// request settings (e.g. a thinking budget or reasoning effort) that shaped
+// a response. It is recorded alongside response nodes for replay and
+// analytics.
+type GenerationParams struct {
+	MaxTokens   *int           `json:"max_tokens,omitempty"`
+	Temperature *float64       `json:"temperature,omitempty"`
+	TopP        *float64       `json:"top_p,omitempty"`
+	TopK        *int           `json:"top_k,omitempty"`
+	Stop        []string       `json:"stop,omitempty"`
+	Seed        *int           `json:"seed,omitempty"`
+	Extra       map[string]any `json:"extra,omitempty"`
+}
+
+// GenerationParams returns the request's generation parameters, or nil when
+// the request sets none.
+func (r *ChatRequest) GenerationParams() *GenerationParams {
+	if r == nil {
+		return nil
+	}
+
+	params := &GenerationParams{
+		MaxTokens:   r.MaxTokens,
+		Temperature: r.Temperature,
+		TopP:        r.TopP,
+		TopK:        r.TopK,
+		Stop:        r.Stop,
+		Seed:        r.Seed,
+		Extra:       r.Extra,
+	}
+	if params.MaxTokens == nil && params.Temperature == nil && params.TopP == nil &&
+		params.TopK == nil && len(params.Stop) == 0 && params.Seed == nil && len(params.Extra) == 0 {
+		return nil
+	}
+	return params
+}
+
+// ContextKey returns a stable key for opaque completion context tokens, used
+// to find the response a follow-up completion request continues from.
+// Returns "" for an empty context.
+func ContextKey(tokens []int) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, t := range tokens {
+		h.Write(strconv.AppendInt(nil, int64(t), 10))
+		h.Write([]byte{','})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
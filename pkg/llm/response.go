@@ -24,17 +24,38 @@ type ChatResponse struct {
 	// Stop reason (e.g., "stop", "length", "tool_use", "end_turn")
 	StopReason string `json:"stop_reason,omitempty"`
 
+	// Choices holds every sampled alternative when the provider returned
+	// more than one (e.g., OpenAI's "n"). Message and StopReason mirror
+	// the first choice.
+	Choices []Choice `json:"choices,omitempty"`
+
 	// Token usage and timing metrics
 	Usage *Usage `json:"usage,omitempty"`
 
 	// Provider-specific fields that don't map to common parameters
 	Extra map[string]any `json:"extra,omitempty"`
 
+	// Context is the opaque conversation state a completion API returned
+	// (Ollama's context tokens), which a follow-up request passes back.
+	Context []int `json:"context,omitempty"`
+
 	// RawResponse preserves the original response payload for cases where
 	// parsing is incomplete or for debugging.
 	RawResponse json.RawMessage `json:"raw_response,omitempty"`
 }
 
+// Choice is a single sampled alternative in a multi-choice response.
+type Choice struct {
+	// Index is the provider's position for the choice.
+	Index int `json:"index"`
+
+	// The assistant's message for this choice
+	Message Message `json:"message"`
+
+	// Stop reason for this choice
+	StopReason string `json:"stop_reason,omitempty"`
+}
+
 // Usage contains token counts and timing information.
 type Usage struct {
 	// Token counts
@@ -0,0 +1,172 @@
+package merkle
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// BranchDiff compares two conversation branches, each identified by the node
+// at its tip.
+type BranchDiff struct {
+	// Ancestor is the lowest common ancestor of the two tips: the fork point.
+	// It is nil when the branches do not share a root.
+	Ancestor *Node
+
+	// Shared is the common prefix of both branches, ordered root first and
+	// ending with Ancestor.
+	Shared []*Node
+
+	// A and B are the divergent turns of each branch after the fork point,
+	// ordered oldest first. Either may be empty when one tip is an ancestor
+	// of the other.
+	A []*Node
+	B []*Node
+
+	// Turns pairs the divergent turns of both branches by their position
+	// after the fork point.
+	Turns []TurnDiff
+}
+
+// TurnDiff compares the turns at the same depth of two diverged branches.
+type TurnDiff struct {
+	// Depth is the turn's position in the conversation, counted from the root
+	// at 0.
+	Depth int
+
+	// A and B are the turns at this depth on each branch. One of them is nil
+	// when the other branch is longer.
+	A *Node
+	B *Node
+
+	RoleChanged    bool
+	ContentChanged bool
+	ModelChanged   bool
+	UsageChanged   bool
+}
+
+// Changed reports whether the turns differ in role, content, model or usage.
+func (t TurnDiff) Changed() bool {
+	return t.RoleChanged || t.ContentChanged || t.ModelChanged || t.UsageChanged
+}
+
+// LowestCommonAncestor returns the deepest node that is an ancestor of (or
+// equal to) both the given nodes. Returns nil if either hash is not found.
+func (d *Dag) LowestCommonAncestor(a, b string) *DagNode {
+	nodeA, nodeB := d.Get(a), d.Get(b)
+	if nodeA == nil || nodeB == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for n := nodeA; n != nil; n = n.Parent {
+		seen[n.Hash] = true
+	}
+	for n := nodeB; n != nil; n = n.Parent {
+		if seen[n.Hash] {
+			return n
+		}
+	}
+	return nil
+}
+
+// LowestCommonAncestor returns the fork point of the branches ending at a and
+// b, loading only their ancestries. It returns nil, nil when the branches do
+// not share a root.
+func LowestCommonAncestor(ctx context.Context, loader DagLoader, a, b string) (*Node, error) {
+	diff, err := Diff(ctx, loader, a, b)
+	if err != nil {
+		return nil, err
+	}
+	return diff.Ancestor, nil
+}
+
+// Diff compares the branches ending at a and b. Since nodes are content
+// addressed, the shared prefix is exactly the run of identical hashes from
+// the root; everything after it is divergent.
+func Diff(ctx context.Context, loader DagLoader, a, b string) (*BranchDiff, error) {
+	pathA, err := rootPath(ctx, loader, a)
+	if err != nil {
+		return nil, err
+	}
+	pathB, err := rootPath(ctx, loader, b)
+	if err != nil {
+		return nil, err
+	}
+
+	shared := 0
+	for shared < len(pathA) && shared < len(pathB) && pathA[shared].Hash == pathB[shared].Hash {
+		shared++
+	}
+
+	diff := &BranchDiff{
+		Shared: pathA[:shared],
+		A:      pathA[shared:],
+		B:      pathB[shared:],
+	}
+	if shared > 0 {
+		diff.Ancestor = pathA[shared-1]
+	}
+
+	for i := 0; i < max(len(diff.A), len(diff.B)); i++ {
+		turn := TurnDiff{Depth: shared + i}
+		if i < len(diff.A) {
+			turn.A = diff.A[i]
+		}
+		if i < len(diff.B) {
+			turn.B = diff.B[i]
+		}
+		compareTurns(&turn)
+		diff.Turns = append(diff.Turns, turn)
+	}
+
+	return diff, nil
+}
+
+// rootPath returns the ancestry of hash ordered root first.
+func rootPath(ctx context.Context, loader DagLoader, hash string) ([]*Node, error) {
+	ancestry, err := loader.Ancestry(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("getting ancestry for %s: %w", hash, err)
+	}
+	if len(ancestry) == 0 {
+		return nil, fmt.Errorf("node %s not found", hash)
+	}
+
+	path := make([]*Node, len(ancestry))
+	for i, node := range ancestry {
+		path[len(ancestry)-1-i] = node
+	}
+	return path, nil
+}
+
+// compareTurns sets the change flags of a turn. A turn present on only one
+// side counts as changed in every respect.
+func compareTurns(t *TurnDiff) {
+	if t.A == nil || t.B == nil {
+		t.RoleChanged, t.ContentChanged, t.ModelChanged, t.UsageChanged = true, true, true, true
+		return
+	}
+
+	t.RoleChanged = t.A.Bucket.Role != t.B.Bucket.Role
+	t.ContentChanged = !reflect.DeepEqual(t.A.Bucket.Content, t.B.Bucket.Content)
+	t.ModelChanged = t.A.Bucket.Model != t.B.Bucket.Model || t.A.Bucket.Provider != t.B.Bucket.Provider
+	t.UsageChanged = usageTokens(t.A.Usage) != usageTokens(t.B.Usage)
+}
+
+// usageTokens returns the token counts of u, ignoring timings, which differ
+// between any two calls.
+func usageTokens(u *llm.Usage) llm.Usage {
+	if u == nil {
+		return llm.Usage{}
+	}
+	return llm.Usage{
+		PromptTokens:             u.PromptTokens,
+		CompletionTokens:         u.CompletionTokens,
+		TotalTokens:              u.TotalTokens,
+		CacheCreationInputTokens: u.CacheCreationInputTokens,
+		CacheReadInputTokens:     u.CacheReadInputTokens,
+	}
+}
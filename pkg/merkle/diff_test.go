@@ -0,0 +1,117 @@
+package merkle_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+var _ = Describe("Diff", func() {
+	var (
+		ctx    context.Context
+		driver *inmemory.Driver
+
+		//        root
+		//         |
+		//       reply
+		//      /     \
+		//   askA     askB
+		//     |        |
+		//  answerA  answerB
+		root, reply, askA, askB, answerA, answerB *merkle.Node
+	)
+
+	put := func(nodes ...*merkle.Node) {
+		for _, n := range nodes {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		driver = inmemory.NewDriver()
+
+		root = merkle.NewNode(dagTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(dagTestBucket("assistant", "Hi!"), root)
+		askA = merkle.NewNode(dagTestBucket("user", "Tell me a joke"), reply)
+		askB = merkle.NewNode(dagTestBucket("user", "Tell me a fact"), reply)
+
+		bucketA := dagTestBucket("assistant", "Why did the chicken...")
+		answerA = merkle.NewNode(bucketA, askA, merkle.NodeMeta{Usage: &llm.Usage{CompletionTokens: 10, TotalDurationNs: 5}})
+		bucketB := dagTestBucket("assistant", "Octopuses have three hearts.")
+		bucketB.Model = "other-model"
+		answerB = merkle.NewNode(bucketB, askB, merkle.NodeMeta{Usage: &llm.Usage{CompletionTokens: 10, TotalDurationNs: 9}})
+
+		put(root, reply, askA, askB, answerA, answerB)
+	})
+
+	It("splits two branches at their fork point", func() {
+		diff, err := merkle.Diff(ctx, driver, answerA.Hash, answerB.Hash)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.Ancestor.Hash).To(Equal(reply.Hash))
+		Expect(diff.Shared).To(HaveLen(2))
+		Expect(diff.Shared[0].Hash).To(Equal(root.Hash))
+		Expect(diff.A).To(HaveLen(2))
+		Expect(diff.A[0].Hash).To(Equal(askA.Hash))
+		Expect(diff.B[1].Hash).To(Equal(answerB.Hash))
+	})
+
+	It("reports per-turn changes", func() {
+		diff, err := merkle.Diff(ctx, driver, answerA.Hash, answerB.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Turns).To(HaveLen(2))
+
+		ask := diff.Turns[0]
+		Expect(ask.Depth).To(Equal(2))
+		Expect(ask.RoleChanged).To(BeFalse())
+		Expect(ask.ContentChanged).To(BeTrue())
+		Expect(ask.ModelChanged).To(BeFalse())
+
+		answer := diff.Turns[1]
+		Expect(answer.ContentChanged).To(BeTrue())
+		Expect(answer.ModelChanged).To(BeTrue())
+		// Only the timings differ, which are not a usage change
+		Expect(answer.UsageChanged).To(BeFalse())
+	})
+
+	It("treats an ancestor tip as a prefix of the other branch", func() {
+		diff, err := merkle.Diff(ctx, driver, reply.Hash, answerA.Hash)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(diff.Ancestor.Hash).To(Equal(reply.Hash))
+		Expect(diff.A).To(BeEmpty())
+		Expect(diff.B).To(HaveLen(2))
+		Expect(diff.Turns[0].A).To(BeNil())
+		Expect(diff.Turns[0].Changed()).To(BeTrue())
+	})
+
+	It("has no ancestor for unrelated conversations", func() {
+		other := merkle.NewNode(dagTestBucket("user", "Unrelated"), nil)
+		put(other)
+
+		ancestor, err := merkle.LowestCommonAncestor(ctx, driver, answerA.Hash, other.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ancestor).To(BeNil())
+	})
+
+	It("returns an error for unknown hashes", func() {
+		_, err := merkle.Diff(ctx, driver, answerA.Hash, "nonexistent")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("finds the lowest common ancestor in a loaded DAG", func() {
+		dag, err := merkle.LoadDag(ctx, driver, root.Hash)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(dag.LowestCommonAncestor(answerA.Hash, answerB.Hash).Hash).To(Equal(reply.Hash))
+		Expect(dag.LowestCommonAncestor(askA.Hash, answerA.Hash).Hash).To(Equal(askA.Hash))
+		Expect(dag.LowestCommonAncestor(askA.Hash, "nonexistent")).To(BeNil())
+	})
+})
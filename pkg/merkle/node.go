@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json/jsontext"
 	"encoding/json/v2"
+	"fmt"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
 )
@@ -31,14 +32,56 @@ type Node struct {
 
 	// Project is the git repository or project name that produced this node
 	Project string `json:"project,omitempty"`
+
+	// RequestedModel is the model the client asked for (only for responses).
+	// It differs from Bucket.Model when a proxy routing rule rewrote the model.
+	RequestedModel string `json:"requested_model,omitempty"`
+
+	// Upstream is the upstream base URL that served the response (only for
+	// responses proxied through a load-balanced provider).
+	Upstream string `json:"upstream,omitempty"`
+
+	// Params holds the generation parameters and request settings that
+	// produced the response (only for responses).
+	Params *llm.GenerationParams `json:"params,omitempty"`
+
+	// ProviderRequestID is the upstream provider's ID for the request that
+	// produced the response (only for responses).
+	ProviderRequestID string `json:"provider_request_id,omitempty"`
+
+	// RateLimits holds the upstream rate-limit response headers, keyed by
+	// lowercase header name (only for responses).
+	RateLimits map[string]string `json:"rate_limits,omitempty"`
+
+	// ChoiceIndex is the provider's index for the sampled choice when a
+	// response returned several alternatives (n > 1). Each choice is stored
+	// as a sibling of the same request chain.
+	ChoiceIndex *int `json:"choice_index,omitempty"`
+
+	// ContextKey identifies the opaque context state a completion API
+	// returned with the response (see llm.ContextKey), so a follow-up
+	// request passing it back continues from this node.
+	ContextKey string `json:"context_key,omitempty"`
+
+	// Metadata holds caller-supplied session metadata (session ID, tags, etc.)
+	// attached via proxy request headers.
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // NodeMeta contains optional metadata for a node that is stored
 // but does not affect the content-addressable hash.
 type NodeMeta struct {
-	StopReason string
-	Usage      *llm.Usage
-	Project    string
+	StopReason        string
+	Usage             *llm.Usage
+	Project           string
+	RequestedModel    string
+	Upstream          string
+	Params            *llm.GenerationParams
+	ProviderRequestID string
+	RateLimits        map[string]string
+	ChoiceIndex       *int
+	ContextKey        string
+	Metadata          map[string]string
 }
 
 // NewNode creates a new node with the computed hash for the provided bucket.
@@ -58,12 +101,30 @@ func NewNode(bucket Bucket, parent *Node, metas ...NodeMeta) *Node {
 		n.StopReason = metas[0].StopReason
 		n.Usage = metas[0].Usage
 		n.Project = metas[0].Project
+		n.RequestedModel = metas[0].RequestedModel
+		n.Upstream = metas[0].Upstream
+		n.Params = metas[0].Params
+		n.ProviderRequestID = metas[0].ProviderRequestID
+		n.RateLimits = metas[0].RateLimits
+		n.ChoiceIndex = metas[0].ChoiceIndex
+		n.ContextKey = metas[0].ContextKey
+		n.Metadata = metas[0].Metadata
 	}
 
 	n.Hash = n.computeHash()
 	return n
 }
 
+// Verify recomputes the node's content-addressed hash from its parent hash
+// and bucket, and returns an error if it does not match Hash. A mismatch means
+// the node's content or parent link was changed after it was hashed.
+func (n *Node) Verify() error {
+	if computed := n.computeHash(); computed != n.Hash {
+		return fmt.Errorf("node %s: content hashes to %s", n.Hash, computed)
+	}
+	return nil
+}
+
 // ComputeHash calculates the content-addressed hash for a node
 func (n *Node) computeHash() string {
 	parent := ""
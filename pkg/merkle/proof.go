@@ -0,0 +1,95 @@
+package merkle
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InclusionProof proves that a node is part of the conversation ending at a
+// leaf: recomputing the hash of each step from the node's hash must arrive at
+// the leaf's hash. A proof only holds the parent links and buckets of the
+// nodes after the proven node, so it can be checked without access to the
+// store, and the proven node's own content need not be disclosed.
+type InclusionProof struct {
+	// Hash is the proven node.
+	Hash string `json:"hash"`
+
+	// Leaf is the tip of the conversation the node is included in.
+	Leaf string `json:"leaf"`
+
+	// Path holds the descendants of the proven node up to and including the
+	// leaf, oldest first.
+	Path []ProofStep `json:"path"`
+}
+
+// ProofStep is the hashed content of one node on an inclusion proof path.
+type ProofStep struct {
+	ParentHash string `json:"parent_hash"`
+	Bucket     Bucket `json:"bucket"`
+}
+
+// NewInclusionProof builds the proof that hash is included in the
+// conversation ending at the first node of ancestry. ancestry is ordered from
+// the leaf back to its root, as returned by storage.Driver.Ancestry.
+func NewInclusionProof(hash string, ancestry []*Node) (*InclusionProof, error) {
+	if len(ancestry) == 0 {
+		return nil, errors.New("empty ancestry")
+	}
+
+	proof := &InclusionProof{Hash: hash, Leaf: ancestry[0].Hash}
+	for i, node := range ancestry {
+		if node.Hash != hash {
+			continue
+		}
+
+		// ancestry[:i] are the descendants of the node, newest first.
+		for j := i - 1; j >= 0; j-- {
+			step := ancestry[j]
+			if step.ParentHash == nil {
+				return nil, fmt.Errorf("node %s has no parent", step.Hash)
+			}
+			proof.Path = append(proof.Path, ProofStep{ParentHash: *step.ParentHash, Bucket: step.Bucket})
+		}
+		return proof, nil
+	}
+
+	return nil, fmt.Errorf("node %s is not an ancestor of %s", hash, proof.Leaf)
+}
+
+// Verify recomputes the hashes along the proof's path and returns an error
+// if they do not link Hash to Leaf.
+func (p *InclusionProof) Verify() error {
+	current := p.Hash
+	for i, step := range p.Path {
+		if step.ParentHash != current {
+			return fmt.Errorf("step %d: parent %s does not match %s", i, step.ParentHash, current)
+		}
+		node := &Node{ParentHash: &step.ParentHash, Bucket: step.Bucket}
+		current = node.computeHash()
+	}
+
+	if current != p.Leaf {
+		return fmt.Errorf("proof hashes to %s, not leaf %s", current, p.Leaf)
+	}
+	return nil
+}
+
+// VerifyAncestry checks every node of ancestry, ordered from a node back to
+// its root as returned by storage.Driver.Ancestry: each node's hash must
+// match its content and each parent link must point at the next node.
+func VerifyAncestry(ancestry []*Node) error {
+	for i, node := range ancestry {
+		if err := node.Verify(); err != nil {
+			return err
+		}
+
+		last := i == len(ancestry)-1
+		switch {
+		case last && node.ParentHash != nil:
+			return fmt.Errorf("node %s: parent %s is missing", node.Hash, *node.ParentHash)
+		case !last && (node.ParentHash == nil || *node.ParentHash != ancestry[i+1].Hash):
+			return fmt.Errorf("node %s: parent link does not match %s", node.Hash, ancestry[i+1].Hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package merkle_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+)
+
+var _ = Describe("Verification", func() {
+	var (
+		root, reply, ask *merkle.Node
+		ancestry         []*merkle.Node
+	)
+
+	BeforeEach(func() {
+		root = merkle.NewNode(testBucket("hello"), nil)
+		reply = merkle.NewNode(testBucket("hi there"), root)
+		ask = merkle.NewNode(testBucket("tell me a joke"), reply)
+		ancestry = []*merkle.Node{ask, reply, root}
+	})
+
+	Describe("Node.Verify", func() {
+		It("accepts untouched nodes", func() {
+			Expect(reply.Verify()).To(Succeed())
+		})
+
+		It("detects edited content", func() {
+			reply.Bucket.Content[0].Text = "edited"
+			Expect(reply.Verify()).To(MatchError(ContainSubstring("content hashes to")))
+		})
+	})
+
+	Describe("VerifyAncestry", func() {
+		It("accepts an intact chain", func() {
+			Expect(merkle.VerifyAncestry(ancestry)).To(Succeed())
+		})
+
+		It("detects a broken parent link", func() {
+			other := merkle.NewNode(testBucket("other"), nil)
+			Expect(merkle.VerifyAncestry([]*merkle.Node{ask, other})).To(MatchError(ContainSubstring("parent link")))
+		})
+
+		It("detects a truncated chain", func() {
+			Expect(merkle.VerifyAncestry([]*merkle.Node{ask, reply})).To(MatchError(ContainSubstring("missing")))
+		})
+	})
+
+	Describe("InclusionProof", func() {
+		It("proves a node is part of the conversation ending at the leaf", func() {
+			proof, err := merkle.NewInclusionProof(root.Hash, ancestry)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proof.Leaf).To(Equal(ask.Hash))
+			Expect(proof.Path).To(HaveLen(2))
+			Expect(proof.Verify()).To(Succeed())
+		})
+
+		It("proves the leaf itself with an empty path", func() {
+			proof, err := merkle.NewInclusionProof(ask.Hash, ancestry)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proof.Path).To(BeEmpty())
+			Expect(proof.Verify()).To(Succeed())
+		})
+
+		It("fails for nodes outside the conversation", func() {
+			other := merkle.NewNode(testBucket("other"), nil)
+			_, err := merkle.NewInclusionProof(other.Hash, ancestry)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("detects tampered steps", func() {
+			proof, err := merkle.NewInclusionProof(root.Hash, ancestry)
+			Expect(err).NotTo(HaveOccurred())
+			proof.Path[0].Bucket.Content[0].Text = "edited"
+			Expect(proof.Verify()).To(HaveOccurred())
+		})
+	})
+})
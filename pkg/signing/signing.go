@@ -0,0 +1,205 @@
+// Package signing signs and verifies recorded conversations.
+//
+// A node's hash covers its parent's hash, so an ed25519 signature over a leaf
+// hash vouches for the whole conversation leading to it. Signatures are
+// stored beside the nodes through storage.SignatureStore, and inclusion
+// proofs (see merkle.InclusionProof) show that any earlier turn is part of a
+// signed conversation without access to the store.
+package signing
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/credentials"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// messagePrefix domain-separates tapes signatures from other uses of the key.
+const messagePrefix = "tapes-sign-v1\n"
+
+// GenerateKey creates a new ed25519 signing key.
+func GenerateKey() (ed25519.PrivateKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating signing key: %w", err)
+	}
+	return key, nil
+}
+
+// LoadOrCreateKey returns the signing key stored in credentials.toml,
+// generating and storing one if none exists. Reports whether the key was
+// created.
+func LoadOrCreateKey(mgr *credentials.Manager) (ed25519.PrivateKey, bool, error) {
+	encoded, err := mgr.GetSigningKey()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if encoded != "" {
+		key, err := DecodePrivateKey(encoded)
+		return key, false, err
+	}
+
+	key, err := GenerateKey()
+	if err != nil {
+		return nil, false, err
+	}
+	if err := mgr.SetSigningKey(base64.StdEncoding.EncodeToString(key)); err != nil {
+		return nil, false, fmt.Errorf("storing signing key: %w", err)
+	}
+	return key, true, nil
+}
+
+// DecodePrivateKey parses a base64 encoded ed25519 private key.
+func DecodePrivateKey(encoded string) (ed25519.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding signing key: %w", err)
+	}
+	if len(raw) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("signing key is %d bytes, want %d", len(raw), ed25519.PrivateKeySize)
+	}
+	return ed25519.PrivateKey(raw), nil
+}
+
+// EncodePublicKey returns the base64 encoding of pub, as printed by
+// "tapes sign --public-key" and accepted by DecodePublicKey.
+func EncodePublicKey(pub ed25519.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(pub)
+}
+
+// DecodePublicKey parses a base64 encoded ed25519 public key.
+func DecodePublicKey(encoded string) (ed25519.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding public key: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key is %d bytes, want %d", len(raw), ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// KeyID returns a short fingerprint of pub: the first 16 hex characters of
+// its sha256 digest.
+func KeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Sign signs the node with hash using key.
+func Sign(key ed25519.PrivateKey, hash string) *storage.Signature {
+	pub, _ := key.Public().(ed25519.PublicKey)
+	return &storage.Signature{
+		Hash:      hash,
+		KeyID:     KeyID(pub),
+		PublicKey: pub,
+		Signature: ed25519.Sign(key, message(hash)),
+		CreatedAt: time.Now(),
+	}
+}
+
+// Verify checks sig against the public key it carries. Callers that need to
+// know who signed must also compare sig.PublicKey with a trusted key.
+func Verify(sig *storage.Signature) error {
+	if len(sig.PublicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("signature of %s: invalid public key", sig.Hash)
+	}
+	if KeyID(sig.PublicKey) != sig.KeyID {
+		return fmt.Errorf("signature of %s: key id %s does not match public key", sig.Hash, sig.KeyID)
+	}
+	if !ed25519.Verify(sig.PublicKey, message(sig.Hash), sig.Signature) {
+		return fmt.Errorf("signature of %s by %s is invalid", sig.Hash, sig.KeyID)
+	}
+	return nil
+}
+
+func message(hash string) []byte {
+	return []byte(messagePrefix + hash)
+}
+
+// Attestation proves that a node is part of a signed conversation. It is
+// self-contained and can be verified offline.
+type Attestation struct {
+	Signature *storage.Signature     `json:"signature"`
+	Proof     *merkle.InclusionProof `json:"proof"`
+}
+
+// Verify checks that the proof links the node to the signed leaf and that the
+// signature over the leaf is valid.
+func (a *Attestation) Verify() error {
+	if a.Signature == nil || a.Proof == nil {
+		return errors.New("attestation is incomplete")
+	}
+	if a.Proof.Leaf != a.Signature.Hash {
+		return fmt.Errorf("proof ends at %s but %s was signed", a.Proof.Leaf, a.Signature.Hash)
+	}
+	if err := a.Proof.Verify(); err != nil {
+		return err
+	}
+	return Verify(a.Signature)
+}
+
+// Attest verifies the stored chain from hash back to its root and returns an
+// attestation for every valid signature of hash or of one of its
+// descendants. Each signed conversation containing hash is verified back to
+// its root as well. Returns an error if any node on those chains fails to
+// verify, or if a stored signature is invalid.
+func Attest(ctx context.Context, driver storage.Driver, hash string) ([]*Attestation, error) {
+	store, ok := driver.(storage.SignatureStore)
+	if !ok {
+		return nil, errors.New("storage driver does not support signatures")
+	}
+
+	ancestry, err := driver.Ancestry(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if err := merkle.VerifyAncestry(ancestry); err != nil {
+		return nil, err
+	}
+
+	signed, err := store.SignedDescendants(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var attestations []*Attestation
+	for _, leaf := range signed {
+		chain, err := driver.Ancestry(ctx, leaf)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := merkle.NewInclusionProof(hash, chain)
+		if err != nil {
+			// hash is not part of this signed conversation.
+			continue
+		}
+		if err := merkle.VerifyAncestry(chain); err != nil {
+			return nil, err
+		}
+
+		sigs, err := store.Signatures(ctx, leaf)
+		if err != nil {
+			return nil, err
+		}
+		for _, sig := range sigs {
+			attestation := &Attestation{Signature: sig, Proof: proof}
+			if err := attestation.Verify(); err != nil {
+				return nil, err
+			}
+			attestations = append(attestations, attestation)
+		}
+	}
+
+	return attestations, nil
+}
@@ -0,0 +1,133 @@
+package signing_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/credentials"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+func signingTestBucket(role, text string) merkle.Bucket {
+	return merkle.Bucket{
+		Type:     "message",
+		Role:     role,
+		Content:  []llm.ContentBlock{{Type: "text", Text: text}},
+		Model:    "test-model",
+		Provider: "test-provider",
+	}
+}
+
+var _ = Describe("Signing", func() {
+	It("signs and verifies a hash", func() {
+		key, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		sig := signing.Sign(key, "abc123")
+		Expect(sig.KeyID).To(HaveLen(16))
+		Expect(signing.Verify(sig)).To(Succeed())
+
+		sig.Hash = "abc124"
+		Expect(signing.Verify(sig)).NotTo(Succeed())
+	})
+
+	It("stores the key in credentials once", func() {
+		mgr, err := credentials.NewManager(GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+
+		first, created, err := signing.LoadOrCreateKey(mgr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeTrue())
+
+		second, created, err := signing.LoadOrCreateKey(mgr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(second.Equal(first)).To(BeTrue())
+	})
+
+	It("round-trips public keys", func() {
+		key, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		pub, err := signing.DecodePublicKey(signing.EncodePublicKey(key.Public().(ed25519.PublicKey)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub.Equal(key.Public())).To(BeTrue())
+	})
+})
+
+var _ = Describe("Attest", func() {
+	var (
+		ctx    context.Context
+		driver *inmemory.Driver
+
+		root, reply, followUp, fork *merkle.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		driver = inmemory.NewDriver()
+
+		root = merkle.NewNode(signingTestBucket("user", "Hello"), nil)
+		reply = merkle.NewNode(signingTestBucket("assistant", "Hi there!"), root)
+		followUp = merkle.NewNode(signingTestBucket("user", "How are you?"), reply)
+		fork = merkle.NewNode(signingTestBucket("assistant", "Goodbye!"), root)
+		for _, n := range []*merkle.Node{root, reply, followUp, fork} {
+			_, err := driver.Put(ctx, n)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("returns attestations for signed descendants only", func() {
+		key, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(driver.PutSignature(ctx, signing.Sign(key, followUp.Hash))).To(Succeed())
+
+		attestations, err := signing.Attest(ctx, driver, reply.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attestations).To(HaveLen(1))
+		Expect(attestations[0].Proof.Hash).To(Equal(reply.Hash))
+		Expect(attestations[0].Proof.Leaf).To(Equal(followUp.Hash))
+
+		attestations, err = signing.Attest(ctx, driver, fork.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attestations).To(BeEmpty())
+	})
+
+	It("produces attestations that verify offline", func() {
+		key, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(driver.PutSignature(ctx, signing.Sign(key, followUp.Hash))).To(Succeed())
+
+		attestations, err := signing.Attest(ctx, driver, root.Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attestations).To(HaveLen(1))
+
+		data, err := json.Marshal(attestations[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		var decoded signing.Attestation
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded.Verify()).To(Succeed())
+
+		decoded.Proof.Path[0].Bucket.Content[0].Text = "Hi there, edited"
+		Expect(decoded.Verify()).NotTo(Succeed())
+	})
+
+	It("rejects forged signatures", func() {
+		key, err := signing.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		sig := signing.Sign(key, reply.Hash)
+		sig.Hash = followUp.Hash
+		Expect(driver.PutSignature(ctx, sig)).To(Succeed())
+
+		_, err = signing.Attest(ctx, driver, root.Hash)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -36,6 +36,22 @@ type State struct {
 	UpdatedAt        time.Time      `json:"updated_at"`
 }
 
+// AgentPID returns the PID of the running agent named name, or 0 when none
+// or several such agents are running.
+func (s *State) AgentPID(name string) int {
+	pid := 0
+	for _, agent := range s.Agents {
+		if agent.Name != name {
+			continue
+		}
+		if pid != 0 {
+			return 0
+		}
+		pid = agent.PID
+	}
+	return pid
+}
+
 type Manager struct {
 	Dir       string
 	StatePath string
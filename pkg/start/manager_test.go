@@ -54,6 +54,18 @@ var _ = Describe("Manager", func() {
 		Expect(loaded.LogPath).To(Equal(filepath.Join(tempDir, "start.log")))
 	})
 
+	It("finds the PID of the only running agent with a name", func() {
+		state := &start.State{Agents: []start.AgentSession{
+			{Name: "claude", PID: 456},
+			{Name: "codex", PID: 789},
+			{Name: "codex", PID: 790},
+		}}
+
+		Expect(state.AgentPID("claude")).To(Equal(456))
+		Expect(state.AgentPID("codex")).To(BeZero())
+		Expect(state.AgentPID("opencode")).To(BeZero())
+	})
+
 	It("clears state", func() {
 		manager, err := start.NewManager(tempDir)
 		Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Rating is a human judgement of a response.
+type Rating string
+
+const (
+	// RatingGood marks a response worth keeping, e.g. for fine-tuning.
+	RatingGood Rating = "good"
+
+	// RatingBad marks an unhelpful or wrong response.
+	RatingBad Rating = "bad"
+
+	// RatingFailure marks a notable failure worth studying.
+	RatingFailure Rating = "failure"
+)
+
+// Ratings lists the valid ratings.
+var Ratings = []Rating{RatingGood, RatingBad, RatingFailure}
+
+// ParseRating returns the rating named s. The empty string is a valid,
+// absent rating.
+func ParseRating(s string) (Rating, error) {
+	r := Rating(strings.ToLower(strings.TrimSpace(s)))
+	if r != "" && !slices.Contains(Ratings, r) {
+		return "", fmt.Errorf("unknown rating %q (use good, bad or failure)", s)
+	}
+	return r, nil
+}
+
+// Annotation is human feedback on a node. Each author holds at most one
+// annotation per node. Annotations are stored beside the nodes and never
+// affect a node's content hash.
+type Annotation struct {
+	// Hash is the annotated node.
+	Hash string `json:"hash"`
+
+	// Author identifies who wrote the annotation.
+	Author string `json:"author"`
+
+	// Rating is the author's judgement of the node, if any.
+	Rating Rating `json:"rating,omitempty"`
+
+	// Labels are free-form tags such as "hallucination" or "great-refactor".
+	Labels []string `json:"labels,omitempty"`
+
+	// Notes is free-text commentary.
+	Notes string `json:"notes,omitempty"`
+
+	CreatedAt time.Time `json:"created_at,omitzero"`
+	UpdatedAt time.Time `json:"updated_at,omitzero"`
+}
+
+// DefaultAuthor returns the author of annotations made on this machine: the
+// current user's login name, or "anonymous" when it cannot be determined.
+func DefaultAuthor() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return "anonymous"
+}
+
+// AnnotationFilter restricts a listing of annotations. Empty fields match
+// anything.
+type AnnotationFilter struct {
+	Rating Rating
+	Label  string
+	Author string
+}
+
+// Match reports whether a satisfies the filter.
+func (f AnnotationFilter) Match(a *Annotation) bool {
+	return (f.Rating == "" || a.Rating == f.Rating) &&
+		(f.Label == "" || slices.Contains(a.Labels, f.Label)) &&
+		(f.Author == "" || a.Author == f.Author)
+}
+
+// AnnotationStore is implemented by drivers that can store annotations.
+type AnnotationStore interface {
+	// PutAnnotation stores an annotation, replacing the rating, labels and
+	// notes of any annotation of the same node by the same author.
+	PutAnnotation(ctx context.Context, annotation *Annotation) error
+
+	// Annotations returns the annotations of the node with hash, oldest
+	// first.
+	Annotations(ctx context.Context, hash string) ([]*Annotation, error)
+
+	// DeleteAnnotation removes the author's annotation of the node with
+	// hash. Deleting a missing annotation is a no-op.
+	DeleteAnnotation(ctx context.Context, hash, author string) error
+
+	// ListAnnotations returns every annotation matching filter, ordered by
+	// hash and then author.
+	ListAnnotations(ctx context.Context, filter AnnotationFilter) ([]*Annotation, error)
+}
@@ -0,0 +1,30 @@
+package storage
+
+import "context"
+
+// Blob is a content-addressed payload, such as image data or a large tool
+// output, stored outside of node rows.
+type Blob struct {
+	// Hash is the SHA-256 of Data (hex-encoded).
+	Hash string
+
+	// MediaType is the MIME type of image payloads; empty for text.
+	MediaType string
+
+	// Data is the raw payload: decoded bytes for images, UTF-8 text for
+	// tool outputs.
+	Data []byte
+}
+
+// BlobStore is implemented by drivers that move large content block payloads
+// out of node rows into a content-addressed blob store. Payloads are restored
+// when nodes are read, so node hashes are unaffected.
+type BlobStore interface {
+	// Blob returns the blob with the given hash.
+	// Returns NotFoundError when it does not exist.
+	Blob(ctx context.Context, hash string) (*Blob, error)
+
+	// CollectBlobs deletes blobs that are no longer referenced by any node
+	// and returns the number removed.
+	CollectBlobs(ctx context.Context) (int, error)
+}
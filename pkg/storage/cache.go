@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+)
+
+// Cache is implemented by drivers that can serve previously recorded
+// responses for identical requests. Cache keys are stored alongside response
+// nodes as metadata and never affect node hashes.
+type Cache interface {
+	// CachedResponse returns the most recently cached response node for key.
+	// Entries older than maxAge are ignored; a zero maxAge disables expiry.
+	// Returns NotFoundError when there is no usable entry.
+	CachedResponse(ctx context.Context, key string, maxAge time.Duration) (*merkle.Node, error)
+
+	// SetCacheKey records the response node hash as the answer for key and
+	// refreshes its cache timestamp.
+	SetCacheKey(ctx context.Context, hash, key string) error
+
+	// RecordCacheHit increments the hit counter of a response node.
+	RecordCacheHit(ctx context.Context, hash string) error
+}
+
+// ContextIndex is implemented by drivers that can find the response node
+// that returned an opaque completion context. Completion APIs that pass the
+// context back instead of the full history (Ollama's /api/generate) are
+// chained under that node rather than starting a new conversation.
+type ContextIndex interface {
+	// NodeByContextKey returns the most recent node stored with key.
+	// Returns NotFoundError when there is none.
+	NodeByContextKey(ctx context.Context, key string) (*merkle.Node, error)
+}
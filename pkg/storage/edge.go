@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// EdgeKind names a relation between nodes that is not expressed by their
+// parent hashes.
+type EdgeKind string
+
+const (
+	// EdgeContinues links the opening node of a conversation that an agent
+	// restarted after compacting its context to the node of the
+	// conversation it continues.
+	EdgeContinues EdgeKind = "continues"
+
+	// EdgeSubagent links a response that spawned a subagent, e.g. with a
+	// Task tool call, to the opening node of the subagent's conversation.
+	EdgeSubagent EdgeKind = "subagent"
+)
+
+// IsSystemRole reports whether role is that of a system or developer
+// message. A conversation's opening node is its first message with another
+// role: conversations with the same system prompt share their root, so
+// edges link conversations by their opening nodes instead.
+func IsSystemRole(role string) bool {
+	return role == "system" || role == "developer"
+}
+
+// Reasons an EdgeContinues edge was recorded, strongest evidence first.
+const (
+	// ContinuesHeader means the client named the continued node with the
+	// X-Tapes-Continues header.
+	ContinuesHeader = "header"
+
+	// ContinuesSummary means the conversation opened with an agent's
+	// compaction summary prompt.
+	ContinuesSummary = "summary"
+
+	// ContinuesToolID means the conversation referenced a tool call made in
+	// the continued conversation.
+	ContinuesToolID = "tool_id"
+)
+
+// Reasons an EdgeSubagent edge was recorded.
+const (
+	// SubagentHeader means the subagent named its parent node with the
+	// X-Tapes-Parent header.
+	SubagentHeader = "header"
+
+	// SubagentPrompt means the subagent's conversation opened with the
+	// prompt of a recent tool call that spawns subagents.
+	SubagentPrompt = "prompt"
+)
+
+// Edge is a relation between two nodes recorded beside the DAG. Edges never
+// affect a node's content hash.
+type Edge struct {
+	// From is the node the edge starts at, e.g. the opening node of a
+	// continuation.
+	From string `json:"from"`
+
+	// To is the node the edge points to, e.g. the last response of the
+	// continued conversation.
+	To string `json:"to"`
+
+	Kind EdgeKind `json:"kind"`
+
+	// Reason records why the edge was inferred.
+	Reason string `json:"reason,omitempty"`
+
+	// ToolUseID is the tool call of From that the edge belongs to, e.g. the
+	// Task call that spawned a subagent.
+	ToolUseID string `json:"tool_use_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// EdgeStore is implemented by drivers that can store edges between nodes.
+type EdgeStore interface {
+	// PutEdge stores an edge. Storing an edge of the same kind between the
+	// same nodes again is a no-op.
+	PutEdge(ctx context.Context, edge *Edge) error
+
+	// EdgesFrom returns the edges of kind starting at the node with hash,
+	// oldest first.
+	EdgesFrom(ctx context.Context, hash string, kind EdgeKind) ([]*Edge, error)
+
+	// EdgesTo returns the edges of kind pointing to the node with hash,
+	// oldest first.
+	EdgesTo(ctx context.Context, hash string, kind EdgeKind) ([]*Edge, error)
+
+	// ListEdges returns every edge of kind, oldest first.
+	ListEdges(ctx context.Context, kind EdgeKind) ([]*Edge, error)
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// EmbeddingCall is a record of an embeddings API call made through the proxy.
+// Embedding calls are kept apart from the conversation DAG.
+type EmbeddingCall struct {
+	// Provider is the provider type that served the call.
+	Provider string
+
+	// Model is the model that produced the embeddings.
+	Model string
+
+	// AgentName is the agent that made the call, if known.
+	AgentName string
+
+	// Project is the git repository or project name the call belongs to.
+	Project string
+
+	// InputCount is the number of inputs embedded.
+	InputCount int
+
+	// Input holds the input texts when input capture is enabled.
+	Input []string
+
+	// Dimensions is the length of each embedding, zero if unknown.
+	Dimensions int
+
+	// Usage is the token usage reported by the provider.
+	Usage *llm.Usage
+
+	// Latency is the time from receiving the request to the upstream
+	// response.
+	Latency time.Duration
+
+	// CreatedAt is when the call was made.
+	CreatedAt time.Time
+}
+
+// EmbeddingStore is implemented by drivers that record embedding calls.
+type EmbeddingStore interface {
+	// PutEmbeddingCall records an embedding call.
+	PutEmbeddingCall(ctx context.Context, call *EmbeddingCall) error
+
+	// EmbeddingCalls returns every recorded embedding call made at or after
+	// since, oldest first. A zero since returns all calls.
+	EmbeddingCalls(ctx context.Context, since time.Time) ([]*EmbeddingCall, error)
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// AnnotationUpdate is the builder for updating Annotation entities.
+type AnnotationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnnotationMutation
+}
+
+// Where appends a list predicates to the AnnotationUpdate builder.
+func (_u *AnnotationUpdate) Where(ps ...predicate.Annotation) *AnnotationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *AnnotationUpdate) SetRating(v string) *AnnotationUpdate {
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableRating(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// ClearRating clears the value of the "rating" field.
+func (_u *AnnotationUpdate) ClearRating() *AnnotationUpdate {
+	_u.mutation.ClearRating()
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *AnnotationUpdate) SetLabels(v []string) *AnnotationUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// AppendLabels appends value to the "labels" field.
+func (_u *AnnotationUpdate) AppendLabels(v []string) *AnnotationUpdate {
+	_u.mutation.AppendLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *AnnotationUpdate) ClearLabels() *AnnotationUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *AnnotationUpdate) SetNotes(v string) *AnnotationUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *AnnotationUpdate) SetNillableNotes(v *string) *AnnotationUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *AnnotationUpdate) ClearNotes() *AnnotationUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnnotationUpdate) SetUpdatedAt(v time.Time) *AnnotationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AnnotationMutation object of the builder.
+func (_u *AnnotationUpdate) Mutation() *AnnotationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnnotationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnnotationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnnotationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnnotationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnnotationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := annotation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *AnnotationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(annotation.Table, annotation.Columns, sqlgraph.NewFieldSpec(annotation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(annotation.FieldRating, field.TypeString, value)
+	}
+	if _u.mutation.RatingCleared() {
+		_spec.ClearField(annotation.FieldRating, field.TypeString)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(annotation.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, annotation.FieldLabels, value)
+		})
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(annotation.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(annotation.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(annotation.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(annotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{annotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnnotationUpdateOne is the builder for updating a single Annotation entity.
+type AnnotationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnnotationMutation
+}
+
+// SetRating sets the "rating" field.
+func (_u *AnnotationUpdateOne) SetRating(v string) *AnnotationUpdateOne {
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableRating(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// ClearRating clears the value of the "rating" field.
+func (_u *AnnotationUpdateOne) ClearRating() *AnnotationUpdateOne {
+	_u.mutation.ClearRating()
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *AnnotationUpdateOne) SetLabels(v []string) *AnnotationUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// AppendLabels appends value to the "labels" field.
+func (_u *AnnotationUpdateOne) AppendLabels(v []string) *AnnotationUpdateOne {
+	_u.mutation.AppendLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *AnnotationUpdateOne) ClearLabels() *AnnotationUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *AnnotationUpdateOne) SetNotes(v string) *AnnotationUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *AnnotationUpdateOne) SetNillableNotes(v *string) *AnnotationUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *AnnotationUpdateOne) ClearNotes() *AnnotationUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AnnotationUpdateOne) SetUpdatedAt(v time.Time) *AnnotationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AnnotationMutation object of the builder.
+func (_u *AnnotationUpdateOne) Mutation() *AnnotationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnnotationUpdate builder.
+func (_u *AnnotationUpdateOne) Where(ps ...predicate.Annotation) *AnnotationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnnotationUpdateOne) Select(field string, fields ...string) *AnnotationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Annotation entity.
+func (_u *AnnotationUpdateOne) Save(ctx context.Context) (*Annotation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnnotationUpdateOne) SaveX(ctx context.Context) *Annotation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnnotationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnnotationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AnnotationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := annotation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *AnnotationUpdateOne) sqlSave(ctx context.Context) (_node *Annotation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(annotation.Table, annotation.Columns, sqlgraph.NewFieldSpec(annotation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Annotation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, annotation.FieldID)
+		for _, f := range fields {
+			if !annotation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != annotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(annotation.FieldRating, field.TypeString, value)
+	}
+	if _u.mutation.RatingCleared() {
+		_spec.ClearField(annotation.FieldRating, field.TypeString)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(annotation.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, annotation.FieldLabels, value)
+		})
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(annotation.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(annotation.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(annotation.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(annotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Annotation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{annotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
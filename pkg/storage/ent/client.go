@@ -15,8 +15,16 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/blob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/facet"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/ref"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/signature"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,10 +32,26 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Annotation is the client for interacting with the Annotation builders.
+	Annotation *AnnotationClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// EmbeddingCall is the client for interacting with the EmbeddingCall builders.
+	EmbeddingCall *EmbeddingCallClient
 	// Facet is the client for interacting with the Facet builders.
 	Facet *FacetClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// NodeBlob is the client for interacting with the NodeBlob builders.
+	NodeBlob *NodeBlobClient
+	// NodeLink is the client for interacting with the NodeLink builders.
+	NodeLink *NodeLinkClient
+	// NodeMetadata is the client for interacting with the NodeMetadata builders.
+	NodeMetadata *NodeMetadataClient
+	// Ref is the client for interacting with the Ref builders.
+	Ref *RefClient
+	// Signature is the client for interacting with the Signature builders.
+	Signature *SignatureClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -39,8 +63,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Annotation = NewAnnotationClient(c.config)
+	c.Blob = NewBlobClient(c.config)
+	c.EmbeddingCall = NewEmbeddingCallClient(c.config)
 	c.Facet = NewFacetClient(c.config)
 	c.Node = NewNodeClient(c.config)
+	c.NodeBlob = NewNodeBlobClient(c.config)
+	c.NodeLink = NewNodeLinkClient(c.config)
+	c.NodeMetadata = NewNodeMetadataClient(c.config)
+	c.Ref = NewRefClient(c.config)
+	c.Signature = NewSignatureClient(c.config)
 }
 
 type (
@@ -131,10 +163,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Facet:  NewFacetClient(cfg),
-		Node:   NewNodeClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Annotation:    NewAnnotationClient(cfg),
+		Blob:          NewBlobClient(cfg),
+		EmbeddingCall: NewEmbeddingCallClient(cfg),
+		Facet:         NewFacetClient(cfg),
+		Node:          NewNodeClient(cfg),
+		NodeBlob:      NewNodeBlobClient(cfg),
+		NodeLink:      NewNodeLinkClient(cfg),
+		NodeMetadata:  NewNodeMetadataClient(cfg),
+		Ref:           NewRefClient(cfg),
+		Signature:     NewSignatureClient(cfg),
 	}, nil
 }
 
@@ -152,17 +192,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Facet:  NewFacetClient(cfg),
-		Node:   NewNodeClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Annotation:    NewAnnotationClient(cfg),
+		Blob:          NewBlobClient(cfg),
+		EmbeddingCall: NewEmbeddingCallClient(cfg),
+		Facet:         NewFacetClient(cfg),
+		Node:          NewNodeClient(cfg),
+		NodeBlob:      NewNodeBlobClient(cfg),
+		NodeLink:      NewNodeLinkClient(cfg),
+		NodeMetadata:  NewNodeMetadataClient(cfg),
+		Ref:           NewRefClient(cfg),
+		Signature:     NewSignatureClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Facet.
+//		Annotation.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -184,29 +232,452 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Facet.Use(hooks...)
-	c.Node.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Annotation, c.Blob, c.EmbeddingCall, c.Facet, c.Node, c.NodeBlob, c.NodeLink,
+		c.NodeMetadata, c.Ref, c.Signature,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Facet.Intercept(interceptors...)
-	c.Node.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Annotation, c.Blob, c.EmbeddingCall, c.Facet, c.Node, c.NodeBlob, c.NodeLink,
+		c.NodeMetadata, c.Ref, c.Signature,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AnnotationMutation:
+		return c.Annotation.mutate(ctx, m)
+	case *BlobMutation:
+		return c.Blob.mutate(ctx, m)
+	case *EmbeddingCallMutation:
+		return c.EmbeddingCall.mutate(ctx, m)
 	case *FacetMutation:
 		return c.Facet.mutate(ctx, m)
 	case *NodeMutation:
 		return c.Node.mutate(ctx, m)
+	case *NodeBlobMutation:
+		return c.NodeBlob.mutate(ctx, m)
+	case *NodeLinkMutation:
+		return c.NodeLink.mutate(ctx, m)
+	case *NodeMetadataMutation:
+		return c.NodeMetadata.mutate(ctx, m)
+	case *RefMutation:
+		return c.Ref.mutate(ctx, m)
+	case *SignatureMutation:
+		return c.Signature.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AnnotationClient is a client for the Annotation schema.
+type AnnotationClient struct {
+	config
+}
+
+// NewAnnotationClient returns a client for the Annotation from the given config.
+func NewAnnotationClient(c config) *AnnotationClient {
+	return &AnnotationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `annotation.Hooks(f(g(h())))`.
+func (c *AnnotationClient) Use(hooks ...Hook) {
+	c.hooks.Annotation = append(c.hooks.Annotation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `annotation.Intercept(f(g(h())))`.
+func (c *AnnotationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Annotation = append(c.inters.Annotation, interceptors...)
+}
+
+// Create returns a builder for creating a Annotation entity.
+func (c *AnnotationClient) Create() *AnnotationCreate {
+	mutation := newAnnotationMutation(c.config, OpCreate)
+	return &AnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Annotation entities.
+func (c *AnnotationClient) CreateBulk(builders ...*AnnotationCreate) *AnnotationCreateBulk {
+	return &AnnotationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnnotationClient) MapCreateBulk(slice any, setFunc func(*AnnotationCreate, int)) *AnnotationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnnotationCreateBulk{err: fmt.Errorf("calling to AnnotationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnnotationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnnotationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Annotation.
+func (c *AnnotationClient) Update() *AnnotationUpdate {
+	mutation := newAnnotationMutation(c.config, OpUpdate)
+	return &AnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnnotationClient) UpdateOne(_m *Annotation) *AnnotationUpdateOne {
+	mutation := newAnnotationMutation(c.config, OpUpdateOne, withAnnotation(_m))
+	return &AnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnnotationClient) UpdateOneID(id int) *AnnotationUpdateOne {
+	mutation := newAnnotationMutation(c.config, OpUpdateOne, withAnnotationID(id))
+	return &AnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Annotation.
+func (c *AnnotationClient) Delete() *AnnotationDelete {
+	mutation := newAnnotationMutation(c.config, OpDelete)
+	return &AnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnnotationClient) DeleteOne(_m *Annotation) *AnnotationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnnotationClient) DeleteOneID(id int) *AnnotationDeleteOne {
+	builder := c.Delete().Where(annotation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnnotationDeleteOne{builder}
+}
+
+// Query returns a query builder for Annotation.
+func (c *AnnotationClient) Query() *AnnotationQuery {
+	return &AnnotationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnnotation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Annotation entity by its id.
+func (c *AnnotationClient) Get(ctx context.Context, id int) (*Annotation, error) {
+	return c.Query().Where(annotation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnnotationClient) GetX(ctx context.Context, id int) *Annotation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnnotationClient) Hooks() []Hook {
+	return c.hooks.Annotation
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnnotationClient) Interceptors() []Interceptor {
+	return c.inters.Annotation
+}
+
+func (c *AnnotationClient) mutate(ctx context.Context, m *AnnotationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Annotation mutation op: %q", m.Op())
+	}
+}
+
+// BlobClient is a client for the Blob schema.
+type BlobClient struct {
+	config
+}
+
+// NewBlobClient returns a client for the Blob from the given config.
+func NewBlobClient(c config) *BlobClient {
+	return &BlobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blob.Hooks(f(g(h())))`.
+func (c *BlobClient) Use(hooks ...Hook) {
+	c.hooks.Blob = append(c.hooks.Blob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blob.Intercept(f(g(h())))`.
+func (c *BlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blob = append(c.inters.Blob, interceptors...)
+}
+
+// Create returns a builder for creating a Blob entity.
+func (c *BlobClient) Create() *BlobCreate {
+	mutation := newBlobMutation(c.config, OpCreate)
+	return &BlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Blob entities.
+func (c *BlobClient) CreateBulk(builders ...*BlobCreate) *BlobCreateBulk {
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlobClient) MapCreateBulk(slice any, setFunc func(*BlobCreate, int)) *BlobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlobCreateBulk{err: fmt.Errorf("calling to BlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Blob.
+func (c *BlobClient) Update() *BlobUpdate {
+	mutation := newBlobMutation(c.config, OpUpdate)
+	return &BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlobClient) UpdateOne(_m *Blob) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlob(_m))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlobClient) UpdateOneID(id string) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlobID(id))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Blob.
+func (c *BlobClient) Delete() *BlobDelete {
+	mutation := newBlobMutation(c.config, OpDelete)
+	return &BlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlobClient) DeleteOne(_m *Blob) *BlobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlobClient) DeleteOneID(id string) *BlobDeleteOne {
+	builder := c.Delete().Where(blob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlobDeleteOne{builder}
+}
+
+// Query returns a query builder for Blob.
+func (c *BlobClient) Query() *BlobQuery {
+	return &BlobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Blob entity by its id.
+func (c *BlobClient) Get(ctx context.Context, id string) (*Blob, error) {
+	return c.Query().Where(blob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlobClient) GetX(ctx context.Context, id string) *Blob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BlobClient) Hooks() []Hook {
+	return c.hooks.Blob
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlobClient) Interceptors() []Interceptor {
+	return c.inters.Blob
+}
+
+func (c *BlobClient) mutate(ctx context.Context, m *BlobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Blob mutation op: %q", m.Op())
+	}
+}
+
+// EmbeddingCallClient is a client for the EmbeddingCall schema.
+type EmbeddingCallClient struct {
+	config
+}
+
+// NewEmbeddingCallClient returns a client for the EmbeddingCall from the given config.
+func NewEmbeddingCallClient(c config) *EmbeddingCallClient {
+	return &EmbeddingCallClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `embeddingcall.Hooks(f(g(h())))`.
+func (c *EmbeddingCallClient) Use(hooks ...Hook) {
+	c.hooks.EmbeddingCall = append(c.hooks.EmbeddingCall, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `embeddingcall.Intercept(f(g(h())))`.
+func (c *EmbeddingCallClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmbeddingCall = append(c.inters.EmbeddingCall, interceptors...)
+}
+
+// Create returns a builder for creating a EmbeddingCall entity.
+func (c *EmbeddingCallClient) Create() *EmbeddingCallCreate {
+	mutation := newEmbeddingCallMutation(c.config, OpCreate)
+	return &EmbeddingCallCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmbeddingCall entities.
+func (c *EmbeddingCallClient) CreateBulk(builders ...*EmbeddingCallCreate) *EmbeddingCallCreateBulk {
+	return &EmbeddingCallCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmbeddingCallClient) MapCreateBulk(slice any, setFunc func(*EmbeddingCallCreate, int)) *EmbeddingCallCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmbeddingCallCreateBulk{err: fmt.Errorf("calling to EmbeddingCallClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmbeddingCallCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmbeddingCallCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmbeddingCall.
+func (c *EmbeddingCallClient) Update() *EmbeddingCallUpdate {
+	mutation := newEmbeddingCallMutation(c.config, OpUpdate)
+	return &EmbeddingCallUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmbeddingCallClient) UpdateOne(_m *EmbeddingCall) *EmbeddingCallUpdateOne {
+	mutation := newEmbeddingCallMutation(c.config, OpUpdateOne, withEmbeddingCall(_m))
+	return &EmbeddingCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmbeddingCallClient) UpdateOneID(id int) *EmbeddingCallUpdateOne {
+	mutation := newEmbeddingCallMutation(c.config, OpUpdateOne, withEmbeddingCallID(id))
+	return &EmbeddingCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmbeddingCall.
+func (c *EmbeddingCallClient) Delete() *EmbeddingCallDelete {
+	mutation := newEmbeddingCallMutation(c.config, OpDelete)
+	return &EmbeddingCallDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmbeddingCallClient) DeleteOne(_m *EmbeddingCall) *EmbeddingCallDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmbeddingCallClient) DeleteOneID(id int) *EmbeddingCallDeleteOne {
+	builder := c.Delete().Where(embeddingcall.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmbeddingCallDeleteOne{builder}
+}
+
+// Query returns a query builder for EmbeddingCall.
+func (c *EmbeddingCallClient) Query() *EmbeddingCallQuery {
+	return &EmbeddingCallQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmbeddingCall},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmbeddingCall entity by its id.
+func (c *EmbeddingCallClient) Get(ctx context.Context, id int) (*EmbeddingCall, error) {
+	return c.Query().Where(embeddingcall.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmbeddingCallClient) GetX(ctx context.Context, id int) *EmbeddingCall {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmbeddingCallClient) Hooks() []Hook {
+	return c.hooks.EmbeddingCall
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmbeddingCallClient) Interceptors() []Interceptor {
+	return c.inters.EmbeddingCall
+}
+
+func (c *EmbeddingCallClient) mutate(ctx context.Context, m *EmbeddingCallMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmbeddingCallCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmbeddingCallUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmbeddingCallUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmbeddingCallDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmbeddingCall mutation op: %q", m.Op())
+	}
+}
+
 // FacetClient is a client for the Facet schema.
 type FacetClient struct {
 	config
@@ -505,12 +976,679 @@ func (c *NodeClient) mutate(ctx context.Context, m *NodeMutation) (Value, error)
 	}
 }
 
+// NodeBlobClient is a client for the NodeBlob schema.
+type NodeBlobClient struct {
+	config
+}
+
+// NewNodeBlobClient returns a client for the NodeBlob from the given config.
+func NewNodeBlobClient(c config) *NodeBlobClient {
+	return &NodeBlobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nodeblob.Hooks(f(g(h())))`.
+func (c *NodeBlobClient) Use(hooks ...Hook) {
+	c.hooks.NodeBlob = append(c.hooks.NodeBlob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nodeblob.Intercept(f(g(h())))`.
+func (c *NodeBlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NodeBlob = append(c.inters.NodeBlob, interceptors...)
+}
+
+// Create returns a builder for creating a NodeBlob entity.
+func (c *NodeBlobClient) Create() *NodeBlobCreate {
+	mutation := newNodeBlobMutation(c.config, OpCreate)
+	return &NodeBlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NodeBlob entities.
+func (c *NodeBlobClient) CreateBulk(builders ...*NodeBlobCreate) *NodeBlobCreateBulk {
+	return &NodeBlobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeBlobClient) MapCreateBulk(slice any, setFunc func(*NodeBlobCreate, int)) *NodeBlobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeBlobCreateBulk{err: fmt.Errorf("calling to NodeBlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeBlobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeBlobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NodeBlob.
+func (c *NodeBlobClient) Update() *NodeBlobUpdate {
+	mutation := newNodeBlobMutation(c.config, OpUpdate)
+	return &NodeBlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeBlobClient) UpdateOne(_m *NodeBlob) *NodeBlobUpdateOne {
+	mutation := newNodeBlobMutation(c.config, OpUpdateOne, withNodeBlob(_m))
+	return &NodeBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeBlobClient) UpdateOneID(id int) *NodeBlobUpdateOne {
+	mutation := newNodeBlobMutation(c.config, OpUpdateOne, withNodeBlobID(id))
+	return &NodeBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NodeBlob.
+func (c *NodeBlobClient) Delete() *NodeBlobDelete {
+	mutation := newNodeBlobMutation(c.config, OpDelete)
+	return &NodeBlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NodeBlobClient) DeleteOne(_m *NodeBlob) *NodeBlobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NodeBlobClient) DeleteOneID(id int) *NodeBlobDeleteOne {
+	builder := c.Delete().Where(nodeblob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeBlobDeleteOne{builder}
+}
+
+// Query returns a query builder for NodeBlob.
+func (c *NodeBlobClient) Query() *NodeBlobQuery {
+	return &NodeBlobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNodeBlob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NodeBlob entity by its id.
+func (c *NodeBlobClient) Get(ctx context.Context, id int) (*NodeBlob, error) {
+	return c.Query().Where(nodeblob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeBlobClient) GetX(ctx context.Context, id int) *NodeBlob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NodeBlobClient) Hooks() []Hook {
+	return c.hooks.NodeBlob
+}
+
+// Interceptors returns the client interceptors.
+func (c *NodeBlobClient) Interceptors() []Interceptor {
+	return c.inters.NodeBlob
+}
+
+func (c *NodeBlobClient) mutate(ctx context.Context, m *NodeBlobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NodeBlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NodeBlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NodeBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NodeBlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NodeBlob mutation op: %q", m.Op())
+	}
+}
+
+// NodeLinkClient is a client for the NodeLink schema.
+type NodeLinkClient struct {
+	config
+}
+
+// NewNodeLinkClient returns a client for the NodeLink from the given config.
+func NewNodeLinkClient(c config) *NodeLinkClient {
+	return &NodeLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nodelink.Hooks(f(g(h())))`.
+func (c *NodeLinkClient) Use(hooks ...Hook) {
+	c.hooks.NodeLink = append(c.hooks.NodeLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nodelink.Intercept(f(g(h())))`.
+func (c *NodeLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NodeLink = append(c.inters.NodeLink, interceptors...)
+}
+
+// Create returns a builder for creating a NodeLink entity.
+func (c *NodeLinkClient) Create() *NodeLinkCreate {
+	mutation := newNodeLinkMutation(c.config, OpCreate)
+	return &NodeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NodeLink entities.
+func (c *NodeLinkClient) CreateBulk(builders ...*NodeLinkCreate) *NodeLinkCreateBulk {
+	return &NodeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeLinkClient) MapCreateBulk(slice any, setFunc func(*NodeLinkCreate, int)) *NodeLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeLinkCreateBulk{err: fmt.Errorf("calling to NodeLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NodeLink.
+func (c *NodeLinkClient) Update() *NodeLinkUpdate {
+	mutation := newNodeLinkMutation(c.config, OpUpdate)
+	return &NodeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeLinkClient) UpdateOne(_m *NodeLink) *NodeLinkUpdateOne {
+	mutation := newNodeLinkMutation(c.config, OpUpdateOne, withNodeLink(_m))
+	return &NodeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeLinkClient) UpdateOneID(id int) *NodeLinkUpdateOne {
+	mutation := newNodeLinkMutation(c.config, OpUpdateOne, withNodeLinkID(id))
+	return &NodeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NodeLink.
+func (c *NodeLinkClient) Delete() *NodeLinkDelete {
+	mutation := newNodeLinkMutation(c.config, OpDelete)
+	return &NodeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NodeLinkClient) DeleteOne(_m *NodeLink) *NodeLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NodeLinkClient) DeleteOneID(id int) *NodeLinkDeleteOne {
+	builder := c.Delete().Where(nodelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for NodeLink.
+func (c *NodeLinkClient) Query() *NodeLinkQuery {
+	return &NodeLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNodeLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NodeLink entity by its id.
+func (c *NodeLinkClient) Get(ctx context.Context, id int) (*NodeLink, error) {
+	return c.Query().Where(nodelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeLinkClient) GetX(ctx context.Context, id int) *NodeLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NodeLinkClient) Hooks() []Hook {
+	return c.hooks.NodeLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *NodeLinkClient) Interceptors() []Interceptor {
+	return c.inters.NodeLink
+}
+
+func (c *NodeLinkClient) mutate(ctx context.Context, m *NodeLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NodeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NodeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NodeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NodeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NodeLink mutation op: %q", m.Op())
+	}
+}
+
+// NodeMetadataClient is a client for the NodeMetadata schema.
+type NodeMetadataClient struct {
+	config
+}
+
+// NewNodeMetadataClient returns a client for the NodeMetadata from the given config.
+func NewNodeMetadataClient(c config) *NodeMetadataClient {
+	return &NodeMetadataClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nodemetadata.Hooks(f(g(h())))`.
+func (c *NodeMetadataClient) Use(hooks ...Hook) {
+	c.hooks.NodeMetadata = append(c.hooks.NodeMetadata, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nodemetadata.Intercept(f(g(h())))`.
+func (c *NodeMetadataClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NodeMetadata = append(c.inters.NodeMetadata, interceptors...)
+}
+
+// Create returns a builder for creating a NodeMetadata entity.
+func (c *NodeMetadataClient) Create() *NodeMetadataCreate {
+	mutation := newNodeMetadataMutation(c.config, OpCreate)
+	return &NodeMetadataCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NodeMetadata entities.
+func (c *NodeMetadataClient) CreateBulk(builders ...*NodeMetadataCreate) *NodeMetadataCreateBulk {
+	return &NodeMetadataCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeMetadataClient) MapCreateBulk(slice any, setFunc func(*NodeMetadataCreate, int)) *NodeMetadataCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeMetadataCreateBulk{err: fmt.Errorf("calling to NodeMetadataClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeMetadataCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeMetadataCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NodeMetadata.
+func (c *NodeMetadataClient) Update() *NodeMetadataUpdate {
+	mutation := newNodeMetadataMutation(c.config, OpUpdate)
+	return &NodeMetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeMetadataClient) UpdateOne(_m *NodeMetadata) *NodeMetadataUpdateOne {
+	mutation := newNodeMetadataMutation(c.config, OpUpdateOne, withNodeMetadata(_m))
+	return &NodeMetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeMetadataClient) UpdateOneID(id int) *NodeMetadataUpdateOne {
+	mutation := newNodeMetadataMutation(c.config, OpUpdateOne, withNodeMetadataID(id))
+	return &NodeMetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NodeMetadata.
+func (c *NodeMetadataClient) Delete() *NodeMetadataDelete {
+	mutation := newNodeMetadataMutation(c.config, OpDelete)
+	return &NodeMetadataDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NodeMetadataClient) DeleteOne(_m *NodeMetadata) *NodeMetadataDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NodeMetadataClient) DeleteOneID(id int) *NodeMetadataDeleteOne {
+	builder := c.Delete().Where(nodemetadata.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeMetadataDeleteOne{builder}
+}
+
+// Query returns a query builder for NodeMetadata.
+func (c *NodeMetadataClient) Query() *NodeMetadataQuery {
+	return &NodeMetadataQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNodeMetadata},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NodeMetadata entity by its id.
+func (c *NodeMetadataClient) Get(ctx context.Context, id int) (*NodeMetadata, error) {
+	return c.Query().Where(nodemetadata.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeMetadataClient) GetX(ctx context.Context, id int) *NodeMetadata {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NodeMetadataClient) Hooks() []Hook {
+	return c.hooks.NodeMetadata
+}
+
+// Interceptors returns the client interceptors.
+func (c *NodeMetadataClient) Interceptors() []Interceptor {
+	return c.inters.NodeMetadata
+}
+
+func (c *NodeMetadataClient) mutate(ctx context.Context, m *NodeMetadataMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NodeMetadataCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NodeMetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NodeMetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NodeMetadataDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NodeMetadata mutation op: %q", m.Op())
+	}
+}
+
+// RefClient is a client for the Ref schema.
+type RefClient struct {
+	config
+}
+
+// NewRefClient returns a client for the Ref from the given config.
+func NewRefClient(c config) *RefClient {
+	return &RefClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ref.Hooks(f(g(h())))`.
+func (c *RefClient) Use(hooks ...Hook) {
+	c.hooks.Ref = append(c.hooks.Ref, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ref.Intercept(f(g(h())))`.
+func (c *RefClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ref = append(c.inters.Ref, interceptors...)
+}
+
+// Create returns a builder for creating a Ref entity.
+func (c *RefClient) Create() *RefCreate {
+	mutation := newRefMutation(c.config, OpCreate)
+	return &RefCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ref entities.
+func (c *RefClient) CreateBulk(builders ...*RefCreate) *RefCreateBulk {
+	return &RefCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefClient) MapCreateBulk(slice any, setFunc func(*RefCreate, int)) *RefCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefCreateBulk{err: fmt.Errorf("calling to RefClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ref.
+func (c *RefClient) Update() *RefUpdate {
+	mutation := newRefMutation(c.config, OpUpdate)
+	return &RefUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefClient) UpdateOne(_m *Ref) *RefUpdateOne {
+	mutation := newRefMutation(c.config, OpUpdateOne, withRef(_m))
+	return &RefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefClient) UpdateOneID(id string) *RefUpdateOne {
+	mutation := newRefMutation(c.config, OpUpdateOne, withRefID(id))
+	return &RefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ref.
+func (c *RefClient) Delete() *RefDelete {
+	mutation := newRefMutation(c.config, OpDelete)
+	return &RefDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefClient) DeleteOne(_m *Ref) *RefDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefClient) DeleteOneID(id string) *RefDeleteOne {
+	builder := c.Delete().Where(ref.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefDeleteOne{builder}
+}
+
+// Query returns a query builder for Ref.
+func (c *RefClient) Query() *RefQuery {
+	return &RefQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRef},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ref entity by its id.
+func (c *RefClient) Get(ctx context.Context, id string) (*Ref, error) {
+	return c.Query().Where(ref.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefClient) GetX(ctx context.Context, id string) *Ref {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RefClient) Hooks() []Hook {
+	return c.hooks.Ref
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefClient) Interceptors() []Interceptor {
+	return c.inters.Ref
+}
+
+func (c *RefClient) mutate(ctx context.Context, m *RefMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Ref mutation op: %q", m.Op())
+	}
+}
+
+// SignatureClient is a client for the Signature schema.
+type SignatureClient struct {
+	config
+}
+
+// NewSignatureClient returns a client for the Signature from the given config.
+func NewSignatureClient(c config) *SignatureClient {
+	return &SignatureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signature.Hooks(f(g(h())))`.
+func (c *SignatureClient) Use(hooks ...Hook) {
+	c.hooks.Signature = append(c.hooks.Signature, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signature.Intercept(f(g(h())))`.
+func (c *SignatureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Signature = append(c.inters.Signature, interceptors...)
+}
+
+// Create returns a builder for creating a Signature entity.
+func (c *SignatureClient) Create() *SignatureCreate {
+	mutation := newSignatureMutation(c.config, OpCreate)
+	return &SignatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Signature entities.
+func (c *SignatureClient) CreateBulk(builders ...*SignatureCreate) *SignatureCreateBulk {
+	return &SignatureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SignatureClient) MapCreateBulk(slice any, setFunc func(*SignatureCreate, int)) *SignatureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SignatureCreateBulk{err: fmt.Errorf("calling to SignatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SignatureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SignatureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Signature.
+func (c *SignatureClient) Update() *SignatureUpdate {
+	mutation := newSignatureMutation(c.config, OpUpdate)
+	return &SignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SignatureClient) UpdateOne(_m *Signature) *SignatureUpdateOne {
+	mutation := newSignatureMutation(c.config, OpUpdateOne, withSignature(_m))
+	return &SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SignatureClient) UpdateOneID(id int) *SignatureUpdateOne {
+	mutation := newSignatureMutation(c.config, OpUpdateOne, withSignatureID(id))
+	return &SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Signature.
+func (c *SignatureClient) Delete() *SignatureDelete {
+	mutation := newSignatureMutation(c.config, OpDelete)
+	return &SignatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SignatureClient) DeleteOne(_m *Signature) *SignatureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SignatureClient) DeleteOneID(id int) *SignatureDeleteOne {
+	builder := c.Delete().Where(signature.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SignatureDeleteOne{builder}
+}
+
+// Query returns a query builder for Signature.
+func (c *SignatureClient) Query() *SignatureQuery {
+	return &SignatureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSignature},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Signature entity by its id.
+func (c *SignatureClient) Get(ctx context.Context, id int) (*Signature, error) {
+	return c.Query().Where(signature.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SignatureClient) GetX(ctx context.Context, id int) *Signature {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SignatureClient) Hooks() []Hook {
+	return c.hooks.Signature
+}
+
+// Interceptors returns the client interceptors.
+func (c *SignatureClient) Interceptors() []Interceptor {
+	return c.inters.Signature
+}
+
+func (c *SignatureClient) mutate(ctx context.Context, m *SignatureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SignatureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SignatureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SignatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SignatureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Signature mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Facet, Node []ent.Hook
+		Annotation, Blob, EmbeddingCall, Facet, Node, NodeBlob, NodeLink, NodeMetadata,
+		Ref, Signature []ent.Hook
 	}
 	inters struct {
-		Facet, Node []ent.Interceptor
+		Annotation, Blob, EmbeddingCall, Facet, Node, NodeBlob, NodeLink, NodeMetadata,
+		Ref, Signature []ent.Interceptor
 	}
 )
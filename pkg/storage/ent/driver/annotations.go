@@ -0,0 +1,121 @@
+package entdriver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// PutAnnotation stores an annotation, replacing the author's previous
+// annotation of the same node.
+func (ed *EntDriver) PutAnnotation(ctx context.Context, a *storage.Annotation) error {
+	existing, err := ed.Client.Annotation.Query().
+		Where(annotation.Hash(a.Hash), annotation.Author(a.Author)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		update := existing.Update().
+			SetRating(string(a.Rating)).
+			SetLabels(a.Labels).
+			SetNotes(a.Notes)
+		if !a.UpdatedAt.IsZero() {
+			update.SetUpdatedAt(a.UpdatedAt)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update annotation: %w", err)
+		}
+		return nil
+	case !ent.IsNotFound(err):
+		return fmt.Errorf("failed to check annotation: %w", err)
+	}
+
+	create := ed.Client.Annotation.Create().
+		SetHash(a.Hash).
+		SetAuthor(a.Author).
+		SetRating(string(a.Rating)).
+		SetLabels(a.Labels).
+		SetNotes(a.Notes)
+	if !a.CreatedAt.IsZero() {
+		create.SetCreatedAt(a.CreatedAt)
+	}
+	if !a.UpdatedAt.IsZero() {
+		create.SetUpdatedAt(a.UpdatedAt)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store annotation: %w", err)
+	}
+	return nil
+}
+
+// Annotations returns the annotations of the node with hash, oldest first.
+func (ed *EntDriver) Annotations(ctx context.Context, hash string) ([]*storage.Annotation, error) {
+	rows, err := ed.Client.Annotation.Query().
+		Where(annotation.Hash(hash)).
+		Order(ent.Asc(annotation.FieldCreatedAt), ent.Asc(annotation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list annotations: %w", err)
+	}
+	return annotationsFromEnt(rows), nil
+}
+
+// DeleteAnnotation removes the author's annotation of the node with hash.
+func (ed *EntDriver) DeleteAnnotation(ctx context.Context, hash, author string) error {
+	_, err := ed.Client.Annotation.Delete().
+		Where(annotation.Hash(hash), annotation.Author(author)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete annotation: %w", err)
+	}
+	return nil
+}
+
+// ListAnnotations returns every annotation matching filter.
+func (ed *EntDriver) ListAnnotations(ctx context.Context, filter storage.AnnotationFilter) ([]*storage.Annotation, error) {
+	var where []predicate.Annotation
+	if filter.Rating != "" {
+		where = append(where, annotation.Rating(string(filter.Rating)))
+	}
+	if filter.Author != "" {
+		where = append(where, annotation.Author(filter.Author))
+	}
+
+	rows, err := ed.Client.Annotation.Query().
+		Where(where...).
+		Order(ent.Asc(annotation.FieldHash), ent.Asc(annotation.FieldAuthor)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list annotations: %w", err)
+	}
+
+	// Labels are stored as JSON, so they are matched here.
+	annotations := annotationsFromEnt(rows)
+	matched := annotations[:0]
+	for _, a := range annotations {
+		if filter.Match(a) {
+			matched = append(matched, a)
+		}
+	}
+	return matched, nil
+}
+
+func annotationsFromEnt(rows []*ent.Annotation) []*storage.Annotation {
+	annotations := make([]*storage.Annotation, 0, len(rows))
+	for _, row := range rows {
+		annotations = append(annotations, &storage.Annotation{
+			Hash:      row.Hash,
+			Author:    row.Author,
+			Rating:    storage.Rating(row.Rating),
+			Labels:    row.Labels,
+			Notes:     row.Notes,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	return annotations
+}
@@ -0,0 +1,249 @@
+package entdriver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/blob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+)
+
+// blobThreshold is the payload size, in bytes, at which image data and tool
+// output move out of the node row into the blob table.
+const blobThreshold = 4 << 10
+
+// blobBatchSize bounds the number of blobs loaded per query. Blobs can be
+// large, so batches are smaller than metadata batches.
+const blobBatchSize = 100
+
+// blobRefsKey is set on stored content blocks whose payload fields live in
+// the blob table. It maps each field name to the blob hash.
+const blobRefsKey = "blob_refs"
+
+// Content block fields that may be moved to the blob table.
+const (
+	imageBase64Field = "image_base64"
+	toolOutputField  = "tool_output"
+)
+
+// pendingBlob is a payload split out of a content block during Put.
+type pendingBlob struct {
+	hash      string
+	data      []byte
+	mediaType string
+}
+
+func newPendingBlob(data []byte, mediaType string) pendingBlob {
+	sum := sha256.Sum256(data)
+	return pendingBlob{hash: hex.EncodeToString(sum[:]), data: data, mediaType: mediaType}
+}
+
+// externalizeBlobs moves large payloads out of the stored content blocks,
+// modifying them in place, and returns the blobs to store. Base64 images are
+// stored decoded, so only canonically encoded data is moved; anything else
+// stays inline, which keeps the restored bucket byte-for-byte identical.
+func externalizeBlobs(content []map[string]any) []pendingBlob {
+	var blobs []pendingBlob
+	seen := map[string]bool{}
+
+	for _, block := range content {
+		refs := map[string]any{}
+
+		if s, ok := block[imageBase64Field].(string); ok && len(s) >= blobThreshold {
+			data, err := base64.StdEncoding.DecodeString(s)
+			if err == nil && base64.StdEncoding.EncodeToString(data) == s {
+				mediaType, _ := block["media_type"].(string)
+				if mediaType == "" {
+					mediaType = "application/octet-stream"
+				}
+				b := newPendingBlob(data, mediaType)
+				refs[imageBase64Field] = b.hash
+				if !seen[b.hash] {
+					seen[b.hash] = true
+					blobs = append(blobs, b)
+				}
+			}
+		}
+
+		if s, ok := block[toolOutputField].(string); ok && len(s) >= blobThreshold {
+			b := newPendingBlob([]byte(s), "")
+			refs[toolOutputField] = b.hash
+			if !seen[b.hash] {
+				seen[b.hash] = true
+				blobs = append(blobs, b)
+			}
+		}
+
+		if len(refs) == 0 {
+			continue
+		}
+		for field := range refs {
+			delete(block, field)
+		}
+		block[blobRefsKey] = refs
+	}
+
+	return blobs
+}
+
+// putBlobs stores blobs that don't exist yet and records that the node
+// references them.
+func putBlobs(ctx context.Context, client *ent.Client, nodeHash string, blobs []pendingBlob) error {
+	for _, b := range blobs {
+		exists, err := client.Blob.Query().
+			Where(blob.ID(b.hash)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check blob existence: %w", err)
+		}
+		if !exists {
+			create := client.Blob.Create().
+				SetID(b.hash).
+				SetData(b.data).
+				SetSize(len(b.data))
+			if b.mediaType != "" {
+				create.SetMediaType(b.mediaType)
+			}
+			if err := create.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to store blob %s: %w", b.hash, err)
+			}
+		}
+
+		err = client.NodeBlob.Create().
+			SetNodeHash(nodeHash).
+			SetBlobHash(b.hash).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to reference blob %s: %w", b.hash, err)
+		}
+	}
+	return nil
+}
+
+// resolveBlobs restores blob payloads into the stored buckets of entNodes,
+// loading the referenced blobs in batched queries.
+func (ed *EntDriver) resolveBlobs(ctx context.Context, entNodes ...*ent.Node) error {
+	var blocks []map[string]any
+	for _, entNode := range entNodes {
+		content, _ := entNode.Bucket["content"].([]any)
+		for _, item := range content {
+			if block, ok := item.(map[string]any); ok {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+	return ed.ResolveBlobs(ctx, blocks...)
+}
+
+// ResolveBlobs restores blob payloads into stored content blocks, such as
+// those read directly from the node content column, modifying them in place.
+// Blocks without blob references are left untouched.
+func (ed *EntDriver) ResolveBlobs(ctx context.Context, blocks ...map[string]any) error {
+	type ref struct {
+		block map[string]any
+		field string
+		hash  string
+	}
+
+	var refs []ref
+	var hashes []string
+	for _, block := range blocks {
+		blockRefs, ok := block[blobRefsKey].(map[string]any)
+		if !ok {
+			continue
+		}
+		for field, v := range blockRefs {
+			hash, _ := v.(string)
+			refs = append(refs, ref{block: block, field: field, hash: hash})
+			hashes = append(hashes, hash)
+		}
+		delete(block, blobRefsKey)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	slices.Sort(hashes)
+	hashes = slices.Compact(hashes)
+
+	data := make(map[string][]byte, len(hashes))
+	for batch := range slices.Chunk(hashes, blobBatchSize) {
+		rows, err := ed.Client.Blob.Query().
+			Where(blob.IDIn(batch...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query blobs: %w", err)
+		}
+		for _, b := range rows {
+			data[b.ID] = b.Data
+		}
+	}
+
+	for _, r := range refs {
+		payload, ok := data[r.hash]
+		if !ok {
+			return fmt.Errorf("missing blob %s", r.hash)
+		}
+		if r.field == imageBase64Field {
+			r.block[r.field] = base64.StdEncoding.EncodeToString(payload)
+		} else {
+			r.block[r.field] = string(payload)
+		}
+	}
+	return nil
+}
+
+// Blob returns the blob with the given hash.
+func (ed *EntDriver) Blob(ctx context.Context, hash string) (*storage.Blob, error) {
+	b, err := ed.Client.Blob.Get(ctx, hash)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storage.NotFoundError{Hash: hash}
+		}
+		return nil, fmt.Errorf("failed to get blob: %w", err)
+	}
+
+	result := &storage.Blob{Hash: b.ID, Data: b.Data}
+	if b.MediaType != nil {
+		result.MediaType = *b.MediaType
+	}
+	return result, nil
+}
+
+// CollectBlobs drops blob references held by nodes that no longer exist, then
+// deletes the blobs nothing references.
+func (ed *EntDriver) CollectBlobs(ctx context.Context) (int, error) {
+	_, err := ed.Client.NodeBlob.Delete().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.NotIn(
+				s.C(nodeblob.FieldNodeHash),
+				sql.Select(node.FieldID).From(sql.Table(node.Table)),
+			))
+		}).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete orphaned blob references: %w", err)
+	}
+
+	n, err := ed.Client.Blob.Delete().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.NotIn(
+				s.C(blob.FieldID),
+				sql.Select(nodeblob.FieldBlobHash).From(sql.Table(nodeblob.Table)),
+			))
+		}).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete unreferenced blobs: %w", err)
+	}
+	return n, nil
+}
@@ -6,75 +6,190 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/merkle"
 	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/ent"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
 )
 
 // EntDriver provides storage operations using an ent client.
 // It is database-agnostic and can be embedded by specific drivers.
 type EntDriver struct {
 	Client *ent.Client
+
+	// putMu serializes Puts. SQLite fails, rather than waits for, a
+	// transaction that read before another one wrote.
+	putMu sync.Mutex
 }
 
 // Put stores a node. Returns true if the node was newly inserted,
-// false if it already existed. This is a no-op due to content-addressing.
+// false if it already existed. Storing an existing node is a no-op due to
+// content-addressing, except that its metadata is merged into the stored
+// metadata.
 func (ed *EntDriver) Put(ctx context.Context, n *merkle.Node) (bool, error) {
 	if n == nil {
 		return false, errors.New("cannot store nil node")
 	}
 
-	// Check if node already exists (idempotent insert)
-	exists, err := ed.Client.Node.Query().
+	created, err := ed.put(ctx, n)
+	if ent.IsConstraintError(err) {
+		// Another writer to the database stored the node first, so this
+		// Put only stores its metadata.
+		created, err = ed.put(ctx, n)
+	}
+	return created, err
+}
+
+// put stores a node and its metadata in one transaction. The existence check
+// shares the transaction, and the node and its blobs are written together so
+// blob garbage collection never observes a reference without its node.
+func (ed *EntDriver) put(ctx context.Context, n *merkle.Node) (bool, error) {
+	ed.putMu.Lock()
+	defer ed.putMu.Unlock()
+
+	tx, err := ed.Client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	created, err := putNode(ctx, tx.Client(), n)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %w", err, rerr)
+		}
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit node creation: %w", err)
+	}
+	return created, nil
+}
+
+// putNode creates n unless it already exists (idempotent insert) and stores
+// its metadata, reporting whether the node was created.
+func putNode(ctx context.Context, client *ent.Client, n *merkle.Node) (bool, error) {
+	exists, err := client.Node.Query().
 		Where(node.ID(n.Hash)).
 		Exist(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to check existence: %w", err)
 	}
-	if exists {
-		return false, nil
+	if !exists {
+		if err := createNode(ctx, client, n); err != nil {
+			return false, err
+		}
+	}
+	if err := putMetadata(ctx, client, n.Hash, n.Metadata); err != nil {
+		return false, err
+	}
+	return !exists, nil
+}
+
+// createNode inserts a new node and any blobs split out of its content, and
+// materializes its position in the DAG.
+func createNode(ctx context.Context, client *ent.Client, n *merkle.Node) error {
+	root, depth, err := lineage(ctx, client, n)
+	if err != nil {
+		return err
+	}
+	hasChildren, err := client.Node.Query().
+		Where(node.ParentHash(n.Hash)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check children: %w", err)
 	}
 
-	create := ed.Client.Node.Create().
+	create := client.Node.Create().
 		SetID(n.Hash).
 		SetNillableParentHash(n.ParentHash).
 		SetType(n.Bucket.Type).
 		SetRole(n.Bucket.Role).
 		SetModel(n.Bucket.Model).
 		SetProvider(n.Bucket.Provider).
-		SetStopReason(n.StopReason)
+		SetStopReason(n.StopReason).
+		SetDepth(depth).
+		SetIsLeaf(!hasChildren)
+
+	if root != "" {
+		create.SetRootHash(root)
+	}
 
 	if n.Project != "" {
 		create.SetProject(n.Project)
 	}
 
+	if n.RequestedModel != "" {
+		create.SetRequestedModel(n.RequestedModel)
+	}
+
+	if n.Upstream != "" {
+		create.SetUpstream(n.Upstream)
+	}
+
 	if n.Bucket.AgentName != "" {
 		create.SetAgentName(n.Bucket.AgentName)
 	}
 
+	if n.ProviderRequestID != "" {
+		create.SetProviderRequestID(n.ProviderRequestID)
+	}
+
+	if len(n.RateLimits) > 0 {
+		create.SetRateLimits(n.RateLimits)
+	}
+
+	if n.ChoiceIndex != nil {
+		create.SetChoiceIndex(*n.ChoiceIndex)
+	}
+
+	if n.ContextKey != "" {
+		create.SetContextKey(n.ContextKey)
+	}
+
+	if n.Params != nil {
+		params, err := toJSONMap(n.Params)
+		if err != nil {
+			return fmt.Errorf("failed to marshal generation params: %w", err)
+		}
+		create.SetGenerationParams(params)
+	}
+
 	// Marshal bucket to JSON for storage
 	bucketJSON, err := json.Marshal(n.Bucket)
 	if err != nil {
-		return false, fmt.Errorf("failed to marshal bucket: %w", err)
+		return fmt.Errorf("failed to marshal bucket: %w", err)
 	}
 	var bucketMap map[string]any
 	if err := json.Unmarshal(bucketJSON, &bucketMap); err != nil {
-		return false, fmt.Errorf("failed to unmarshal bucket to map: %w", err)
+		return fmt.Errorf("failed to unmarshal bucket to map: %w", err)
 	}
-	create.SetBucket(bucketMap)
 
 	// Marshal content blocks
 	contentJSON, err := json.Marshal(n.Bucket.Content)
 	if err != nil {
-		return false, fmt.Errorf("failed to marshal content: %w", err)
+		return fmt.Errorf("failed to marshal content: %w", err)
 	}
 	var contentSlice []map[string]any
 	if err := json.Unmarshal(contentJSON, &contentSlice); err != nil {
-		return false, fmt.Errorf("failed to unmarshal content to slice: %w", err)
+		return fmt.Errorf("failed to unmarshal content to slice: %w", err)
 	}
+
+	// Large payloads move to the blob table; the bucket and content column
+	// keep references. Readers of the content column resolve them with
+	// ResolveBlobs.
+	blobs := externalizeBlobs(contentSlice)
+	if len(blobs) > 0 {
+		bucketMap["content"] = contentSlice
+	}
+	create.SetBucket(bucketMap)
 	create.SetContent(contentSlice)
 
 	// Set usage fields if available
@@ -102,12 +217,115 @@ func (ed *EntDriver) Put(ctx context.Context, n *merkle.Node) (bool, error) {
 		}
 	}
 
-	err = create.Exec(ctx)
+	if err := putBlobs(ctx, client, n.Hash, blobs); err != nil {
+		return err
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("could not execute node creation: %w", err)
+	}
+
+	if hasChildren && root != "" {
+		if err := adoptDescendants(ctx, client, n.Hash, root, depth); err != nil {
+			return err
+		}
+	}
+
+	if n.ParentHash != nil {
+		err := client.Node.Update().
+			Where(node.ID(*n.ParentHash), node.IsLeaf(true)).
+			SetIsLeaf(false).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update parent leaf flag: %w", err)
+		}
+	}
+	return nil
+}
+
+// putMetadata stores metadata for the node with the given hash, overwriting
+// the values of keys that are already set.
+func putMetadata(ctx context.Context, client *ent.Client, hash string, metadata map[string]string) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	existing, err := client.NodeMetadata.Query().
+		Where(nodemetadata.NodeHash(hash)).
+		All(ctx)
 	if err != nil {
-		return false, fmt.Errorf("could not execute node creation: %w", err)
+		return fmt.Errorf("failed to query node metadata: %w", err)
 	}
+	byKey := make(map[string]*ent.NodeMetadata, len(existing))
+	for _, m := range existing {
+		byKey[m.Key] = m
+	}
+
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		v := metadata[k]
+		if m, ok := byKey[k]; ok {
+			if m.Value == v {
+				continue
+			}
+			if err := m.Update().SetValue(v).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update node metadata %q: %w", k, err)
+			}
+			continue
+		}
+
+		err := client.NodeMetadata.Create().
+			SetNodeHash(hash).
+			SetKey(k).
+			SetValue(v).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create node metadata %q: %w", k, err)
+		}
+	}
+
+	return nil
+}
 
-	return true, nil
+// metadataBatchSize bounds the number of hashes per metadata query, keeping
+// large listings under the database's bound parameter limit.
+const metadataBatchSize = 500
+
+// attachMetadata loads the stored metadata for nodes in batched queries.
+func (ed *EntDriver) attachMetadata(ctx context.Context, nodes ...*merkle.Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	byHash := make(map[string]*merkle.Node, len(nodes))
+	hashes := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		byHash[n.Hash] = n
+		hashes = append(hashes, n.Hash)
+	}
+
+	for batch := range slices.Chunk(hashes, metadataBatchSize) {
+		rows, err := ed.Client.NodeMetadata.Query().
+			Where(nodemetadata.NodeHashIn(batch...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query node metadata: %w", err)
+		}
+
+		for _, m := range rows {
+			n := byHash[m.NodeHash]
+			if n.Metadata == nil {
+				n.Metadata = map[string]string{}
+			}
+			n.Metadata[m.Key] = m.Value
+		}
+	}
+	return nil
 }
 
 // Get retrieves a node by its hash.
@@ -119,7 +337,12 @@ func (ed *EntDriver) Get(ctx context.Context, hash string) (*merkle.Node, error)
 		}
 		return nil, fmt.Errorf("failed to get node: %w", err)
 	}
-	return ed.entNodeToMerkleNode(entNode)
+
+	nodes, err := ed.entNodesToMerkleNodes(ctx, []*ent.Node{entNode})
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
 }
 
 // Has checks if a node exists by its hash.
@@ -129,6 +352,19 @@ func (ed *EntDriver) Has(ctx context.Context, hash string) (bool, error) {
 		Exist(ctx)
 }
 
+// HashesWithPrefix returns up to limit node hashes starting with prefix.
+func (ed *EntDriver) HashesWithPrefix(ctx context.Context, prefix string, limit int) ([]string, error) {
+	hashes, err := ed.Client.Node.Query().
+		Where(predicate.Node(sql.FieldHasPrefix(node.FieldID, prefix))).
+		Order(ent.Asc(node.FieldID)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hashes: %w", err)
+	}
+	return hashes, nil
+}
+
 // GetByParent retrieves all nodes that have the given parent hash.
 // Uses the children edge for efficient lookups.
 func (ed *EntDriver) GetByParent(ctx context.Context, parentHash *string) ([]*merkle.Node, error) {
@@ -151,7 +387,7 @@ func (ed *EntDriver) GetByParent(ctx context.Context, parentHash *string) ([]*me
 	if err != nil {
 		return nil, fmt.Errorf("failed to query nodes: %w", err)
 	}
-	return ed.entNodesToMerkleNodes(entNodes)
+	return ed.entNodesToMerkleNodes(ctx, entNodes)
 }
 
 // List returns all nodes in the store.
@@ -162,30 +398,39 @@ func (ed *EntDriver) List(ctx context.Context) ([]*merkle.Node, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to query nodes: %w", err)
 	}
-	return ed.entNodesToMerkleNodes(entNodes)
+	return ed.entNodesToMerkleNodes(ctx, entNodes)
 }
 
 // Roots returns all root nodes (nodes with no parent).
+// Uses the materialized root hash and depth.
 func (ed *EntDriver) Roots(ctx context.Context) ([]*merkle.Node, error) {
-	return ed.GetByParent(ctx, nil)
+	entNodes, err := ed.Client.Node.Query().
+		Where(node.Depth(0), rootOfItself()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query roots: %w", err)
+	}
+	return ed.entNodesToMerkleNodes(ctx, entNodes)
 }
 
 // Leaves returns all leaf nodes (nodes with no children).
-// Uses the children edge for efficient detection.
+// Uses the materialized is_leaf index.
 func (ed *EntDriver) Leaves(ctx context.Context) ([]*merkle.Node, error) {
 	entNodes, err := ed.Client.Node.Query().
-		Where(node.Not(node.HasChildren())).
+		Where(node.IsLeaf(true)).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query leaves: %w", err)
 	}
-	return ed.entNodesToMerkleNodes(entNodes)
+	return ed.entNodesToMerkleNodes(ctx, entNodes)
 }
 
 // Ancestry returns the path from a node back to its root (node first, root last).
-// Uses the parent edge for traversal.
+// It loads the shallower nodes of the node's conversation in one query via the
+// materialized root hash, falling back to the parent edge for nodes that are
+// not materialized.
 func (ed *EntDriver) Ancestry(ctx context.Context, hash string) ([]*merkle.Node, error) {
-	var path []*merkle.Node
+	var path []*ent.Node
 
 	current, err := ed.Client.Node.Get(ctx, hash)
 	if err != nil {
@@ -195,17 +440,33 @@ func (ed *EntDriver) Ancestry(ctx context.Context, hash string) ([]*merkle.Node,
 		return nil, fmt.Errorf("failed to get node: %w", err)
 	}
 
-	for current != nil {
-		n, err := ed.entNodeToMerkleNode(current)
+	byID := map[string]*ent.Node{}
+	if current.RootHash != nil && current.Depth > 0 {
+		rows, err := ed.Client.Node.Query().
+			Where(node.RootHash(*current.RootHash), node.DepthLT(current.Depth)).
+			All(ctx)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to query ancestry: %w", err)
+		}
+		for _, row := range rows {
+			byID[row.ID] = row
+		}
+	}
+
+	for current != nil {
+		path = append(path, current)
+		if current.ParentHash == nil {
+			break // Reached root
+		}
+		if parent, ok := byID[*current.ParentHash]; ok {
+			current = parent
+			continue
 		}
-		path = append(path, n)
 
 		// Use the parent edge to traverse up
 		parent, err := current.QueryParent().Only(ctx)
 		if ent.IsNotFound(err) {
-			break // Reached root
+			break // Reached the topmost stored ancestor
 		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to query parent: %w", err)
@@ -213,11 +474,26 @@ func (ed *EntDriver) Ancestry(ctx context.Context, hash string) ([]*merkle.Node,
 		current = parent
 	}
 
-	return path, nil
+	return ed.entNodesToMerkleNodes(ctx, path)
 }
 
-// Depth returns the depth of a node (0 for roots).
+// Depth returns the depth of a node (0 for roots), read from the materialized
+// depth column when available.
 func (ed *EntDriver) Depth(ctx context.Context, hash string) (int, error) {
+	n, err := ed.Client.Node.Query().
+		Where(node.ID(hash)).
+		Select(node.FieldRootHash, node.FieldDepth).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, storage.NotFoundError{Hash: hash}
+		}
+		return 0, fmt.Errorf("failed to get node: %w", err)
+	}
+	if n.RootHash != nil {
+		return n.Depth, nil
+	}
+
 	path, err := ed.Ancestry(ctx, hash)
 	if err != nil {
 		return 0, err
@@ -252,6 +528,68 @@ func (ed *EntDriver) UpdateUsage(ctx context.Context, hash string, usage *llm.Us
 	return update.Exec(ctx)
 }
 
+// CachedResponse returns the most recently cached response node for key.
+func (ed *EntDriver) CachedResponse(ctx context.Context, key string, maxAge time.Duration) (*merkle.Node, error) {
+	query := ed.Client.Node.Query().Where(node.CacheKey(key))
+	if maxAge > 0 {
+		query = query.Where(node.CachedAtGTE(time.Now().Add(-maxAge)))
+	}
+
+	entNode, err := query.Order(ent.Desc(node.FieldCachedAt)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storage.NotFoundError{}
+		}
+		return nil, fmt.Errorf("failed to query cached response: %w", err)
+	}
+	if err := ed.resolveBlobs(ctx, entNode); err != nil {
+		return nil, err
+	}
+	return ed.entNodeToMerkleNode(entNode)
+}
+
+// SetCacheKey records the node as the cached response for key.
+func (ed *EntDriver) SetCacheKey(ctx context.Context, hash, key string) error {
+	err := ed.Client.Node.UpdateOneID(hash).
+		SetCacheKey(key).
+		SetCachedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return storage.NotFoundError{Hash: hash}
+	}
+	return err
+}
+
+// RecordCacheHit increments the hit counter of a cached response node.
+func (ed *EntDriver) RecordCacheHit(ctx context.Context, hash string) error {
+	err := ed.Client.Node.UpdateOneID(hash).
+		AddCacheHits(1).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return storage.NotFoundError{Hash: hash}
+	}
+	return err
+}
+
+// NodeByContextKey returns the most recently created node stored with the
+// completion context key.
+func (ed *EntDriver) NodeByContextKey(ctx context.Context, key string) (*merkle.Node, error) {
+	entNode, err := ed.Client.Node.Query().
+		Where(node.ContextKey(key)).
+		Order(ent.Desc(node.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storage.NotFoundError{}
+		}
+		return nil, fmt.Errorf("failed to query node by context key: %w", err)
+	}
+	if err := ed.resolveBlobs(ctx, entNode); err != nil {
+		return nil, err
+	}
+	return ed.entNodeToMerkleNode(entNode)
+}
+
 // Close closes the database connection.
 func (ed *EntDriver) Close() error {
 	return ed.Client.Close()
@@ -281,6 +619,42 @@ func (ed *EntDriver) entNodeToMerkleNode(entNode *ent.Node) (*merkle.Node, error
 		node.Project = *entNode.Project
 	}
 
+	if entNode.RequestedModel != nil {
+		node.RequestedModel = *entNode.RequestedModel
+	}
+
+	if entNode.Upstream != nil {
+		node.Upstream = *entNode.Upstream
+	}
+
+	if entNode.ProviderRequestID != nil {
+		node.ProviderRequestID = *entNode.ProviderRequestID
+	}
+
+	if len(entNode.RateLimits) > 0 {
+		node.RateLimits = entNode.RateLimits
+	}
+
+	if entNode.ChoiceIndex != nil {
+		index := *entNode.ChoiceIndex
+		node.ChoiceIndex = &index
+	}
+
+	if entNode.ContextKey != nil {
+		node.ContextKey = *entNode.ContextKey
+	}
+
+	if len(entNode.GenerationParams) > 0 {
+		paramsJSON, err := json.Marshal(entNode.GenerationParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal generation params map: %w", err)
+		}
+		node.Params = &llm.GenerationParams{}
+		if err := json.Unmarshal(paramsJSON, node.Params); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal generation params: %w", err)
+		}
+	}
+
 	// Rebuild usage metrics if they exist.
 	if entNode.PromptTokens != nil ||
 		entNode.CompletionTokens != nil ||
@@ -323,7 +697,25 @@ func (ed *EntDriver) entNodeToMerkleNode(entNode *ent.Node) (*merkle.Node, error
 	return node, nil
 }
 
-func (ed *EntDriver) entNodesToMerkleNodes(entNodes []*ent.Node) ([]*merkle.Node, error) {
+// toJSONMap converts v to its generic JSON object form for storage in a JSON
+// column.
+func toJSONMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (ed *EntDriver) entNodesToMerkleNodes(ctx context.Context, entNodes []*ent.Node) ([]*merkle.Node, error) {
+	if err := ed.resolveBlobs(ctx, entNodes...); err != nil {
+		return nil, err
+	}
+
 	nodes := make([]*merkle.Node, 0, len(entNodes))
 	for _, entNode := range entNodes {
 		n, err := ed.entNodeToMerkleNode(entNode)
@@ -332,5 +724,8 @@ func (ed *EntDriver) entNodesToMerkleNodes(entNodes []*ent.Node) ([]*merkle.Node
 		}
 		nodes = append(nodes, n)
 	}
+	if err := ed.attachMetadata(ctx, nodes...); err != nil {
+		return nil, err
+	}
 	return nodes, nil
 }
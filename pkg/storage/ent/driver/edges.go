@@ -0,0 +1,82 @@
+package entdriver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// PutEdge stores an edge, ignoring repeated edges of the same kind between
+// the same nodes.
+func (ed *EntDriver) PutEdge(ctx context.Context, e *storage.Edge) error {
+	exists, err := ed.Client.NodeLink.Query().
+		Where(nodelink.FromHash(e.From), nodelink.ToHash(e.To), nodelink.Kind(string(e.Kind))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check edge: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	create := ed.Client.NodeLink.Create().
+		SetFromHash(e.From).
+		SetToHash(e.To).
+		SetKind(string(e.Kind)).
+		SetReason(e.Reason).
+		SetToolUseID(e.ToolUseID)
+	if !e.CreatedAt.IsZero() {
+		create.SetCreatedAt(e.CreatedAt)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store edge: %w", err)
+	}
+	return nil
+}
+
+// EdgesFrom returns the edges of kind starting at the node with hash.
+func (ed *EntDriver) EdgesFrom(ctx context.Context, hash string, kind storage.EdgeKind) ([]*storage.Edge, error) {
+	return ed.edges(ctx, nodelink.FromHash(hash), nodelink.Kind(string(kind)))
+}
+
+// EdgesTo returns the edges of kind pointing to the node with hash.
+func (ed *EntDriver) EdgesTo(ctx context.Context, hash string, kind storage.EdgeKind) ([]*storage.Edge, error) {
+	return ed.edges(ctx, nodelink.ToHash(hash), nodelink.Kind(string(kind)))
+}
+
+// ListEdges returns every edge of kind.
+func (ed *EntDriver) ListEdges(ctx context.Context, kind storage.EdgeKind) ([]*storage.Edge, error) {
+	return ed.edges(ctx, nodelink.Kind(string(kind)))
+}
+
+func (ed *EntDriver) edges(ctx context.Context, where ...predicate.NodeLink) ([]*storage.Edge, error) {
+	rows, err := ed.Client.NodeLink.Query().
+		Where(where...).
+		Order(ent.Asc(nodelink.FieldCreatedAt), ent.Asc(nodelink.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list edges: %w", err)
+	}
+	return EdgesFromEnt(rows), nil
+}
+
+// EdgesFromEnt converts ent edge rows to storage edges.
+func EdgesFromEnt(rows []*ent.NodeLink) []*storage.Edge {
+	edges := make([]*storage.Edge, 0, len(rows))
+	for _, row := range rows {
+		edges = append(edges, &storage.Edge{
+			From:      row.FromHash,
+			To:        row.ToHash,
+			Kind:      storage.EdgeKind(row.Kind),
+			Reason:    row.Reason,
+			ToolUseID: row.ToolUseID,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return edges
+}
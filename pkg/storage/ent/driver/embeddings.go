@@ -0,0 +1,82 @@
+package entdriver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
+)
+
+// PutEmbeddingCall records an embedding call.
+func (ed *EntDriver) PutEmbeddingCall(ctx context.Context, call *storage.EmbeddingCall) error {
+	create := ed.Client.EmbeddingCall.Create().
+		SetProvider(call.Provider).
+		SetModel(call.Model).
+		SetAgentName(call.AgentName).
+		SetProject(call.Project).
+		SetInputCount(call.InputCount).
+		SetDimensions(call.Dimensions).
+		SetLatencyNs(call.Latency.Nanoseconds())
+
+	if len(call.Input) > 0 {
+		create.SetInput(call.Input)
+	}
+	if call.Usage != nil {
+		create.SetPromptTokens(call.Usage.PromptTokens)
+		create.SetTotalTokens(call.Usage.TotalTokens)
+	}
+	if !call.CreatedAt.IsZero() {
+		create.SetCreatedAt(call.CreatedAt)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store embedding call: %w", err)
+	}
+	return nil
+}
+
+// EmbeddingCalls returns the embedding calls made at or after since, oldest
+// first. A zero since returns all calls.
+func (ed *EntDriver) EmbeddingCalls(ctx context.Context, since time.Time) ([]*storage.EmbeddingCall, error) {
+	query := ed.Client.EmbeddingCall.Query().
+		Order(ent.Asc(embeddingcall.FieldCreatedAt))
+	if !since.IsZero() {
+		query.Where(embeddingcall.CreatedAtGTE(since))
+	}
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list embedding calls: %w", err)
+	}
+
+	calls := make([]*storage.EmbeddingCall, 0, len(rows))
+	for _, row := range rows {
+		call := &storage.EmbeddingCall{
+			Provider:   row.Provider,
+			Model:      row.Model,
+			AgentName:  row.AgentName,
+			Project:    row.Project,
+			InputCount: row.InputCount,
+			Input:      row.Input,
+			Dimensions: row.Dimensions,
+			Latency:    time.Duration(row.LatencyNs),
+			CreatedAt:  row.CreatedAt,
+		}
+		if row.PromptTokens != nil || row.TotalTokens != nil {
+			call.Usage = &llm.Usage{}
+			if row.PromptTokens != nil {
+				call.Usage.PromptTokens = *row.PromptTokens
+			}
+			if row.TotalTokens != nil {
+				call.Usage.TotalTokens = *row.TotalTokens
+			}
+		}
+		calls = append(calls, call)
+	}
+
+	return calls, nil
+}
@@ -0,0 +1,180 @@
+package entdriver
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// lineage returns the root hash and depth of n from its stored parent. The
+// root is "" when the parent is missing or not yet materialized, leaving the
+// node for Materialize to backfill.
+func lineage(ctx context.Context, client *ent.Client, n *merkle.Node) (string, int, error) {
+	if n.ParentHash == nil {
+		return n.Hash, 0, nil
+	}
+
+	parent, err := client.Node.Query().
+		Where(node.ID(*n.ParentHash)).
+		Select(node.FieldRootHash, node.FieldDepth).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to query parent: %w", err)
+	}
+	if parent.RootHash == nil {
+		return "", 0, nil
+	}
+	return *parent.RootHash, parent.Depth + 1, nil
+}
+
+// rootOfItself matches nodes whose materialized root hash is their own.
+func rootOfItself() predicate.Node {
+	return func(s *sql.Selector) {
+		s.Where(sql.ColumnsEQ(s.C(node.FieldRootHash), s.C(node.FieldID)))
+	}
+}
+
+// adoptDescendants materializes the descendants of the node with hash that
+// were stored while it was missing, now that it is stored at root and depth.
+func adoptDescendants(ctx context.Context, client *ent.Client, hash, root string, depth int) error {
+	parents := []string{hash}
+	for len(parents) > 0 {
+		depth++
+		children, err := client.Node.Query().
+			Where(node.ParentHashIn(parents...), node.RootHashIsNil()).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query descendants: %w", err)
+		}
+		if len(children) == 0 {
+			return nil
+		}
+		err = client.Node.Update().
+			Where(node.IDIn(children...)).
+			SetRootHash(root).
+			SetDepth(depth).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to materialize descendants: %w", err)
+		}
+		parents = children
+	}
+	return nil
+}
+
+// Materialize backfills the root hash, depth and leaf flag of nodes recorded
+// before those columns existed, and of nodes whose parent was missing when
+// they were stored. Nodes whose stored ancestry still ends at a missing
+// parent are left unmaterialized, so that they are placed once the parent is
+// stored rather than treated as roots.
+// It is a single indexed lookup when no unmaterialized node can be placed.
+func (ed *EntDriver) Materialize(ctx context.Context) error {
+	pending, err := ed.Client.Node.Query().
+		Where(
+			node.RootHashIsNil(),
+			node.Or(node.ParentHashIsNil(), node.HasParentWith(node.RootHashNotNil())),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check unmaterialized nodes: %w", err)
+	}
+	if !pending {
+		return nil
+	}
+
+	rows, err := ed.Client.Node.Query().
+		Select(node.FieldParentHash, node.FieldRootHash, node.FieldDepth, node.FieldIsLeaf).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query nodes: %w", err)
+	}
+
+	byID := make(map[string]*ent.Node, len(rows))
+	hasChildren := make(map[string]bool)
+	for _, row := range rows {
+		byID[row.ID] = row
+		if row.ParentHash != nil {
+			hasChildren[*row.ParentHash] = true
+		}
+	}
+
+	type position struct {
+		root  string
+		depth int
+	}
+	resolved := make(map[string]position, len(rows))
+	for _, row := range rows {
+		if row.RootHash != nil {
+			resolved[row.ID] = position{root: *row.RootHash, depth: row.Depth}
+		}
+	}
+
+	// Walk each unresolved node up to the nearest resolved ancestor or to
+	// its root, then assign positions back down the walked path. Paths that
+	// end at a missing parent stay unresolved.
+	for _, row := range rows {
+		var path []*ent.Node
+		seen := map[string]bool{}
+		current := row
+		base, found := position{}, false
+		for current != nil {
+			if p, ok := resolved[current.ID]; ok {
+				base, found = p, true
+				break
+			}
+			if seen[current.ID] {
+				break
+			}
+			seen[current.ID] = true
+			path = append(path, current)
+			if current.ParentHash == nil {
+				base, found = position{root: current.ID, depth: -1}, true
+				break
+			}
+			current = byID[*current.ParentHash]
+		}
+		if !found {
+			continue
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			base.depth++
+			resolved[path[i].ID] = base
+		}
+	}
+
+	tx, err := ed.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for _, row := range rows {
+		p, ok := resolved[row.ID]
+		isLeaf := !hasChildren[row.ID]
+		if (row.RootHash != nil || !ok) && row.IsLeaf == isLeaf {
+			continue
+		}
+		update := tx.Node.UpdateOneID(row.ID).SetIsLeaf(isLeaf)
+		if ok {
+			update.SetRootHash(p.root).SetDepth(p.depth)
+		}
+		err := update.Exec(ctx)
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: rolling back: %w", err, rerr)
+			}
+			return fmt.Errorf("failed to materialize node %s: %w", row.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit materialized nodes: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package entdriver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/ref"
+)
+
+// Ref returns the ref with name.
+func (ed *EntDriver) Ref(ctx context.Context, name string) (*storage.Ref, error) {
+	row, err := ed.Client.Ref.Get(ctx, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storage.RefNotFoundError{Name: name}
+		}
+		return nil, fmt.Errorf("failed to get ref: %w", err)
+	}
+	return toStorageRef(row), nil
+}
+
+// Refs returns all refs ordered by name.
+func (ed *EntDriver) Refs(ctx context.Context) ([]*storage.Ref, error) {
+	rows, err := ed.Client.Ref.Query().
+		Order(ent.Asc(ref.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list refs: %w", err)
+	}
+
+	refs := make([]*storage.Ref, 0, len(rows))
+	for _, row := range rows {
+		refs = append(refs, toStorageRef(row))
+	}
+	return refs, nil
+}
+
+// PutRef creates r or moves the existing ref of the same name and kind.
+func (ed *EntDriver) PutRef(ctx context.Context, r *storage.Ref) error {
+	existing, err := ed.Client.Ref.Get(ctx, r.Name)
+	switch {
+	case ent.IsNotFound(err):
+		if err := ed.Client.Ref.Create().
+			SetID(r.Name).
+			SetHash(r.Hash).
+			SetKind(string(r.Kind)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create ref: %w", err)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get ref: %w", err)
+	case existing.Kind != string(r.Kind):
+		return fmt.Errorf("ref %s is a %s, not a %s", r.Name, existing.Kind, r.Kind)
+	}
+
+	if err := existing.Update().SetHash(r.Hash).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update ref: %w", err)
+	}
+	return nil
+}
+
+// DeleteRef removes the ref with name.
+func (ed *EntDriver) DeleteRef(ctx context.Context, name string) error {
+	err := ed.Client.Ref.DeleteOneID(name).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return storage.RefNotFoundError{Name: name}
+		}
+		return fmt.Errorf("failed to delete ref: %w", err)
+	}
+	return nil
+}
+
+// AdvanceBranches moves the branches pointing at parent to its child.
+func (ed *EntDriver) AdvanceBranches(ctx context.Context, parent, child string) error {
+	err := ed.Client.Ref.Update().
+		Where(ref.Hash(parent), ref.Kind(string(storage.RefBranch))).
+		SetHash(child).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to advance branches: %w", err)
+	}
+	return nil
+}
+
+func toStorageRef(row *ent.Ref) *storage.Ref {
+	return &storage.Ref{
+		Name:      row.ID,
+		Hash:      row.Hash,
+		Kind:      storage.RefKind(row.Kind),
+		UpdatedAt: row.UpdatedAt,
+	}
+}
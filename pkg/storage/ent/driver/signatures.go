@@ -0,0 +1,119 @@
+package entdriver
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/signature"
+)
+
+// PutSignature stores a signature, ignoring repeated signatures of the same
+// node by the same key.
+func (ed *EntDriver) PutSignature(ctx context.Context, sig *storage.Signature) error {
+	exists, err := ed.Client.Signature.Query().
+		Where(signature.Hash(sig.Hash), signature.KeyID(sig.KeyID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check signature: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	create := ed.Client.Signature.Create().
+		SetHash(sig.Hash).
+		SetKeyID(sig.KeyID).
+		SetPublicKey(sig.PublicKey).
+		SetSignature(sig.Signature)
+	if !sig.CreatedAt.IsZero() {
+		create.SetCreatedAt(sig.CreatedAt)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store signature: %w", err)
+	}
+	return nil
+}
+
+// Signatures returns the signatures of the node with hash, oldest first.
+func (ed *EntDriver) Signatures(ctx context.Context, hash string) ([]*storage.Signature, error) {
+	rows, err := ed.Client.Signature.Query().
+		Where(signature.Hash(hash)).
+		Order(ent.Asc(signature.FieldCreatedAt), ent.Asc(signature.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signatures: %w", err)
+	}
+
+	sigs := make([]*storage.Signature, 0, len(rows))
+	for _, row := range rows {
+		sigs = append(sigs, &storage.Signature{
+			Hash:      row.Hash,
+			KeyID:     row.KeyID,
+			PublicKey: row.PublicKey,
+			Signature: row.Signature,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return sigs, nil
+}
+
+// SignedHashes returns the hashes of all signed nodes in order.
+func (ed *EntDriver) SignedHashes(ctx context.Context) ([]string, error) {
+	hashes, err := ed.Client.Signature.Query().
+		Unique(true).
+		Order(ent.Asc(signature.FieldHash)).
+		Select(signature.FieldHash).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signed hashes: %w", err)
+	}
+	return hashes, nil
+}
+
+// SignedDescendants returns, in order, the hashes of signed nodes in the
+// conversation tree of hash at or below its depth, using the materialized
+// root_hash and depth columns. Falls back to all signed hashes when hash is
+// not yet materialized.
+func (ed *EntDriver) SignedDescendants(ctx context.Context, hash string) ([]string, error) {
+	target, err := ed.Client.Node.Query().
+		Where(node.ID(hash)).
+		Select(node.FieldRootHash, node.FieldDepth).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, storage.NotFoundError{Hash: hash}
+		}
+		return nil, fmt.Errorf("failed to get node: %w", err)
+	}
+	if target.RootHash == nil {
+		return ed.SignedHashes(ctx)
+	}
+
+	root, depth := *target.RootHash, target.Depth
+	hashes, err := ed.Client.Signature.Query().
+		Where(func(s *sql.Selector) {
+			t := sql.Table(node.Table)
+			s.Where(sql.In(s.C(signature.FieldHash),
+				sql.Select(t.C(node.FieldID)).
+					From(t).
+					Where(sql.And(
+						sql.EQ(t.C(node.FieldRootHash), root),
+						sql.GTE(t.C(node.FieldDepth), depth),
+					)),
+			))
+		}).
+		Unique(true).
+		Order(ent.Asc(signature.FieldHash)).
+		Select(signature.FieldHash).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signed descendants: %w", err)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
+)
+
+// EmbeddingCall is the model entity for the EmbeddingCall schema.
+type EmbeddingCall struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// AgentName holds the value of the "agent_name" field.
+	AgentName string `json:"agent_name,omitempty"`
+	// Project holds the value of the "project" field.
+	Project string `json:"project,omitempty"`
+	// InputCount holds the value of the "input_count" field.
+	InputCount int `json:"input_count,omitempty"`
+	// Input holds the value of the "input" field.
+	Input []string `json:"input,omitempty"`
+	// Dimensions holds the value of the "dimensions" field.
+	Dimensions int `json:"dimensions,omitempty"`
+	// PromptTokens holds the value of the "prompt_tokens" field.
+	PromptTokens *int `json:"prompt_tokens,omitempty"`
+	// TotalTokens holds the value of the "total_tokens" field.
+	TotalTokens *int `json:"total_tokens,omitempty"`
+	// LatencyNs holds the value of the "latency_ns" field.
+	LatencyNs int64 `json:"latency_ns,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmbeddingCall) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case embeddingcall.FieldInput:
+			values[i] = new([]byte)
+		case embeddingcall.FieldID, embeddingcall.FieldInputCount, embeddingcall.FieldDimensions, embeddingcall.FieldPromptTokens, embeddingcall.FieldTotalTokens, embeddingcall.FieldLatencyNs:
+			values[i] = new(sql.NullInt64)
+		case embeddingcall.FieldProvider, embeddingcall.FieldModel, embeddingcall.FieldAgentName, embeddingcall.FieldProject:
+			values[i] = new(sql.NullString)
+		case embeddingcall.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmbeddingCall fields.
+func (_m *EmbeddingCall) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case embeddingcall.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case embeddingcall.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case embeddingcall.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case embeddingcall.FieldAgentName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_name", values[i])
+			} else if value.Valid {
+				_m.AgentName = value.String
+			}
+		case embeddingcall.FieldProject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project", values[i])
+			} else if value.Valid {
+				_m.Project = value.String
+			}
+		case embeddingcall.FieldInputCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_count", values[i])
+			} else if value.Valid {
+				_m.InputCount = int(value.Int64)
+			}
+		case embeddingcall.FieldInput:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field input", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Input); err != nil {
+					return fmt.Errorf("unmarshal field input: %w", err)
+				}
+			}
+		case embeddingcall.FieldDimensions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dimensions", values[i])
+			} else if value.Valid {
+				_m.Dimensions = int(value.Int64)
+			}
+		case embeddingcall.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = new(int)
+				*_m.PromptTokens = int(value.Int64)
+			}
+		case embeddingcall.FieldTotalTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tokens", values[i])
+			} else if value.Valid {
+				_m.TotalTokens = new(int)
+				*_m.TotalTokens = int(value.Int64)
+			}
+		case embeddingcall.FieldLatencyNs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ns", values[i])
+			} else if value.Valid {
+				_m.LatencyNs = value.Int64
+			}
+		case embeddingcall.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmbeddingCall.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmbeddingCall) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmbeddingCall.
+// Note that you need to call EmbeddingCall.Unwrap() before calling this method if this EmbeddingCall
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmbeddingCall) Update() *EmbeddingCallUpdateOne {
+	return NewEmbeddingCallClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmbeddingCall entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmbeddingCall) Unwrap() *EmbeddingCall {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmbeddingCall is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmbeddingCall) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmbeddingCall(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("agent_name=")
+	builder.WriteString(_m.AgentName)
+	builder.WriteString(", ")
+	builder.WriteString("project=")
+	builder.WriteString(_m.Project)
+	builder.WriteString(", ")
+	builder.WriteString("input_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InputCount))
+	builder.WriteString(", ")
+	builder.WriteString("input=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Input))
+	builder.WriteString(", ")
+	builder.WriteString("dimensions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Dimensions))
+	builder.WriteString(", ")
+	if v := _m.PromptTokens; v != nil {
+		builder.WriteString("prompt_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TotalTokens; v != nil {
+		builder.WriteString("total_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("latency_ns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatencyNs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmbeddingCalls is a parsable slice of EmbeddingCall.
+type EmbeddingCalls []*EmbeddingCall
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package embeddingcall
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the embeddingcall type in the database.
+	Label = "embedding_call"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldAgentName holds the string denoting the agent_name field in the database.
+	FieldAgentName = "agent_name"
+	// FieldProject holds the string denoting the project field in the database.
+	FieldProject = "project"
+	// FieldInputCount holds the string denoting the input_count field in the database.
+	FieldInputCount = "input_count"
+	// FieldInput holds the string denoting the input field in the database.
+	FieldInput = "input"
+	// FieldDimensions holds the string denoting the dimensions field in the database.
+	FieldDimensions = "dimensions"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldTotalTokens holds the string denoting the total_tokens field in the database.
+	FieldTotalTokens = "total_tokens"
+	// FieldLatencyNs holds the string denoting the latency_ns field in the database.
+	FieldLatencyNs = "latency_ns"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the embeddingcall in the database.
+	Table = "embedding_calls"
+)
+
+// Columns holds all SQL columns for embeddingcall fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldModel,
+	FieldAgentName,
+	FieldProject,
+	FieldInputCount,
+	FieldInput,
+	FieldDimensions,
+	FieldPromptTokens,
+	FieldTotalTokens,
+	FieldLatencyNs,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EmbeddingCall queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByAgentName orders the results by the agent_name field.
+func ByAgentName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentName, opts...).ToFunc()
+}
+
+// ByProject orders the results by the project field.
+func ByProject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProject, opts...).ToFunc()
+}
+
+// ByInputCount orders the results by the input_count field.
+func ByInputCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputCount, opts...).ToFunc()
+}
+
+// ByDimensions orders the results by the dimensions field.
+func ByDimensions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDimensions, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByTotalTokens orders the results by the total_tokens field.
+func ByTotalTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTokens, opts...).ToFunc()
+}
+
+// ByLatencyNs orders the results by the latency_ns field.
+func ByLatencyNs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyNs, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package embeddingcall
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldProvider, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldModel, v))
+}
+
+// AgentName applies equality check predicate on the "agent_name" field. It's identical to AgentNameEQ.
+func AgentName(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldAgentName, v))
+}
+
+// Project applies equality check predicate on the "project" field. It's identical to ProjectEQ.
+func Project(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldProject, v))
+}
+
+// InputCount applies equality check predicate on the "input_count" field. It's identical to InputCountEQ.
+func InputCount(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldInputCount, v))
+}
+
+// Dimensions applies equality check predicate on the "dimensions" field. It's identical to DimensionsEQ.
+func Dimensions(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldDimensions, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// TotalTokens applies equality check predicate on the "total_tokens" field. It's identical to TotalTokensEQ.
+func TotalTokens(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// LatencyNs applies equality check predicate on the "latency_ns" field. It's identical to LatencyNsEQ.
+func LatencyNs(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldLatencyNs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContainsFold(FieldModel, v))
+}
+
+// AgentNameEQ applies the EQ predicate on the "agent_name" field.
+func AgentNameEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldAgentName, v))
+}
+
+// AgentNameNEQ applies the NEQ predicate on the "agent_name" field.
+func AgentNameNEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldAgentName, v))
+}
+
+// AgentNameIn applies the In predicate on the "agent_name" field.
+func AgentNameIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldAgentName, vs...))
+}
+
+// AgentNameNotIn applies the NotIn predicate on the "agent_name" field.
+func AgentNameNotIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldAgentName, vs...))
+}
+
+// AgentNameGT applies the GT predicate on the "agent_name" field.
+func AgentNameGT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldAgentName, v))
+}
+
+// AgentNameGTE applies the GTE predicate on the "agent_name" field.
+func AgentNameGTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldAgentName, v))
+}
+
+// AgentNameLT applies the LT predicate on the "agent_name" field.
+func AgentNameLT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldAgentName, v))
+}
+
+// AgentNameLTE applies the LTE predicate on the "agent_name" field.
+func AgentNameLTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldAgentName, v))
+}
+
+// AgentNameContains applies the Contains predicate on the "agent_name" field.
+func AgentNameContains(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContains(FieldAgentName, v))
+}
+
+// AgentNameHasPrefix applies the HasPrefix predicate on the "agent_name" field.
+func AgentNameHasPrefix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasPrefix(FieldAgentName, v))
+}
+
+// AgentNameHasSuffix applies the HasSuffix predicate on the "agent_name" field.
+func AgentNameHasSuffix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasSuffix(FieldAgentName, v))
+}
+
+// AgentNameIsNil applies the IsNil predicate on the "agent_name" field.
+func AgentNameIsNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIsNull(FieldAgentName))
+}
+
+// AgentNameNotNil applies the NotNil predicate on the "agent_name" field.
+func AgentNameNotNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotNull(FieldAgentName))
+}
+
+// AgentNameEqualFold applies the EqualFold predicate on the "agent_name" field.
+func AgentNameEqualFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEqualFold(FieldAgentName, v))
+}
+
+// AgentNameContainsFold applies the ContainsFold predicate on the "agent_name" field.
+func AgentNameContainsFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContainsFold(FieldAgentName, v))
+}
+
+// ProjectEQ applies the EQ predicate on the "project" field.
+func ProjectEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldProject, v))
+}
+
+// ProjectNEQ applies the NEQ predicate on the "project" field.
+func ProjectNEQ(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldProject, v))
+}
+
+// ProjectIn applies the In predicate on the "project" field.
+func ProjectIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldProject, vs...))
+}
+
+// ProjectNotIn applies the NotIn predicate on the "project" field.
+func ProjectNotIn(vs ...string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldProject, vs...))
+}
+
+// ProjectGT applies the GT predicate on the "project" field.
+func ProjectGT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldProject, v))
+}
+
+// ProjectGTE applies the GTE predicate on the "project" field.
+func ProjectGTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldProject, v))
+}
+
+// ProjectLT applies the LT predicate on the "project" field.
+func ProjectLT(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldProject, v))
+}
+
+// ProjectLTE applies the LTE predicate on the "project" field.
+func ProjectLTE(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldProject, v))
+}
+
+// ProjectContains applies the Contains predicate on the "project" field.
+func ProjectContains(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContains(FieldProject, v))
+}
+
+// ProjectHasPrefix applies the HasPrefix predicate on the "project" field.
+func ProjectHasPrefix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasPrefix(FieldProject, v))
+}
+
+// ProjectHasSuffix applies the HasSuffix predicate on the "project" field.
+func ProjectHasSuffix(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldHasSuffix(FieldProject, v))
+}
+
+// ProjectIsNil applies the IsNil predicate on the "project" field.
+func ProjectIsNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIsNull(FieldProject))
+}
+
+// ProjectNotNil applies the NotNil predicate on the "project" field.
+func ProjectNotNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotNull(FieldProject))
+}
+
+// ProjectEqualFold applies the EqualFold predicate on the "project" field.
+func ProjectEqualFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEqualFold(FieldProject, v))
+}
+
+// ProjectContainsFold applies the ContainsFold predicate on the "project" field.
+func ProjectContainsFold(v string) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldContainsFold(FieldProject, v))
+}
+
+// InputCountEQ applies the EQ predicate on the "input_count" field.
+func InputCountEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldInputCount, v))
+}
+
+// InputCountNEQ applies the NEQ predicate on the "input_count" field.
+func InputCountNEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldInputCount, v))
+}
+
+// InputCountIn applies the In predicate on the "input_count" field.
+func InputCountIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldInputCount, vs...))
+}
+
+// InputCountNotIn applies the NotIn predicate on the "input_count" field.
+func InputCountNotIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldInputCount, vs...))
+}
+
+// InputCountGT applies the GT predicate on the "input_count" field.
+func InputCountGT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldInputCount, v))
+}
+
+// InputCountGTE applies the GTE predicate on the "input_count" field.
+func InputCountGTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldInputCount, v))
+}
+
+// InputCountLT applies the LT predicate on the "input_count" field.
+func InputCountLT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldInputCount, v))
+}
+
+// InputCountLTE applies the LTE predicate on the "input_count" field.
+func InputCountLTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldInputCount, v))
+}
+
+// InputIsNil applies the IsNil predicate on the "input" field.
+func InputIsNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIsNull(FieldInput))
+}
+
+// InputNotNil applies the NotNil predicate on the "input" field.
+func InputNotNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotNull(FieldInput))
+}
+
+// DimensionsEQ applies the EQ predicate on the "dimensions" field.
+func DimensionsEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldDimensions, v))
+}
+
+// DimensionsNEQ applies the NEQ predicate on the "dimensions" field.
+func DimensionsNEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldDimensions, v))
+}
+
+// DimensionsIn applies the In predicate on the "dimensions" field.
+func DimensionsIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldDimensions, vs...))
+}
+
+// DimensionsNotIn applies the NotIn predicate on the "dimensions" field.
+func DimensionsNotIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldDimensions, vs...))
+}
+
+// DimensionsGT applies the GT predicate on the "dimensions" field.
+func DimensionsGT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldDimensions, v))
+}
+
+// DimensionsGTE applies the GTE predicate on the "dimensions" field.
+func DimensionsGTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldDimensions, v))
+}
+
+// DimensionsLT applies the LT predicate on the "dimensions" field.
+func DimensionsLT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldDimensions, v))
+}
+
+// DimensionsLTE applies the LTE predicate on the "dimensions" field.
+func DimensionsLTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldDimensions, v))
+}
+
+// DimensionsIsNil applies the IsNil predicate on the "dimensions" field.
+func DimensionsIsNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIsNull(FieldDimensions))
+}
+
+// DimensionsNotNil applies the NotNil predicate on the "dimensions" field.
+func DimensionsNotNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotNull(FieldDimensions))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// PromptTokensIsNil applies the IsNil predicate on the "prompt_tokens" field.
+func PromptTokensIsNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIsNull(FieldPromptTokens))
+}
+
+// PromptTokensNotNil applies the NotNil predicate on the "prompt_tokens" field.
+func PromptTokensNotNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotNull(FieldPromptTokens))
+}
+
+// TotalTokensEQ applies the EQ predicate on the "total_tokens" field.
+func TotalTokensEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensNEQ applies the NEQ predicate on the "total_tokens" field.
+func TotalTokensNEQ(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensIn applies the In predicate on the "total_tokens" field.
+func TotalTokensIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensNotIn applies the NotIn predicate on the "total_tokens" field.
+func TotalTokensNotIn(vs ...int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensGT applies the GT predicate on the "total_tokens" field.
+func TotalTokensGT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldTotalTokens, v))
+}
+
+// TotalTokensGTE applies the GTE predicate on the "total_tokens" field.
+func TotalTokensGTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldTotalTokens, v))
+}
+
+// TotalTokensLT applies the LT predicate on the "total_tokens" field.
+func TotalTokensLT(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldTotalTokens, v))
+}
+
+// TotalTokensLTE applies the LTE predicate on the "total_tokens" field.
+func TotalTokensLTE(v int) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldTotalTokens, v))
+}
+
+// TotalTokensIsNil applies the IsNil predicate on the "total_tokens" field.
+func TotalTokensIsNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIsNull(FieldTotalTokens))
+}
+
+// TotalTokensNotNil applies the NotNil predicate on the "total_tokens" field.
+func TotalTokensNotNil() predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotNull(FieldTotalTokens))
+}
+
+// LatencyNsEQ applies the EQ predicate on the "latency_ns" field.
+func LatencyNsEQ(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldLatencyNs, v))
+}
+
+// LatencyNsNEQ applies the NEQ predicate on the "latency_ns" field.
+func LatencyNsNEQ(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldLatencyNs, v))
+}
+
+// LatencyNsIn applies the In predicate on the "latency_ns" field.
+func LatencyNsIn(vs ...int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldLatencyNs, vs...))
+}
+
+// LatencyNsNotIn applies the NotIn predicate on the "latency_ns" field.
+func LatencyNsNotIn(vs ...int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldLatencyNs, vs...))
+}
+
+// LatencyNsGT applies the GT predicate on the "latency_ns" field.
+func LatencyNsGT(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldLatencyNs, v))
+}
+
+// LatencyNsGTE applies the GTE predicate on the "latency_ns" field.
+func LatencyNsGTE(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldLatencyNs, v))
+}
+
+// LatencyNsLT applies the LT predicate on the "latency_ns" field.
+func LatencyNsLT(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldLatencyNs, v))
+}
+
+// LatencyNsLTE applies the LTE predicate on the "latency_ns" field.
+func LatencyNsLTE(v int64) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldLatencyNs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmbeddingCall) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmbeddingCall) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmbeddingCall) predicate.EmbeddingCall {
+	return predicate.EmbeddingCall(sql.NotPredicates(p))
+}
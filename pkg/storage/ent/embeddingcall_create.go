@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
+)
+
+// EmbeddingCallCreate is the builder for creating a EmbeddingCall entity.
+type EmbeddingCallCreate struct {
+	config
+	mutation *EmbeddingCallMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *EmbeddingCallCreate) SetProvider(v string) *EmbeddingCallCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *EmbeddingCallCreate) SetModel(v string) *EmbeddingCallCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_c *EmbeddingCallCreate) SetAgentName(v string) *EmbeddingCallCreate {
+	_c.mutation.SetAgentName(v)
+	return _c
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_c *EmbeddingCallCreate) SetNillableAgentName(v *string) *EmbeddingCallCreate {
+	if v != nil {
+		_c.SetAgentName(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" field.
+func (_c *EmbeddingCallCreate) SetProject(v string) *EmbeddingCallCreate {
+	_c.mutation.SetProject(v)
+	return _c
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_c *EmbeddingCallCreate) SetNillableProject(v *string) *EmbeddingCallCreate {
+	if v != nil {
+		_c.SetProject(*v)
+	}
+	return _c
+}
+
+// SetInputCount sets the "input_count" field.
+func (_c *EmbeddingCallCreate) SetInputCount(v int) *EmbeddingCallCreate {
+	_c.mutation.SetInputCount(v)
+	return _c
+}
+
+// SetInput sets the "input" field.
+func (_c *EmbeddingCallCreate) SetInput(v []string) *EmbeddingCallCreate {
+	_c.mutation.SetInput(v)
+	return _c
+}
+
+// SetDimensions sets the "dimensions" field.
+func (_c *EmbeddingCallCreate) SetDimensions(v int) *EmbeddingCallCreate {
+	_c.mutation.SetDimensions(v)
+	return _c
+}
+
+// SetNillableDimensions sets the "dimensions" field if the given value is not nil.
+func (_c *EmbeddingCallCreate) SetNillableDimensions(v *int) *EmbeddingCallCreate {
+	if v != nil {
+		_c.SetDimensions(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *EmbeddingCallCreate) SetPromptTokens(v int) *EmbeddingCallCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *EmbeddingCallCreate) SetNillablePromptTokens(v *int) *EmbeddingCallCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *EmbeddingCallCreate) SetTotalTokens(v int) *EmbeddingCallCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *EmbeddingCallCreate) SetNillableTotalTokens(v *int) *EmbeddingCallCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetLatencyNs sets the "latency_ns" field.
+func (_c *EmbeddingCallCreate) SetLatencyNs(v int64) *EmbeddingCallCreate {
+	_c.mutation.SetLatencyNs(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmbeddingCallCreate) SetCreatedAt(v time.Time) *EmbeddingCallCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmbeddingCallCreate) SetNillableCreatedAt(v *time.Time) *EmbeddingCallCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EmbeddingCallMutation object of the builder.
+func (_c *EmbeddingCallCreate) Mutation() *EmbeddingCallMutation {
+	return _c.mutation
+}
+
+// Save creates the EmbeddingCall in the database.
+func (_c *EmbeddingCallCreate) Save(ctx context.Context) (*EmbeddingCall, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmbeddingCallCreate) SaveX(ctx context.Context) *EmbeddingCall {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmbeddingCallCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmbeddingCallCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmbeddingCallCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := embeddingcall.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmbeddingCallCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "EmbeddingCall.provider"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "EmbeddingCall.model"`)}
+	}
+	if _, ok := _c.mutation.InputCount(); !ok {
+		return &ValidationError{Name: "input_count", err: errors.New(`ent: missing required field "EmbeddingCall.input_count"`)}
+	}
+	if _, ok := _c.mutation.LatencyNs(); !ok {
+		return &ValidationError{Name: "latency_ns", err: errors.New(`ent: missing required field "EmbeddingCall.latency_ns"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmbeddingCall.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EmbeddingCallCreate) sqlSave(ctx context.Context) (*EmbeddingCall, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmbeddingCallCreate) createSpec() (*EmbeddingCall, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmbeddingCall{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(embeddingcall.Table, sqlgraph.NewFieldSpec(embeddingcall.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(embeddingcall.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(embeddingcall.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.AgentName(); ok {
+		_spec.SetField(embeddingcall.FieldAgentName, field.TypeString, value)
+		_node.AgentName = value
+	}
+	if value, ok := _c.mutation.Project(); ok {
+		_spec.SetField(embeddingcall.FieldProject, field.TypeString, value)
+		_node.Project = value
+	}
+	if value, ok := _c.mutation.InputCount(); ok {
+		_spec.SetField(embeddingcall.FieldInputCount, field.TypeInt, value)
+		_node.InputCount = value
+	}
+	if value, ok := _c.mutation.Input(); ok {
+		_spec.SetField(embeddingcall.FieldInput, field.TypeJSON, value)
+		_node.Input = value
+	}
+	if value, ok := _c.mutation.Dimensions(); ok {
+		_spec.SetField(embeddingcall.FieldDimensions, field.TypeInt, value)
+		_node.Dimensions = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(embeddingcall.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = &value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(embeddingcall.FieldTotalTokens, field.TypeInt, value)
+		_node.TotalTokens = &value
+	}
+	if value, ok := _c.mutation.LatencyNs(); ok {
+		_spec.SetField(embeddingcall.FieldLatencyNs, field.TypeInt64, value)
+		_node.LatencyNs = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(embeddingcall.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EmbeddingCallCreateBulk is the builder for creating many EmbeddingCall entities in bulk.
+type EmbeddingCallCreateBulk struct {
+	config
+	err      error
+	builders []*EmbeddingCallCreate
+}
+
+// Save creates the EmbeddingCall entities in the database.
+func (_c *EmbeddingCallCreateBulk) Save(ctx context.Context) ([]*EmbeddingCall, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmbeddingCall, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmbeddingCallMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmbeddingCallCreateBulk) SaveX(ctx context.Context) []*EmbeddingCall {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmbeddingCallCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmbeddingCallCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// EmbeddingCallDelete is the builder for deleting a EmbeddingCall entity.
+type EmbeddingCallDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingCallMutation
+}
+
+// Where appends a list predicates to the EmbeddingCallDelete builder.
+func (_d *EmbeddingCallDelete) Where(ps ...predicate.EmbeddingCall) *EmbeddingCallDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EmbeddingCallDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmbeddingCallDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EmbeddingCallDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(embeddingcall.Table, sqlgraph.NewFieldSpec(embeddingcall.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EmbeddingCallDeleteOne is the builder for deleting a single EmbeddingCall entity.
+type EmbeddingCallDeleteOne struct {
+	_d *EmbeddingCallDelete
+}
+
+// Where appends a list predicates to the EmbeddingCallDelete builder.
+func (_d *EmbeddingCallDeleteOne) Where(ps ...predicate.EmbeddingCall) *EmbeddingCallDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EmbeddingCallDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{embeddingcall.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EmbeddingCallDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
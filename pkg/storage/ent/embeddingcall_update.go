@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// EmbeddingCallUpdate is the builder for updating EmbeddingCall entities.
+type EmbeddingCallUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingCallMutation
+}
+
+// Where appends a list predicates to the EmbeddingCallUpdate builder.
+func (_u *EmbeddingCallUpdate) Where(ps ...predicate.EmbeddingCall) *EmbeddingCallUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the EmbeddingCallMutation object of the builder.
+func (_u *EmbeddingCallUpdate) Mutation() *EmbeddingCallMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmbeddingCallUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingCallUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmbeddingCallUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingCallUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EmbeddingCallUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingcall.Table, embeddingcall.Columns, sqlgraph.NewFieldSpec(embeddingcall.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AgentNameCleared() {
+		_spec.ClearField(embeddingcall.FieldAgentName, field.TypeString)
+	}
+	if _u.mutation.ProjectCleared() {
+		_spec.ClearField(embeddingcall.FieldProject, field.TypeString)
+	}
+	if _u.mutation.InputCleared() {
+		_spec.ClearField(embeddingcall.FieldInput, field.TypeJSON)
+	}
+	if _u.mutation.DimensionsCleared() {
+		_spec.ClearField(embeddingcall.FieldDimensions, field.TypeInt)
+	}
+	if _u.mutation.PromptTokensCleared() {
+		_spec.ClearField(embeddingcall.FieldPromptTokens, field.TypeInt)
+	}
+	if _u.mutation.TotalTokensCleared() {
+		_spec.ClearField(embeddingcall.FieldTotalTokens, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmbeddingCallUpdateOne is the builder for updating a single EmbeddingCall entity.
+type EmbeddingCallUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmbeddingCallMutation
+}
+
+// Mutation returns the EmbeddingCallMutation object of the builder.
+func (_u *EmbeddingCallUpdateOne) Mutation() *EmbeddingCallMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmbeddingCallUpdate builder.
+func (_u *EmbeddingCallUpdateOne) Where(ps ...predicate.EmbeddingCall) *EmbeddingCallUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmbeddingCallUpdateOne) Select(field string, fields ...string) *EmbeddingCallUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmbeddingCall entity.
+func (_u *EmbeddingCallUpdateOne) Save(ctx context.Context) (*EmbeddingCall, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingCallUpdateOne) SaveX(ctx context.Context) *EmbeddingCall {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmbeddingCallUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingCallUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EmbeddingCallUpdateOne) sqlSave(ctx context.Context) (_node *EmbeddingCall, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingcall.Table, embeddingcall.Columns, sqlgraph.NewFieldSpec(embeddingcall.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmbeddingCall.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingcall.FieldID)
+		for _, f := range fields {
+			if !embeddingcall.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != embeddingcall.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.AgentNameCleared() {
+		_spec.ClearField(embeddingcall.FieldAgentName, field.TypeString)
+	}
+	if _u.mutation.ProjectCleared() {
+		_spec.ClearField(embeddingcall.FieldProject, field.TypeString)
+	}
+	if _u.mutation.InputCleared() {
+		_spec.ClearField(embeddingcall.FieldInput, field.TypeJSON)
+	}
+	if _u.mutation.DimensionsCleared() {
+		_spec.ClearField(embeddingcall.FieldDimensions, field.TypeInt)
+	}
+	if _u.mutation.PromptTokensCleared() {
+		_spec.ClearField(embeddingcall.FieldPromptTokens, field.TypeInt)
+	}
+	if _u.mutation.TotalTokensCleared() {
+		_spec.ClearField(embeddingcall.FieldTotalTokens, field.TypeInt)
+	}
+	_node = &EmbeddingCall{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingcall.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/blob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/facet"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/ref"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/signature"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			facet.Table: facet.ValidColumn,
-			node.Table:  node.ValidColumn,
+			annotation.Table:    annotation.ValidColumn,
+			blob.Table:          blob.ValidColumn,
+			embeddingcall.Table: embeddingcall.ValidColumn,
+			facet.Table:         facet.ValidColumn,
+			node.Table:          node.ValidColumn,
+			nodeblob.Table:      nodeblob.ValidColumn,
+			nodelink.Table:      nodelink.ValidColumn,
+			nodemetadata.Table:  nodemetadata.ValidColumn,
+			ref.Table:           ref.ValidColumn,
+			signature.Table:     signature.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -9,6 +9,42 @@ import (
 	"github.com/papercomputeco/tapes/pkg/storage/ent"
 )
 
+// The AnnotationFunc type is an adapter to allow the use of ordinary
+// function as Annotation mutator.
+type AnnotationFunc func(context.Context, *ent.AnnotationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnnotationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AnnotationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnnotationMutation", m)
+}
+
+// The BlobFunc type is an adapter to allow the use of ordinary
+// function as Blob mutator.
+type BlobFunc func(context.Context, *ent.BlobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlobMutation", m)
+}
+
+// The EmbeddingCallFunc type is an adapter to allow the use of ordinary
+// function as EmbeddingCall mutator.
+type EmbeddingCallFunc func(context.Context, *ent.EmbeddingCallMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmbeddingCallFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmbeddingCallMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmbeddingCallMutation", m)
+}
+
 // The FacetFunc type is an adapter to allow the use of ordinary
 // function as Facet mutator.
 type FacetFunc func(context.Context, *ent.FacetMutation) (ent.Value, error)
@@ -33,6 +69,66 @@ func (f NodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NodeMutation", m)
 }
 
+// The NodeBlobFunc type is an adapter to allow the use of ordinary
+// function as NodeBlob mutator.
+type NodeBlobFunc func(context.Context, *ent.NodeBlobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NodeBlobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NodeBlobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NodeBlobMutation", m)
+}
+
+// The NodeLinkFunc type is an adapter to allow the use of ordinary
+// function as NodeLink mutator.
+type NodeLinkFunc func(context.Context, *ent.NodeLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NodeLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NodeLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NodeLinkMutation", m)
+}
+
+// The NodeMetadataFunc type is an adapter to allow the use of ordinary
+// function as NodeMetadata mutator.
+type NodeMetadataFunc func(context.Context, *ent.NodeMetadataMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NodeMetadataFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NodeMetadataMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NodeMetadataMutation", m)
+}
+
+// The RefFunc type is an adapter to allow the use of ordinary
+// function as Ref mutator.
+type RefFunc func(context.Context, *ent.RefMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefMutation", m)
+}
+
+// The SignatureFunc type is an adapter to allow the use of ordinary
+// function as Signature mutator.
+type SignatureFunc func(context.Context, *ent.SignatureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SignatureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SignatureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SignatureMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -8,6 +8,82 @@ import (
 )
 
 var (
+	// AnnotationsColumns holds the columns for the "annotations" table.
+	AnnotationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "rating", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AnnotationsTable holds the schema information for the "annotations" table.
+	AnnotationsTable = &schema.Table{
+		Name:       "annotations",
+		Columns:    AnnotationsColumns,
+		PrimaryKey: []*schema.Column{AnnotationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "annotation_hash_author",
+				Unique:  true,
+				Columns: []*schema.Column{AnnotationsColumns[1], AnnotationsColumns[2]},
+			},
+			{
+				Name:    "annotation_rating",
+				Unique:  false,
+				Columns: []*schema.Column{AnnotationsColumns[3]},
+			},
+		},
+	}
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "media_type", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+	}
+	// EmbeddingCallsColumns holds the columns for the "embedding_calls" table.
+	EmbeddingCallsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString},
+		{Name: "agent_name", Type: field.TypeString, Nullable: true},
+		{Name: "project", Type: field.TypeString, Nullable: true},
+		{Name: "input_count", Type: field.TypeInt},
+		{Name: "input", Type: field.TypeJSON, Nullable: true},
+		{Name: "dimensions", Type: field.TypeInt, Nullable: true},
+		{Name: "prompt_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "total_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "latency_ns", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// EmbeddingCallsTable holds the schema information for the "embedding_calls" table.
+	EmbeddingCallsTable = &schema.Table{
+		Name:       "embedding_calls",
+		Columns:    EmbeddingCallsColumns,
+		PrimaryKey: []*schema.Column{EmbeddingCallsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "embeddingcall_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmbeddingCallsColumns[11]},
+			},
+			{
+				Name:    "embeddingcall_model",
+				Unique:  false,
+				Columns: []*schema.Column{EmbeddingCallsColumns[2]},
+			},
+		},
+	}
 	// FacetsColumns holds the columns for the "facets" table.
 	FacetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -47,6 +123,19 @@ var (
 		{Name: "total_duration_ns", Type: field.TypeInt64, Nullable: true},
 		{Name: "prompt_duration_ns", Type: field.TypeInt64, Nullable: true},
 		{Name: "project", Type: field.TypeString, Nullable: true},
+		{Name: "requested_model", Type: field.TypeString, Nullable: true},
+		{Name: "upstream", Type: field.TypeString, Nullable: true},
+		{Name: "generation_params", Type: field.TypeJSON, Nullable: true},
+		{Name: "provider_request_id", Type: field.TypeString, Nullable: true},
+		{Name: "rate_limits", Type: field.TypeJSON, Nullable: true},
+		{Name: "choice_index", Type: field.TypeInt, Nullable: true},
+		{Name: "context_key", Type: field.TypeString, Nullable: true},
+		{Name: "cache_key", Type: field.TypeString, Nullable: true},
+		{Name: "cached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cache_hits", Type: field.TypeInt, Default: 0},
+		{Name: "root_hash", Type: field.TypeString, Nullable: true},
+		{Name: "depth", Type: field.TypeInt, Default: 0},
+		{Name: "is_leaf", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "parent_hash", Type: field.TypeString, Nullable: true},
 	}
@@ -58,7 +147,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "nodes_nodes_parent",
-				Columns:    []*schema.Column{NodesColumns[18]},
+				Columns:    []*schema.Column{NodesColumns[31]},
 				RefColumns: []*schema.Column{NodesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -67,7 +156,7 @@ var (
 			{
 				Name:    "node_parent_hash",
 				Unique:  false,
-				Columns: []*schema.Column{NodesColumns[18]},
+				Columns: []*schema.Column{NodesColumns[31]},
 			},
 			{
 				Name:    "node_role",
@@ -99,12 +188,171 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{NodesColumns[16]},
 			},
+			{
+				Name:    "node_cache_key",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[24]},
+			},
+			{
+				Name:    "node_provider_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[20]},
+			},
+			{
+				Name:    "node_context_key",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[23]},
+			},
+			{
+				Name:    "node_root_hash_depth",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[27], NodesColumns[28]},
+			},
+			{
+				Name:    "node_is_leaf",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[29]},
+			},
+		},
+	}
+	// NodeBlobsColumns holds the columns for the "node_blobs" table.
+	NodeBlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "node_hash", Type: field.TypeString},
+		{Name: "blob_hash", Type: field.TypeString},
+	}
+	// NodeBlobsTable holds the schema information for the "node_blobs" table.
+	NodeBlobsTable = &schema.Table{
+		Name:       "node_blobs",
+		Columns:    NodeBlobsColumns,
+		PrimaryKey: []*schema.Column{NodeBlobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nodeblob_node_hash_blob_hash",
+				Unique:  true,
+				Columns: []*schema.Column{NodeBlobsColumns[1], NodeBlobsColumns[2]},
+			},
+			{
+				Name:    "nodeblob_blob_hash",
+				Unique:  false,
+				Columns: []*schema.Column{NodeBlobsColumns[2]},
+			},
+		},
+	}
+	// NodeLinksColumns holds the columns for the "node_links" table.
+	NodeLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_hash", Type: field.TypeString},
+		{Name: "to_hash", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "tool_use_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// NodeLinksTable holds the schema information for the "node_links" table.
+	NodeLinksTable = &schema.Table{
+		Name:       "node_links",
+		Columns:    NodeLinksColumns,
+		PrimaryKey: []*schema.Column{NodeLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nodelink_from_hash_to_hash_kind",
+				Unique:  true,
+				Columns: []*schema.Column{NodeLinksColumns[1], NodeLinksColumns[2], NodeLinksColumns[3]},
+			},
+			{
+				Name:    "nodelink_to_hash_kind",
+				Unique:  false,
+				Columns: []*schema.Column{NodeLinksColumns[2], NodeLinksColumns[3]},
+			},
+			{
+				Name:    "nodelink_kind",
+				Unique:  false,
+				Columns: []*schema.Column{NodeLinksColumns[3]},
+			},
+		},
+	}
+	// NodeMetadataColumns holds the columns for the "node_metadata" table.
+	NodeMetadataColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "node_hash", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// NodeMetadataTable holds the schema information for the "node_metadata" table.
+	NodeMetadataTable = &schema.Table{
+		Name:       "node_metadata",
+		Columns:    NodeMetadataColumns,
+		PrimaryKey: []*schema.Column{NodeMetadataColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nodemetadata_node_hash_key",
+				Unique:  true,
+				Columns: []*schema.Column{NodeMetadataColumns[1], NodeMetadataColumns[2]},
+			},
+			{
+				Name:    "nodemetadata_key_value",
+				Unique:  false,
+				Columns: []*schema.Column{NodeMetadataColumns[2], NodeMetadataColumns[3]},
+			},
+		},
+	}
+	// RefsColumns holds the columns for the "refs" table.
+	RefsColumns = []*schema.Column{
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RefsTable holds the schema information for the "refs" table.
+	RefsTable = &schema.Table{
+		Name:       "refs",
+		Columns:    RefsColumns,
+		PrimaryKey: []*schema.Column{RefsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ref_hash",
+				Unique:  false,
+				Columns: []*schema.Column{RefsColumns[1]},
+			},
+		},
+	}
+	// SignaturesColumns holds the columns for the "signatures" table.
+	SignaturesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "key_id", Type: field.TypeString},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "signature", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+	}
+	// SignaturesTable holds the schema information for the "signatures" table.
+	SignaturesTable = &schema.Table{
+		Name:       "signatures",
+		Columns:    SignaturesColumns,
+		PrimaryKey: []*schema.Column{SignaturesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signature_hash_key_id",
+				Unique:  true,
+				Columns: []*schema.Column{SignaturesColumns[1], SignaturesColumns[2]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AnnotationsTable,
+		BlobsTable,
+		EmbeddingCallsTable,
 		FacetsTable,
 		NodesTable,
+		NodeBlobsTable,
+		NodeLinksTable,
+		NodeMetadataTable,
+		RefsTable,
+		SignaturesTable,
 	}
 )
 
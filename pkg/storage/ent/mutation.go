@@ -11,9 +11,17 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/blob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/facet"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/ref"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/signature"
 )
 
 const (
@@ -25,36 +33,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFacet = "Facet"
-	TypeNode  = "Node"
+	TypeAnnotation    = "Annotation"
+	TypeBlob          = "Blob"
+	TypeEmbeddingCall = "EmbeddingCall"
+	TypeFacet         = "Facet"
+	TypeNode          = "Node"
+	TypeNodeBlob      = "NodeBlob"
+	TypeNodeLink      = "NodeLink"
+	TypeNodeMetadata  = "NodeMetadata"
+	TypeRef           = "Ref"
+	TypeSignature     = "Signature"
 )
 
-// FacetMutation represents an operation that mutates the Facet nodes in the graph.
-type FacetMutation struct {
+// AnnotationMutation represents an operation that mutates the Annotation nodes in the graph.
+type AnnotationMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	session_id    *string
-	facets        *map[string]interface{}
+	id            *int
+	hash          *string
+	author        *string
+	rating        *string
+	labels        *[]string
+	appendlabels  []string
+	notes         *string
 	created_at    *time.Time
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Facet, error)
-	predicates    []predicate.Facet
+	oldValue      func(context.Context) (*Annotation, error)
+	predicates    []predicate.Annotation
 }
 
-var _ ent.Mutation = (*FacetMutation)(nil)
+var _ ent.Mutation = (*AnnotationMutation)(nil)
 
-// facetOption allows management of the mutation configuration using functional options.
-type facetOption func(*FacetMutation)
+// annotationOption allows management of the mutation configuration using functional options.
+type annotationOption func(*AnnotationMutation)
 
-// newFacetMutation creates new mutation for the Facet entity.
-func newFacetMutation(c config, op Op, opts ...facetOption) *FacetMutation {
-	m := &FacetMutation{
+// newAnnotationMutation creates new mutation for the Annotation entity.
+func newAnnotationMutation(c config, op Op, opts ...annotationOption) *AnnotationMutation {
+	m := &AnnotationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFacet,
+		typ:           TypeAnnotation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -63,20 +84,20 @@ func newFacetMutation(c config, op Op, opts ...facetOption) *FacetMutation {
 	return m
 }
 
-// withFacetID sets the ID field of the mutation.
-func withFacetID(id string) facetOption {
-	return func(m *FacetMutation) {
+// withAnnotationID sets the ID field of the mutation.
+func withAnnotationID(id int) annotationOption {
+	return func(m *AnnotationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Facet
+			value *Annotation
 		)
-		m.oldValue = func(ctx context.Context) (*Facet, error) {
+		m.oldValue = func(ctx context.Context) (*Annotation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Facet.Get(ctx, id)
+					value, err = m.Client().Annotation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -85,10 +106,10 @@ func withFacetID(id string) facetOption {
 	}
 }
 
-// withFacet sets the old Facet of the mutation.
-func withFacet(node *Facet) facetOption {
-	return func(m *FacetMutation) {
-		m.oldValue = func(context.Context) (*Facet, error) {
+// withAnnotation sets the old Annotation of the mutation.
+func withAnnotation(node *Annotation) annotationOption {
+	return func(m *AnnotationMutation) {
+		m.oldValue = func(context.Context) (*Annotation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -97,7 +118,7 @@ func withFacet(node *Facet) facetOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FacetMutation) Client() *Client {
+func (m AnnotationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -105,7 +126,7 @@ func (m FacetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FacetMutation) Tx() (*Tx, error) {
+func (m AnnotationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -114,15 +135,9 @@ func (m FacetMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Facet entities.
-func (m *FacetMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FacetMutation) ID() (id string, exists bool) {
+func (m *AnnotationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -133,113 +148,263 @@ func (m *FacetMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FacetMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AnnotationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Facet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Annotation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *FacetMutation) SetSessionID(s string) {
-	m.session_id = &s
+// SetHash sets the "hash" field.
+func (m *AnnotationMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *FacetMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AnnotationMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the Facet entity.
-// If the Facet object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FacetMutation) OldSessionID(ctx context.Context) (v string, err error) {
+func (m *AnnotationMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.SessionID, nil
+	return oldValue.Hash, nil
 }
 
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *FacetMutation) ResetSessionID() {
-	m.session_id = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *AnnotationMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetFacets sets the "facets" field.
-func (m *FacetMutation) SetFacets(value map[string]interface{}) {
-	m.facets = &value
+// SetAuthor sets the "author" field.
+func (m *AnnotationMutation) SetAuthor(s string) {
+	m.author = &s
 }
 
-// Facets returns the value of the "facets" field in the mutation.
-func (m *FacetMutation) Facets() (r map[string]interface{}, exists bool) {
-	v := m.facets
+// Author returns the value of the "author" field in the mutation.
+func (m *AnnotationMutation) Author() (r string, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFacets returns the old "facets" field's value of the Facet entity.
-// If the Facet object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthor returns the old "author" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FacetMutation) OldFacets(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *AnnotationMutation) OldAuthor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFacets is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFacets requires an ID field in the mutation")
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFacets: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
 	}
-	return oldValue.Facets, nil
+	return oldValue.Author, nil
 }
 
-// ClearFacets clears the value of the "facets" field.
-func (m *FacetMutation) ClearFacets() {
-	m.facets = nil
-	m.clearedFields[facet.FieldFacets] = struct{}{}
+// ResetAuthor resets all changes to the "author" field.
+func (m *AnnotationMutation) ResetAuthor() {
+	m.author = nil
 }
 
-// FacetsCleared returns if the "facets" field was cleared in this mutation.
-func (m *FacetMutation) FacetsCleared() bool {
-	_, ok := m.clearedFields[facet.FieldFacets]
+// SetRating sets the "rating" field.
+func (m *AnnotationMutation) SetRating(s string) {
+	m.rating = &s
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *AnnotationMutation) Rating() (r string, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationMutation) OldRating(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// ClearRating clears the value of the "rating" field.
+func (m *AnnotationMutation) ClearRating() {
+	m.rating = nil
+	m.clearedFields[annotation.FieldRating] = struct{}{}
+}
+
+// RatingCleared returns if the "rating" field was cleared in this mutation.
+func (m *AnnotationMutation) RatingCleared() bool {
+	_, ok := m.clearedFields[annotation.FieldRating]
 	return ok
 }
 
-// ResetFacets resets all changes to the "facets" field.
-func (m *FacetMutation) ResetFacets() {
-	m.facets = nil
-	delete(m.clearedFields, facet.FieldFacets)
+// ResetRating resets all changes to the "rating" field.
+func (m *AnnotationMutation) ResetRating() {
+	m.rating = nil
+	delete(m.clearedFields, annotation.FieldRating)
+}
+
+// SetLabels sets the "labels" field.
+func (m *AnnotationMutation) SetLabels(s []string) {
+	m.labels = &s
+	m.appendlabels = nil
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *AnnotationMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// AppendLabels adds s to the "labels" field.
+func (m *AnnotationMutation) AppendLabels(s []string) {
+	m.appendlabels = append(m.appendlabels, s...)
+}
+
+// AppendedLabels returns the list of values that were appended to the "labels" field in this mutation.
+func (m *AnnotationMutation) AppendedLabels() ([]string, bool) {
+	if len(m.appendlabels) == 0 {
+		return nil, false
+	}
+	return m.appendlabels, true
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *AnnotationMutation) ClearLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	m.clearedFields[annotation.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *AnnotationMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[annotation.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *AnnotationMutation) ResetLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	delete(m.clearedFields, annotation.FieldLabels)
+}
+
+// SetNotes sets the "notes" field.
+func (m *AnnotationMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *AnnotationMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *AnnotationMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[annotation.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *AnnotationMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[annotation.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *AnnotationMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, annotation.FieldNotes)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FacetMutation) SetCreatedAt(t time.Time) {
+func (m *AnnotationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FacetMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AnnotationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -247,10 +412,10 @@ func (m *FacetMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Facet entity.
-// If the Facet object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FacetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AnnotationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -265,19 +430,55 @@ func (m *FacetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FacetMutation) ResetCreatedAt() {
+func (m *AnnotationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the FacetMutation builder.
-func (m *FacetMutation) Where(ps ...predicate.Facet) {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AnnotationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AnnotationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AnnotationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the AnnotationMutation builder.
+func (m *AnnotationMutation) Where(ps ...predicate.Annotation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FacetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AnnotationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FacetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Facet, len(ps))
+func (m *AnnotationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Annotation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -285,33 +486,45 @@ func (m *FacetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FacetMutation) Op() Op {
+func (m *AnnotationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FacetMutation) SetOp(op Op) {
+func (m *AnnotationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Facet).
-func (m *FacetMutation) Type() string {
+// Type returns the node type of this mutation (Annotation).
+func (m *AnnotationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FacetMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.session_id != nil {
-		fields = append(fields, facet.FieldSessionID)
+func (m *AnnotationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.hash != nil {
+		fields = append(fields, annotation.FieldHash)
 	}
-	if m.facets != nil {
-		fields = append(fields, facet.FieldFacets)
+	if m.author != nil {
+		fields = append(fields, annotation.FieldAuthor)
+	}
+	if m.rating != nil {
+		fields = append(fields, annotation.FieldRating)
+	}
+	if m.labels != nil {
+		fields = append(fields, annotation.FieldLabels)
+	}
+	if m.notes != nil {
+		fields = append(fields, annotation.FieldNotes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, facet.FieldCreatedAt)
+		fields = append(fields, annotation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, annotation.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -319,14 +532,22 @@ func (m *FacetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FacetMutation) Field(name string) (ent.Value, bool) {
+func (m *AnnotationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case facet.FieldSessionID:
-		return m.SessionID()
-	case facet.FieldFacets:
-		return m.Facets()
-	case facet.FieldCreatedAt:
+	case annotation.FieldHash:
+		return m.Hash()
+	case annotation.FieldAuthor:
+		return m.Author()
+	case annotation.FieldRating:
+		return m.Rating()
+	case annotation.FieldLabels:
+		return m.Labels()
+	case annotation.FieldNotes:
+		return m.Notes()
+	case annotation.FieldCreatedAt:
 		return m.CreatedAt()
+	case annotation.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -334,216 +555,251 @@ func (m *FacetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FacetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnnotationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case facet.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case facet.FieldFacets:
-		return m.OldFacets(ctx)
-	case facet.FieldCreatedAt:
+	case annotation.FieldHash:
+		return m.OldHash(ctx)
+	case annotation.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case annotation.FieldRating:
+		return m.OldRating(ctx)
+	case annotation.FieldLabels:
+		return m.OldLabels(ctx)
+	case annotation.FieldNotes:
+		return m.OldNotes(ctx)
+	case annotation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case annotation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Facet field %s", name)
+	return nil, fmt.Errorf("unknown Annotation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FacetMutation) SetField(name string, value ent.Value) error {
+func (m *AnnotationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case facet.FieldSessionID:
+	case annotation.FieldHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionID(v)
+		m.SetHash(v)
 		return nil
-	case facet.FieldFacets:
-		v, ok := value.(map[string]interface{})
+	case annotation.FieldAuthor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFacets(v)
+		m.SetAuthor(v)
 		return nil
-	case facet.FieldCreatedAt:
+	case annotation.FieldRating:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case annotation.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case annotation.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case annotation.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case annotation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Facet field %s", name)
+	return fmt.Errorf("unknown Annotation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FacetMutation) AddedFields() []string {
+func (m *AnnotationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FacetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnnotationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FacetMutation) AddField(name string, value ent.Value) error {
+func (m *AnnotationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Facet numeric field %s", name)
+	return fmt.Errorf("unknown Annotation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FacetMutation) ClearedFields() []string {
+func (m *AnnotationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(facet.FieldFacets) {
-		fields = append(fields, facet.FieldFacets)
+	if m.FieldCleared(annotation.FieldRating) {
+		fields = append(fields, annotation.FieldRating)
+	}
+	if m.FieldCleared(annotation.FieldLabels) {
+		fields = append(fields, annotation.FieldLabels)
+	}
+	if m.FieldCleared(annotation.FieldNotes) {
+		fields = append(fields, annotation.FieldNotes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FacetMutation) FieldCleared(name string) bool {
+func (m *AnnotationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FacetMutation) ClearField(name string) error {
+func (m *AnnotationMutation) ClearField(name string) error {
 	switch name {
-	case facet.FieldFacets:
-		m.ClearFacets()
+	case annotation.FieldRating:
+		m.ClearRating()
+		return nil
+	case annotation.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case annotation.FieldNotes:
+		m.ClearNotes()
 		return nil
 	}
-	return fmt.Errorf("unknown Facet nullable field %s", name)
+	return fmt.Errorf("unknown Annotation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FacetMutation) ResetField(name string) error {
+func (m *AnnotationMutation) ResetField(name string) error {
 	switch name {
-	case facet.FieldSessionID:
-		m.ResetSessionID()
+	case annotation.FieldHash:
+		m.ResetHash()
 		return nil
-	case facet.FieldFacets:
-		m.ResetFacets()
+	case annotation.FieldAuthor:
+		m.ResetAuthor()
 		return nil
-	case facet.FieldCreatedAt:
+	case annotation.FieldRating:
+		m.ResetRating()
+		return nil
+	case annotation.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case annotation.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case annotation.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case annotation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Facet field %s", name)
+	return fmt.Errorf("unknown Annotation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FacetMutation) AddedEdges() []string {
+func (m *AnnotationMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FacetMutation) AddedIDs(name string) []ent.Value {
+func (m *AnnotationMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FacetMutation) RemovedEdges() []string {
+func (m *AnnotationMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FacetMutation) RemovedIDs(name string) []ent.Value {
+func (m *AnnotationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FacetMutation) ClearedEdges() []string {
+func (m *AnnotationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FacetMutation) EdgeCleared(name string) bool {
+func (m *AnnotationMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FacetMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Facet unique edge %s", name)
+func (m *AnnotationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Annotation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FacetMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Facet edge %s", name)
+func (m *AnnotationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Annotation edge %s", name)
 }
 
-// NodeMutation represents an operation that mutates the Node nodes in the graph.
-type NodeMutation struct {
+// BlobMutation represents an operation that mutates the Blob nodes in the graph.
+type BlobMutation struct {
 	config
-	op                             Op
-	typ                            string
-	id                             *string
-	bucket                         *map[string]interface{}
-	_type                          *string
-	role                           *string
-	content                        *[]map[string]interface{}
-	appendcontent                  []map[string]interface{}
-	model                          *string
-	provider                       *string
-	agent_name                     *string
-	stop_reason                    *string
-	prompt_tokens                  *int
-	addprompt_tokens               *int
-	completion_tokens              *int
-	addcompletion_tokens           *int
-	total_tokens                   *int
-	addtotal_tokens                *int
-	cache_creation_input_tokens    *int
-	addcache_creation_input_tokens *int
-	cache_read_input_tokens        *int
-	addcache_read_input_tokens     *int
-	total_duration_ns              *int64
-	addtotal_duration_ns           *int64
-	prompt_duration_ns             *int64
-	addprompt_duration_ns          *int64
-	project                        *string
-	created_at                     *time.Time
-	clearedFields                  map[string]struct{}
-	parent                         *string
-	clearedparent                  bool
-	children                       map[string]struct{}
-	removedchildren                map[string]struct{}
-	clearedchildren                bool
-	done                           bool
-	oldValue                       func(context.Context) (*Node, error)
-	predicates                     []predicate.Node
+	op            Op
+	typ           string
+	id            *string
+	data          *[]byte
+	media_type    *string
+	size          *int
+	addsize       *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Blob, error)
+	predicates    []predicate.Blob
 }
 
-var _ ent.Mutation = (*NodeMutation)(nil)
+var _ ent.Mutation = (*BlobMutation)(nil)
 
-// nodeOption allows management of the mutation configuration using functional options.
-type nodeOption func(*NodeMutation)
+// blobOption allows management of the mutation configuration using functional options.
+type blobOption func(*BlobMutation)
 
-// newNodeMutation creates new mutation for the Node entity.
-func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
-	m := &NodeMutation{
+// newBlobMutation creates new mutation for the Blob entity.
+func newBlobMutation(c config, op Op, opts ...blobOption) *BlobMutation {
+	m := &BlobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNode,
+		typ:           TypeBlob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -552,20 +808,20 @@ func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
 	return m
 }
 
-// withNodeID sets the ID field of the mutation.
-func withNodeID(id string) nodeOption {
-	return func(m *NodeMutation) {
+// withBlobID sets the ID field of the mutation.
+func withBlobID(id string) blobOption {
+	return func(m *BlobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Node
+			value *Blob
 		)
-		m.oldValue = func(ctx context.Context) (*Node, error) {
+		m.oldValue = func(ctx context.Context) (*Blob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Node.Get(ctx, id)
+					value, err = m.Client().Blob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -574,10 +830,10 @@ func withNodeID(id string) nodeOption {
 	}
 }
 
-// withNode sets the old Node of the mutation.
-func withNode(node *Node) nodeOption {
-	return func(m *NodeMutation) {
-		m.oldValue = func(context.Context) (*Node, error) {
+// withBlob sets the old Blob of the mutation.
+func withBlob(node *Blob) blobOption {
+	return func(m *BlobMutation) {
+		m.oldValue = func(context.Context) (*Blob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -586,7 +842,7 @@ func withNode(node *Node) nodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NodeMutation) Client() *Client {
+func (m BlobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -594,7 +850,7 @@ func (m NodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NodeMutation) Tx() (*Tx, error) {
+func (m BlobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -604,14 +860,14 @@ func (m NodeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Node entities.
-func (m *NodeMutation) SetID(id string) {
+// operation is only accepted on creation of Blob entities.
+func (m *BlobMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NodeMutation) ID() (id string, exists bool) {
+func (m *BlobMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -622,7 +878,7 @@ func (m *NodeMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NodeMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BlobMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -631,329 +887,577 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Blob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetParentHash sets the "parent_hash" field.
-func (m *NodeMutation) SetParentHash(s string) {
-	m.parent = &s
+// SetData sets the "data" field.
+func (m *BlobMutation) SetData(b []byte) {
+	m.data = &b
 }
 
-// ParentHash returns the value of the "parent_hash" field in the mutation.
-func (m *NodeMutation) ParentHash() (r string, exists bool) {
-	v := m.parent
+// Data returns the value of the "data" field in the mutation.
+func (m *BlobMutation) Data() (r []byte, exists bool) {
+	v := m.data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentHash returns the old "parent_hash" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldData returns the old "data" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldParentHash(ctx context.Context) (v *string, err error) {
+func (m *BlobMutation) OldData(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentHash requires an ID field in the mutation")
+		return v, errors.New("OldData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
 	}
-	return oldValue.ParentHash, nil
-}
-
-// ClearParentHash clears the value of the "parent_hash" field.
-func (m *NodeMutation) ClearParentHash() {
-	m.parent = nil
-	m.clearedFields[node.FieldParentHash] = struct{}{}
-}
-
-// ParentHashCleared returns if the "parent_hash" field was cleared in this mutation.
-func (m *NodeMutation) ParentHashCleared() bool {
-	_, ok := m.clearedFields[node.FieldParentHash]
-	return ok
+	return oldValue.Data, nil
 }
 
-// ResetParentHash resets all changes to the "parent_hash" field.
-func (m *NodeMutation) ResetParentHash() {
-	m.parent = nil
-	delete(m.clearedFields, node.FieldParentHash)
+// ResetData resets all changes to the "data" field.
+func (m *BlobMutation) ResetData() {
+	m.data = nil
 }
 
-// SetBucket sets the "bucket" field.
-func (m *NodeMutation) SetBucket(value map[string]interface{}) {
-	m.bucket = &value
+// SetMediaType sets the "media_type" field.
+func (m *BlobMutation) SetMediaType(s string) {
+	m.media_type = &s
 }
 
-// Bucket returns the value of the "bucket" field in the mutation.
-func (m *NodeMutation) Bucket() (r map[string]interface{}, exists bool) {
-	v := m.bucket
+// MediaType returns the value of the "media_type" field in the mutation.
+func (m *BlobMutation) MediaType() (r string, exists bool) {
+	v := m.media_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBucket returns the old "bucket" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldMediaType returns the old "media_type" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldBucket(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *BlobMutation) OldMediaType(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
+		return v, errors.New("OldMediaType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBucket requires an ID field in the mutation")
+		return v, errors.New("OldMediaType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
+		return v, fmt.Errorf("querying old value for OldMediaType: %w", err)
 	}
-	return oldValue.Bucket, nil
+	return oldValue.MediaType, nil
 }
 
-// ClearBucket clears the value of the "bucket" field.
-func (m *NodeMutation) ClearBucket() {
-	m.bucket = nil
-	m.clearedFields[node.FieldBucket] = struct{}{}
+// ClearMediaType clears the value of the "media_type" field.
+func (m *BlobMutation) ClearMediaType() {
+	m.media_type = nil
+	m.clearedFields[blob.FieldMediaType] = struct{}{}
 }
 
-// BucketCleared returns if the "bucket" field was cleared in this mutation.
-func (m *NodeMutation) BucketCleared() bool {
-	_, ok := m.clearedFields[node.FieldBucket]
+// MediaTypeCleared returns if the "media_type" field was cleared in this mutation.
+func (m *BlobMutation) MediaTypeCleared() bool {
+	_, ok := m.clearedFields[blob.FieldMediaType]
 	return ok
 }
 
-// ResetBucket resets all changes to the "bucket" field.
-func (m *NodeMutation) ResetBucket() {
-	m.bucket = nil
-	delete(m.clearedFields, node.FieldBucket)
+// ResetMediaType resets all changes to the "media_type" field.
+func (m *BlobMutation) ResetMediaType() {
+	m.media_type = nil
+	delete(m.clearedFields, blob.FieldMediaType)
 }
 
-// SetType sets the "type" field.
-func (m *NodeMutation) SetType(s string) {
-	m._type = &s
+// SetSize sets the "size" field.
+func (m *BlobMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *NodeMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Size returns the value of the "size" field in the mutation.
+func (m *BlobMutation) Size() (r int, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *BlobMutation) OldSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Size, nil
 }
 
-// ClearType clears the value of the "type" field.
-func (m *NodeMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[node.FieldType] = struct{}{}
+// AddSize adds i to the "size" field.
+func (m *BlobMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *NodeMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[node.FieldType]
-	return ok
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *BlobMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *NodeMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, node.FieldType)
+// ResetSize resets all changes to the "size" field.
+func (m *BlobMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetRole sets the "role" field.
-func (m *NodeMutation) SetRole(s string) {
-	m.role = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *BlobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *NodeMutation) Role() (r string, exists bool) {
-	v := m.role
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *BlobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearRole clears the value of the "role" field.
-func (m *NodeMutation) ClearRole() {
-	m.role = nil
-	m.clearedFields[node.FieldRole] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RoleCleared returns if the "role" field was cleared in this mutation.
-func (m *NodeMutation) RoleCleared() bool {
-	_, ok := m.clearedFields[node.FieldRole]
-	return ok
+// Where appends a list predicates to the BlobMutation builder.
+func (m *BlobMutation) Where(ps ...predicate.Blob) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *NodeMutation) ResetRole() {
-	m.role = nil
-	delete(m.clearedFields, node.FieldRole)
+// WhereP appends storage-level predicates to the BlobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Blob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetContent sets the "content" field.
-func (m *NodeMutation) SetContent(value []map[string]interface{}) {
-	m.content = &value
-	m.appendcontent = nil
+// Op returns the operation name.
+func (m *BlobMutation) Op() Op {
+	return m.op
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *NodeMutation) Content() (r []map[string]interface{}, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *BlobMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldContent returns the old "content" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldContent(ctx context.Context) (v []map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (Blob).
+func (m *BlobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlobMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.data != nil {
+		fields = append(fields, blob.FieldData)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+	if m.media_type != nil {
+		fields = append(fields, blob.FieldMediaType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	if m.size != nil {
+		fields = append(fields, blob.FieldSize)
 	}
-	return oldValue.Content, nil
-}
-
-// AppendContent adds value to the "content" field.
-func (m *NodeMutation) AppendContent(value []map[string]interface{}) {
-	m.appendcontent = append(m.appendcontent, value...)
+	if m.created_at != nil {
+		fields = append(fields, blob.FieldCreatedAt)
+	}
+	return fields
 }
 
-// AppendedContent returns the list of values that were appended to the "content" field in this mutation.
-func (m *NodeMutation) AppendedContent() ([]map[string]interface{}, bool) {
-	if len(m.appendcontent) == 0 {
-		return nil, false
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldData:
+		return m.Data()
+	case blob.FieldMediaType:
+		return m.MediaType()
+	case blob.FieldSize:
+		return m.Size()
+	case blob.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	return m.appendcontent, true
+	return nil, false
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *NodeMutation) ClearContent() {
-	m.content = nil
-	m.appendcontent = nil
-	m.clearedFields[node.FieldContent] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blob.FieldData:
+		return m.OldData(ctx)
+	case blob.FieldMediaType:
+		return m.OldMediaType(ctx)
+	case blob.FieldSize:
+		return m.OldSize(ctx)
+	case blob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Blob field %s", name)
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *NodeMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[node.FieldContent]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case blob.FieldMediaType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaType(v)
+		return nil
+	case blob.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case blob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blob field %s", name)
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *NodeMutation) ResetContent() {
-	m.content = nil
-	m.appendcontent = nil
-	delete(m.clearedFields, node.FieldContent)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlobMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, blob.FieldSize)
+	}
+	return fields
 }
 
-// SetModel sets the "model" field.
-func (m *NodeMutation) SetModel(s string) {
-	m.model = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *NodeMutation) Model() (r string, exists bool) {
-	v := m.model
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Blob numeric field %s", name)
 }
 
-// OldModel returns the old "model" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldModel(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(blob.FieldMediaType) {
+		fields = append(fields, blob.FieldMediaType)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlobMutation) ClearField(name string) error {
+	switch name {
+	case blob.FieldMediaType:
+		m.ClearMediaType()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	return fmt.Errorf("unknown Blob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlobMutation) ResetField(name string) error {
+	switch name {
+	case blob.FieldData:
+		m.ResetData()
+		return nil
+	case blob.FieldMediaType:
+		m.ResetMediaType()
+		return nil
+	case blob.FieldSize:
+		m.ResetSize()
+		return nil
+	case blob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return oldValue.Model, nil
+	return fmt.Errorf("unknown Blob field %s", name)
 }
 
-// ClearModel clears the value of the "model" field.
-func (m *NodeMutation) ClearModel() {
-	m.model = nil
-	m.clearedFields[node.FieldModel] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ModelCleared returns if the "model" field was cleared in this mutation.
-func (m *NodeMutation) ModelCleared() bool {
-	_, ok := m.clearedFields[node.FieldModel]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlobMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *NodeMutation) ResetModel() {
-	m.model = nil
-	delete(m.clearedFields, node.FieldModel)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Blob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Blob edge %s", name)
+}
+
+// EmbeddingCallMutation represents an operation that mutates the EmbeddingCall nodes in the graph.
+type EmbeddingCallMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	provider         *string
+	model            *string
+	agent_name       *string
+	project          *string
+	input_count      *int
+	addinput_count   *int
+	input            *[]string
+	appendinput      []string
+	dimensions       *int
+	adddimensions    *int
+	prompt_tokens    *int
+	addprompt_tokens *int
+	total_tokens     *int
+	addtotal_tokens  *int
+	latency_ns       *int64
+	addlatency_ns    *int64
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*EmbeddingCall, error)
+	predicates       []predicate.EmbeddingCall
+}
+
+var _ ent.Mutation = (*EmbeddingCallMutation)(nil)
+
+// embeddingcallOption allows management of the mutation configuration using functional options.
+type embeddingcallOption func(*EmbeddingCallMutation)
+
+// newEmbeddingCallMutation creates new mutation for the EmbeddingCall entity.
+func newEmbeddingCallMutation(c config, op Op, opts ...embeddingcallOption) *EmbeddingCallMutation {
+	m := &EmbeddingCallMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmbeddingCall,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmbeddingCallID sets the ID field of the mutation.
+func withEmbeddingCallID(id int) embeddingcallOption {
+	return func(m *EmbeddingCallMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmbeddingCall
+		)
+		m.oldValue = func(ctx context.Context) (*EmbeddingCall, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmbeddingCall.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmbeddingCall sets the old EmbeddingCall of the mutation.
+func withEmbeddingCall(node *EmbeddingCall) embeddingcallOption {
+	return func(m *EmbeddingCallMutation) {
+		m.oldValue = func(context.Context) (*EmbeddingCall, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmbeddingCallMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmbeddingCallMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmbeddingCallMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmbeddingCallMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmbeddingCall.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
 // SetProvider sets the "provider" field.
-func (m *NodeMutation) SetProvider(s string) {
+func (m *EmbeddingCallMutation) SetProvider(s string) {
 	m.provider = &s
 }
 
 // Provider returns the value of the "provider" field in the mutation.
-func (m *NodeMutation) Provider() (r string, exists bool) {
+func (m *EmbeddingCallMutation) Provider() (r string, exists bool) {
 	v := m.provider
 	if v == nil {
 		return
@@ -961,10 +1465,10 @@ func (m *NodeMutation) Provider() (r string, exists bool) {
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *EmbeddingCallMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
@@ -978,31 +1482,54 @@ func (m *NodeMutation) OldProvider(ctx context.Context) (v string, err error) {
 	return oldValue.Provider, nil
 }
 
-// ClearProvider clears the value of the "provider" field.
-func (m *NodeMutation) ClearProvider() {
+// ResetProvider resets all changes to the "provider" field.
+func (m *EmbeddingCallMutation) ResetProvider() {
 	m.provider = nil
-	m.clearedFields[node.FieldProvider] = struct{}{}
 }
 
-// ProviderCleared returns if the "provider" field was cleared in this mutation.
-func (m *NodeMutation) ProviderCleared() bool {
-	_, ok := m.clearedFields[node.FieldProvider]
-	return ok
+// SetModel sets the "model" field.
+func (m *EmbeddingCallMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *NodeMutation) ResetProvider() {
-	m.provider = nil
-	delete(m.clearedFields, node.FieldProvider)
+// Model returns the value of the "model" field in the mutation.
+func (m *EmbeddingCallMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingCallMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *EmbeddingCallMutation) ResetModel() {
+	m.model = nil
 }
 
 // SetAgentName sets the "agent_name" field.
-func (m *NodeMutation) SetAgentName(s string) {
+func (m *EmbeddingCallMutation) SetAgentName(s string) {
 	m.agent_name = &s
 }
 
 // AgentName returns the value of the "agent_name" field in the mutation.
-func (m *NodeMutation) AgentName() (r string, exists bool) {
+func (m *EmbeddingCallMutation) AgentName() (r string, exists bool) {
 	v := m.agent_name
 	if v == nil {
 		return
@@ -1010,10 +1537,10 @@ func (m *NodeMutation) AgentName() (r string, exists bool) {
 	return *v, true
 }
 
-// OldAgentName returns the old "agent_name" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentName returns the old "agent_name" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldAgentName(ctx context.Context) (v string, err error) {
+func (m *EmbeddingCallMutation) OldAgentName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAgentName is only allowed on UpdateOne operations")
 	}
@@ -1028,220 +1555,341 @@ func (m *NodeMutation) OldAgentName(ctx context.Context) (v string, err error) {
 }
 
 // ClearAgentName clears the value of the "agent_name" field.
-func (m *NodeMutation) ClearAgentName() {
+func (m *EmbeddingCallMutation) ClearAgentName() {
 	m.agent_name = nil
-	m.clearedFields[node.FieldAgentName] = struct{}{}
+	m.clearedFields[embeddingcall.FieldAgentName] = struct{}{}
 }
 
 // AgentNameCleared returns if the "agent_name" field was cleared in this mutation.
-func (m *NodeMutation) AgentNameCleared() bool {
-	_, ok := m.clearedFields[node.FieldAgentName]
+func (m *EmbeddingCallMutation) AgentNameCleared() bool {
+	_, ok := m.clearedFields[embeddingcall.FieldAgentName]
 	return ok
 }
 
 // ResetAgentName resets all changes to the "agent_name" field.
-func (m *NodeMutation) ResetAgentName() {
+func (m *EmbeddingCallMutation) ResetAgentName() {
 	m.agent_name = nil
-	delete(m.clearedFields, node.FieldAgentName)
+	delete(m.clearedFields, embeddingcall.FieldAgentName)
 }
 
-// SetStopReason sets the "stop_reason" field.
-func (m *NodeMutation) SetStopReason(s string) {
-	m.stop_reason = &s
+// SetProject sets the "project" field.
+func (m *EmbeddingCallMutation) SetProject(s string) {
+	m.project = &s
 }
 
-// StopReason returns the value of the "stop_reason" field in the mutation.
-func (m *NodeMutation) StopReason() (r string, exists bool) {
-	v := m.stop_reason
+// Project returns the value of the "project" field in the mutation.
+func (m *EmbeddingCallMutation) Project() (r string, exists bool) {
+	v := m.project
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStopReason returns the old "stop_reason" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldProject returns the old "project" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldStopReason(ctx context.Context) (v string, err error) {
+func (m *EmbeddingCallMutation) OldProject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStopReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldProject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStopReason requires an ID field in the mutation")
+		return v, errors.New("OldProject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStopReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldProject: %w", err)
 	}
-	return oldValue.StopReason, nil
+	return oldValue.Project, nil
 }
 
-// ClearStopReason clears the value of the "stop_reason" field.
-func (m *NodeMutation) ClearStopReason() {
-	m.stop_reason = nil
-	m.clearedFields[node.FieldStopReason] = struct{}{}
+// ClearProject clears the value of the "project" field.
+func (m *EmbeddingCallMutation) ClearProject() {
+	m.project = nil
+	m.clearedFields[embeddingcall.FieldProject] = struct{}{}
 }
 
-// StopReasonCleared returns if the "stop_reason" field was cleared in this mutation.
-func (m *NodeMutation) StopReasonCleared() bool {
-	_, ok := m.clearedFields[node.FieldStopReason]
+// ProjectCleared returns if the "project" field was cleared in this mutation.
+func (m *EmbeddingCallMutation) ProjectCleared() bool {
+	_, ok := m.clearedFields[embeddingcall.FieldProject]
 	return ok
 }
 
-// ResetStopReason resets all changes to the "stop_reason" field.
-func (m *NodeMutation) ResetStopReason() {
-	m.stop_reason = nil
-	delete(m.clearedFields, node.FieldStopReason)
+// ResetProject resets all changes to the "project" field.
+func (m *EmbeddingCallMutation) ResetProject() {
+	m.project = nil
+	delete(m.clearedFields, embeddingcall.FieldProject)
 }
 
-// SetPromptTokens sets the "prompt_tokens" field.
-func (m *NodeMutation) SetPromptTokens(i int) {
-	m.prompt_tokens = &i
-	m.addprompt_tokens = nil
+// SetInputCount sets the "input_count" field.
+func (m *EmbeddingCallMutation) SetInputCount(i int) {
+	m.input_count = &i
+	m.addinput_count = nil
 }
 
-// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
-func (m *NodeMutation) PromptTokens() (r int, exists bool) {
-	v := m.prompt_tokens
+// InputCount returns the value of the "input_count" field in the mutation.
+func (m *EmbeddingCallMutation) InputCount() (r int, exists bool) {
+	v := m.input_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromptTokens returns the old "prompt_tokens" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldInputCount returns the old "input_count" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldPromptTokens(ctx context.Context) (v *int, err error) {
+func (m *EmbeddingCallMutation) OldInputCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldInputCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+		return v, errors.New("OldInputCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldInputCount: %w", err)
 	}
-	return oldValue.PromptTokens, nil
+	return oldValue.InputCount, nil
 }
 
-// AddPromptTokens adds i to the "prompt_tokens" field.
-func (m *NodeMutation) AddPromptTokens(i int) {
-	if m.addprompt_tokens != nil {
-		*m.addprompt_tokens += i
+// AddInputCount adds i to the "input_count" field.
+func (m *EmbeddingCallMutation) AddInputCount(i int) {
+	if m.addinput_count != nil {
+		*m.addinput_count += i
 	} else {
-		m.addprompt_tokens = &i
+		m.addinput_count = &i
 	}
 }
 
-// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
-func (m *NodeMutation) AddedPromptTokens() (r int, exists bool) {
-	v := m.addprompt_tokens
+// AddedInputCount returns the value that was added to the "input_count" field in this mutation.
+func (m *EmbeddingCallMutation) AddedInputCount() (r int, exists bool) {
+	v := m.addinput_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearPromptTokens clears the value of the "prompt_tokens" field.
-func (m *NodeMutation) ClearPromptTokens() {
-	m.prompt_tokens = nil
-	m.addprompt_tokens = nil
-	m.clearedFields[node.FieldPromptTokens] = struct{}{}
+// ResetInputCount resets all changes to the "input_count" field.
+func (m *EmbeddingCallMutation) ResetInputCount() {
+	m.input_count = nil
+	m.addinput_count = nil
 }
 
-// PromptTokensCleared returns if the "prompt_tokens" field was cleared in this mutation.
-func (m *NodeMutation) PromptTokensCleared() bool {
-	_, ok := m.clearedFields[node.FieldPromptTokens]
+// SetInput sets the "input" field.
+func (m *EmbeddingCallMutation) SetInput(s []string) {
+	m.input = &s
+	m.appendinput = nil
+}
+
+// Input returns the value of the "input" field in the mutation.
+func (m *EmbeddingCallMutation) Input() (r []string, exists bool) {
+	v := m.input
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInput returns the old "input" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingCallMutation) OldInput(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInput: %w", err)
+	}
+	return oldValue.Input, nil
+}
+
+// AppendInput adds s to the "input" field.
+func (m *EmbeddingCallMutation) AppendInput(s []string) {
+	m.appendinput = append(m.appendinput, s...)
+}
+
+// AppendedInput returns the list of values that were appended to the "input" field in this mutation.
+func (m *EmbeddingCallMutation) AppendedInput() ([]string, bool) {
+	if len(m.appendinput) == 0 {
+		return nil, false
+	}
+	return m.appendinput, true
+}
+
+// ClearInput clears the value of the "input" field.
+func (m *EmbeddingCallMutation) ClearInput() {
+	m.input = nil
+	m.appendinput = nil
+	m.clearedFields[embeddingcall.FieldInput] = struct{}{}
+}
+
+// InputCleared returns if the "input" field was cleared in this mutation.
+func (m *EmbeddingCallMutation) InputCleared() bool {
+	_, ok := m.clearedFields[embeddingcall.FieldInput]
 	return ok
 }
 
-// ResetPromptTokens resets all changes to the "prompt_tokens" field.
-func (m *NodeMutation) ResetPromptTokens() {
-	m.prompt_tokens = nil
-	m.addprompt_tokens = nil
-	delete(m.clearedFields, node.FieldPromptTokens)
+// ResetInput resets all changes to the "input" field.
+func (m *EmbeddingCallMutation) ResetInput() {
+	m.input = nil
+	m.appendinput = nil
+	delete(m.clearedFields, embeddingcall.FieldInput)
 }
 
-// SetCompletionTokens sets the "completion_tokens" field.
-func (m *NodeMutation) SetCompletionTokens(i int) {
-	m.completion_tokens = &i
-	m.addcompletion_tokens = nil
+// SetDimensions sets the "dimensions" field.
+func (m *EmbeddingCallMutation) SetDimensions(i int) {
+	m.dimensions = &i
+	m.adddimensions = nil
 }
 
-// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
-func (m *NodeMutation) CompletionTokens() (r int, exists bool) {
-	v := m.completion_tokens
+// Dimensions returns the value of the "dimensions" field in the mutation.
+func (m *EmbeddingCallMutation) Dimensions() (r int, exists bool) {
+	v := m.dimensions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletionTokens returns the old "completion_tokens" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldDimensions returns the old "dimensions" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCompletionTokens(ctx context.Context) (v *int, err error) {
+func (m *EmbeddingCallMutation) OldDimensions(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldDimensions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+		return v, errors.New("OldDimensions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldDimensions: %w", err)
 	}
-	return oldValue.CompletionTokens, nil
+	return oldValue.Dimensions, nil
 }
 
-// AddCompletionTokens adds i to the "completion_tokens" field.
-func (m *NodeMutation) AddCompletionTokens(i int) {
-	if m.addcompletion_tokens != nil {
-		*m.addcompletion_tokens += i
+// AddDimensions adds i to the "dimensions" field.
+func (m *EmbeddingCallMutation) AddDimensions(i int) {
+	if m.adddimensions != nil {
+		*m.adddimensions += i
 	} else {
-		m.addcompletion_tokens = &i
+		m.adddimensions = &i
 	}
 }
 
-// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
-func (m *NodeMutation) AddedCompletionTokens() (r int, exists bool) {
-	v := m.addcompletion_tokens
+// AddedDimensions returns the value that was added to the "dimensions" field in this mutation.
+func (m *EmbeddingCallMutation) AddedDimensions() (r int, exists bool) {
+	v := m.adddimensions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCompletionTokens clears the value of the "completion_tokens" field.
-func (m *NodeMutation) ClearCompletionTokens() {
-	m.completion_tokens = nil
-	m.addcompletion_tokens = nil
-	m.clearedFields[node.FieldCompletionTokens] = struct{}{}
+// ClearDimensions clears the value of the "dimensions" field.
+func (m *EmbeddingCallMutation) ClearDimensions() {
+	m.dimensions = nil
+	m.adddimensions = nil
+	m.clearedFields[embeddingcall.FieldDimensions] = struct{}{}
 }
 
-// CompletionTokensCleared returns if the "completion_tokens" field was cleared in this mutation.
-func (m *NodeMutation) CompletionTokensCleared() bool {
-	_, ok := m.clearedFields[node.FieldCompletionTokens]
+// DimensionsCleared returns if the "dimensions" field was cleared in this mutation.
+func (m *EmbeddingCallMutation) DimensionsCleared() bool {
+	_, ok := m.clearedFields[embeddingcall.FieldDimensions]
 	return ok
 }
 
-// ResetCompletionTokens resets all changes to the "completion_tokens" field.
-func (m *NodeMutation) ResetCompletionTokens() {
-	m.completion_tokens = nil
-	m.addcompletion_tokens = nil
-	delete(m.clearedFields, node.FieldCompletionTokens)
+// ResetDimensions resets all changes to the "dimensions" field.
+func (m *EmbeddingCallMutation) ResetDimensions() {
+	m.dimensions = nil
+	m.adddimensions = nil
+	delete(m.clearedFields, embeddingcall.FieldDimensions)
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *EmbeddingCallMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *EmbeddingCallMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingCallMutation) OldPromptTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *EmbeddingCallMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *EmbeddingCallMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (m *EmbeddingCallMutation) ClearPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	m.clearedFields[embeddingcall.FieldPromptTokens] = struct{}{}
+}
+
+// PromptTokensCleared returns if the "prompt_tokens" field was cleared in this mutation.
+func (m *EmbeddingCallMutation) PromptTokensCleared() bool {
+	_, ok := m.clearedFields[embeddingcall.FieldPromptTokens]
+	return ok
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *EmbeddingCallMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	delete(m.clearedFields, embeddingcall.FieldPromptTokens)
 }
 
 // SetTotalTokens sets the "total_tokens" field.
-func (m *NodeMutation) SetTotalTokens(i int) {
+func (m *EmbeddingCallMutation) SetTotalTokens(i int) {
 	m.total_tokens = &i
 	m.addtotal_tokens = nil
 }
 
 // TotalTokens returns the value of the "total_tokens" field in the mutation.
-func (m *NodeMutation) TotalTokens() (r int, exists bool) {
+func (m *EmbeddingCallMutation) TotalTokens() (r int, exists bool) {
 	v := m.total_tokens
 	if v == nil {
 		return
@@ -1249,10 +1897,10 @@ func (m *NodeMutation) TotalTokens() (r int, exists bool) {
 	return *v, true
 }
 
-// OldTotalTokens returns the old "total_tokens" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalTokens returns the old "total_tokens" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldTotalTokens(ctx context.Context) (v *int, err error) {
+func (m *EmbeddingCallMutation) OldTotalTokens(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
 	}
@@ -1267,7 +1915,7 @@ func (m *NodeMutation) OldTotalTokens(ctx context.Context) (v *int, err error) {
 }
 
 // AddTotalTokens adds i to the "total_tokens" field.
-func (m *NodeMutation) AddTotalTokens(i int) {
+func (m *EmbeddingCallMutation) AddTotalTokens(i int) {
 	if m.addtotal_tokens != nil {
 		*m.addtotal_tokens += i
 	} else {
@@ -1276,7 +1924,7 @@ func (m *NodeMutation) AddTotalTokens(i int) {
 }
 
 // AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
-func (m *NodeMutation) AddedTotalTokens() (r int, exists bool) {
+func (m *EmbeddingCallMutation) AddedTotalTokens() (r int, exists bool) {
 	v := m.addtotal_tokens
 	if v == nil {
 		return
@@ -1285,1194 +1933,6545 @@ func (m *NodeMutation) AddedTotalTokens() (r int, exists bool) {
 }
 
 // ClearTotalTokens clears the value of the "total_tokens" field.
-func (m *NodeMutation) ClearTotalTokens() {
+func (m *EmbeddingCallMutation) ClearTotalTokens() {
 	m.total_tokens = nil
 	m.addtotal_tokens = nil
-	m.clearedFields[node.FieldTotalTokens] = struct{}{}
+	m.clearedFields[embeddingcall.FieldTotalTokens] = struct{}{}
 }
 
 // TotalTokensCleared returns if the "total_tokens" field was cleared in this mutation.
-func (m *NodeMutation) TotalTokensCleared() bool {
-	_, ok := m.clearedFields[node.FieldTotalTokens]
+func (m *EmbeddingCallMutation) TotalTokensCleared() bool {
+	_, ok := m.clearedFields[embeddingcall.FieldTotalTokens]
 	return ok
 }
 
 // ResetTotalTokens resets all changes to the "total_tokens" field.
-func (m *NodeMutation) ResetTotalTokens() {
+func (m *EmbeddingCallMutation) ResetTotalTokens() {
 	m.total_tokens = nil
 	m.addtotal_tokens = nil
-	delete(m.clearedFields, node.FieldTotalTokens)
+	delete(m.clearedFields, embeddingcall.FieldTotalTokens)
 }
 
-// SetCacheCreationInputTokens sets the "cache_creation_input_tokens" field.
-func (m *NodeMutation) SetCacheCreationInputTokens(i int) {
-	m.cache_creation_input_tokens = &i
-	m.addcache_creation_input_tokens = nil
+// SetLatencyNs sets the "latency_ns" field.
+func (m *EmbeddingCallMutation) SetLatencyNs(i int64) {
+	m.latency_ns = &i
+	m.addlatency_ns = nil
 }
 
-// CacheCreationInputTokens returns the value of the "cache_creation_input_tokens" field in the mutation.
-func (m *NodeMutation) CacheCreationInputTokens() (r int, exists bool) {
-	v := m.cache_creation_input_tokens
+// LatencyNs returns the value of the "latency_ns" field in the mutation.
+func (m *EmbeddingCallMutation) LatencyNs() (r int64, exists bool) {
+	v := m.latency_ns
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheCreationInputTokens returns the old "cache_creation_input_tokens" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldLatencyNs returns the old "latency_ns" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCacheCreationInputTokens(ctx context.Context) (v *int, err error) {
+func (m *EmbeddingCallMutation) OldLatencyNs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheCreationInputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldLatencyNs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheCreationInputTokens requires an ID field in the mutation")
+		return v, errors.New("OldLatencyNs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheCreationInputTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldLatencyNs: %w", err)
 	}
-	return oldValue.CacheCreationInputTokens, nil
+	return oldValue.LatencyNs, nil
 }
 
-// AddCacheCreationInputTokens adds i to the "cache_creation_input_tokens" field.
-func (m *NodeMutation) AddCacheCreationInputTokens(i int) {
-	if m.addcache_creation_input_tokens != nil {
-		*m.addcache_creation_input_tokens += i
+// AddLatencyNs adds i to the "latency_ns" field.
+func (m *EmbeddingCallMutation) AddLatencyNs(i int64) {
+	if m.addlatency_ns != nil {
+		*m.addlatency_ns += i
 	} else {
-		m.addcache_creation_input_tokens = &i
+		m.addlatency_ns = &i
 	}
 }
 
-// AddedCacheCreationInputTokens returns the value that was added to the "cache_creation_input_tokens" field in this mutation.
-func (m *NodeMutation) AddedCacheCreationInputTokens() (r int, exists bool) {
-	v := m.addcache_creation_input_tokens
+// AddedLatencyNs returns the value that was added to the "latency_ns" field in this mutation.
+func (m *EmbeddingCallMutation) AddedLatencyNs() (r int64, exists bool) {
+	v := m.addlatency_ns
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCacheCreationInputTokens clears the value of the "cache_creation_input_tokens" field.
-func (m *NodeMutation) ClearCacheCreationInputTokens() {
-	m.cache_creation_input_tokens = nil
-	m.addcache_creation_input_tokens = nil
-	m.clearedFields[node.FieldCacheCreationInputTokens] = struct{}{}
-}
-
-// CacheCreationInputTokensCleared returns if the "cache_creation_input_tokens" field was cleared in this mutation.
-func (m *NodeMutation) CacheCreationInputTokensCleared() bool {
-	_, ok := m.clearedFields[node.FieldCacheCreationInputTokens]
-	return ok
-}
-
-// ResetCacheCreationInputTokens resets all changes to the "cache_creation_input_tokens" field.
-func (m *NodeMutation) ResetCacheCreationInputTokens() {
-	m.cache_creation_input_tokens = nil
-	m.addcache_creation_input_tokens = nil
-	delete(m.clearedFields, node.FieldCacheCreationInputTokens)
+// ResetLatencyNs resets all changes to the "latency_ns" field.
+func (m *EmbeddingCallMutation) ResetLatencyNs() {
+	m.latency_ns = nil
+	m.addlatency_ns = nil
 }
 
-// SetCacheReadInputTokens sets the "cache_read_input_tokens" field.
-func (m *NodeMutation) SetCacheReadInputTokens(i int) {
-	m.cache_read_input_tokens = &i
-	m.addcache_read_input_tokens = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *EmbeddingCallMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CacheReadInputTokens returns the value of the "cache_read_input_tokens" field in the mutation.
-func (m *NodeMutation) CacheReadInputTokens() (r int, exists bool) {
-	v := m.cache_read_input_tokens
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmbeddingCallMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadInputTokens returns the old "cache_read_input_tokens" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EmbeddingCall entity.
+// If the EmbeddingCall object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCacheReadInputTokens(ctx context.Context) (v *int, err error) {
+func (m *EmbeddingCallMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadInputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadInputTokens requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadInputTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CacheReadInputTokens, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddCacheReadInputTokens adds i to the "cache_read_input_tokens" field.
-func (m *NodeMutation) AddCacheReadInputTokens(i int) {
-	if m.addcache_read_input_tokens != nil {
-		*m.addcache_read_input_tokens += i
-	} else {
-		m.addcache_read_input_tokens = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmbeddingCallMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedCacheReadInputTokens returns the value that was added to the "cache_read_input_tokens" field in this mutation.
-func (m *NodeMutation) AddedCacheReadInputTokens() (r int, exists bool) {
-	v := m.addcache_read_input_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the EmbeddingCallMutation builder.
+func (m *EmbeddingCallMutation) Where(ps ...predicate.EmbeddingCall) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearCacheReadInputTokens clears the value of the "cache_read_input_tokens" field.
-func (m *NodeMutation) ClearCacheReadInputTokens() {
-	m.cache_read_input_tokens = nil
-	m.addcache_read_input_tokens = nil
-	m.clearedFields[node.FieldCacheReadInputTokens] = struct{}{}
+// WhereP appends storage-level predicates to the EmbeddingCallMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmbeddingCallMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmbeddingCall, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// CacheReadInputTokensCleared returns if the "cache_read_input_tokens" field was cleared in this mutation.
-func (m *NodeMutation) CacheReadInputTokensCleared() bool {
-	_, ok := m.clearedFields[node.FieldCacheReadInputTokens]
-	return ok
+// Op returns the operation name.
+func (m *EmbeddingCallMutation) Op() Op {
+	return m.op
 }
 
-// ResetCacheReadInputTokens resets all changes to the "cache_read_input_tokens" field.
-func (m *NodeMutation) ResetCacheReadInputTokens() {
-	m.cache_read_input_tokens = nil
-	m.addcache_read_input_tokens = nil
-	delete(m.clearedFields, node.FieldCacheReadInputTokens)
+// SetOp allows setting the mutation operation.
+func (m *EmbeddingCallMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetTotalDurationNs sets the "total_duration_ns" field.
-func (m *NodeMutation) SetTotalDurationNs(i int64) {
-	m.total_duration_ns = &i
-	m.addtotal_duration_ns = nil
+// Type returns the node type of this mutation (EmbeddingCall).
+func (m *EmbeddingCallMutation) Type() string {
+	return m.typ
 }
 
-// TotalDurationNs returns the value of the "total_duration_ns" field in the mutation.
-func (m *NodeMutation) TotalDurationNs() (r int64, exists bool) {
-	v := m.total_duration_ns
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmbeddingCallMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.provider != nil {
+		fields = append(fields, embeddingcall.FieldProvider)
 	}
-	return *v, true
-}
-
-// OldTotalDurationNs returns the old "total_duration_ns" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldTotalDurationNs(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDurationNs is only allowed on UpdateOne operations")
+	if m.model != nil {
+		fields = append(fields, embeddingcall.FieldModel)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDurationNs requires an ID field in the mutation")
+	if m.agent_name != nil {
+		fields = append(fields, embeddingcall.FieldAgentName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDurationNs: %w", err)
+	if m.project != nil {
+		fields = append(fields, embeddingcall.FieldProject)
 	}
-	return oldValue.TotalDurationNs, nil
-}
-
-// AddTotalDurationNs adds i to the "total_duration_ns" field.
-func (m *NodeMutation) AddTotalDurationNs(i int64) {
-	if m.addtotal_duration_ns != nil {
-		*m.addtotal_duration_ns += i
-	} else {
-		m.addtotal_duration_ns = &i
+	if m.input_count != nil {
+		fields = append(fields, embeddingcall.FieldInputCount)
 	}
-}
-
-// AddedTotalDurationNs returns the value that was added to the "total_duration_ns" field in this mutation.
-func (m *NodeMutation) AddedTotalDurationNs() (r int64, exists bool) {
-	v := m.addtotal_duration_ns
-	if v == nil {
-		return
+	if m.input != nil {
+		fields = append(fields, embeddingcall.FieldInput)
 	}
-	return *v, true
-}
-
-// ClearTotalDurationNs clears the value of the "total_duration_ns" field.
-func (m *NodeMutation) ClearTotalDurationNs() {
-	m.total_duration_ns = nil
-	m.addtotal_duration_ns = nil
-	m.clearedFields[node.FieldTotalDurationNs] = struct{}{}
-}
-
-// TotalDurationNsCleared returns if the "total_duration_ns" field was cleared in this mutation.
-func (m *NodeMutation) TotalDurationNsCleared() bool {
-	_, ok := m.clearedFields[node.FieldTotalDurationNs]
-	return ok
-}
-
-// ResetTotalDurationNs resets all changes to the "total_duration_ns" field.
-func (m *NodeMutation) ResetTotalDurationNs() {
-	m.total_duration_ns = nil
-	m.addtotal_duration_ns = nil
-	delete(m.clearedFields, node.FieldTotalDurationNs)
-}
-
-// SetPromptDurationNs sets the "prompt_duration_ns" field.
-func (m *NodeMutation) SetPromptDurationNs(i int64) {
-	m.prompt_duration_ns = &i
-	m.addprompt_duration_ns = nil
-}
-
-// PromptDurationNs returns the value of the "prompt_duration_ns" field in the mutation.
-func (m *NodeMutation) PromptDurationNs() (r int64, exists bool) {
-	v := m.prompt_duration_ns
-	if v == nil {
-		return
+	if m.dimensions != nil {
+		fields = append(fields, embeddingcall.FieldDimensions)
 	}
-	return *v, true
-}
-
-// OldPromptDurationNs returns the old "prompt_duration_ns" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldPromptDurationNs(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptDurationNs is only allowed on UpdateOne operations")
+	if m.prompt_tokens != nil {
+		fields = append(fields, embeddingcall.FieldPromptTokens)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptDurationNs requires an ID field in the mutation")
+	if m.total_tokens != nil {
+		fields = append(fields, embeddingcall.FieldTotalTokens)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptDurationNs: %w", err)
+	if m.latency_ns != nil {
+		fields = append(fields, embeddingcall.FieldLatencyNs)
 	}
-	return oldValue.PromptDurationNs, nil
-}
-
-// AddPromptDurationNs adds i to the "prompt_duration_ns" field.
-func (m *NodeMutation) AddPromptDurationNs(i int64) {
-	if m.addprompt_duration_ns != nil {
-		*m.addprompt_duration_ns += i
-	} else {
-		m.addprompt_duration_ns = &i
+	if m.created_at != nil {
+		fields = append(fields, embeddingcall.FieldCreatedAt)
 	}
+	return fields
 }
 
-// AddedPromptDurationNs returns the value that was added to the "prompt_duration_ns" field in this mutation.
-func (m *NodeMutation) AddedPromptDurationNs() (r int64, exists bool) {
-	v := m.addprompt_duration_ns
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmbeddingCallMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingcall.FieldProvider:
+		return m.Provider()
+	case embeddingcall.FieldModel:
+		return m.Model()
+	case embeddingcall.FieldAgentName:
+		return m.AgentName()
+	case embeddingcall.FieldProject:
+		return m.Project()
+	case embeddingcall.FieldInputCount:
+		return m.InputCount()
+	case embeddingcall.FieldInput:
+		return m.Input()
+	case embeddingcall.FieldDimensions:
+		return m.Dimensions()
+	case embeddingcall.FieldPromptTokens:
+		return m.PromptTokens()
+	case embeddingcall.FieldTotalTokens:
+		return m.TotalTokens()
+	case embeddingcall.FieldLatencyNs:
+		return m.LatencyNs()
+	case embeddingcall.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	return *v, true
-}
-
-// ClearPromptDurationNs clears the value of the "prompt_duration_ns" field.
-func (m *NodeMutation) ClearPromptDurationNs() {
-	m.prompt_duration_ns = nil
-	m.addprompt_duration_ns = nil
-	m.clearedFields[node.FieldPromptDurationNs] = struct{}{}
-}
-
-// PromptDurationNsCleared returns if the "prompt_duration_ns" field was cleared in this mutation.
-func (m *NodeMutation) PromptDurationNsCleared() bool {
-	_, ok := m.clearedFields[node.FieldPromptDurationNs]
-	return ok
-}
-
-// ResetPromptDurationNs resets all changes to the "prompt_duration_ns" field.
-func (m *NodeMutation) ResetPromptDurationNs() {
-	m.prompt_duration_ns = nil
-	m.addprompt_duration_ns = nil
-	delete(m.clearedFields, node.FieldPromptDurationNs)
-}
-
-// SetProject sets the "project" field.
-func (m *NodeMutation) SetProject(s string) {
-	m.project = &s
+	return nil, false
 }
 
-// Project returns the value of the "project" field in the mutation.
-func (m *NodeMutation) Project() (r string, exists bool) {
-	v := m.project
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmbeddingCallMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case embeddingcall.FieldProvider:
+		return m.OldProvider(ctx)
+	case embeddingcall.FieldModel:
+		return m.OldModel(ctx)
+	case embeddingcall.FieldAgentName:
+		return m.OldAgentName(ctx)
+	case embeddingcall.FieldProject:
+		return m.OldProject(ctx)
+	case embeddingcall.FieldInputCount:
+		return m.OldInputCount(ctx)
+	case embeddingcall.FieldInput:
+		return m.OldInput(ctx)
+	case embeddingcall.FieldDimensions:
+		return m.OldDimensions(ctx)
+	case embeddingcall.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case embeddingcall.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case embeddingcall.FieldLatencyNs:
+		return m.OldLatencyNs(ctx)
+	case embeddingcall.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown EmbeddingCall field %s", name)
 }
 
-// OldProject returns the old "project" field's value of the Node entity.
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingCallMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case embeddingcall.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case embeddingcall.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case embeddingcall.FieldAgentName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentName(v)
+		return nil
+	case embeddingcall.FieldProject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProject(v)
+		return nil
+	case embeddingcall.FieldInputCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputCount(v)
+		return nil
+	case embeddingcall.FieldInput:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInput(v)
+		return nil
+	case embeddingcall.FieldDimensions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDimensions(v)
+		return nil
+	case embeddingcall.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case embeddingcall.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
+	case embeddingcall.FieldLatencyNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyNs(v)
+		return nil
+	case embeddingcall.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCall field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmbeddingCallMutation) AddedFields() []string {
+	var fields []string
+	if m.addinput_count != nil {
+		fields = append(fields, embeddingcall.FieldInputCount)
+	}
+	if m.adddimensions != nil {
+		fields = append(fields, embeddingcall.FieldDimensions)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, embeddingcall.FieldPromptTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, embeddingcall.FieldTotalTokens)
+	}
+	if m.addlatency_ns != nil {
+		fields = append(fields, embeddingcall.FieldLatencyNs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmbeddingCallMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingcall.FieldInputCount:
+		return m.AddedInputCount()
+	case embeddingcall.FieldDimensions:
+		return m.AddedDimensions()
+	case embeddingcall.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case embeddingcall.FieldTotalTokens:
+		return m.AddedTotalTokens()
+	case embeddingcall.FieldLatencyNs:
+		return m.AddedLatencyNs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingCallMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case embeddingcall.FieldInputCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputCount(v)
+		return nil
+	case embeddingcall.FieldDimensions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDimensions(v)
+		return nil
+	case embeddingcall.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case embeddingcall.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTokens(v)
+		return nil
+	case embeddingcall.FieldLatencyNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyNs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCall numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmbeddingCallMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(embeddingcall.FieldAgentName) {
+		fields = append(fields, embeddingcall.FieldAgentName)
+	}
+	if m.FieldCleared(embeddingcall.FieldProject) {
+		fields = append(fields, embeddingcall.FieldProject)
+	}
+	if m.FieldCleared(embeddingcall.FieldInput) {
+		fields = append(fields, embeddingcall.FieldInput)
+	}
+	if m.FieldCleared(embeddingcall.FieldDimensions) {
+		fields = append(fields, embeddingcall.FieldDimensions)
+	}
+	if m.FieldCleared(embeddingcall.FieldPromptTokens) {
+		fields = append(fields, embeddingcall.FieldPromptTokens)
+	}
+	if m.FieldCleared(embeddingcall.FieldTotalTokens) {
+		fields = append(fields, embeddingcall.FieldTotalTokens)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmbeddingCallMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmbeddingCallMutation) ClearField(name string) error {
+	switch name {
+	case embeddingcall.FieldAgentName:
+		m.ClearAgentName()
+		return nil
+	case embeddingcall.FieldProject:
+		m.ClearProject()
+		return nil
+	case embeddingcall.FieldInput:
+		m.ClearInput()
+		return nil
+	case embeddingcall.FieldDimensions:
+		m.ClearDimensions()
+		return nil
+	case embeddingcall.FieldPromptTokens:
+		m.ClearPromptTokens()
+		return nil
+	case embeddingcall.FieldTotalTokens:
+		m.ClearTotalTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCall nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmbeddingCallMutation) ResetField(name string) error {
+	switch name {
+	case embeddingcall.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case embeddingcall.FieldModel:
+		m.ResetModel()
+		return nil
+	case embeddingcall.FieldAgentName:
+		m.ResetAgentName()
+		return nil
+	case embeddingcall.FieldProject:
+		m.ResetProject()
+		return nil
+	case embeddingcall.FieldInputCount:
+		m.ResetInputCount()
+		return nil
+	case embeddingcall.FieldInput:
+		m.ResetInput()
+		return nil
+	case embeddingcall.FieldDimensions:
+		m.ResetDimensions()
+		return nil
+	case embeddingcall.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case embeddingcall.FieldTotalTokens:
+		m.ResetTotalTokens()
+		return nil
+	case embeddingcall.FieldLatencyNs:
+		m.ResetLatencyNs()
+		return nil
+	case embeddingcall.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCall field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmbeddingCallMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmbeddingCallMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmbeddingCallMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmbeddingCallMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmbeddingCallMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmbeddingCallMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmbeddingCallMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingCall unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmbeddingCallMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingCall edge %s", name)
+}
+
+// FacetMutation represents an operation that mutates the Facet nodes in the graph.
+type FacetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	session_id    *string
+	facets        *map[string]interface{}
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Facet, error)
+	predicates    []predicate.Facet
+}
+
+var _ ent.Mutation = (*FacetMutation)(nil)
+
+// facetOption allows management of the mutation configuration using functional options.
+type facetOption func(*FacetMutation)
+
+// newFacetMutation creates new mutation for the Facet entity.
+func newFacetMutation(c config, op Op, opts ...facetOption) *FacetMutation {
+	m := &FacetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFacet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFacetID sets the ID field of the mutation.
+func withFacetID(id string) facetOption {
+	return func(m *FacetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Facet
+		)
+		m.oldValue = func(ctx context.Context) (*Facet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Facet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFacet sets the old Facet of the mutation.
+func withFacet(node *Facet) facetOption {
+	return func(m *FacetMutation) {
+		m.oldValue = func(context.Context) (*Facet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FacetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FacetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Facet entities.
+func (m *FacetMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FacetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FacetMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Facet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *FacetMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *FacetMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the Facet entity.
+// If the Facet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FacetMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *FacetMutation) ResetSessionID() {
+	m.session_id = nil
+}
+
+// SetFacets sets the "facets" field.
+func (m *FacetMutation) SetFacets(value map[string]interface{}) {
+	m.facets = &value
+}
+
+// Facets returns the value of the "facets" field in the mutation.
+func (m *FacetMutation) Facets() (r map[string]interface{}, exists bool) {
+	v := m.facets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFacets returns the old "facets" field's value of the Facet entity.
+// If the Facet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FacetMutation) OldFacets(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFacets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFacets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFacets: %w", err)
+	}
+	return oldValue.Facets, nil
+}
+
+// ClearFacets clears the value of the "facets" field.
+func (m *FacetMutation) ClearFacets() {
+	m.facets = nil
+	m.clearedFields[facet.FieldFacets] = struct{}{}
+}
+
+// FacetsCleared returns if the "facets" field was cleared in this mutation.
+func (m *FacetMutation) FacetsCleared() bool {
+	_, ok := m.clearedFields[facet.FieldFacets]
+	return ok
+}
+
+// ResetFacets resets all changes to the "facets" field.
+func (m *FacetMutation) ResetFacets() {
+	m.facets = nil
+	delete(m.clearedFields, facet.FieldFacets)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FacetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FacetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Facet entity.
+// If the Facet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FacetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FacetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FacetMutation builder.
+func (m *FacetMutation) Where(ps ...predicate.Facet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FacetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FacetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Facet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FacetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FacetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Facet).
+func (m *FacetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FacetMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.session_id != nil {
+		fields = append(fields, facet.FieldSessionID)
+	}
+	if m.facets != nil {
+		fields = append(fields, facet.FieldFacets)
+	}
+	if m.created_at != nil {
+		fields = append(fields, facet.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FacetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case facet.FieldSessionID:
+		return m.SessionID()
+	case facet.FieldFacets:
+		return m.Facets()
+	case facet.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FacetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case facet.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case facet.FieldFacets:
+		return m.OldFacets(ctx)
+	case facet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Facet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FacetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case facet.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case facet.FieldFacets:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFacets(v)
+		return nil
+	case facet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Facet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FacetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FacetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FacetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Facet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FacetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(facet.FieldFacets) {
+		fields = append(fields, facet.FieldFacets)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FacetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FacetMutation) ClearField(name string) error {
+	switch name {
+	case facet.FieldFacets:
+		m.ClearFacets()
+		return nil
+	}
+	return fmt.Errorf("unknown Facet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FacetMutation) ResetField(name string) error {
+	switch name {
+	case facet.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case facet.FieldFacets:
+		m.ResetFacets()
+		return nil
+	case facet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Facet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FacetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FacetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FacetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FacetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FacetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FacetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FacetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Facet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FacetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Facet edge %s", name)
+}
+
+// NodeMutation represents an operation that mutates the Node nodes in the graph.
+type NodeMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *string
+	bucket                         *map[string]interface{}
+	_type                          *string
+	role                           *string
+	content                        *[]map[string]interface{}
+	appendcontent                  []map[string]interface{}
+	model                          *string
+	provider                       *string
+	agent_name                     *string
+	stop_reason                    *string
+	prompt_tokens                  *int
+	addprompt_tokens               *int
+	completion_tokens              *int
+	addcompletion_tokens           *int
+	total_tokens                   *int
+	addtotal_tokens                *int
+	cache_creation_input_tokens    *int
+	addcache_creation_input_tokens *int
+	cache_read_input_tokens        *int
+	addcache_read_input_tokens     *int
+	total_duration_ns              *int64
+	addtotal_duration_ns           *int64
+	prompt_duration_ns             *int64
+	addprompt_duration_ns          *int64
+	project                        *string
+	requested_model                *string
+	upstream                       *string
+	generation_params              *map[string]interface{}
+	provider_request_id            *string
+	rate_limits                    *map[string]string
+	choice_index                   *int
+	addchoice_index                *int
+	context_key                    *string
+	cache_key                      *string
+	cached_at                      *time.Time
+	cache_hits                     *int
+	addcache_hits                  *int
+	root_hash                      *string
+	depth                          *int
+	adddepth                       *int
+	is_leaf                        *bool
+	created_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	parent                         *string
+	clearedparent                  bool
+	children                       map[string]struct{}
+	removedchildren                map[string]struct{}
+	clearedchildren                bool
+	done                           bool
+	oldValue                       func(context.Context) (*Node, error)
+	predicates                     []predicate.Node
+}
+
+var _ ent.Mutation = (*NodeMutation)(nil)
+
+// nodeOption allows management of the mutation configuration using functional options.
+type nodeOption func(*NodeMutation)
+
+// newNodeMutation creates new mutation for the Node entity.
+func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
+	m := &NodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeID sets the ID field of the mutation.
+func withNodeID(id string) nodeOption {
+	return func(m *NodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Node
+		)
+		m.oldValue = func(ctx context.Context) (*Node, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Node.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNode sets the old Node of the mutation.
+func withNode(node *Node) nodeOption {
+	return func(m *NodeMutation) {
+		m.oldValue = func(context.Context) (*Node, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Node entities.
+func (m *NodeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetParentHash sets the "parent_hash" field.
+func (m *NodeMutation) SetParentHash(s string) {
+	m.parent = &s
+}
+
+// ParentHash returns the value of the "parent_hash" field in the mutation.
+func (m *NodeMutation) ParentHash() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentHash returns the old "parent_hash" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldParentHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentHash: %w", err)
+	}
+	return oldValue.ParentHash, nil
+}
+
+// ClearParentHash clears the value of the "parent_hash" field.
+func (m *NodeMutation) ClearParentHash() {
+	m.parent = nil
+	m.clearedFields[node.FieldParentHash] = struct{}{}
+}
+
+// ParentHashCleared returns if the "parent_hash" field was cleared in this mutation.
+func (m *NodeMutation) ParentHashCleared() bool {
+	_, ok := m.clearedFields[node.FieldParentHash]
+	return ok
+}
+
+// ResetParentHash resets all changes to the "parent_hash" field.
+func (m *NodeMutation) ResetParentHash() {
+	m.parent = nil
+	delete(m.clearedFields, node.FieldParentHash)
+}
+
+// SetBucket sets the "bucket" field.
+func (m *NodeMutation) SetBucket(value map[string]interface{}) {
+	m.bucket = &value
+}
+
+// Bucket returns the value of the "bucket" field in the mutation.
+func (m *NodeMutation) Bucket() (r map[string]interface{}, exists bool) {
+	v := m.bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucket returns the old "bucket" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldBucket(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
+	}
+	return oldValue.Bucket, nil
+}
+
+// ClearBucket clears the value of the "bucket" field.
+func (m *NodeMutation) ClearBucket() {
+	m.bucket = nil
+	m.clearedFields[node.FieldBucket] = struct{}{}
+}
+
+// BucketCleared returns if the "bucket" field was cleared in this mutation.
+func (m *NodeMutation) BucketCleared() bool {
+	_, ok := m.clearedFields[node.FieldBucket]
+	return ok
+}
+
+// ResetBucket resets all changes to the "bucket" field.
+func (m *NodeMutation) ResetBucket() {
+	m.bucket = nil
+	delete(m.clearedFields, node.FieldBucket)
+}
+
+// SetType sets the "type" field.
+func (m *NodeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NodeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *NodeMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[node.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *NodeMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[node.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NodeMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, node.FieldType)
+}
+
+// SetRole sets the "role" field.
+func (m *NodeMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *NodeMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ClearRole clears the value of the "role" field.
+func (m *NodeMutation) ClearRole() {
+	m.role = nil
+	m.clearedFields[node.FieldRole] = struct{}{}
+}
+
+// RoleCleared returns if the "role" field was cleared in this mutation.
+func (m *NodeMutation) RoleCleared() bool {
+	_, ok := m.clearedFields[node.FieldRole]
+	return ok
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *NodeMutation) ResetRole() {
+	m.role = nil
+	delete(m.clearedFields, node.FieldRole)
+}
+
+// SetContent sets the "content" field.
+func (m *NodeMutation) SetContent(value []map[string]interface{}) {
+	m.content = &value
+	m.appendcontent = nil
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *NodeMutation) Content() (r []map[string]interface{}, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldContent(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// AppendContent adds value to the "content" field.
+func (m *NodeMutation) AppendContent(value []map[string]interface{}) {
+	m.appendcontent = append(m.appendcontent, value...)
+}
+
+// AppendedContent returns the list of values that were appended to the "content" field in this mutation.
+func (m *NodeMutation) AppendedContent() ([]map[string]interface{}, bool) {
+	if len(m.appendcontent) == 0 {
+		return nil, false
+	}
+	return m.appendcontent, true
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *NodeMutation) ClearContent() {
+	m.content = nil
+	m.appendcontent = nil
+	m.clearedFields[node.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *NodeMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[node.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *NodeMutation) ResetContent() {
+	m.content = nil
+	m.appendcontent = nil
+	delete(m.clearedFields, node.FieldContent)
+}
+
+// SetModel sets the "model" field.
+func (m *NodeMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *NodeMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *NodeMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[node.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *NodeMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[node.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *NodeMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, node.FieldModel)
+}
+
+// SetProvider sets the "provider" field.
+func (m *NodeMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *NodeMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (m *NodeMutation) ClearProvider() {
+	m.provider = nil
+	m.clearedFields[node.FieldProvider] = struct{}{}
+}
+
+// ProviderCleared returns if the "provider" field was cleared in this mutation.
+func (m *NodeMutation) ProviderCleared() bool {
+	_, ok := m.clearedFields[node.FieldProvider]
+	return ok
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *NodeMutation) ResetProvider() {
+	m.provider = nil
+	delete(m.clearedFields, node.FieldProvider)
+}
+
+// SetAgentName sets the "agent_name" field.
+func (m *NodeMutation) SetAgentName(s string) {
+	m.agent_name = &s
+}
+
+// AgentName returns the value of the "agent_name" field in the mutation.
+func (m *NodeMutation) AgentName() (r string, exists bool) {
+	v := m.agent_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentName returns the old "agent_name" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldAgentName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentName: %w", err)
+	}
+	return oldValue.AgentName, nil
+}
+
+// ClearAgentName clears the value of the "agent_name" field.
+func (m *NodeMutation) ClearAgentName() {
+	m.agent_name = nil
+	m.clearedFields[node.FieldAgentName] = struct{}{}
+}
+
+// AgentNameCleared returns if the "agent_name" field was cleared in this mutation.
+func (m *NodeMutation) AgentNameCleared() bool {
+	_, ok := m.clearedFields[node.FieldAgentName]
+	return ok
+}
+
+// ResetAgentName resets all changes to the "agent_name" field.
+func (m *NodeMutation) ResetAgentName() {
+	m.agent_name = nil
+	delete(m.clearedFields, node.FieldAgentName)
+}
+
+// SetStopReason sets the "stop_reason" field.
+func (m *NodeMutation) SetStopReason(s string) {
+	m.stop_reason = &s
+}
+
+// StopReason returns the value of the "stop_reason" field in the mutation.
+func (m *NodeMutation) StopReason() (r string, exists bool) {
+	v := m.stop_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStopReason returns the old "stop_reason" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldStopReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStopReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStopReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStopReason: %w", err)
+	}
+	return oldValue.StopReason, nil
+}
+
+// ClearStopReason clears the value of the "stop_reason" field.
+func (m *NodeMutation) ClearStopReason() {
+	m.stop_reason = nil
+	m.clearedFields[node.FieldStopReason] = struct{}{}
+}
+
+// StopReasonCleared returns if the "stop_reason" field was cleared in this mutation.
+func (m *NodeMutation) StopReasonCleared() bool {
+	_, ok := m.clearedFields[node.FieldStopReason]
+	return ok
+}
+
+// ResetStopReason resets all changes to the "stop_reason" field.
+func (m *NodeMutation) ResetStopReason() {
+	m.stop_reason = nil
+	delete(m.clearedFields, node.FieldStopReason)
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *NodeMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *NodeMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldPromptTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *NodeMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *NodeMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (m *NodeMutation) ClearPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	m.clearedFields[node.FieldPromptTokens] = struct{}{}
+}
+
+// PromptTokensCleared returns if the "prompt_tokens" field was cleared in this mutation.
+func (m *NodeMutation) PromptTokensCleared() bool {
+	_, ok := m.clearedFields[node.FieldPromptTokens]
+	return ok
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *NodeMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	delete(m.clearedFields, node.FieldPromptTokens)
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *NodeMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *NodeMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCompletionTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *NodeMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *NodeMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (m *NodeMutation) ClearCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+	m.clearedFields[node.FieldCompletionTokens] = struct{}{}
+}
+
+// CompletionTokensCleared returns if the "completion_tokens" field was cleared in this mutation.
+func (m *NodeMutation) CompletionTokensCleared() bool {
+	_, ok := m.clearedFields[node.FieldCompletionTokens]
+	return ok
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *NodeMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+	delete(m.clearedFields, node.FieldCompletionTokens)
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (m *NodeMutation) SetTotalTokens(i int) {
+	m.total_tokens = &i
+	m.addtotal_tokens = nil
+}
+
+// TotalTokens returns the value of the "total_tokens" field in the mutation.
+func (m *NodeMutation) TotalTokens() (r int, exists bool) {
+	v := m.total_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTokens returns the old "total_tokens" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTotalTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTokens: %w", err)
+	}
+	return oldValue.TotalTokens, nil
+}
+
+// AddTotalTokens adds i to the "total_tokens" field.
+func (m *NodeMutation) AddTotalTokens(i int) {
+	if m.addtotal_tokens != nil {
+		*m.addtotal_tokens += i
+	} else {
+		m.addtotal_tokens = &i
+	}
+}
+
+// AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
+func (m *NodeMutation) AddedTotalTokens() (r int, exists bool) {
+	v := m.addtotal_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalTokens clears the value of the "total_tokens" field.
+func (m *NodeMutation) ClearTotalTokens() {
+	m.total_tokens = nil
+	m.addtotal_tokens = nil
+	m.clearedFields[node.FieldTotalTokens] = struct{}{}
+}
+
+// TotalTokensCleared returns if the "total_tokens" field was cleared in this mutation.
+func (m *NodeMutation) TotalTokensCleared() bool {
+	_, ok := m.clearedFields[node.FieldTotalTokens]
+	return ok
+}
+
+// ResetTotalTokens resets all changes to the "total_tokens" field.
+func (m *NodeMutation) ResetTotalTokens() {
+	m.total_tokens = nil
+	m.addtotal_tokens = nil
+	delete(m.clearedFields, node.FieldTotalTokens)
+}
+
+// SetCacheCreationInputTokens sets the "cache_creation_input_tokens" field.
+func (m *NodeMutation) SetCacheCreationInputTokens(i int) {
+	m.cache_creation_input_tokens = &i
+	m.addcache_creation_input_tokens = nil
+}
+
+// CacheCreationInputTokens returns the value of the "cache_creation_input_tokens" field in the mutation.
+func (m *NodeMutation) CacheCreationInputTokens() (r int, exists bool) {
+	v := m.cache_creation_input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheCreationInputTokens returns the old "cache_creation_input_tokens" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCacheCreationInputTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheCreationInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheCreationInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheCreationInputTokens: %w", err)
+	}
+	return oldValue.CacheCreationInputTokens, nil
+}
+
+// AddCacheCreationInputTokens adds i to the "cache_creation_input_tokens" field.
+func (m *NodeMutation) AddCacheCreationInputTokens(i int) {
+	if m.addcache_creation_input_tokens != nil {
+		*m.addcache_creation_input_tokens += i
+	} else {
+		m.addcache_creation_input_tokens = &i
+	}
+}
+
+// AddedCacheCreationInputTokens returns the value that was added to the "cache_creation_input_tokens" field in this mutation.
+func (m *NodeMutation) AddedCacheCreationInputTokens() (r int, exists bool) {
+	v := m.addcache_creation_input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheCreationInputTokens clears the value of the "cache_creation_input_tokens" field.
+func (m *NodeMutation) ClearCacheCreationInputTokens() {
+	m.cache_creation_input_tokens = nil
+	m.addcache_creation_input_tokens = nil
+	m.clearedFields[node.FieldCacheCreationInputTokens] = struct{}{}
+}
+
+// CacheCreationInputTokensCleared returns if the "cache_creation_input_tokens" field was cleared in this mutation.
+func (m *NodeMutation) CacheCreationInputTokensCleared() bool {
+	_, ok := m.clearedFields[node.FieldCacheCreationInputTokens]
+	return ok
+}
+
+// ResetCacheCreationInputTokens resets all changes to the "cache_creation_input_tokens" field.
+func (m *NodeMutation) ResetCacheCreationInputTokens() {
+	m.cache_creation_input_tokens = nil
+	m.addcache_creation_input_tokens = nil
+	delete(m.clearedFields, node.FieldCacheCreationInputTokens)
+}
+
+// SetCacheReadInputTokens sets the "cache_read_input_tokens" field.
+func (m *NodeMutation) SetCacheReadInputTokens(i int) {
+	m.cache_read_input_tokens = &i
+	m.addcache_read_input_tokens = nil
+}
+
+// CacheReadInputTokens returns the value of the "cache_read_input_tokens" field in the mutation.
+func (m *NodeMutation) CacheReadInputTokens() (r int, exists bool) {
+	v := m.cache_read_input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadInputTokens returns the old "cache_read_input_tokens" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCacheReadInputTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadInputTokens: %w", err)
+	}
+	return oldValue.CacheReadInputTokens, nil
+}
+
+// AddCacheReadInputTokens adds i to the "cache_read_input_tokens" field.
+func (m *NodeMutation) AddCacheReadInputTokens(i int) {
+	if m.addcache_read_input_tokens != nil {
+		*m.addcache_read_input_tokens += i
+	} else {
+		m.addcache_read_input_tokens = &i
+	}
+}
+
+// AddedCacheReadInputTokens returns the value that was added to the "cache_read_input_tokens" field in this mutation.
+func (m *NodeMutation) AddedCacheReadInputTokens() (r int, exists bool) {
+	v := m.addcache_read_input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheReadInputTokens clears the value of the "cache_read_input_tokens" field.
+func (m *NodeMutation) ClearCacheReadInputTokens() {
+	m.cache_read_input_tokens = nil
+	m.addcache_read_input_tokens = nil
+	m.clearedFields[node.FieldCacheReadInputTokens] = struct{}{}
+}
+
+// CacheReadInputTokensCleared returns if the "cache_read_input_tokens" field was cleared in this mutation.
+func (m *NodeMutation) CacheReadInputTokensCleared() bool {
+	_, ok := m.clearedFields[node.FieldCacheReadInputTokens]
+	return ok
+}
+
+// ResetCacheReadInputTokens resets all changes to the "cache_read_input_tokens" field.
+func (m *NodeMutation) ResetCacheReadInputTokens() {
+	m.cache_read_input_tokens = nil
+	m.addcache_read_input_tokens = nil
+	delete(m.clearedFields, node.FieldCacheReadInputTokens)
+}
+
+// SetTotalDurationNs sets the "total_duration_ns" field.
+func (m *NodeMutation) SetTotalDurationNs(i int64) {
+	m.total_duration_ns = &i
+	m.addtotal_duration_ns = nil
+}
+
+// TotalDurationNs returns the value of the "total_duration_ns" field in the mutation.
+func (m *NodeMutation) TotalDurationNs() (r int64, exists bool) {
+	v := m.total_duration_ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDurationNs returns the old "total_duration_ns" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTotalDurationNs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDurationNs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDurationNs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDurationNs: %w", err)
+	}
+	return oldValue.TotalDurationNs, nil
+}
+
+// AddTotalDurationNs adds i to the "total_duration_ns" field.
+func (m *NodeMutation) AddTotalDurationNs(i int64) {
+	if m.addtotal_duration_ns != nil {
+		*m.addtotal_duration_ns += i
+	} else {
+		m.addtotal_duration_ns = &i
+	}
+}
+
+// AddedTotalDurationNs returns the value that was added to the "total_duration_ns" field in this mutation.
+func (m *NodeMutation) AddedTotalDurationNs() (r int64, exists bool) {
+	v := m.addtotal_duration_ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalDurationNs clears the value of the "total_duration_ns" field.
+func (m *NodeMutation) ClearTotalDurationNs() {
+	m.total_duration_ns = nil
+	m.addtotal_duration_ns = nil
+	m.clearedFields[node.FieldTotalDurationNs] = struct{}{}
+}
+
+// TotalDurationNsCleared returns if the "total_duration_ns" field was cleared in this mutation.
+func (m *NodeMutation) TotalDurationNsCleared() bool {
+	_, ok := m.clearedFields[node.FieldTotalDurationNs]
+	return ok
+}
+
+// ResetTotalDurationNs resets all changes to the "total_duration_ns" field.
+func (m *NodeMutation) ResetTotalDurationNs() {
+	m.total_duration_ns = nil
+	m.addtotal_duration_ns = nil
+	delete(m.clearedFields, node.FieldTotalDurationNs)
+}
+
+// SetPromptDurationNs sets the "prompt_duration_ns" field.
+func (m *NodeMutation) SetPromptDurationNs(i int64) {
+	m.prompt_duration_ns = &i
+	m.addprompt_duration_ns = nil
+}
+
+// PromptDurationNs returns the value of the "prompt_duration_ns" field in the mutation.
+func (m *NodeMutation) PromptDurationNs() (r int64, exists bool) {
+	v := m.prompt_duration_ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptDurationNs returns the old "prompt_duration_ns" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldPromptDurationNs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptDurationNs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptDurationNs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptDurationNs: %w", err)
+	}
+	return oldValue.PromptDurationNs, nil
+}
+
+// AddPromptDurationNs adds i to the "prompt_duration_ns" field.
+func (m *NodeMutation) AddPromptDurationNs(i int64) {
+	if m.addprompt_duration_ns != nil {
+		*m.addprompt_duration_ns += i
+	} else {
+		m.addprompt_duration_ns = &i
+	}
+}
+
+// AddedPromptDurationNs returns the value that was added to the "prompt_duration_ns" field in this mutation.
+func (m *NodeMutation) AddedPromptDurationNs() (r int64, exists bool) {
+	v := m.addprompt_duration_ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptDurationNs clears the value of the "prompt_duration_ns" field.
+func (m *NodeMutation) ClearPromptDurationNs() {
+	m.prompt_duration_ns = nil
+	m.addprompt_duration_ns = nil
+	m.clearedFields[node.FieldPromptDurationNs] = struct{}{}
+}
+
+// PromptDurationNsCleared returns if the "prompt_duration_ns" field was cleared in this mutation.
+func (m *NodeMutation) PromptDurationNsCleared() bool {
+	_, ok := m.clearedFields[node.FieldPromptDurationNs]
+	return ok
+}
+
+// ResetPromptDurationNs resets all changes to the "prompt_duration_ns" field.
+func (m *NodeMutation) ResetPromptDurationNs() {
+	m.prompt_duration_ns = nil
+	m.addprompt_duration_ns = nil
+	delete(m.clearedFields, node.FieldPromptDurationNs)
+}
+
+// SetProject sets the "project" field.
+func (m *NodeMutation) SetProject(s string) {
+	m.project = &s
+}
+
+// Project returns the value of the "project" field in the mutation.
+func (m *NodeMutation) Project() (r string, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProject returns the old "project" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldProject(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProject: %w", err)
+	}
+	return oldValue.Project, nil
+}
+
+// ClearProject clears the value of the "project" field.
+func (m *NodeMutation) ClearProject() {
+	m.project = nil
+	m.clearedFields[node.FieldProject] = struct{}{}
+}
+
+// ProjectCleared returns if the "project" field was cleared in this mutation.
+func (m *NodeMutation) ProjectCleared() bool {
+	_, ok := m.clearedFields[node.FieldProject]
+	return ok
+}
+
+// ResetProject resets all changes to the "project" field.
+func (m *NodeMutation) ResetProject() {
+	m.project = nil
+	delete(m.clearedFields, node.FieldProject)
+}
+
+// SetRequestedModel sets the "requested_model" field.
+func (m *NodeMutation) SetRequestedModel(s string) {
+	m.requested_model = &s
+}
+
+// RequestedModel returns the value of the "requested_model" field in the mutation.
+func (m *NodeMutation) RequestedModel() (r string, exists bool) {
+	v := m.requested_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedModel returns the old "requested_model" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldRequestedModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedModel: %w", err)
+	}
+	return oldValue.RequestedModel, nil
+}
+
+// ClearRequestedModel clears the value of the "requested_model" field.
+func (m *NodeMutation) ClearRequestedModel() {
+	m.requested_model = nil
+	m.clearedFields[node.FieldRequestedModel] = struct{}{}
+}
+
+// RequestedModelCleared returns if the "requested_model" field was cleared in this mutation.
+func (m *NodeMutation) RequestedModelCleared() bool {
+	_, ok := m.clearedFields[node.FieldRequestedModel]
+	return ok
+}
+
+// ResetRequestedModel resets all changes to the "requested_model" field.
+func (m *NodeMutation) ResetRequestedModel() {
+	m.requested_model = nil
+	delete(m.clearedFields, node.FieldRequestedModel)
+}
+
+// SetUpstream sets the "upstream" field.
+func (m *NodeMutation) SetUpstream(s string) {
+	m.upstream = &s
+}
+
+// Upstream returns the value of the "upstream" field in the mutation.
+func (m *NodeMutation) Upstream() (r string, exists bool) {
+	v := m.upstream
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpstream returns the old "upstream" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldUpstream(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpstream is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpstream requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpstream: %w", err)
+	}
+	return oldValue.Upstream, nil
+}
+
+// ClearUpstream clears the value of the "upstream" field.
+func (m *NodeMutation) ClearUpstream() {
+	m.upstream = nil
+	m.clearedFields[node.FieldUpstream] = struct{}{}
+}
+
+// UpstreamCleared returns if the "upstream" field was cleared in this mutation.
+func (m *NodeMutation) UpstreamCleared() bool {
+	_, ok := m.clearedFields[node.FieldUpstream]
+	return ok
+}
+
+// ResetUpstream resets all changes to the "upstream" field.
+func (m *NodeMutation) ResetUpstream() {
+	m.upstream = nil
+	delete(m.clearedFields, node.FieldUpstream)
+}
+
+// SetGenerationParams sets the "generation_params" field.
+func (m *NodeMutation) SetGenerationParams(value map[string]interface{}) {
+	m.generation_params = &value
+}
+
+// GenerationParams returns the value of the "generation_params" field in the mutation.
+func (m *NodeMutation) GenerationParams() (r map[string]interface{}, exists bool) {
+	v := m.generation_params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenerationParams returns the old "generation_params" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldGenerationParams(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenerationParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenerationParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenerationParams: %w", err)
+	}
+	return oldValue.GenerationParams, nil
+}
+
+// ClearGenerationParams clears the value of the "generation_params" field.
+func (m *NodeMutation) ClearGenerationParams() {
+	m.generation_params = nil
+	m.clearedFields[node.FieldGenerationParams] = struct{}{}
+}
+
+// GenerationParamsCleared returns if the "generation_params" field was cleared in this mutation.
+func (m *NodeMutation) GenerationParamsCleared() bool {
+	_, ok := m.clearedFields[node.FieldGenerationParams]
+	return ok
+}
+
+// ResetGenerationParams resets all changes to the "generation_params" field.
+func (m *NodeMutation) ResetGenerationParams() {
+	m.generation_params = nil
+	delete(m.clearedFields, node.FieldGenerationParams)
+}
+
+// SetProviderRequestID sets the "provider_request_id" field.
+func (m *NodeMutation) SetProviderRequestID(s string) {
+	m.provider_request_id = &s
+}
+
+// ProviderRequestID returns the value of the "provider_request_id" field in the mutation.
+func (m *NodeMutation) ProviderRequestID() (r string, exists bool) {
+	v := m.provider_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderRequestID returns the old "provider_request_id" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldProviderRequestID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderRequestID: %w", err)
+	}
+	return oldValue.ProviderRequestID, nil
+}
+
+// ClearProviderRequestID clears the value of the "provider_request_id" field.
+func (m *NodeMutation) ClearProviderRequestID() {
+	m.provider_request_id = nil
+	m.clearedFields[node.FieldProviderRequestID] = struct{}{}
+}
+
+// ProviderRequestIDCleared returns if the "provider_request_id" field was cleared in this mutation.
+func (m *NodeMutation) ProviderRequestIDCleared() bool {
+	_, ok := m.clearedFields[node.FieldProviderRequestID]
+	return ok
+}
+
+// ResetProviderRequestID resets all changes to the "provider_request_id" field.
+func (m *NodeMutation) ResetProviderRequestID() {
+	m.provider_request_id = nil
+	delete(m.clearedFields, node.FieldProviderRequestID)
+}
+
+// SetRateLimits sets the "rate_limits" field.
+func (m *NodeMutation) SetRateLimits(value map[string]string) {
+	m.rate_limits = &value
+}
+
+// RateLimits returns the value of the "rate_limits" field in the mutation.
+func (m *NodeMutation) RateLimits() (r map[string]string, exists bool) {
+	v := m.rate_limits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRateLimits returns the old "rate_limits" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldRateLimits(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRateLimits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRateLimits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRateLimits: %w", err)
+	}
+	return oldValue.RateLimits, nil
+}
+
+// ClearRateLimits clears the value of the "rate_limits" field.
+func (m *NodeMutation) ClearRateLimits() {
+	m.rate_limits = nil
+	m.clearedFields[node.FieldRateLimits] = struct{}{}
+}
+
+// RateLimitsCleared returns if the "rate_limits" field was cleared in this mutation.
+func (m *NodeMutation) RateLimitsCleared() bool {
+	_, ok := m.clearedFields[node.FieldRateLimits]
+	return ok
+}
+
+// ResetRateLimits resets all changes to the "rate_limits" field.
+func (m *NodeMutation) ResetRateLimits() {
+	m.rate_limits = nil
+	delete(m.clearedFields, node.FieldRateLimits)
+}
+
+// SetChoiceIndex sets the "choice_index" field.
+func (m *NodeMutation) SetChoiceIndex(i int) {
+	m.choice_index = &i
+	m.addchoice_index = nil
+}
+
+// ChoiceIndex returns the value of the "choice_index" field in the mutation.
+func (m *NodeMutation) ChoiceIndex() (r int, exists bool) {
+	v := m.choice_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoiceIndex returns the old "choice_index" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldChoiceIndex(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoiceIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoiceIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoiceIndex: %w", err)
+	}
+	return oldValue.ChoiceIndex, nil
+}
+
+// AddChoiceIndex adds i to the "choice_index" field.
+func (m *NodeMutation) AddChoiceIndex(i int) {
+	if m.addchoice_index != nil {
+		*m.addchoice_index += i
+	} else {
+		m.addchoice_index = &i
+	}
+}
+
+// AddedChoiceIndex returns the value that was added to the "choice_index" field in this mutation.
+func (m *NodeMutation) AddedChoiceIndex() (r int, exists bool) {
+	v := m.addchoice_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChoiceIndex clears the value of the "choice_index" field.
+func (m *NodeMutation) ClearChoiceIndex() {
+	m.choice_index = nil
+	m.addchoice_index = nil
+	m.clearedFields[node.FieldChoiceIndex] = struct{}{}
+}
+
+// ChoiceIndexCleared returns if the "choice_index" field was cleared in this mutation.
+func (m *NodeMutation) ChoiceIndexCleared() bool {
+	_, ok := m.clearedFields[node.FieldChoiceIndex]
+	return ok
+}
+
+// ResetChoiceIndex resets all changes to the "choice_index" field.
+func (m *NodeMutation) ResetChoiceIndex() {
+	m.choice_index = nil
+	m.addchoice_index = nil
+	delete(m.clearedFields, node.FieldChoiceIndex)
+}
+
+// SetContextKey sets the "context_key" field.
+func (m *NodeMutation) SetContextKey(s string) {
+	m.context_key = &s
+}
+
+// ContextKey returns the value of the "context_key" field in the mutation.
+func (m *NodeMutation) ContextKey() (r string, exists bool) {
+	v := m.context_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContextKey returns the old "context_key" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldContextKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContextKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContextKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContextKey: %w", err)
+	}
+	return oldValue.ContextKey, nil
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (m *NodeMutation) ClearContextKey() {
+	m.context_key = nil
+	m.clearedFields[node.FieldContextKey] = struct{}{}
+}
+
+// ContextKeyCleared returns if the "context_key" field was cleared in this mutation.
+func (m *NodeMutation) ContextKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldContextKey]
+	return ok
+}
+
+// ResetContextKey resets all changes to the "context_key" field.
+func (m *NodeMutation) ResetContextKey() {
+	m.context_key = nil
+	delete(m.clearedFields, node.FieldContextKey)
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (m *NodeMutation) SetCacheKey(s string) {
+	m.cache_key = &s
+}
+
+// CacheKey returns the value of the "cache_key" field in the mutation.
+func (m *NodeMutation) CacheKey() (r string, exists bool) {
+	v := m.cache_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheKey returns the old "cache_key" field's value of the Node entity.
 // If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldProject(ctx context.Context) (v *string, err error) {
+func (m *NodeMutation) OldCacheKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheKey: %w", err)
+	}
+	return oldValue.CacheKey, nil
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (m *NodeMutation) ClearCacheKey() {
+	m.cache_key = nil
+	m.clearedFields[node.FieldCacheKey] = struct{}{}
+}
+
+// CacheKeyCleared returns if the "cache_key" field was cleared in this mutation.
+func (m *NodeMutation) CacheKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldCacheKey]
+	return ok
+}
+
+// ResetCacheKey resets all changes to the "cache_key" field.
+func (m *NodeMutation) ResetCacheKey() {
+	m.cache_key = nil
+	delete(m.clearedFields, node.FieldCacheKey)
+}
+
+// SetCachedAt sets the "cached_at" field.
+func (m *NodeMutation) SetCachedAt(t time.Time) {
+	m.cached_at = &t
+}
+
+// CachedAt returns the value of the "cached_at" field in the mutation.
+func (m *NodeMutation) CachedAt() (r time.Time, exists bool) {
+	v := m.cached_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCachedAt returns the old "cached_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCachedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCachedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCachedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCachedAt: %w", err)
+	}
+	return oldValue.CachedAt, nil
+}
+
+// ClearCachedAt clears the value of the "cached_at" field.
+func (m *NodeMutation) ClearCachedAt() {
+	m.cached_at = nil
+	m.clearedFields[node.FieldCachedAt] = struct{}{}
+}
+
+// CachedAtCleared returns if the "cached_at" field was cleared in this mutation.
+func (m *NodeMutation) CachedAtCleared() bool {
+	_, ok := m.clearedFields[node.FieldCachedAt]
+	return ok
+}
+
+// ResetCachedAt resets all changes to the "cached_at" field.
+func (m *NodeMutation) ResetCachedAt() {
+	m.cached_at = nil
+	delete(m.clearedFields, node.FieldCachedAt)
+}
+
+// SetCacheHits sets the "cache_hits" field.
+func (m *NodeMutation) SetCacheHits(i int) {
+	m.cache_hits = &i
+	m.addcache_hits = nil
+}
+
+// CacheHits returns the value of the "cache_hits" field in the mutation.
+func (m *NodeMutation) CacheHits() (r int, exists bool) {
+	v := m.cache_hits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheHits returns the old "cache_hits" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCacheHits(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheHits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheHits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheHits: %w", err)
+	}
+	return oldValue.CacheHits, nil
+}
+
+// AddCacheHits adds i to the "cache_hits" field.
+func (m *NodeMutation) AddCacheHits(i int) {
+	if m.addcache_hits != nil {
+		*m.addcache_hits += i
+	} else {
+		m.addcache_hits = &i
+	}
+}
+
+// AddedCacheHits returns the value that was added to the "cache_hits" field in this mutation.
+func (m *NodeMutation) AddedCacheHits() (r int, exists bool) {
+	v := m.addcache_hits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheHits resets all changes to the "cache_hits" field.
+func (m *NodeMutation) ResetCacheHits() {
+	m.cache_hits = nil
+	m.addcache_hits = nil
+}
+
+// SetRootHash sets the "root_hash" field.
+func (m *NodeMutation) SetRootHash(s string) {
+	m.root_hash = &s
+}
+
+// RootHash returns the value of the "root_hash" field in the mutation.
+func (m *NodeMutation) RootHash() (r string, exists bool) {
+	v := m.root_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRootHash returns the old "root_hash" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldRootHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRootHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRootHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRootHash: %w", err)
+	}
+	return oldValue.RootHash, nil
+}
+
+// ClearRootHash clears the value of the "root_hash" field.
+func (m *NodeMutation) ClearRootHash() {
+	m.root_hash = nil
+	m.clearedFields[node.FieldRootHash] = struct{}{}
+}
+
+// RootHashCleared returns if the "root_hash" field was cleared in this mutation.
+func (m *NodeMutation) RootHashCleared() bool {
+	_, ok := m.clearedFields[node.FieldRootHash]
+	return ok
+}
+
+// ResetRootHash resets all changes to the "root_hash" field.
+func (m *NodeMutation) ResetRootHash() {
+	m.root_hash = nil
+	delete(m.clearedFields, node.FieldRootHash)
+}
+
+// SetDepth sets the "depth" field.
+func (m *NodeMutation) SetDepth(i int) {
+	m.depth = &i
+	m.adddepth = nil
+}
+
+// Depth returns the value of the "depth" field in the mutation.
+func (m *NodeMutation) Depth() (r int, exists bool) {
+	v := m.depth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepth returns the old "depth" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldDepth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepth: %w", err)
+	}
+	return oldValue.Depth, nil
+}
+
+// AddDepth adds i to the "depth" field.
+func (m *NodeMutation) AddDepth(i int) {
+	if m.adddepth != nil {
+		*m.adddepth += i
+	} else {
+		m.adddepth = &i
+	}
+}
+
+// AddedDepth returns the value that was added to the "depth" field in this mutation.
+func (m *NodeMutation) AddedDepth() (r int, exists bool) {
+	v := m.adddepth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDepth resets all changes to the "depth" field.
+func (m *NodeMutation) ResetDepth() {
+	m.depth = nil
+	m.adddepth = nil
+}
+
+// SetIsLeaf sets the "is_leaf" field.
+func (m *NodeMutation) SetIsLeaf(b bool) {
+	m.is_leaf = &b
+}
+
+// IsLeaf returns the value of the "is_leaf" field in the mutation.
+func (m *NodeMutation) IsLeaf() (r bool, exists bool) {
+	v := m.is_leaf
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLeaf returns the old "is_leaf" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldIsLeaf(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLeaf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLeaf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLeaf: %w", err)
+	}
+	return oldValue.IsLeaf, nil
+}
+
+// ResetIsLeaf resets all changes to the "is_leaf" field.
+func (m *NodeMutation) ResetIsLeaf() {
+	m.is_leaf = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetParentID sets the "parent" edge to the Node entity by id.
+func (m *NodeMutation) SetParentID(id string) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Node entity.
+func (m *NodeMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[node.FieldParentHash] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Node entity was cleared.
+func (m *NodeMutation) ParentCleared() bool {
+	return m.ParentHashCleared() || m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *NodeMutation) ParentID() (id string, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NodeMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *NodeMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Node entity by ids.
+func (m *NodeMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Node entity.
+func (m *NodeMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Node entity was cleared.
+func (m *NodeMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Node entity by IDs.
+func (m *NodeMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Node entity.
+func (m *NodeMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NodeMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *NodeMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the NodeMutation builder.
+func (m *NodeMutation) Where(ps ...predicate.Node) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Node).
+func (m *NodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeMutation) Fields() []string {
+	fields := make([]string, 0, 31)
+	if m.parent != nil {
+		fields = append(fields, node.FieldParentHash)
+	}
+	if m.bucket != nil {
+		fields = append(fields, node.FieldBucket)
+	}
+	if m._type != nil {
+		fields = append(fields, node.FieldType)
+	}
+	if m.role != nil {
+		fields = append(fields, node.FieldRole)
+	}
+	if m.content != nil {
+		fields = append(fields, node.FieldContent)
+	}
+	if m.model != nil {
+		fields = append(fields, node.FieldModel)
+	}
+	if m.provider != nil {
+		fields = append(fields, node.FieldProvider)
+	}
+	if m.agent_name != nil {
+		fields = append(fields, node.FieldAgentName)
+	}
+	if m.stop_reason != nil {
+		fields = append(fields, node.FieldStopReason)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, node.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, node.FieldCompletionTokens)
+	}
+	if m.total_tokens != nil {
+		fields = append(fields, node.FieldTotalTokens)
+	}
+	if m.cache_creation_input_tokens != nil {
+		fields = append(fields, node.FieldCacheCreationInputTokens)
+	}
+	if m.cache_read_input_tokens != nil {
+		fields = append(fields, node.FieldCacheReadInputTokens)
+	}
+	if m.total_duration_ns != nil {
+		fields = append(fields, node.FieldTotalDurationNs)
+	}
+	if m.prompt_duration_ns != nil {
+		fields = append(fields, node.FieldPromptDurationNs)
+	}
+	if m.project != nil {
+		fields = append(fields, node.FieldProject)
+	}
+	if m.requested_model != nil {
+		fields = append(fields, node.FieldRequestedModel)
+	}
+	if m.upstream != nil {
+		fields = append(fields, node.FieldUpstream)
+	}
+	if m.generation_params != nil {
+		fields = append(fields, node.FieldGenerationParams)
+	}
+	if m.provider_request_id != nil {
+		fields = append(fields, node.FieldProviderRequestID)
+	}
+	if m.rate_limits != nil {
+		fields = append(fields, node.FieldRateLimits)
+	}
+	if m.choice_index != nil {
+		fields = append(fields, node.FieldChoiceIndex)
+	}
+	if m.context_key != nil {
+		fields = append(fields, node.FieldContextKey)
+	}
+	if m.cache_key != nil {
+		fields = append(fields, node.FieldCacheKey)
+	}
+	if m.cached_at != nil {
+		fields = append(fields, node.FieldCachedAt)
+	}
+	if m.cache_hits != nil {
+		fields = append(fields, node.FieldCacheHits)
+	}
+	if m.root_hash != nil {
+		fields = append(fields, node.FieldRootHash)
+	}
+	if m.depth != nil {
+		fields = append(fields, node.FieldDepth)
+	}
+	if m.is_leaf != nil {
+		fields = append(fields, node.FieldIsLeaf)
+	}
+	if m.created_at != nil {
+		fields = append(fields, node.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldParentHash:
+		return m.ParentHash()
+	case node.FieldBucket:
+		return m.Bucket()
+	case node.FieldType:
+		return m.GetType()
+	case node.FieldRole:
+		return m.Role()
+	case node.FieldContent:
+		return m.Content()
+	case node.FieldModel:
+		return m.Model()
+	case node.FieldProvider:
+		return m.Provider()
+	case node.FieldAgentName:
+		return m.AgentName()
+	case node.FieldStopReason:
+		return m.StopReason()
+	case node.FieldPromptTokens:
+		return m.PromptTokens()
+	case node.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case node.FieldTotalTokens:
+		return m.TotalTokens()
+	case node.FieldCacheCreationInputTokens:
+		return m.CacheCreationInputTokens()
+	case node.FieldCacheReadInputTokens:
+		return m.CacheReadInputTokens()
+	case node.FieldTotalDurationNs:
+		return m.TotalDurationNs()
+	case node.FieldPromptDurationNs:
+		return m.PromptDurationNs()
+	case node.FieldProject:
+		return m.Project()
+	case node.FieldRequestedModel:
+		return m.RequestedModel()
+	case node.FieldUpstream:
+		return m.Upstream()
+	case node.FieldGenerationParams:
+		return m.GenerationParams()
+	case node.FieldProviderRequestID:
+		return m.ProviderRequestID()
+	case node.FieldRateLimits:
+		return m.RateLimits()
+	case node.FieldChoiceIndex:
+		return m.ChoiceIndex()
+	case node.FieldContextKey:
+		return m.ContextKey()
+	case node.FieldCacheKey:
+		return m.CacheKey()
+	case node.FieldCachedAt:
+		return m.CachedAt()
+	case node.FieldCacheHits:
+		return m.CacheHits()
+	case node.FieldRootHash:
+		return m.RootHash()
+	case node.FieldDepth:
+		return m.Depth()
+	case node.FieldIsLeaf:
+		return m.IsLeaf()
+	case node.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case node.FieldParentHash:
+		return m.OldParentHash(ctx)
+	case node.FieldBucket:
+		return m.OldBucket(ctx)
+	case node.FieldType:
+		return m.OldType(ctx)
+	case node.FieldRole:
+		return m.OldRole(ctx)
+	case node.FieldContent:
+		return m.OldContent(ctx)
+	case node.FieldModel:
+		return m.OldModel(ctx)
+	case node.FieldProvider:
+		return m.OldProvider(ctx)
+	case node.FieldAgentName:
+		return m.OldAgentName(ctx)
+	case node.FieldStopReason:
+		return m.OldStopReason(ctx)
+	case node.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case node.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case node.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case node.FieldCacheCreationInputTokens:
+		return m.OldCacheCreationInputTokens(ctx)
+	case node.FieldCacheReadInputTokens:
+		return m.OldCacheReadInputTokens(ctx)
+	case node.FieldTotalDurationNs:
+		return m.OldTotalDurationNs(ctx)
+	case node.FieldPromptDurationNs:
+		return m.OldPromptDurationNs(ctx)
+	case node.FieldProject:
+		return m.OldProject(ctx)
+	case node.FieldRequestedModel:
+		return m.OldRequestedModel(ctx)
+	case node.FieldUpstream:
+		return m.OldUpstream(ctx)
+	case node.FieldGenerationParams:
+		return m.OldGenerationParams(ctx)
+	case node.FieldProviderRequestID:
+		return m.OldProviderRequestID(ctx)
+	case node.FieldRateLimits:
+		return m.OldRateLimits(ctx)
+	case node.FieldChoiceIndex:
+		return m.OldChoiceIndex(ctx)
+	case node.FieldContextKey:
+		return m.OldContextKey(ctx)
+	case node.FieldCacheKey:
+		return m.OldCacheKey(ctx)
+	case node.FieldCachedAt:
+		return m.OldCachedAt(ctx)
+	case node.FieldCacheHits:
+		return m.OldCacheHits(ctx)
+	case node.FieldRootHash:
+		return m.OldRootHash(ctx)
+	case node.FieldDepth:
+		return m.OldDepth(ctx)
+	case node.FieldIsLeaf:
+		return m.OldIsLeaf(ctx)
+	case node.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Node field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case node.FieldParentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentHash(v)
+		return nil
+	case node.FieldBucket:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucket(v)
+		return nil
+	case node.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case node.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case node.FieldContent:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case node.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case node.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case node.FieldAgentName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentName(v)
+		return nil
+	case node.FieldStopReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStopReason(v)
+		return nil
+	case node.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case node.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case node.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
+	case node.FieldCacheCreationInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheCreationInputTokens(v)
+		return nil
+	case node.FieldCacheReadInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadInputTokens(v)
+		return nil
+	case node.FieldTotalDurationNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDurationNs(v)
+		return nil
+	case node.FieldPromptDurationNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptDurationNs(v)
+		return nil
+	case node.FieldProject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProject(v)
+		return nil
+	case node.FieldRequestedModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedModel(v)
+		return nil
+	case node.FieldUpstream:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpstream(v)
+		return nil
+	case node.FieldGenerationParams:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenerationParams(v)
+		return nil
+	case node.FieldProviderRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderRequestID(v)
+		return nil
+	case node.FieldRateLimits:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRateLimits(v)
+		return nil
+	case node.FieldChoiceIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoiceIndex(v)
+		return nil
+	case node.FieldContextKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContextKey(v)
+		return nil
+	case node.FieldCacheKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheKey(v)
+		return nil
+	case node.FieldCachedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCachedAt(v)
+		return nil
+	case node.FieldCacheHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheHits(v)
+		return nil
+	case node.FieldRootHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootHash(v)
+		return nil
+	case node.FieldDepth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepth(v)
+		return nil
+	case node.FieldIsLeaf:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLeaf(v)
+		return nil
+	case node.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addprompt_tokens != nil {
+		fields = append(fields, node.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, node.FieldCompletionTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, node.FieldTotalTokens)
+	}
+	if m.addcache_creation_input_tokens != nil {
+		fields = append(fields, node.FieldCacheCreationInputTokens)
+	}
+	if m.addcache_read_input_tokens != nil {
+		fields = append(fields, node.FieldCacheReadInputTokens)
+	}
+	if m.addtotal_duration_ns != nil {
+		fields = append(fields, node.FieldTotalDurationNs)
+	}
+	if m.addprompt_duration_ns != nil {
+		fields = append(fields, node.FieldPromptDurationNs)
+	}
+	if m.addchoice_index != nil {
+		fields = append(fields, node.FieldChoiceIndex)
+	}
+	if m.addcache_hits != nil {
+		fields = append(fields, node.FieldCacheHits)
+	}
+	if m.adddepth != nil {
+		fields = append(fields, node.FieldDepth)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case node.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case node.FieldTotalTokens:
+		return m.AddedTotalTokens()
+	case node.FieldCacheCreationInputTokens:
+		return m.AddedCacheCreationInputTokens()
+	case node.FieldCacheReadInputTokens:
+		return m.AddedCacheReadInputTokens()
+	case node.FieldTotalDurationNs:
+		return m.AddedTotalDurationNs()
+	case node.FieldPromptDurationNs:
+		return m.AddedPromptDurationNs()
+	case node.FieldChoiceIndex:
+		return m.AddedChoiceIndex()
+	case node.FieldCacheHits:
+		return m.AddedCacheHits()
+	case node.FieldDepth:
+		return m.AddedDepth()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case node.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case node.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case node.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTokens(v)
+		return nil
+	case node.FieldCacheCreationInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheCreationInputTokens(v)
+		return nil
+	case node.FieldCacheReadInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadInputTokens(v)
+		return nil
+	case node.FieldTotalDurationNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDurationNs(v)
+		return nil
+	case node.FieldPromptDurationNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptDurationNs(v)
+		return nil
+	case node.FieldChoiceIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChoiceIndex(v)
+		return nil
+	case node.FieldCacheHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheHits(v)
+		return nil
+	case node.FieldDepth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepth(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Node numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(node.FieldParentHash) {
+		fields = append(fields, node.FieldParentHash)
+	}
+	if m.FieldCleared(node.FieldBucket) {
+		fields = append(fields, node.FieldBucket)
+	}
+	if m.FieldCleared(node.FieldType) {
+		fields = append(fields, node.FieldType)
+	}
+	if m.FieldCleared(node.FieldRole) {
+		fields = append(fields, node.FieldRole)
+	}
+	if m.FieldCleared(node.FieldContent) {
+		fields = append(fields, node.FieldContent)
+	}
+	if m.FieldCleared(node.FieldModel) {
+		fields = append(fields, node.FieldModel)
+	}
+	if m.FieldCleared(node.FieldProvider) {
+		fields = append(fields, node.FieldProvider)
+	}
+	if m.FieldCleared(node.FieldAgentName) {
+		fields = append(fields, node.FieldAgentName)
+	}
+	if m.FieldCleared(node.FieldStopReason) {
+		fields = append(fields, node.FieldStopReason)
+	}
+	if m.FieldCleared(node.FieldPromptTokens) {
+		fields = append(fields, node.FieldPromptTokens)
+	}
+	if m.FieldCleared(node.FieldCompletionTokens) {
+		fields = append(fields, node.FieldCompletionTokens)
+	}
+	if m.FieldCleared(node.FieldTotalTokens) {
+		fields = append(fields, node.FieldTotalTokens)
+	}
+	if m.FieldCleared(node.FieldCacheCreationInputTokens) {
+		fields = append(fields, node.FieldCacheCreationInputTokens)
+	}
+	if m.FieldCleared(node.FieldCacheReadInputTokens) {
+		fields = append(fields, node.FieldCacheReadInputTokens)
+	}
+	if m.FieldCleared(node.FieldTotalDurationNs) {
+		fields = append(fields, node.FieldTotalDurationNs)
+	}
+	if m.FieldCleared(node.FieldPromptDurationNs) {
+		fields = append(fields, node.FieldPromptDurationNs)
+	}
+	if m.FieldCleared(node.FieldProject) {
+		fields = append(fields, node.FieldProject)
+	}
+	if m.FieldCleared(node.FieldRequestedModel) {
+		fields = append(fields, node.FieldRequestedModel)
+	}
+	if m.FieldCleared(node.FieldUpstream) {
+		fields = append(fields, node.FieldUpstream)
+	}
+	if m.FieldCleared(node.FieldGenerationParams) {
+		fields = append(fields, node.FieldGenerationParams)
+	}
+	if m.FieldCleared(node.FieldProviderRequestID) {
+		fields = append(fields, node.FieldProviderRequestID)
+	}
+	if m.FieldCleared(node.FieldRateLimits) {
+		fields = append(fields, node.FieldRateLimits)
+	}
+	if m.FieldCleared(node.FieldChoiceIndex) {
+		fields = append(fields, node.FieldChoiceIndex)
+	}
+	if m.FieldCleared(node.FieldContextKey) {
+		fields = append(fields, node.FieldContextKey)
+	}
+	if m.FieldCleared(node.FieldCacheKey) {
+		fields = append(fields, node.FieldCacheKey)
+	}
+	if m.FieldCleared(node.FieldCachedAt) {
+		fields = append(fields, node.FieldCachedAt)
+	}
+	if m.FieldCleared(node.FieldRootHash) {
+		fields = append(fields, node.FieldRootHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeMutation) ClearField(name string) error {
+	switch name {
+	case node.FieldParentHash:
+		m.ClearParentHash()
+		return nil
+	case node.FieldBucket:
+		m.ClearBucket()
+		return nil
+	case node.FieldType:
+		m.ClearType()
+		return nil
+	case node.FieldRole:
+		m.ClearRole()
+		return nil
+	case node.FieldContent:
+		m.ClearContent()
+		return nil
+	case node.FieldModel:
+		m.ClearModel()
+		return nil
+	case node.FieldProvider:
+		m.ClearProvider()
+		return nil
+	case node.FieldAgentName:
+		m.ClearAgentName()
+		return nil
+	case node.FieldStopReason:
+		m.ClearStopReason()
+		return nil
+	case node.FieldPromptTokens:
+		m.ClearPromptTokens()
+		return nil
+	case node.FieldCompletionTokens:
+		m.ClearCompletionTokens()
+		return nil
+	case node.FieldTotalTokens:
+		m.ClearTotalTokens()
+		return nil
+	case node.FieldCacheCreationInputTokens:
+		m.ClearCacheCreationInputTokens()
+		return nil
+	case node.FieldCacheReadInputTokens:
+		m.ClearCacheReadInputTokens()
+		return nil
+	case node.FieldTotalDurationNs:
+		m.ClearTotalDurationNs()
+		return nil
+	case node.FieldPromptDurationNs:
+		m.ClearPromptDurationNs()
+		return nil
+	case node.FieldProject:
+		m.ClearProject()
+		return nil
+	case node.FieldRequestedModel:
+		m.ClearRequestedModel()
+		return nil
+	case node.FieldUpstream:
+		m.ClearUpstream()
+		return nil
+	case node.FieldGenerationParams:
+		m.ClearGenerationParams()
+		return nil
+	case node.FieldProviderRequestID:
+		m.ClearProviderRequestID()
+		return nil
+	case node.FieldRateLimits:
+		m.ClearRateLimits()
+		return nil
+	case node.FieldChoiceIndex:
+		m.ClearChoiceIndex()
+		return nil
+	case node.FieldContextKey:
+		m.ClearContextKey()
+		return nil
+	case node.FieldCacheKey:
+		m.ClearCacheKey()
+		return nil
+	case node.FieldCachedAt:
+		m.ClearCachedAt()
+		return nil
+	case node.FieldRootHash:
+		m.ClearRootHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Node nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeMutation) ResetField(name string) error {
+	switch name {
+	case node.FieldParentHash:
+		m.ResetParentHash()
+		return nil
+	case node.FieldBucket:
+		m.ResetBucket()
+		return nil
+	case node.FieldType:
+		m.ResetType()
+		return nil
+	case node.FieldRole:
+		m.ResetRole()
+		return nil
+	case node.FieldContent:
+		m.ResetContent()
+		return nil
+	case node.FieldModel:
+		m.ResetModel()
+		return nil
+	case node.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case node.FieldAgentName:
+		m.ResetAgentName()
+		return nil
+	case node.FieldStopReason:
+		m.ResetStopReason()
+		return nil
+	case node.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case node.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case node.FieldTotalTokens:
+		m.ResetTotalTokens()
+		return nil
+	case node.FieldCacheCreationInputTokens:
+		m.ResetCacheCreationInputTokens()
+		return nil
+	case node.FieldCacheReadInputTokens:
+		m.ResetCacheReadInputTokens()
+		return nil
+	case node.FieldTotalDurationNs:
+		m.ResetTotalDurationNs()
+		return nil
+	case node.FieldPromptDurationNs:
+		m.ResetPromptDurationNs()
+		return nil
+	case node.FieldProject:
+		m.ResetProject()
+		return nil
+	case node.FieldRequestedModel:
+		m.ResetRequestedModel()
+		return nil
+	case node.FieldUpstream:
+		m.ResetUpstream()
+		return nil
+	case node.FieldGenerationParams:
+		m.ResetGenerationParams()
+		return nil
+	case node.FieldProviderRequestID:
+		m.ResetProviderRequestID()
+		return nil
+	case node.FieldRateLimits:
+		m.ResetRateLimits()
+		return nil
+	case node.FieldChoiceIndex:
+		m.ResetChoiceIndex()
+		return nil
+	case node.FieldContextKey:
+		m.ResetContextKey()
+		return nil
+	case node.FieldCacheKey:
+		m.ResetCacheKey()
+		return nil
+	case node.FieldCachedAt:
+		m.ResetCachedAt()
+		return nil
+	case node.FieldCacheHits:
+		m.ResetCacheHits()
+		return nil
+	case node.FieldRootHash:
+		m.ResetRootHash()
+		return nil
+	case node.FieldDepth:
+		m.ResetDepth()
+		return nil
+	case node.FieldIsLeaf:
+		m.ResetIsLeaf()
+		return nil
+	case node.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, node.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, node.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case node.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case node.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchildren != nil {
+		edges = append(edges, node.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case node.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, node.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, node.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case node.EdgeParent:
+		return m.clearedparent
+	case node.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeMutation) ClearEdge(name string) error {
+	switch name {
+	case node.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Node unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeMutation) ResetEdge(name string) error {
+	switch name {
+	case node.EdgeParent:
+		m.ResetParent()
+		return nil
+	case node.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Node edge %s", name)
+}
+
+// NodeBlobMutation represents an operation that mutates the NodeBlob nodes in the graph.
+type NodeBlobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	node_hash     *string
+	blob_hash     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NodeBlob, error)
+	predicates    []predicate.NodeBlob
+}
+
+var _ ent.Mutation = (*NodeBlobMutation)(nil)
+
+// nodeblobOption allows management of the mutation configuration using functional options.
+type nodeblobOption func(*NodeBlobMutation)
+
+// newNodeBlobMutation creates new mutation for the NodeBlob entity.
+func newNodeBlobMutation(c config, op Op, opts ...nodeblobOption) *NodeBlobMutation {
+	m := &NodeBlobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNodeBlob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeBlobID sets the ID field of the mutation.
+func withNodeBlobID(id int) nodeblobOption {
+	return func(m *NodeBlobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NodeBlob
+		)
+		m.oldValue = func(ctx context.Context) (*NodeBlob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NodeBlob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNodeBlob sets the old NodeBlob of the mutation.
+func withNodeBlob(node *NodeBlob) nodeblobOption {
+	return func(m *NodeBlobMutation) {
+		m.oldValue = func(context.Context) (*NodeBlob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeBlobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeBlobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeBlobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeBlobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NodeBlob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (m *NodeBlobMutation) SetNodeHash(s string) {
+	m.node_hash = &s
+}
+
+// NodeHash returns the value of the "node_hash" field in the mutation.
+func (m *NodeBlobMutation) NodeHash() (r string, exists bool) {
+	v := m.node_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeHash returns the old "node_hash" field's value of the NodeBlob entity.
+// If the NodeBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeBlobMutation) OldNodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeHash: %w", err)
+	}
+	return oldValue.NodeHash, nil
+}
+
+// ResetNodeHash resets all changes to the "node_hash" field.
+func (m *NodeBlobMutation) ResetNodeHash() {
+	m.node_hash = nil
+}
+
+// SetBlobHash sets the "blob_hash" field.
+func (m *NodeBlobMutation) SetBlobHash(s string) {
+	m.blob_hash = &s
+}
+
+// BlobHash returns the value of the "blob_hash" field in the mutation.
+func (m *NodeBlobMutation) BlobHash() (r string, exists bool) {
+	v := m.blob_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlobHash returns the old "blob_hash" field's value of the NodeBlob entity.
+// If the NodeBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeBlobMutation) OldBlobHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlobHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlobHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlobHash: %w", err)
+	}
+	return oldValue.BlobHash, nil
+}
+
+// ResetBlobHash resets all changes to the "blob_hash" field.
+func (m *NodeBlobMutation) ResetBlobHash() {
+	m.blob_hash = nil
+}
+
+// Where appends a list predicates to the NodeBlobMutation builder.
+func (m *NodeBlobMutation) Where(ps ...predicate.NodeBlob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeBlobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeBlobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NodeBlob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeBlobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeBlobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NodeBlob).
+func (m *NodeBlobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeBlobMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.node_hash != nil {
+		fields = append(fields, nodeblob.FieldNodeHash)
+	}
+	if m.blob_hash != nil {
+		fields = append(fields, nodeblob.FieldBlobHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeBlobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nodeblob.FieldNodeHash:
+		return m.NodeHash()
+	case nodeblob.FieldBlobHash:
+		return m.BlobHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeBlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nodeblob.FieldNodeHash:
+		return m.OldNodeHash(ctx)
+	case nodeblob.FieldBlobHash:
+		return m.OldBlobHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown NodeBlob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeBlobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nodeblob.FieldNodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeHash(v)
+		return nil
+	case nodeblob.FieldBlobHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlobHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NodeBlob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeBlobMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeBlobMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeBlobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NodeBlob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeBlobMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeBlobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeBlobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NodeBlob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeBlobMutation) ResetField(name string) error {
+	switch name {
+	case nodeblob.FieldNodeHash:
+		m.ResetNodeHash()
+		return nil
+	case nodeblob.FieldBlobHash:
+		m.ResetBlobHash()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeBlob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeBlobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeBlobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeBlobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeBlobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeBlobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeBlobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeBlobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NodeBlob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeBlobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NodeBlob edge %s", name)
+}
+
+// NodeLinkMutation represents an operation that mutates the NodeLink nodes in the graph.
+type NodeLinkMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	from_hash     *string
+	to_hash       *string
+	kind          *string
+	reason        *string
+	tool_use_id   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NodeLink, error)
+	predicates    []predicate.NodeLink
+}
+
+var _ ent.Mutation = (*NodeLinkMutation)(nil)
+
+// nodelinkOption allows management of the mutation configuration using functional options.
+type nodelinkOption func(*NodeLinkMutation)
+
+// newNodeLinkMutation creates new mutation for the NodeLink entity.
+func newNodeLinkMutation(c config, op Op, opts ...nodelinkOption) *NodeLinkMutation {
+	m := &NodeLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNodeLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeLinkID sets the ID field of the mutation.
+func withNodeLinkID(id int) nodelinkOption {
+	return func(m *NodeLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NodeLink
+		)
+		m.oldValue = func(ctx context.Context) (*NodeLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NodeLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNodeLink sets the old NodeLink of the mutation.
+func withNodeLink(node *NodeLink) nodelinkOption {
+	return func(m *NodeLinkMutation) {
+		m.oldValue = func(context.Context) (*NodeLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeLinkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeLinkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NodeLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFromHash sets the "from_hash" field.
+func (m *NodeLinkMutation) SetFromHash(s string) {
+	m.from_hash = &s
+}
+
+// FromHash returns the value of the "from_hash" field in the mutation.
+func (m *NodeLinkMutation) FromHash() (r string, exists bool) {
+	v := m.from_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromHash returns the old "from_hash" field's value of the NodeLink entity.
+// If the NodeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeLinkMutation) OldFromHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromHash: %w", err)
+	}
+	return oldValue.FromHash, nil
+}
+
+// ResetFromHash resets all changes to the "from_hash" field.
+func (m *NodeLinkMutation) ResetFromHash() {
+	m.from_hash = nil
+}
+
+// SetToHash sets the "to_hash" field.
+func (m *NodeLinkMutation) SetToHash(s string) {
+	m.to_hash = &s
+}
+
+// ToHash returns the value of the "to_hash" field in the mutation.
+func (m *NodeLinkMutation) ToHash() (r string, exists bool) {
+	v := m.to_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToHash returns the old "to_hash" field's value of the NodeLink entity.
+// If the NodeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeLinkMutation) OldToHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToHash: %w", err)
+	}
+	return oldValue.ToHash, nil
+}
+
+// ResetToHash resets all changes to the "to_hash" field.
+func (m *NodeLinkMutation) ResetToHash() {
+	m.to_hash = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *NodeLinkMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NodeLinkMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the NodeLink entity.
+// If the NodeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeLinkMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NodeLinkMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *NodeLinkMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *NodeLinkMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the NodeLink entity.
+// If the NodeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeLinkMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *NodeLinkMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[nodelink.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *NodeLinkMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[nodelink.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *NodeLinkMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, nodelink.FieldReason)
+}
+
+// SetToolUseID sets the "tool_use_id" field.
+func (m *NodeLinkMutation) SetToolUseID(s string) {
+	m.tool_use_id = &s
+}
+
+// ToolUseID returns the value of the "tool_use_id" field in the mutation.
+func (m *NodeLinkMutation) ToolUseID() (r string, exists bool) {
+	v := m.tool_use_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToolUseID returns the old "tool_use_id" field's value of the NodeLink entity.
+// If the NodeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeLinkMutation) OldToolUseID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToolUseID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToolUseID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToolUseID: %w", err)
+	}
+	return oldValue.ToolUseID, nil
+}
+
+// ClearToolUseID clears the value of the "tool_use_id" field.
+func (m *NodeLinkMutation) ClearToolUseID() {
+	m.tool_use_id = nil
+	m.clearedFields[nodelink.FieldToolUseID] = struct{}{}
+}
+
+// ToolUseIDCleared returns if the "tool_use_id" field was cleared in this mutation.
+func (m *NodeLinkMutation) ToolUseIDCleared() bool {
+	_, ok := m.clearedFields[nodelink.FieldToolUseID]
+	return ok
+}
+
+// ResetToolUseID resets all changes to the "tool_use_id" field.
+func (m *NodeLinkMutation) ResetToolUseID() {
+	m.tool_use_id = nil
+	delete(m.clearedFields, nodelink.FieldToolUseID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NodeLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NodeLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NodeLink entity.
+// If the NodeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NodeLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the NodeLinkMutation builder.
+func (m *NodeLinkMutation) Where(ps ...predicate.NodeLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NodeLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NodeLink).
+func (m *NodeLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeLinkMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.from_hash != nil {
+		fields = append(fields, nodelink.FieldFromHash)
+	}
+	if m.to_hash != nil {
+		fields = append(fields, nodelink.FieldToHash)
+	}
+	if m.kind != nil {
+		fields = append(fields, nodelink.FieldKind)
+	}
+	if m.reason != nil {
+		fields = append(fields, nodelink.FieldReason)
+	}
+	if m.tool_use_id != nil {
+		fields = append(fields, nodelink.FieldToolUseID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, nodelink.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nodelink.FieldFromHash:
+		return m.FromHash()
+	case nodelink.FieldToHash:
+		return m.ToHash()
+	case nodelink.FieldKind:
+		return m.Kind()
+	case nodelink.FieldReason:
+		return m.Reason()
+	case nodelink.FieldToolUseID:
+		return m.ToolUseID()
+	case nodelink.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nodelink.FieldFromHash:
+		return m.OldFromHash(ctx)
+	case nodelink.FieldToHash:
+		return m.OldToHash(ctx)
+	case nodelink.FieldKind:
+		return m.OldKind(ctx)
+	case nodelink.FieldReason:
+		return m.OldReason(ctx)
+	case nodelink.FieldToolUseID:
+		return m.OldToolUseID(ctx)
+	case nodelink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NodeLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nodelink.FieldFromHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromHash(v)
+		return nil
+	case nodelink.FieldToHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToHash(v)
+		return nil
+	case nodelink.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case nodelink.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case nodelink.FieldToolUseID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToolUseID(v)
+		return nil
+	case nodelink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NodeLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeLinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeLinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NodeLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nodelink.FieldReason) {
+		fields = append(fields, nodelink.FieldReason)
+	}
+	if m.FieldCleared(nodelink.FieldToolUseID) {
+		fields = append(fields, nodelink.FieldToolUseID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeLinkMutation) ClearField(name string) error {
+	switch name {
+	case nodelink.FieldReason:
+		m.ClearReason()
+		return nil
+	case nodelink.FieldToolUseID:
+		m.ClearToolUseID()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeLinkMutation) ResetField(name string) error {
+	switch name {
+	case nodelink.FieldFromHash:
+		m.ResetFromHash()
+		return nil
+	case nodelink.FieldToHash:
+		m.ResetToHash()
+		return nil
+	case nodelink.FieldKind:
+		m.ResetKind()
+		return nil
+	case nodelink.FieldReason:
+		m.ResetReason()
+		return nil
+	case nodelink.FieldToolUseID:
+		m.ResetToolUseID()
+		return nil
+	case nodelink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeLinkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeLinkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeLinkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NodeLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeLinkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NodeLink edge %s", name)
+}
+
+// NodeMetadataMutation represents an operation that mutates the NodeMetadata nodes in the graph.
+type NodeMetadataMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	node_hash     *string
+	key           *string
+	value         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NodeMetadata, error)
+	predicates    []predicate.NodeMetadata
+}
+
+var _ ent.Mutation = (*NodeMetadataMutation)(nil)
+
+// nodemetadataOption allows management of the mutation configuration using functional options.
+type nodemetadataOption func(*NodeMetadataMutation)
+
+// newNodeMetadataMutation creates new mutation for the NodeMetadata entity.
+func newNodeMetadataMutation(c config, op Op, opts ...nodemetadataOption) *NodeMetadataMutation {
+	m := &NodeMetadataMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNodeMetadata,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeMetadataID sets the ID field of the mutation.
+func withNodeMetadataID(id int) nodemetadataOption {
+	return func(m *NodeMetadataMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NodeMetadata
+		)
+		m.oldValue = func(ctx context.Context) (*NodeMetadata, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NodeMetadata.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNodeMetadata sets the old NodeMetadata of the mutation.
+func withNodeMetadata(node *NodeMetadata) nodemetadataOption {
+	return func(m *NodeMetadataMutation) {
+		m.oldValue = func(context.Context) (*NodeMetadata, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeMetadataMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeMetadataMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeMetadataMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeMetadataMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NodeMetadata.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (m *NodeMetadataMutation) SetNodeHash(s string) {
+	m.node_hash = &s
+}
+
+// NodeHash returns the value of the "node_hash" field in the mutation.
+func (m *NodeMetadataMutation) NodeHash() (r string, exists bool) {
+	v := m.node_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeHash returns the old "node_hash" field's value of the NodeMetadata entity.
+// If the NodeMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMetadataMutation) OldNodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeHash: %w", err)
+	}
+	return oldValue.NodeHash, nil
+}
+
+// ResetNodeHash resets all changes to the "node_hash" field.
+func (m *NodeMetadataMutation) ResetNodeHash() {
+	m.node_hash = nil
+}
+
+// SetKey sets the "key" field.
+func (m *NodeMetadataMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *NodeMetadataMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the NodeMetadata entity.
+// If the NodeMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMetadataMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *NodeMetadataMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *NodeMetadataMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *NodeMetadataMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the NodeMetadata entity.
+// If the NodeMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMetadataMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *NodeMetadataMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NodeMetadataMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NodeMetadataMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NodeMetadata entity.
+// If the NodeMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NodeMetadataMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the NodeMetadataMutation builder.
+func (m *NodeMetadataMutation) Where(ps ...predicate.NodeMetadata) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeMetadataMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NodeMetadata, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeMetadataMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeMetadataMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NodeMetadata).
+func (m *NodeMetadataMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeMetadataMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.node_hash != nil {
+		fields = append(fields, nodemetadata.FieldNodeHash)
+	}
+	if m.key != nil {
+		fields = append(fields, nodemetadata.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, nodemetadata.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, nodemetadata.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeMetadataMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nodemetadata.FieldNodeHash:
+		return m.NodeHash()
+	case nodemetadata.FieldKey:
+		return m.Key()
+	case nodemetadata.FieldValue:
+		return m.Value()
+	case nodemetadata.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeMetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nodemetadata.FieldNodeHash:
+		return m.OldNodeHash(ctx)
+	case nodemetadata.FieldKey:
+		return m.OldKey(ctx)
+	case nodemetadata.FieldValue:
+		return m.OldValue(ctx)
+	case nodemetadata.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NodeMetadata field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMetadataMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nodemetadata.FieldNodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeHash(v)
+		return nil
+	case nodemetadata.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case nodemetadata.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case nodemetadata.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NodeMetadata field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeMetadataMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeMetadataMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMetadataMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NodeMetadata numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeMetadataMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeMetadataMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeMetadataMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NodeMetadata nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeMetadataMutation) ResetField(name string) error {
+	switch name {
+	case nodemetadata.FieldNodeHash:
+		m.ResetNodeHash()
+		return nil
+	case nodemetadata.FieldKey:
+		m.ResetKey()
+		return nil
+	case nodemetadata.FieldValue:
+		m.ResetValue()
+		return nil
+	case nodemetadata.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NodeMetadata field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeMetadataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeMetadataMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeMetadataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeMetadataMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeMetadataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeMetadataMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeMetadataMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NodeMetadata unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeMetadataMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NodeMetadata edge %s", name)
+}
+
+// RefMutation represents an operation that mutates the Ref nodes in the graph.
+type RefMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	hash          *string
+	kind          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Ref, error)
+	predicates    []predicate.Ref
+}
+
+var _ ent.Mutation = (*RefMutation)(nil)
+
+// refOption allows management of the mutation configuration using functional options.
+type refOption func(*RefMutation)
+
+// newRefMutation creates new mutation for the Ref entity.
+func newRefMutation(c config, op Op, opts ...refOption) *RefMutation {
+	m := &RefMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRef,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefID sets the ID field of the mutation.
+func withRefID(id string) refOption {
+	return func(m *RefMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Ref
+		)
+		m.oldValue = func(ctx context.Context) (*Ref, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Ref.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRef sets the old Ref of the mutation.
+func withRef(node *Ref) refOption {
+	return func(m *RefMutation) {
+		m.oldValue = func(context.Context) (*Ref, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Ref entities.
+func (m *RefMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Ref.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *RefMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *RefMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Ref entity.
+// If the Ref object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProject is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *RefMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *RefMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *RefMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Ref entity.
+// If the Ref object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *RefMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Ref entity.
+// If the Ref object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RefMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RefMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Ref entity.
+// If the Ref object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RefMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RefMutation builder.
+func (m *RefMutation) Where(ps ...predicate.Ref) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ref, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProject requires an ID field in the mutation")
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Ref).
+func (m *RefMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.hash != nil {
+		fields = append(fields, ref.FieldHash)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProject: %w", err)
+	if m.kind != nil {
+		fields = append(fields, ref.FieldKind)
 	}
-	return oldValue.Project, nil
+	if m.created_at != nil {
+		fields = append(fields, ref.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ref.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearProject clears the value of the "project" field.
-func (m *NodeMutation) ClearProject() {
-	m.project = nil
-	m.clearedFields[node.FieldProject] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ref.FieldHash:
+		return m.Hash()
+	case ref.FieldKind:
+		return m.Kind()
+	case ref.FieldCreatedAt:
+		return m.CreatedAt()
+	case ref.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// ProjectCleared returns if the "project" field was cleared in this mutation.
-func (m *NodeMutation) ProjectCleared() bool {
-	_, ok := m.clearedFields[node.FieldProject]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ref.FieldHash:
+		return m.OldHash(ctx)
+	case ref.FieldKind:
+		return m.OldKind(ctx)
+	case ref.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ref.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ref field %s", name)
 }
 
-// ResetProject resets all changes to the "project" field.
-func (m *NodeMutation) ResetProject() {
-	m.project = nil
-	delete(m.clearedFields, node.FieldProject)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ref.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case ref.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ref.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ref.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ref field %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NodeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefMutation) AddedFields() []string {
+	return nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Ref numeric field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Ref nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefMutation) ResetField(name string) error {
+	switch name {
+	case ref.FieldHash:
+		m.ResetHash()
+		return nil
+	case ref.FieldKind:
+		m.ResetKind()
+		return nil
+	case ref.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ref.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return fmt.Errorf("unknown Ref field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Ref unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Ref edge %s", name)
+}
+
+// SignatureMutation represents an operation that mutates the Signature nodes in the graph.
+type SignatureMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	hash          *string
+	key_id        *string
+	public_key    *[]byte
+	signature     *[]byte
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Signature, error)
+	predicates    []predicate.Signature
+}
+
+var _ ent.Mutation = (*SignatureMutation)(nil)
+
+// signatureOption allows management of the mutation configuration using functional options.
+type signatureOption func(*SignatureMutation)
+
+// newSignatureMutation creates new mutation for the Signature entity.
+func newSignatureMutation(c config, op Op, opts ...signatureOption) *SignatureMutation {
+	m := &SignatureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSignature,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.CreatedAt, nil
+	return m
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NodeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// withSignatureID sets the ID field of the mutation.
+func withSignatureID(id int) signatureOption {
+	return func(m *SignatureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Signature
+		)
+		m.oldValue = func(ctx context.Context) (*Signature, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Signature.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetParentID sets the "parent" edge to the Node entity by id.
-func (m *NodeMutation) SetParentID(id string) {
-	m.parent = &id
+// withSignature sets the old Signature of the mutation.
+func withSignature(node *Signature) signatureOption {
+	return func(m *SignatureMutation) {
+		m.oldValue = func(context.Context) (*Signature, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ClearParent clears the "parent" edge to the Node entity.
-func (m *NodeMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[node.FieldParentHash] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SignatureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ParentCleared reports if the "parent" edge to the Node entity was cleared.
-func (m *NodeMutation) ParentCleared() bool {
-	return m.ParentHashCleared() || m.clearedparent
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SignatureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *NodeMutation) ParentID() (id string, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SignatureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SignatureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Signature.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *NodeMutation) ParentIDs() (ids []string) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetHash sets the "hash" field.
+func (m *SignatureMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *NodeMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// Hash returns the value of the "hash" field in the mutation.
+func (m *SignatureMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddChildIDs adds the "children" edge to the Node entity by ids.
-func (m *NodeMutation) AddChildIDs(ids ...string) {
-	if m.children == nil {
-		m.children = make(map[string]struct{})
+// OldHash returns the old "hash" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
+	return oldValue.Hash, nil
 }
 
-// ClearChildren clears the "children" edge to the Node entity.
-func (m *NodeMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetHash resets all changes to the "hash" field.
+func (m *SignatureMutation) ResetHash() {
+	m.hash = nil
 }
 
-// ChildrenCleared reports if the "children" edge to the Node entity was cleared.
-func (m *NodeMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetKeyID sets the "key_id" field.
+func (m *SignatureMutation) SetKeyID(s string) {
+	m.key_id = &s
 }
 
-// RemoveChildIDs removes the "children" edge to the Node entity by IDs.
-func (m *NodeMutation) RemoveChildIDs(ids ...string) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *SignatureMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Node entity.
-func (m *NodeMutation) RemovedChildrenIDs() (ids []string) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// OldKeyID returns the old "key_id" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *NodeMutation) ChildrenIDs() (ids []string) {
-	for id := range m.children {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *NodeMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *SignatureMutation) ResetKeyID() {
+	m.key_id = nil
 }
 
-// Where appends a list predicates to the NodeMutation builder.
-func (m *NodeMutation) Where(ps ...predicate.Node) {
-	m.predicates = append(m.predicates, ps...)
+// SetPublicKey sets the "public_key" field.
+func (m *SignatureMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
 }
 
-// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Node, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SignatureMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NodeMutation) Op() Op {
-	return m.op
+// OldPublicKey returns the old "public_key" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NodeMutation) SetOp(op Op) {
-	m.op = op
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SignatureMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// Type returns the node type of this mutation (Node).
-func (m *NodeMutation) Type() string {
-	return m.typ
+// SetSignature sets the "signature" field.
+func (m *SignatureMutation) SetSignature(b []byte) {
+	m.signature = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NodeMutation) Fields() []string {
-	fields := make([]string, 0, 18)
-	if m.parent != nil {
-		fields = append(fields, node.FieldParentHash)
-	}
-	if m.bucket != nil {
-		fields = append(fields, node.FieldBucket)
-	}
-	if m._type != nil {
-		fields = append(fields, node.FieldType)
-	}
-	if m.role != nil {
-		fields = append(fields, node.FieldRole)
-	}
-	if m.content != nil {
-		fields = append(fields, node.FieldContent)
-	}
-	if m.model != nil {
-		fields = append(fields, node.FieldModel)
-	}
-	if m.provider != nil {
-		fields = append(fields, node.FieldProvider)
-	}
-	if m.agent_name != nil {
-		fields = append(fields, node.FieldAgentName)
-	}
-	if m.stop_reason != nil {
-		fields = append(fields, node.FieldStopReason)
-	}
-	if m.prompt_tokens != nil {
-		fields = append(fields, node.FieldPromptTokens)
-	}
-	if m.completion_tokens != nil {
-		fields = append(fields, node.FieldCompletionTokens)
-	}
-	if m.total_tokens != nil {
-		fields = append(fields, node.FieldTotalTokens)
-	}
-	if m.cache_creation_input_tokens != nil {
-		fields = append(fields, node.FieldCacheCreationInputTokens)
-	}
-	if m.cache_read_input_tokens != nil {
-		fields = append(fields, node.FieldCacheReadInputTokens)
-	}
-	if m.total_duration_ns != nil {
-		fields = append(fields, node.FieldTotalDurationNs)
+// Signature returns the value of the "signature" field in the mutation.
+func (m *SignatureMutation) Signature() (r []byte, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
 	}
-	if m.prompt_duration_ns != nil {
-		fields = append(fields, node.FieldPromptDurationNs)
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
 	}
-	if m.project != nil {
-		fields = append(fields, node.FieldProject)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, node.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
 	}
-	return fields
+	return oldValue.Signature, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NodeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case node.FieldParentHash:
-		return m.ParentHash()
-	case node.FieldBucket:
-		return m.Bucket()
-	case node.FieldType:
-		return m.GetType()
-	case node.FieldRole:
-		return m.Role()
-	case node.FieldContent:
-		return m.Content()
-	case node.FieldModel:
-		return m.Model()
-	case node.FieldProvider:
-		return m.Provider()
-	case node.FieldAgentName:
-		return m.AgentName()
-	case node.FieldStopReason:
-		return m.StopReason()
-	case node.FieldPromptTokens:
-		return m.PromptTokens()
-	case node.FieldCompletionTokens:
-		return m.CompletionTokens()
-	case node.FieldTotalTokens:
-		return m.TotalTokens()
-	case node.FieldCacheCreationInputTokens:
-		return m.CacheCreationInputTokens()
-	case node.FieldCacheReadInputTokens:
-		return m.CacheReadInputTokens()
-	case node.FieldTotalDurationNs:
-		return m.TotalDurationNs()
-	case node.FieldPromptDurationNs:
-		return m.PromptDurationNs()
-	case node.FieldProject:
-		return m.Project()
-	case node.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ResetSignature resets all changes to the "signature" field.
+func (m *SignatureMutation) ResetSignature() {
+	m.signature = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case node.FieldParentHash:
-		return m.OldParentHash(ctx)
-	case node.FieldBucket:
-		return m.OldBucket(ctx)
-	case node.FieldType:
-		return m.OldType(ctx)
-	case node.FieldRole:
-		return m.OldRole(ctx)
-	case node.FieldContent:
-		return m.OldContent(ctx)
-	case node.FieldModel:
-		return m.OldModel(ctx)
-	case node.FieldProvider:
-		return m.OldProvider(ctx)
-	case node.FieldAgentName:
-		return m.OldAgentName(ctx)
-	case node.FieldStopReason:
-		return m.OldStopReason(ctx)
-	case node.FieldPromptTokens:
-		return m.OldPromptTokens(ctx)
-	case node.FieldCompletionTokens:
-		return m.OldCompletionTokens(ctx)
-	case node.FieldTotalTokens:
-		return m.OldTotalTokens(ctx)
-	case node.FieldCacheCreationInputTokens:
-		return m.OldCacheCreationInputTokens(ctx)
-	case node.FieldCacheReadInputTokens:
-		return m.OldCacheReadInputTokens(ctx)
-	case node.FieldTotalDurationNs:
-		return m.OldTotalDurationNs(ctx)
-	case node.FieldPromptDurationNs:
-		return m.OldPromptDurationNs(ctx)
-	case node.FieldProject:
-		return m.OldProject(ctx)
-	case node.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Node field %s", name)
+// SetCreatedAt sets the "created_at" field.
+func (m *SignatureMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NodeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case node.FieldParentHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentHash(v)
-		return nil
-	case node.FieldBucket:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBucket(v)
-		return nil
-	case node.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case node.FieldRole:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRole(v)
-		return nil
-	case node.FieldContent:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case node.FieldModel:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModel(v)
-		return nil
-	case node.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case node.FieldAgentName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAgentName(v)
-		return nil
-	case node.FieldStopReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStopReason(v)
-		return nil
-	case node.FieldPromptTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptTokens(v)
-		return nil
-	case node.FieldCompletionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionTokens(v)
-		return nil
-	case node.FieldTotalTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalTokens(v)
-		return nil
-	case node.FieldCacheCreationInputTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCacheCreationInputTokens(v)
-		return nil
-	case node.FieldCacheReadInputTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCacheReadInputTokens(v)
-		return nil
-	case node.FieldTotalDurationNs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalDurationNs(v)
-		return nil
-	case node.FieldPromptDurationNs:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptDurationNs(v)
-		return nil
-	case node.FieldProject:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProject(v)
-		return nil
-	case node.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SignatureMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NodeMutation) AddedFields() []string {
-	var fields []string
-	if m.addprompt_tokens != nil {
-		fields = append(fields, node.FieldPromptTokens)
+// OldCreatedAt returns the old "created_at" field's value of the Signature entity.
+// If the Signature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignatureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.addcompletion_tokens != nil {
-		fields = append(fields, node.FieldCompletionTokens)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.addtotal_tokens != nil {
-		fields = append(fields, node.FieldTotalTokens)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.addcache_creation_input_tokens != nil {
-		fields = append(fields, node.FieldCacheCreationInputTokens)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SignatureMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the SignatureMutation builder.
+func (m *SignatureMutation) Where(ps ...predicate.Signature) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SignatureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SignatureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Signature, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.addcache_read_input_tokens != nil {
-		fields = append(fields, node.FieldCacheReadInputTokens)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SignatureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SignatureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Signature).
+func (m *SignatureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SignatureMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.hash != nil {
+		fields = append(fields, signature.FieldHash)
 	}
-	if m.addtotal_duration_ns != nil {
-		fields = append(fields, node.FieldTotalDurationNs)
+	if m.key_id != nil {
+		fields = append(fields, signature.FieldKeyID)
 	}
-	if m.addprompt_duration_ns != nil {
-		fields = append(fields, node.FieldPromptDurationNs)
+	if m.public_key != nil {
+		fields = append(fields, signature.FieldPublicKey)
+	}
+	if m.signature != nil {
+		fields = append(fields, signature.FieldSignature)
+	}
+	if m.created_at != nil {
+		fields = append(fields, signature.FieldCreatedAt)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SignatureMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case node.FieldPromptTokens:
-		return m.AddedPromptTokens()
-	case node.FieldCompletionTokens:
-		return m.AddedCompletionTokens()
-	case node.FieldTotalTokens:
-		return m.AddedTotalTokens()
-	case node.FieldCacheCreationInputTokens:
-		return m.AddedCacheCreationInputTokens()
-	case node.FieldCacheReadInputTokens:
-		return m.AddedCacheReadInputTokens()
-	case node.FieldTotalDurationNs:
-		return m.AddedTotalDurationNs()
-	case node.FieldPromptDurationNs:
-		return m.AddedPromptDurationNs()
+	case signature.FieldHash:
+		return m.Hash()
+	case signature.FieldKeyID:
+		return m.KeyID()
+	case signature.FieldPublicKey:
+		return m.PublicKey()
+	case signature.FieldSignature:
+		return m.Signature()
+	case signature.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SignatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case signature.FieldHash:
+		return m.OldHash(ctx)
+	case signature.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case signature.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signature.FieldSignature:
+		return m.OldSignature(ctx)
+	case signature.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Signature field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeMutation) AddField(name string, value ent.Value) error {
+func (m *SignatureMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case node.FieldPromptTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPromptTokens(v)
-		return nil
-	case node.FieldCompletionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionTokens(v)
-		return nil
-	case node.FieldTotalTokens:
-		v, ok := value.(int)
+	case signature.FieldHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalTokens(v)
+		m.SetHash(v)
 		return nil
-	case node.FieldCacheCreationInputTokens:
-		v, ok := value.(int)
+	case signature.FieldKeyID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCacheCreationInputTokens(v)
+		m.SetKeyID(v)
 		return nil
-	case node.FieldCacheReadInputTokens:
-		v, ok := value.(int)
+	case signature.FieldPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCacheReadInputTokens(v)
+		m.SetPublicKey(v)
 		return nil
-	case node.FieldTotalDurationNs:
-		v, ok := value.(int64)
+	case signature.FieldSignature:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalDurationNs(v)
+		m.SetSignature(v)
 		return nil
-	case node.FieldPromptDurationNs:
-		v, ok := value.(int64)
+	case signature.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPromptDurationNs(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Node numeric field %s", name)
+	return fmt.Errorf("unknown Signature field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SignatureMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SignatureMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SignatureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Signature numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NodeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(node.FieldParentHash) {
-		fields = append(fields, node.FieldParentHash)
-	}
-	if m.FieldCleared(node.FieldBucket) {
-		fields = append(fields, node.FieldBucket)
-	}
-	if m.FieldCleared(node.FieldType) {
-		fields = append(fields, node.FieldType)
-	}
-	if m.FieldCleared(node.FieldRole) {
-		fields = append(fields, node.FieldRole)
-	}
-	if m.FieldCleared(node.FieldContent) {
-		fields = append(fields, node.FieldContent)
-	}
-	if m.FieldCleared(node.FieldModel) {
-		fields = append(fields, node.FieldModel)
-	}
-	if m.FieldCleared(node.FieldProvider) {
-		fields = append(fields, node.FieldProvider)
-	}
-	if m.FieldCleared(node.FieldAgentName) {
-		fields = append(fields, node.FieldAgentName)
-	}
-	if m.FieldCleared(node.FieldStopReason) {
-		fields = append(fields, node.FieldStopReason)
-	}
-	if m.FieldCleared(node.FieldPromptTokens) {
-		fields = append(fields, node.FieldPromptTokens)
-	}
-	if m.FieldCleared(node.FieldCompletionTokens) {
-		fields = append(fields, node.FieldCompletionTokens)
-	}
-	if m.FieldCleared(node.FieldTotalTokens) {
-		fields = append(fields, node.FieldTotalTokens)
-	}
-	if m.FieldCleared(node.FieldCacheCreationInputTokens) {
-		fields = append(fields, node.FieldCacheCreationInputTokens)
-	}
-	if m.FieldCleared(node.FieldCacheReadInputTokens) {
-		fields = append(fields, node.FieldCacheReadInputTokens)
-	}
-	if m.FieldCleared(node.FieldTotalDurationNs) {
-		fields = append(fields, node.FieldTotalDurationNs)
-	}
-	if m.FieldCleared(node.FieldPromptDurationNs) {
-		fields = append(fields, node.FieldPromptDurationNs)
-	}
-	if m.FieldCleared(node.FieldProject) {
-		fields = append(fields, node.FieldProject)
-	}
-	return fields
+func (m *SignatureMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NodeMutation) FieldCleared(name string) bool {
+func (m *SignatureMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NodeMutation) ClearField(name string) error {
-	switch name {
-	case node.FieldParentHash:
-		m.ClearParentHash()
-		return nil
-	case node.FieldBucket:
-		m.ClearBucket()
-		return nil
-	case node.FieldType:
-		m.ClearType()
-		return nil
-	case node.FieldRole:
-		m.ClearRole()
-		return nil
-	case node.FieldContent:
-		m.ClearContent()
-		return nil
-	case node.FieldModel:
-		m.ClearModel()
-		return nil
-	case node.FieldProvider:
-		m.ClearProvider()
-		return nil
-	case node.FieldAgentName:
-		m.ClearAgentName()
-		return nil
-	case node.FieldStopReason:
-		m.ClearStopReason()
-		return nil
-	case node.FieldPromptTokens:
-		m.ClearPromptTokens()
-		return nil
-	case node.FieldCompletionTokens:
-		m.ClearCompletionTokens()
-		return nil
-	case node.FieldTotalTokens:
-		m.ClearTotalTokens()
-		return nil
-	case node.FieldCacheCreationInputTokens:
-		m.ClearCacheCreationInputTokens()
-		return nil
-	case node.FieldCacheReadInputTokens:
-		m.ClearCacheReadInputTokens()
-		return nil
-	case node.FieldTotalDurationNs:
-		m.ClearTotalDurationNs()
-		return nil
-	case node.FieldPromptDurationNs:
-		m.ClearPromptDurationNs()
-		return nil
-	case node.FieldProject:
-		m.ClearProject()
-		return nil
-	}
-	return fmt.Errorf("unknown Node nullable field %s", name)
+func (m *SignatureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Signature nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NodeMutation) ResetField(name string) error {
+func (m *SignatureMutation) ResetField(name string) error {
 	switch name {
-	case node.FieldParentHash:
-		m.ResetParentHash()
-		return nil
-	case node.FieldBucket:
-		m.ResetBucket()
-		return nil
-	case node.FieldType:
-		m.ResetType()
-		return nil
-	case node.FieldRole:
-		m.ResetRole()
-		return nil
-	case node.FieldContent:
-		m.ResetContent()
-		return nil
-	case node.FieldModel:
-		m.ResetModel()
-		return nil
-	case node.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case node.FieldAgentName:
-		m.ResetAgentName()
+	case signature.FieldHash:
+		m.ResetHash()
 		return nil
-	case node.FieldStopReason:
-		m.ResetStopReason()
-		return nil
-	case node.FieldPromptTokens:
-		m.ResetPromptTokens()
-		return nil
-	case node.FieldCompletionTokens:
-		m.ResetCompletionTokens()
-		return nil
-	case node.FieldTotalTokens:
-		m.ResetTotalTokens()
-		return nil
-	case node.FieldCacheCreationInputTokens:
-		m.ResetCacheCreationInputTokens()
-		return nil
-	case node.FieldCacheReadInputTokens:
-		m.ResetCacheReadInputTokens()
-		return nil
-	case node.FieldTotalDurationNs:
-		m.ResetTotalDurationNs()
+	case signature.FieldKeyID:
+		m.ResetKeyID()
 		return nil
-	case node.FieldPromptDurationNs:
-		m.ResetPromptDurationNs()
+	case signature.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case node.FieldProject:
-		m.ResetProject()
+	case signature.FieldSignature:
+		m.ResetSignature()
 		return nil
-	case node.FieldCreatedAt:
+	case signature.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return fmt.Errorf("unknown Signature field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.parent != nil {
-		edges = append(edges, node.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, node.EdgeChildren)
-	}
+func (m *SignatureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NodeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case node.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case node.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SignatureMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchildren != nil {
-		edges = append(edges, node.EdgeChildren)
-	}
+func (m *SignatureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case node.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SignatureMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedparent {
-		edges = append(edges, node.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, node.EdgeChildren)
-	}
+func (m *SignatureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NodeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case node.EdgeParent:
-		return m.clearedparent
-	case node.EdgeChildren:
-		return m.clearedchildren
-	}
+func (m *SignatureMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NodeMutation) ClearEdge(name string) error {
-	switch name {
-	case node.EdgeParent:
-		m.ClearParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Node unique edge %s", name)
+func (m *SignatureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Signature unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NodeMutation) ResetEdge(name string) error {
-	switch name {
-	case node.EdgeParent:
-		m.ResetParent()
-		return nil
-	case node.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	}
-	return fmt.Errorf("unknown Node edge %s", name)
+func (m *SignatureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Signature edge %s", name)
 }
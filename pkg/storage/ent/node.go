@@ -52,6 +52,32 @@ type Node struct {
 	PromptDurationNs *int64 `json:"prompt_duration_ns,omitempty"`
 	// Project holds the value of the "project" field.
 	Project *string `json:"project,omitempty"`
+	// RequestedModel holds the value of the "requested_model" field.
+	RequestedModel *string `json:"requested_model,omitempty"`
+	// Upstream holds the value of the "upstream" field.
+	Upstream *string `json:"upstream,omitempty"`
+	// GenerationParams holds the value of the "generation_params" field.
+	GenerationParams map[string]interface{} `json:"generation_params,omitempty"`
+	// ProviderRequestID holds the value of the "provider_request_id" field.
+	ProviderRequestID *string `json:"provider_request_id,omitempty"`
+	// RateLimits holds the value of the "rate_limits" field.
+	RateLimits map[string]string `json:"rate_limits,omitempty"`
+	// ChoiceIndex holds the value of the "choice_index" field.
+	ChoiceIndex *int `json:"choice_index,omitempty"`
+	// ContextKey holds the value of the "context_key" field.
+	ContextKey *string `json:"context_key,omitempty"`
+	// CacheKey holds the value of the "cache_key" field.
+	CacheKey *string `json:"cache_key,omitempty"`
+	// CachedAt holds the value of the "cached_at" field.
+	CachedAt *time.Time `json:"cached_at,omitempty"`
+	// CacheHits holds the value of the "cache_hits" field.
+	CacheHits int `json:"cache_hits,omitempty"`
+	// RootHash holds the value of the "root_hash" field.
+	RootHash *string `json:"root_hash,omitempty"`
+	// Depth holds the value of the "depth" field.
+	Depth int `json:"depth,omitempty"`
+	// IsLeaf holds the value of the "is_leaf" field.
+	IsLeaf bool `json:"is_leaf,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -96,13 +122,15 @@ func (*Node) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case node.FieldBucket, node.FieldContent:
+		case node.FieldBucket, node.FieldContent, node.FieldGenerationParams, node.FieldRateLimits:
 			values[i] = new([]byte)
-		case node.FieldPromptTokens, node.FieldCompletionTokens, node.FieldTotalTokens, node.FieldCacheCreationInputTokens, node.FieldCacheReadInputTokens, node.FieldTotalDurationNs, node.FieldPromptDurationNs:
+		case node.FieldIsLeaf:
+			values[i] = new(sql.NullBool)
+		case node.FieldPromptTokens, node.FieldCompletionTokens, node.FieldTotalTokens, node.FieldCacheCreationInputTokens, node.FieldCacheReadInputTokens, node.FieldTotalDurationNs, node.FieldPromptDurationNs, node.FieldChoiceIndex, node.FieldCacheHits, node.FieldDepth:
 			values[i] = new(sql.NullInt64)
-		case node.FieldID, node.FieldParentHash, node.FieldType, node.FieldRole, node.FieldModel, node.FieldProvider, node.FieldAgentName, node.FieldStopReason, node.FieldProject:
+		case node.FieldID, node.FieldParentHash, node.FieldType, node.FieldRole, node.FieldModel, node.FieldProvider, node.FieldAgentName, node.FieldStopReason, node.FieldProject, node.FieldRequestedModel, node.FieldUpstream, node.FieldProviderRequestID, node.FieldContextKey, node.FieldCacheKey, node.FieldRootHash:
 			values[i] = new(sql.NullString)
-		case node.FieldCreatedAt:
+		case node.FieldCachedAt, node.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -240,6 +268,96 @@ func (_m *Node) assignValues(columns []string, values []any) error {
 				_m.Project = new(string)
 				*_m.Project = value.String
 			}
+		case node.FieldRequestedModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_model", values[i])
+			} else if value.Valid {
+				_m.RequestedModel = new(string)
+				*_m.RequestedModel = value.String
+			}
+		case node.FieldUpstream:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upstream", values[i])
+			} else if value.Valid {
+				_m.Upstream = new(string)
+				*_m.Upstream = value.String
+			}
+		case node.FieldGenerationParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field generation_params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GenerationParams); err != nil {
+					return fmt.Errorf("unmarshal field generation_params: %w", err)
+				}
+			}
+		case node.FieldProviderRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_request_id", values[i])
+			} else if value.Valid {
+				_m.ProviderRequestID = new(string)
+				*_m.ProviderRequestID = value.String
+			}
+		case node.FieldRateLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RateLimits); err != nil {
+					return fmt.Errorf("unmarshal field rate_limits: %w", err)
+				}
+			}
+		case node.FieldChoiceIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field choice_index", values[i])
+			} else if value.Valid {
+				_m.ChoiceIndex = new(int)
+				*_m.ChoiceIndex = int(value.Int64)
+			}
+		case node.FieldContextKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field context_key", values[i])
+			} else if value.Valid {
+				_m.ContextKey = new(string)
+				*_m.ContextKey = value.String
+			}
+		case node.FieldCacheKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_key", values[i])
+			} else if value.Valid {
+				_m.CacheKey = new(string)
+				*_m.CacheKey = value.String
+			}
+		case node.FieldCachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cached_at", values[i])
+			} else if value.Valid {
+				_m.CachedAt = new(time.Time)
+				*_m.CachedAt = value.Time
+			}
+		case node.FieldCacheHits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_hits", values[i])
+			} else if value.Valid {
+				_m.CacheHits = int(value.Int64)
+			}
+		case node.FieldRootHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field root_hash", values[i])
+			} else if value.Valid {
+				_m.RootHash = new(string)
+				*_m.RootHash = value.String
+			}
+		case node.FieldDepth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field depth", values[i])
+			} else if value.Valid {
+				_m.Depth = int(value.Int64)
+			}
+		case node.FieldIsLeaf:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_leaf", values[i])
+			} else if value.Valid {
+				_m.IsLeaf = value.Bool
+			}
 		case node.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -361,6 +479,61 @@ func (_m *Node) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.RequestedModel; v != nil {
+		builder.WriteString("requested_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Upstream; v != nil {
+		builder.WriteString("upstream=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("generation_params=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GenerationParams))
+	builder.WriteString(", ")
+	if v := _m.ProviderRequestID; v != nil {
+		builder.WriteString("provider_request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rate_limits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimits))
+	builder.WriteString(", ")
+	if v := _m.ChoiceIndex; v != nil {
+		builder.WriteString("choice_index=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ContextKey; v != nil {
+		builder.WriteString("context_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CacheKey; v != nil {
+		builder.WriteString("cache_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CachedAt; v != nil {
+		builder.WriteString("cached_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cache_hits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CacheHits))
+	builder.WriteString(", ")
+	if v := _m.RootHash; v != nil {
+		builder.WriteString("root_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("depth=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Depth))
+	builder.WriteString(", ")
+	builder.WriteString("is_leaf=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsLeaf))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -48,6 +48,32 @@ const (
 	FieldPromptDurationNs = "prompt_duration_ns"
 	// FieldProject holds the string denoting the project field in the database.
 	FieldProject = "project"
+	// FieldRequestedModel holds the string denoting the requested_model field in the database.
+	FieldRequestedModel = "requested_model"
+	// FieldUpstream holds the string denoting the upstream field in the database.
+	FieldUpstream = "upstream"
+	// FieldGenerationParams holds the string denoting the generation_params field in the database.
+	FieldGenerationParams = "generation_params"
+	// FieldProviderRequestID holds the string denoting the provider_request_id field in the database.
+	FieldProviderRequestID = "provider_request_id"
+	// FieldRateLimits holds the string denoting the rate_limits field in the database.
+	FieldRateLimits = "rate_limits"
+	// FieldChoiceIndex holds the string denoting the choice_index field in the database.
+	FieldChoiceIndex = "choice_index"
+	// FieldContextKey holds the string denoting the context_key field in the database.
+	FieldContextKey = "context_key"
+	// FieldCacheKey holds the string denoting the cache_key field in the database.
+	FieldCacheKey = "cache_key"
+	// FieldCachedAt holds the string denoting the cached_at field in the database.
+	FieldCachedAt = "cached_at"
+	// FieldCacheHits holds the string denoting the cache_hits field in the database.
+	FieldCacheHits = "cache_hits"
+	// FieldRootHash holds the string denoting the root_hash field in the database.
+	FieldRootHash = "root_hash"
+	// FieldDepth holds the string denoting the depth field in the database.
+	FieldDepth = "depth"
+	// FieldIsLeaf holds the string denoting the is_leaf field in the database.
+	FieldIsLeaf = "is_leaf"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
@@ -86,6 +112,19 @@ var Columns = []string{
 	FieldTotalDurationNs,
 	FieldPromptDurationNs,
 	FieldProject,
+	FieldRequestedModel,
+	FieldUpstream,
+	FieldGenerationParams,
+	FieldProviderRequestID,
+	FieldRateLimits,
+	FieldChoiceIndex,
+	FieldContextKey,
+	FieldCacheKey,
+	FieldCachedAt,
+	FieldCacheHits,
+	FieldRootHash,
+	FieldDepth,
+	FieldIsLeaf,
 	FieldCreatedAt,
 }
 
@@ -100,6 +139,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCacheHits holds the default value on creation for the "cache_hits" field.
+	DefaultCacheHits int
+	// DefaultDepth holds the default value on creation for the "depth" field.
+	DefaultDepth int
+	// DefaultIsLeaf holds the default value on creation for the "is_leaf" field.
+	DefaultIsLeaf bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -189,6 +234,61 @@ func ByProject(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProject, opts...).ToFunc()
 }
 
+// ByRequestedModel orders the results by the requested_model field.
+func ByRequestedModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedModel, opts...).ToFunc()
+}
+
+// ByUpstream orders the results by the upstream field.
+func ByUpstream(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpstream, opts...).ToFunc()
+}
+
+// ByProviderRequestID orders the results by the provider_request_id field.
+func ByProviderRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderRequestID, opts...).ToFunc()
+}
+
+// ByChoiceIndex orders the results by the choice_index field.
+func ByChoiceIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChoiceIndex, opts...).ToFunc()
+}
+
+// ByContextKey orders the results by the context_key field.
+func ByContextKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContextKey, opts...).ToFunc()
+}
+
+// ByCacheKey orders the results by the cache_key field.
+func ByCacheKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheKey, opts...).ToFunc()
+}
+
+// ByCachedAt orders the results by the cached_at field.
+func ByCachedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCachedAt, opts...).ToFunc()
+}
+
+// ByCacheHits orders the results by the cache_hits field.
+func ByCacheHits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheHits, opts...).ToFunc()
+}
+
+// ByRootHash orders the results by the root_hash field.
+func ByRootHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRootHash, opts...).ToFunc()
+}
+
+// ByDepth orders the results by the depth field.
+func ByDepth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepth, opts...).ToFunc()
+}
+
+// ByIsLeaf orders the results by the is_leaf field.
+func ByIsLeaf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsLeaf, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
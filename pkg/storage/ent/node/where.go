@@ -140,6 +140,61 @@ func Project(v string) predicate.Node {
 	return predicate.Node(sql.FieldEQ(FieldProject, v))
 }
 
+// RequestedModel applies equality check predicate on the "requested_model" field. It's identical to RequestedModelEQ.
+func RequestedModel(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldRequestedModel, v))
+}
+
+// Upstream applies equality check predicate on the "upstream" field. It's identical to UpstreamEQ.
+func Upstream(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldUpstream, v))
+}
+
+// ProviderRequestID applies equality check predicate on the "provider_request_id" field. It's identical to ProviderRequestIDEQ.
+func ProviderRequestID(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldProviderRequestID, v))
+}
+
+// ChoiceIndex applies equality check predicate on the "choice_index" field. It's identical to ChoiceIndexEQ.
+func ChoiceIndex(v int) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldChoiceIndex, v))
+}
+
+// ContextKey applies equality check predicate on the "context_key" field. It's identical to ContextKeyEQ.
+func ContextKey(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldContextKey, v))
+}
+
+// CacheKey applies equality check predicate on the "cache_key" field. It's identical to CacheKeyEQ.
+func CacheKey(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// CachedAt applies equality check predicate on the "cached_at" field. It's identical to CachedAtEQ.
+func CachedAt(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCachedAt, v))
+}
+
+// CacheHits applies equality check predicate on the "cache_hits" field. It's identical to CacheHitsEQ.
+func CacheHits(v int) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCacheHits, v))
+}
+
+// RootHash applies equality check predicate on the "root_hash" field. It's identical to RootHashEQ.
+func RootHash(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldRootHash, v))
+}
+
+// Depth applies equality check predicate on the "depth" field. It's identical to DepthEQ.
+func Depth(v int) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldDepth, v))
+}
+
+// IsLeaf applies equality check predicate on the "is_leaf" field. It's identical to IsLeafEQ.
+func IsLeaf(v bool) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldIsLeaf, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Node {
 	return predicate.Node(sql.FieldEQ(FieldCreatedAt, v))
@@ -1115,6 +1170,666 @@ func ProjectContainsFold(v string) predicate.Node {
 	return predicate.Node(sql.FieldContainsFold(FieldProject, v))
 }
 
+// RequestedModelEQ applies the EQ predicate on the "requested_model" field.
+func RequestedModelEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldRequestedModel, v))
+}
+
+// RequestedModelNEQ applies the NEQ predicate on the "requested_model" field.
+func RequestedModelNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldRequestedModel, v))
+}
+
+// RequestedModelIn applies the In predicate on the "requested_model" field.
+func RequestedModelIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldRequestedModel, vs...))
+}
+
+// RequestedModelNotIn applies the NotIn predicate on the "requested_model" field.
+func RequestedModelNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldRequestedModel, vs...))
+}
+
+// RequestedModelGT applies the GT predicate on the "requested_model" field.
+func RequestedModelGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldRequestedModel, v))
+}
+
+// RequestedModelGTE applies the GTE predicate on the "requested_model" field.
+func RequestedModelGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldRequestedModel, v))
+}
+
+// RequestedModelLT applies the LT predicate on the "requested_model" field.
+func RequestedModelLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldRequestedModel, v))
+}
+
+// RequestedModelLTE applies the LTE predicate on the "requested_model" field.
+func RequestedModelLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldRequestedModel, v))
+}
+
+// RequestedModelContains applies the Contains predicate on the "requested_model" field.
+func RequestedModelContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldRequestedModel, v))
+}
+
+// RequestedModelHasPrefix applies the HasPrefix predicate on the "requested_model" field.
+func RequestedModelHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldRequestedModel, v))
+}
+
+// RequestedModelHasSuffix applies the HasSuffix predicate on the "requested_model" field.
+func RequestedModelHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldRequestedModel, v))
+}
+
+// RequestedModelIsNil applies the IsNil predicate on the "requested_model" field.
+func RequestedModelIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldRequestedModel))
+}
+
+// RequestedModelNotNil applies the NotNil predicate on the "requested_model" field.
+func RequestedModelNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldRequestedModel))
+}
+
+// RequestedModelEqualFold applies the EqualFold predicate on the "requested_model" field.
+func RequestedModelEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldRequestedModel, v))
+}
+
+// RequestedModelContainsFold applies the ContainsFold predicate on the "requested_model" field.
+func RequestedModelContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldRequestedModel, v))
+}
+
+// UpstreamEQ applies the EQ predicate on the "upstream" field.
+func UpstreamEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldUpstream, v))
+}
+
+// UpstreamNEQ applies the NEQ predicate on the "upstream" field.
+func UpstreamNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldUpstream, v))
+}
+
+// UpstreamIn applies the In predicate on the "upstream" field.
+func UpstreamIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldUpstream, vs...))
+}
+
+// UpstreamNotIn applies the NotIn predicate on the "upstream" field.
+func UpstreamNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldUpstream, vs...))
+}
+
+// UpstreamGT applies the GT predicate on the "upstream" field.
+func UpstreamGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldUpstream, v))
+}
+
+// UpstreamGTE applies the GTE predicate on the "upstream" field.
+func UpstreamGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldUpstream, v))
+}
+
+// UpstreamLT applies the LT predicate on the "upstream" field.
+func UpstreamLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldUpstream, v))
+}
+
+// UpstreamLTE applies the LTE predicate on the "upstream" field.
+func UpstreamLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldUpstream, v))
+}
+
+// UpstreamContains applies the Contains predicate on the "upstream" field.
+func UpstreamContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldUpstream, v))
+}
+
+// UpstreamHasPrefix applies the HasPrefix predicate on the "upstream" field.
+func UpstreamHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldUpstream, v))
+}
+
+// UpstreamHasSuffix applies the HasSuffix predicate on the "upstream" field.
+func UpstreamHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldUpstream, v))
+}
+
+// UpstreamIsNil applies the IsNil predicate on the "upstream" field.
+func UpstreamIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldUpstream))
+}
+
+// UpstreamNotNil applies the NotNil predicate on the "upstream" field.
+func UpstreamNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldUpstream))
+}
+
+// UpstreamEqualFold applies the EqualFold predicate on the "upstream" field.
+func UpstreamEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldUpstream, v))
+}
+
+// UpstreamContainsFold applies the ContainsFold predicate on the "upstream" field.
+func UpstreamContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldUpstream, v))
+}
+
+// GenerationParamsIsNil applies the IsNil predicate on the "generation_params" field.
+func GenerationParamsIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldGenerationParams))
+}
+
+// GenerationParamsNotNil applies the NotNil predicate on the "generation_params" field.
+func GenerationParamsNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldGenerationParams))
+}
+
+// ProviderRequestIDEQ applies the EQ predicate on the "provider_request_id" field.
+func ProviderRequestIDEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDNEQ applies the NEQ predicate on the "provider_request_id" field.
+func ProviderRequestIDNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDIn applies the In predicate on the "provider_request_id" field.
+func ProviderRequestIDIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldProviderRequestID, vs...))
+}
+
+// ProviderRequestIDNotIn applies the NotIn predicate on the "provider_request_id" field.
+func ProviderRequestIDNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldProviderRequestID, vs...))
+}
+
+// ProviderRequestIDGT applies the GT predicate on the "provider_request_id" field.
+func ProviderRequestIDGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDGTE applies the GTE predicate on the "provider_request_id" field.
+func ProviderRequestIDGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDLT applies the LT predicate on the "provider_request_id" field.
+func ProviderRequestIDLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDLTE applies the LTE predicate on the "provider_request_id" field.
+func ProviderRequestIDLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDContains applies the Contains predicate on the "provider_request_id" field.
+func ProviderRequestIDContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDHasPrefix applies the HasPrefix predicate on the "provider_request_id" field.
+func ProviderRequestIDHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDHasSuffix applies the HasSuffix predicate on the "provider_request_id" field.
+func ProviderRequestIDHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDIsNil applies the IsNil predicate on the "provider_request_id" field.
+func ProviderRequestIDIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldProviderRequestID))
+}
+
+// ProviderRequestIDNotNil applies the NotNil predicate on the "provider_request_id" field.
+func ProviderRequestIDNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldProviderRequestID))
+}
+
+// ProviderRequestIDEqualFold applies the EqualFold predicate on the "provider_request_id" field.
+func ProviderRequestIDEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldProviderRequestID, v))
+}
+
+// ProviderRequestIDContainsFold applies the ContainsFold predicate on the "provider_request_id" field.
+func ProviderRequestIDContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldProviderRequestID, v))
+}
+
+// RateLimitsIsNil applies the IsNil predicate on the "rate_limits" field.
+func RateLimitsIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldRateLimits))
+}
+
+// RateLimitsNotNil applies the NotNil predicate on the "rate_limits" field.
+func RateLimitsNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldRateLimits))
+}
+
+// ChoiceIndexEQ applies the EQ predicate on the "choice_index" field.
+func ChoiceIndexEQ(v int) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldChoiceIndex, v))
+}
+
+// ChoiceIndexNEQ applies the NEQ predicate on the "choice_index" field.
+func ChoiceIndexNEQ(v int) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldChoiceIndex, v))
+}
+
+// ChoiceIndexIn applies the In predicate on the "choice_index" field.
+func ChoiceIndexIn(vs ...int) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldChoiceIndex, vs...))
+}
+
+// ChoiceIndexNotIn applies the NotIn predicate on the "choice_index" field.
+func ChoiceIndexNotIn(vs ...int) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldChoiceIndex, vs...))
+}
+
+// ChoiceIndexGT applies the GT predicate on the "choice_index" field.
+func ChoiceIndexGT(v int) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldChoiceIndex, v))
+}
+
+// ChoiceIndexGTE applies the GTE predicate on the "choice_index" field.
+func ChoiceIndexGTE(v int) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldChoiceIndex, v))
+}
+
+// ChoiceIndexLT applies the LT predicate on the "choice_index" field.
+func ChoiceIndexLT(v int) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldChoiceIndex, v))
+}
+
+// ChoiceIndexLTE applies the LTE predicate on the "choice_index" field.
+func ChoiceIndexLTE(v int) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldChoiceIndex, v))
+}
+
+// ChoiceIndexIsNil applies the IsNil predicate on the "choice_index" field.
+func ChoiceIndexIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldChoiceIndex))
+}
+
+// ChoiceIndexNotNil applies the NotNil predicate on the "choice_index" field.
+func ChoiceIndexNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldChoiceIndex))
+}
+
+// ContextKeyEQ applies the EQ predicate on the "context_key" field.
+func ContextKeyEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldContextKey, v))
+}
+
+// ContextKeyNEQ applies the NEQ predicate on the "context_key" field.
+func ContextKeyNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldContextKey, v))
+}
+
+// ContextKeyIn applies the In predicate on the "context_key" field.
+func ContextKeyIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldContextKey, vs...))
+}
+
+// ContextKeyNotIn applies the NotIn predicate on the "context_key" field.
+func ContextKeyNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldContextKey, vs...))
+}
+
+// ContextKeyGT applies the GT predicate on the "context_key" field.
+func ContextKeyGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldContextKey, v))
+}
+
+// ContextKeyGTE applies the GTE predicate on the "context_key" field.
+func ContextKeyGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldContextKey, v))
+}
+
+// ContextKeyLT applies the LT predicate on the "context_key" field.
+func ContextKeyLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldContextKey, v))
+}
+
+// ContextKeyLTE applies the LTE predicate on the "context_key" field.
+func ContextKeyLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldContextKey, v))
+}
+
+// ContextKeyContains applies the Contains predicate on the "context_key" field.
+func ContextKeyContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldContextKey, v))
+}
+
+// ContextKeyHasPrefix applies the HasPrefix predicate on the "context_key" field.
+func ContextKeyHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldContextKey, v))
+}
+
+// ContextKeyHasSuffix applies the HasSuffix predicate on the "context_key" field.
+func ContextKeyHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldContextKey, v))
+}
+
+// ContextKeyIsNil applies the IsNil predicate on the "context_key" field.
+func ContextKeyIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldContextKey))
+}
+
+// ContextKeyNotNil applies the NotNil predicate on the "context_key" field.
+func ContextKeyNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldContextKey))
+}
+
+// ContextKeyEqualFold applies the EqualFold predicate on the "context_key" field.
+func ContextKeyEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldContextKey, v))
+}
+
+// ContextKeyContainsFold applies the ContainsFold predicate on the "context_key" field.
+func ContextKeyContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldContextKey, v))
+}
+
+// CacheKeyEQ applies the EQ predicate on the "cache_key" field.
+func CacheKeyEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// CacheKeyNEQ applies the NEQ predicate on the "cache_key" field.
+func CacheKeyNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldCacheKey, v))
+}
+
+// CacheKeyIn applies the In predicate on the "cache_key" field.
+func CacheKeyIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyNotIn applies the NotIn predicate on the "cache_key" field.
+func CacheKeyNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyGT applies the GT predicate on the "cache_key" field.
+func CacheKeyGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldCacheKey, v))
+}
+
+// CacheKeyGTE applies the GTE predicate on the "cache_key" field.
+func CacheKeyGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldCacheKey, v))
+}
+
+// CacheKeyLT applies the LT predicate on the "cache_key" field.
+func CacheKeyLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldCacheKey, v))
+}
+
+// CacheKeyLTE applies the LTE predicate on the "cache_key" field.
+func CacheKeyLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldCacheKey, v))
+}
+
+// CacheKeyContains applies the Contains predicate on the "cache_key" field.
+func CacheKeyContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldCacheKey, v))
+}
+
+// CacheKeyHasPrefix applies the HasPrefix predicate on the "cache_key" field.
+func CacheKeyHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldCacheKey, v))
+}
+
+// CacheKeyHasSuffix applies the HasSuffix predicate on the "cache_key" field.
+func CacheKeyHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldCacheKey, v))
+}
+
+// CacheKeyIsNil applies the IsNil predicate on the "cache_key" field.
+func CacheKeyIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldCacheKey))
+}
+
+// CacheKeyNotNil applies the NotNil predicate on the "cache_key" field.
+func CacheKeyNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldCacheKey))
+}
+
+// CacheKeyEqualFold applies the EqualFold predicate on the "cache_key" field.
+func CacheKeyEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldCacheKey, v))
+}
+
+// CacheKeyContainsFold applies the ContainsFold predicate on the "cache_key" field.
+func CacheKeyContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldCacheKey, v))
+}
+
+// CachedAtEQ applies the EQ predicate on the "cached_at" field.
+func CachedAtEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCachedAt, v))
+}
+
+// CachedAtNEQ applies the NEQ predicate on the "cached_at" field.
+func CachedAtNEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldCachedAt, v))
+}
+
+// CachedAtIn applies the In predicate on the "cached_at" field.
+func CachedAtIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldCachedAt, vs...))
+}
+
+// CachedAtNotIn applies the NotIn predicate on the "cached_at" field.
+func CachedAtNotIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldCachedAt, vs...))
+}
+
+// CachedAtGT applies the GT predicate on the "cached_at" field.
+func CachedAtGT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldCachedAt, v))
+}
+
+// CachedAtGTE applies the GTE predicate on the "cached_at" field.
+func CachedAtGTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldCachedAt, v))
+}
+
+// CachedAtLT applies the LT predicate on the "cached_at" field.
+func CachedAtLT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldCachedAt, v))
+}
+
+// CachedAtLTE applies the LTE predicate on the "cached_at" field.
+func CachedAtLTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldCachedAt, v))
+}
+
+// CachedAtIsNil applies the IsNil predicate on the "cached_at" field.
+func CachedAtIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldCachedAt))
+}
+
+// CachedAtNotNil applies the NotNil predicate on the "cached_at" field.
+func CachedAtNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldCachedAt))
+}
+
+// CacheHitsEQ applies the EQ predicate on the "cache_hits" field.
+func CacheHitsEQ(v int) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCacheHits, v))
+}
+
+// CacheHitsNEQ applies the NEQ predicate on the "cache_hits" field.
+func CacheHitsNEQ(v int) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldCacheHits, v))
+}
+
+// CacheHitsIn applies the In predicate on the "cache_hits" field.
+func CacheHitsIn(vs ...int) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldCacheHits, vs...))
+}
+
+// CacheHitsNotIn applies the NotIn predicate on the "cache_hits" field.
+func CacheHitsNotIn(vs ...int) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldCacheHits, vs...))
+}
+
+// CacheHitsGT applies the GT predicate on the "cache_hits" field.
+func CacheHitsGT(v int) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldCacheHits, v))
+}
+
+// CacheHitsGTE applies the GTE predicate on the "cache_hits" field.
+func CacheHitsGTE(v int) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldCacheHits, v))
+}
+
+// CacheHitsLT applies the LT predicate on the "cache_hits" field.
+func CacheHitsLT(v int) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldCacheHits, v))
+}
+
+// CacheHitsLTE applies the LTE predicate on the "cache_hits" field.
+func CacheHitsLTE(v int) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldCacheHits, v))
+}
+
+// RootHashEQ applies the EQ predicate on the "root_hash" field.
+func RootHashEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldRootHash, v))
+}
+
+// RootHashNEQ applies the NEQ predicate on the "root_hash" field.
+func RootHashNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldRootHash, v))
+}
+
+// RootHashIn applies the In predicate on the "root_hash" field.
+func RootHashIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldRootHash, vs...))
+}
+
+// RootHashNotIn applies the NotIn predicate on the "root_hash" field.
+func RootHashNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldRootHash, vs...))
+}
+
+// RootHashGT applies the GT predicate on the "root_hash" field.
+func RootHashGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldRootHash, v))
+}
+
+// RootHashGTE applies the GTE predicate on the "root_hash" field.
+func RootHashGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldRootHash, v))
+}
+
+// RootHashLT applies the LT predicate on the "root_hash" field.
+func RootHashLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldRootHash, v))
+}
+
+// RootHashLTE applies the LTE predicate on the "root_hash" field.
+func RootHashLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldRootHash, v))
+}
+
+// RootHashContains applies the Contains predicate on the "root_hash" field.
+func RootHashContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldRootHash, v))
+}
+
+// RootHashHasPrefix applies the HasPrefix predicate on the "root_hash" field.
+func RootHashHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldRootHash, v))
+}
+
+// RootHashHasSuffix applies the HasSuffix predicate on the "root_hash" field.
+func RootHashHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldRootHash, v))
+}
+
+// RootHashIsNil applies the IsNil predicate on the "root_hash" field.
+func RootHashIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldRootHash))
+}
+
+// RootHashNotNil applies the NotNil predicate on the "root_hash" field.
+func RootHashNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldRootHash))
+}
+
+// RootHashEqualFold applies the EqualFold predicate on the "root_hash" field.
+func RootHashEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldRootHash, v))
+}
+
+// RootHashContainsFold applies the ContainsFold predicate on the "root_hash" field.
+func RootHashContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldRootHash, v))
+}
+
+// DepthEQ applies the EQ predicate on the "depth" field.
+func DepthEQ(v int) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldDepth, v))
+}
+
+// DepthNEQ applies the NEQ predicate on the "depth" field.
+func DepthNEQ(v int) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldDepth, v))
+}
+
+// DepthIn applies the In predicate on the "depth" field.
+func DepthIn(vs ...int) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldDepth, vs...))
+}
+
+// DepthNotIn applies the NotIn predicate on the "depth" field.
+func DepthNotIn(vs ...int) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldDepth, vs...))
+}
+
+// DepthGT applies the GT predicate on the "depth" field.
+func DepthGT(v int) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldDepth, v))
+}
+
+// DepthGTE applies the GTE predicate on the "depth" field.
+func DepthGTE(v int) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldDepth, v))
+}
+
+// DepthLT applies the LT predicate on the "depth" field.
+func DepthLT(v int) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldDepth, v))
+}
+
+// DepthLTE applies the LTE predicate on the "depth" field.
+func DepthLTE(v int) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldDepth, v))
+}
+
+// IsLeafEQ applies the EQ predicate on the "is_leaf" field.
+func IsLeafEQ(v bool) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldIsLeaf, v))
+}
+
+// IsLeafNEQ applies the NEQ predicate on the "is_leaf" field.
+func IsLeafNEQ(v bool) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldIsLeaf, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Node {
 	return predicate.Node(sql.FieldEQ(FieldCreatedAt, v))
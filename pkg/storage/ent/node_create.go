@@ -242,6 +242,172 @@ func (_c *NodeCreate) SetNillableProject(v *string) *NodeCreate {
 	return _c
 }
 
+// SetRequestedModel sets the "requested_model" field.
+func (_c *NodeCreate) SetRequestedModel(v string) *NodeCreate {
+	_c.mutation.SetRequestedModel(v)
+	return _c
+}
+
+// SetNillableRequestedModel sets the "requested_model" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableRequestedModel(v *string) *NodeCreate {
+	if v != nil {
+		_c.SetRequestedModel(*v)
+	}
+	return _c
+}
+
+// SetUpstream sets the "upstream" field.
+func (_c *NodeCreate) SetUpstream(v string) *NodeCreate {
+	_c.mutation.SetUpstream(v)
+	return _c
+}
+
+// SetNillableUpstream sets the "upstream" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableUpstream(v *string) *NodeCreate {
+	if v != nil {
+		_c.SetUpstream(*v)
+	}
+	return _c
+}
+
+// SetGenerationParams sets the "generation_params" field.
+func (_c *NodeCreate) SetGenerationParams(v map[string]interface{}) *NodeCreate {
+	_c.mutation.SetGenerationParams(v)
+	return _c
+}
+
+// SetProviderRequestID sets the "provider_request_id" field.
+func (_c *NodeCreate) SetProviderRequestID(v string) *NodeCreate {
+	_c.mutation.SetProviderRequestID(v)
+	return _c
+}
+
+// SetNillableProviderRequestID sets the "provider_request_id" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableProviderRequestID(v *string) *NodeCreate {
+	if v != nil {
+		_c.SetProviderRequestID(*v)
+	}
+	return _c
+}
+
+// SetRateLimits sets the "rate_limits" field.
+func (_c *NodeCreate) SetRateLimits(v map[string]string) *NodeCreate {
+	_c.mutation.SetRateLimits(v)
+	return _c
+}
+
+// SetChoiceIndex sets the "choice_index" field.
+func (_c *NodeCreate) SetChoiceIndex(v int) *NodeCreate {
+	_c.mutation.SetChoiceIndex(v)
+	return _c
+}
+
+// SetNillableChoiceIndex sets the "choice_index" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableChoiceIndex(v *int) *NodeCreate {
+	if v != nil {
+		_c.SetChoiceIndex(*v)
+	}
+	return _c
+}
+
+// SetContextKey sets the "context_key" field.
+func (_c *NodeCreate) SetContextKey(v string) *NodeCreate {
+	_c.mutation.SetContextKey(v)
+	return _c
+}
+
+// SetNillableContextKey sets the "context_key" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableContextKey(v *string) *NodeCreate {
+	if v != nil {
+		_c.SetContextKey(*v)
+	}
+	return _c
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (_c *NodeCreate) SetCacheKey(v string) *NodeCreate {
+	_c.mutation.SetCacheKey(v)
+	return _c
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableCacheKey(v *string) *NodeCreate {
+	if v != nil {
+		_c.SetCacheKey(*v)
+	}
+	return _c
+}
+
+// SetCachedAt sets the "cached_at" field.
+func (_c *NodeCreate) SetCachedAt(v time.Time) *NodeCreate {
+	_c.mutation.SetCachedAt(v)
+	return _c
+}
+
+// SetNillableCachedAt sets the "cached_at" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableCachedAt(v *time.Time) *NodeCreate {
+	if v != nil {
+		_c.SetCachedAt(*v)
+	}
+	return _c
+}
+
+// SetCacheHits sets the "cache_hits" field.
+func (_c *NodeCreate) SetCacheHits(v int) *NodeCreate {
+	_c.mutation.SetCacheHits(v)
+	return _c
+}
+
+// SetNillableCacheHits sets the "cache_hits" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableCacheHits(v *int) *NodeCreate {
+	if v != nil {
+		_c.SetCacheHits(*v)
+	}
+	return _c
+}
+
+// SetRootHash sets the "root_hash" field.
+func (_c *NodeCreate) SetRootHash(v string) *NodeCreate {
+	_c.mutation.SetRootHash(v)
+	return _c
+}
+
+// SetNillableRootHash sets the "root_hash" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableRootHash(v *string) *NodeCreate {
+	if v != nil {
+		_c.SetRootHash(*v)
+	}
+	return _c
+}
+
+// SetDepth sets the "depth" field.
+func (_c *NodeCreate) SetDepth(v int) *NodeCreate {
+	_c.mutation.SetDepth(v)
+	return _c
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableDepth(v *int) *NodeCreate {
+	if v != nil {
+		_c.SetDepth(*v)
+	}
+	return _c
+}
+
+// SetIsLeaf sets the "is_leaf" field.
+func (_c *NodeCreate) SetIsLeaf(v bool) *NodeCreate {
+	_c.mutation.SetIsLeaf(v)
+	return _c
+}
+
+// SetNillableIsLeaf sets the "is_leaf" field if the given value is not nil.
+func (_c *NodeCreate) SetNillableIsLeaf(v *bool) *NodeCreate {
+	if v != nil {
+		_c.SetIsLeaf(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *NodeCreate) SetCreatedAt(v time.Time) *NodeCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -331,6 +497,18 @@ func (_c *NodeCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *NodeCreate) defaults() {
+	if _, ok := _c.mutation.CacheHits(); !ok {
+		v := node.DefaultCacheHits
+		_c.mutation.SetCacheHits(v)
+	}
+	if _, ok := _c.mutation.Depth(); !ok {
+		v := node.DefaultDepth
+		_c.mutation.SetDepth(v)
+	}
+	if _, ok := _c.mutation.IsLeaf(); !ok {
+		v := node.DefaultIsLeaf
+		_c.mutation.SetIsLeaf(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := node.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -339,6 +517,15 @@ func (_c *NodeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *NodeCreate) check() error {
+	if _, ok := _c.mutation.CacheHits(); !ok {
+		return &ValidationError{Name: "cache_hits", err: errors.New(`ent: missing required field "Node.cache_hits"`)}
+	}
+	if _, ok := _c.mutation.Depth(); !ok {
+		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Node.depth"`)}
+	}
+	if _, ok := _c.mutation.IsLeaf(); !ok {
+		return &ValidationError{Name: "is_leaf", err: errors.New(`ent: missing required field "Node.is_leaf"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Node.created_at"`)}
 	}
@@ -446,6 +633,58 @@ func (_c *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
 		_spec.SetField(node.FieldProject, field.TypeString, value)
 		_node.Project = &value
 	}
+	if value, ok := _c.mutation.RequestedModel(); ok {
+		_spec.SetField(node.FieldRequestedModel, field.TypeString, value)
+		_node.RequestedModel = &value
+	}
+	if value, ok := _c.mutation.Upstream(); ok {
+		_spec.SetField(node.FieldUpstream, field.TypeString, value)
+		_node.Upstream = &value
+	}
+	if value, ok := _c.mutation.GenerationParams(); ok {
+		_spec.SetField(node.FieldGenerationParams, field.TypeJSON, value)
+		_node.GenerationParams = value
+	}
+	if value, ok := _c.mutation.ProviderRequestID(); ok {
+		_spec.SetField(node.FieldProviderRequestID, field.TypeString, value)
+		_node.ProviderRequestID = &value
+	}
+	if value, ok := _c.mutation.RateLimits(); ok {
+		_spec.SetField(node.FieldRateLimits, field.TypeJSON, value)
+		_node.RateLimits = value
+	}
+	if value, ok := _c.mutation.ChoiceIndex(); ok {
+		_spec.SetField(node.FieldChoiceIndex, field.TypeInt, value)
+		_node.ChoiceIndex = &value
+	}
+	if value, ok := _c.mutation.ContextKey(); ok {
+		_spec.SetField(node.FieldContextKey, field.TypeString, value)
+		_node.ContextKey = &value
+	}
+	if value, ok := _c.mutation.CacheKey(); ok {
+		_spec.SetField(node.FieldCacheKey, field.TypeString, value)
+		_node.CacheKey = &value
+	}
+	if value, ok := _c.mutation.CachedAt(); ok {
+		_spec.SetField(node.FieldCachedAt, field.TypeTime, value)
+		_node.CachedAt = &value
+	}
+	if value, ok := _c.mutation.CacheHits(); ok {
+		_spec.SetField(node.FieldCacheHits, field.TypeInt, value)
+		_node.CacheHits = value
+	}
+	if value, ok := _c.mutation.RootHash(); ok {
+		_spec.SetField(node.FieldRootHash, field.TypeString, value)
+		_node.RootHash = &value
+	}
+	if value, ok := _c.mutation.Depth(); ok {
+		_spec.SetField(node.FieldDepth, field.TypeInt, value)
+		_node.Depth = value
+	}
+	if value, ok := _c.mutation.IsLeaf(); ok {
+		_spec.SetField(node.FieldIsLeaf, field.TypeBool, value)
+		_node.IsLeaf = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(node.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -407,6 +408,253 @@ func (_u *NodeUpdate) ClearProject() *NodeUpdate {
 	return _u
 }
 
+// SetRequestedModel sets the "requested_model" field.
+func (_u *NodeUpdate) SetRequestedModel(v string) *NodeUpdate {
+	_u.mutation.SetRequestedModel(v)
+	return _u
+}
+
+// SetNillableRequestedModel sets the "requested_model" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableRequestedModel(v *string) *NodeUpdate {
+	if v != nil {
+		_u.SetRequestedModel(*v)
+	}
+	return _u
+}
+
+// ClearRequestedModel clears the value of the "requested_model" field.
+func (_u *NodeUpdate) ClearRequestedModel() *NodeUpdate {
+	_u.mutation.ClearRequestedModel()
+	return _u
+}
+
+// SetUpstream sets the "upstream" field.
+func (_u *NodeUpdate) SetUpstream(v string) *NodeUpdate {
+	_u.mutation.SetUpstream(v)
+	return _u
+}
+
+// SetNillableUpstream sets the "upstream" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableUpstream(v *string) *NodeUpdate {
+	if v != nil {
+		_u.SetUpstream(*v)
+	}
+	return _u
+}
+
+// ClearUpstream clears the value of the "upstream" field.
+func (_u *NodeUpdate) ClearUpstream() *NodeUpdate {
+	_u.mutation.ClearUpstream()
+	return _u
+}
+
+// SetGenerationParams sets the "generation_params" field.
+func (_u *NodeUpdate) SetGenerationParams(v map[string]interface{}) *NodeUpdate {
+	_u.mutation.SetGenerationParams(v)
+	return _u
+}
+
+// ClearGenerationParams clears the value of the "generation_params" field.
+func (_u *NodeUpdate) ClearGenerationParams() *NodeUpdate {
+	_u.mutation.ClearGenerationParams()
+	return _u
+}
+
+// SetProviderRequestID sets the "provider_request_id" field.
+func (_u *NodeUpdate) SetProviderRequestID(v string) *NodeUpdate {
+	_u.mutation.SetProviderRequestID(v)
+	return _u
+}
+
+// SetNillableProviderRequestID sets the "provider_request_id" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableProviderRequestID(v *string) *NodeUpdate {
+	if v != nil {
+		_u.SetProviderRequestID(*v)
+	}
+	return _u
+}
+
+// ClearProviderRequestID clears the value of the "provider_request_id" field.
+func (_u *NodeUpdate) ClearProviderRequestID() *NodeUpdate {
+	_u.mutation.ClearProviderRequestID()
+	return _u
+}
+
+// SetRateLimits sets the "rate_limits" field.
+func (_u *NodeUpdate) SetRateLimits(v map[string]string) *NodeUpdate {
+	_u.mutation.SetRateLimits(v)
+	return _u
+}
+
+// ClearRateLimits clears the value of the "rate_limits" field.
+func (_u *NodeUpdate) ClearRateLimits() *NodeUpdate {
+	_u.mutation.ClearRateLimits()
+	return _u
+}
+
+// SetChoiceIndex sets the "choice_index" field.
+func (_u *NodeUpdate) SetChoiceIndex(v int) *NodeUpdate {
+	_u.mutation.ResetChoiceIndex()
+	_u.mutation.SetChoiceIndex(v)
+	return _u
+}
+
+// SetNillableChoiceIndex sets the "choice_index" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableChoiceIndex(v *int) *NodeUpdate {
+	if v != nil {
+		_u.SetChoiceIndex(*v)
+	}
+	return _u
+}
+
+// AddChoiceIndex adds value to the "choice_index" field.
+func (_u *NodeUpdate) AddChoiceIndex(v int) *NodeUpdate {
+	_u.mutation.AddChoiceIndex(v)
+	return _u
+}
+
+// ClearChoiceIndex clears the value of the "choice_index" field.
+func (_u *NodeUpdate) ClearChoiceIndex() *NodeUpdate {
+	_u.mutation.ClearChoiceIndex()
+	return _u
+}
+
+// SetContextKey sets the "context_key" field.
+func (_u *NodeUpdate) SetContextKey(v string) *NodeUpdate {
+	_u.mutation.SetContextKey(v)
+	return _u
+}
+
+// SetNillableContextKey sets the "context_key" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableContextKey(v *string) *NodeUpdate {
+	if v != nil {
+		_u.SetContextKey(*v)
+	}
+	return _u
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (_u *NodeUpdate) ClearContextKey() *NodeUpdate {
+	_u.mutation.ClearContextKey()
+	return _u
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (_u *NodeUpdate) SetCacheKey(v string) *NodeUpdate {
+	_u.mutation.SetCacheKey(v)
+	return _u
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableCacheKey(v *string) *NodeUpdate {
+	if v != nil {
+		_u.SetCacheKey(*v)
+	}
+	return _u
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (_u *NodeUpdate) ClearCacheKey() *NodeUpdate {
+	_u.mutation.ClearCacheKey()
+	return _u
+}
+
+// SetCachedAt sets the "cached_at" field.
+func (_u *NodeUpdate) SetCachedAt(v time.Time) *NodeUpdate {
+	_u.mutation.SetCachedAt(v)
+	return _u
+}
+
+// SetNillableCachedAt sets the "cached_at" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableCachedAt(v *time.Time) *NodeUpdate {
+	if v != nil {
+		_u.SetCachedAt(*v)
+	}
+	return _u
+}
+
+// ClearCachedAt clears the value of the "cached_at" field.
+func (_u *NodeUpdate) ClearCachedAt() *NodeUpdate {
+	_u.mutation.ClearCachedAt()
+	return _u
+}
+
+// SetCacheHits sets the "cache_hits" field.
+func (_u *NodeUpdate) SetCacheHits(v int) *NodeUpdate {
+	_u.mutation.ResetCacheHits()
+	_u.mutation.SetCacheHits(v)
+	return _u
+}
+
+// SetNillableCacheHits sets the "cache_hits" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableCacheHits(v *int) *NodeUpdate {
+	if v != nil {
+		_u.SetCacheHits(*v)
+	}
+	return _u
+}
+
+// AddCacheHits adds value to the "cache_hits" field.
+func (_u *NodeUpdate) AddCacheHits(v int) *NodeUpdate {
+	_u.mutation.AddCacheHits(v)
+	return _u
+}
+
+// SetRootHash sets the "root_hash" field.
+func (_u *NodeUpdate) SetRootHash(v string) *NodeUpdate {
+	_u.mutation.SetRootHash(v)
+	return _u
+}
+
+// SetNillableRootHash sets the "root_hash" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableRootHash(v *string) *NodeUpdate {
+	if v != nil {
+		_u.SetRootHash(*v)
+	}
+	return _u
+}
+
+// ClearRootHash clears the value of the "root_hash" field.
+func (_u *NodeUpdate) ClearRootHash() *NodeUpdate {
+	_u.mutation.ClearRootHash()
+	return _u
+}
+
+// SetDepth sets the "depth" field.
+func (_u *NodeUpdate) SetDepth(v int) *NodeUpdate {
+	_u.mutation.ResetDepth()
+	_u.mutation.SetDepth(v)
+	return _u
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableDepth(v *int) *NodeUpdate {
+	if v != nil {
+		_u.SetDepth(*v)
+	}
+	return _u
+}
+
+// AddDepth adds value to the "depth" field.
+func (_u *NodeUpdate) AddDepth(v int) *NodeUpdate {
+	_u.mutation.AddDepth(v)
+	return _u
+}
+
+// SetIsLeaf sets the "is_leaf" field.
+func (_u *NodeUpdate) SetIsLeaf(v bool) *NodeUpdate {
+	_u.mutation.SetIsLeaf(v)
+	return _u
+}
+
+// SetNillableIsLeaf sets the "is_leaf" field if the given value is not nil.
+func (_u *NodeUpdate) SetNillableIsLeaf(v *bool) *NodeUpdate {
+	if v != nil {
+		_u.SetIsLeaf(*v)
+	}
+	return _u
+}
+
 // SetParentID sets the "parent" edge to the Node entity by ID.
 func (_u *NodeUpdate) SetParentID(id string) *NodeUpdate {
 	_u.mutation.SetParentID(id)
@@ -631,6 +879,84 @@ func (_u *NodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ProjectCleared() {
 		_spec.ClearField(node.FieldProject, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestedModel(); ok {
+		_spec.SetField(node.FieldRequestedModel, field.TypeString, value)
+	}
+	if _u.mutation.RequestedModelCleared() {
+		_spec.ClearField(node.FieldRequestedModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Upstream(); ok {
+		_spec.SetField(node.FieldUpstream, field.TypeString, value)
+	}
+	if _u.mutation.UpstreamCleared() {
+		_spec.ClearField(node.FieldUpstream, field.TypeString)
+	}
+	if value, ok := _u.mutation.GenerationParams(); ok {
+		_spec.SetField(node.FieldGenerationParams, field.TypeJSON, value)
+	}
+	if _u.mutation.GenerationParamsCleared() {
+		_spec.ClearField(node.FieldGenerationParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ProviderRequestID(); ok {
+		_spec.SetField(node.FieldProviderRequestID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderRequestIDCleared() {
+		_spec.ClearField(node.FieldProviderRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RateLimits(); ok {
+		_spec.SetField(node.FieldRateLimits, field.TypeJSON, value)
+	}
+	if _u.mutation.RateLimitsCleared() {
+		_spec.ClearField(node.FieldRateLimits, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChoiceIndex(); ok {
+		_spec.SetField(node.FieldChoiceIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChoiceIndex(); ok {
+		_spec.AddField(node.FieldChoiceIndex, field.TypeInt, value)
+	}
+	if _u.mutation.ChoiceIndexCleared() {
+		_spec.ClearField(node.FieldChoiceIndex, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ContextKey(); ok {
+		_spec.SetField(node.FieldContextKey, field.TypeString, value)
+	}
+	if _u.mutation.ContextKeyCleared() {
+		_spec.ClearField(node.FieldContextKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.CacheKey(); ok {
+		_spec.SetField(node.FieldCacheKey, field.TypeString, value)
+	}
+	if _u.mutation.CacheKeyCleared() {
+		_spec.ClearField(node.FieldCacheKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.CachedAt(); ok {
+		_spec.SetField(node.FieldCachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CachedAtCleared() {
+		_spec.ClearField(node.FieldCachedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CacheHits(); ok {
+		_spec.SetField(node.FieldCacheHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCacheHits(); ok {
+		_spec.AddField(node.FieldCacheHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RootHash(); ok {
+		_spec.SetField(node.FieldRootHash, field.TypeString, value)
+	}
+	if _u.mutation.RootHashCleared() {
+		_spec.ClearField(node.FieldRootHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Depth(); ok {
+		_spec.SetField(node.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDepth(); ok {
+		_spec.AddField(node.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsLeaf(); ok {
+		_spec.SetField(node.FieldIsLeaf, field.TypeBool, value)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1104,6 +1430,253 @@ func (_u *NodeUpdateOne) ClearProject() *NodeUpdateOne {
 	return _u
 }
 
+// SetRequestedModel sets the "requested_model" field.
+func (_u *NodeUpdateOne) SetRequestedModel(v string) *NodeUpdateOne {
+	_u.mutation.SetRequestedModel(v)
+	return _u
+}
+
+// SetNillableRequestedModel sets the "requested_model" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableRequestedModel(v *string) *NodeUpdateOne {
+	if v != nil {
+		_u.SetRequestedModel(*v)
+	}
+	return _u
+}
+
+// ClearRequestedModel clears the value of the "requested_model" field.
+func (_u *NodeUpdateOne) ClearRequestedModel() *NodeUpdateOne {
+	_u.mutation.ClearRequestedModel()
+	return _u
+}
+
+// SetUpstream sets the "upstream" field.
+func (_u *NodeUpdateOne) SetUpstream(v string) *NodeUpdateOne {
+	_u.mutation.SetUpstream(v)
+	return _u
+}
+
+// SetNillableUpstream sets the "upstream" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableUpstream(v *string) *NodeUpdateOne {
+	if v != nil {
+		_u.SetUpstream(*v)
+	}
+	return _u
+}
+
+// ClearUpstream clears the value of the "upstream" field.
+func (_u *NodeUpdateOne) ClearUpstream() *NodeUpdateOne {
+	_u.mutation.ClearUpstream()
+	return _u
+}
+
+// SetGenerationParams sets the "generation_params" field.
+func (_u *NodeUpdateOne) SetGenerationParams(v map[string]interface{}) *NodeUpdateOne {
+	_u.mutation.SetGenerationParams(v)
+	return _u
+}
+
+// ClearGenerationParams clears the value of the "generation_params" field.
+func (_u *NodeUpdateOne) ClearGenerationParams() *NodeUpdateOne {
+	_u.mutation.ClearGenerationParams()
+	return _u
+}
+
+// SetProviderRequestID sets the "provider_request_id" field.
+func (_u *NodeUpdateOne) SetProviderRequestID(v string) *NodeUpdateOne {
+	_u.mutation.SetProviderRequestID(v)
+	return _u
+}
+
+// SetNillableProviderRequestID sets the "provider_request_id" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableProviderRequestID(v *string) *NodeUpdateOne {
+	if v != nil {
+		_u.SetProviderRequestID(*v)
+	}
+	return _u
+}
+
+// ClearProviderRequestID clears the value of the "provider_request_id" field.
+func (_u *NodeUpdateOne) ClearProviderRequestID() *NodeUpdateOne {
+	_u.mutation.ClearProviderRequestID()
+	return _u
+}
+
+// SetRateLimits sets the "rate_limits" field.
+func (_u *NodeUpdateOne) SetRateLimits(v map[string]string) *NodeUpdateOne {
+	_u.mutation.SetRateLimits(v)
+	return _u
+}
+
+// ClearRateLimits clears the value of the "rate_limits" field.
+func (_u *NodeUpdateOne) ClearRateLimits() *NodeUpdateOne {
+	_u.mutation.ClearRateLimits()
+	return _u
+}
+
+// SetChoiceIndex sets the "choice_index" field.
+func (_u *NodeUpdateOne) SetChoiceIndex(v int) *NodeUpdateOne {
+	_u.mutation.ResetChoiceIndex()
+	_u.mutation.SetChoiceIndex(v)
+	return _u
+}
+
+// SetNillableChoiceIndex sets the "choice_index" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableChoiceIndex(v *int) *NodeUpdateOne {
+	if v != nil {
+		_u.SetChoiceIndex(*v)
+	}
+	return _u
+}
+
+// AddChoiceIndex adds value to the "choice_index" field.
+func (_u *NodeUpdateOne) AddChoiceIndex(v int) *NodeUpdateOne {
+	_u.mutation.AddChoiceIndex(v)
+	return _u
+}
+
+// ClearChoiceIndex clears the value of the "choice_index" field.
+func (_u *NodeUpdateOne) ClearChoiceIndex() *NodeUpdateOne {
+	_u.mutation.ClearChoiceIndex()
+	return _u
+}
+
+// SetContextKey sets the "context_key" field.
+func (_u *NodeUpdateOne) SetContextKey(v string) *NodeUpdateOne {
+	_u.mutation.SetContextKey(v)
+	return _u
+}
+
+// SetNillableContextKey sets the "context_key" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableContextKey(v *string) *NodeUpdateOne {
+	if v != nil {
+		_u.SetContextKey(*v)
+	}
+	return _u
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (_u *NodeUpdateOne) ClearContextKey() *NodeUpdateOne {
+	_u.mutation.ClearContextKey()
+	return _u
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (_u *NodeUpdateOne) SetCacheKey(v string) *NodeUpdateOne {
+	_u.mutation.SetCacheKey(v)
+	return _u
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableCacheKey(v *string) *NodeUpdateOne {
+	if v != nil {
+		_u.SetCacheKey(*v)
+	}
+	return _u
+}
+
+// ClearCacheKey clears the value of the "cache_key" field.
+func (_u *NodeUpdateOne) ClearCacheKey() *NodeUpdateOne {
+	_u.mutation.ClearCacheKey()
+	return _u
+}
+
+// SetCachedAt sets the "cached_at" field.
+func (_u *NodeUpdateOne) SetCachedAt(v time.Time) *NodeUpdateOne {
+	_u.mutation.SetCachedAt(v)
+	return _u
+}
+
+// SetNillableCachedAt sets the "cached_at" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableCachedAt(v *time.Time) *NodeUpdateOne {
+	if v != nil {
+		_u.SetCachedAt(*v)
+	}
+	return _u
+}
+
+// ClearCachedAt clears the value of the "cached_at" field.
+func (_u *NodeUpdateOne) ClearCachedAt() *NodeUpdateOne {
+	_u.mutation.ClearCachedAt()
+	return _u
+}
+
+// SetCacheHits sets the "cache_hits" field.
+func (_u *NodeUpdateOne) SetCacheHits(v int) *NodeUpdateOne {
+	_u.mutation.ResetCacheHits()
+	_u.mutation.SetCacheHits(v)
+	return _u
+}
+
+// SetNillableCacheHits sets the "cache_hits" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableCacheHits(v *int) *NodeUpdateOne {
+	if v != nil {
+		_u.SetCacheHits(*v)
+	}
+	return _u
+}
+
+// AddCacheHits adds value to the "cache_hits" field.
+func (_u *NodeUpdateOne) AddCacheHits(v int) *NodeUpdateOne {
+	_u.mutation.AddCacheHits(v)
+	return _u
+}
+
+// SetRootHash sets the "root_hash" field.
+func (_u *NodeUpdateOne) SetRootHash(v string) *NodeUpdateOne {
+	_u.mutation.SetRootHash(v)
+	return _u
+}
+
+// SetNillableRootHash sets the "root_hash" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableRootHash(v *string) *NodeUpdateOne {
+	if v != nil {
+		_u.SetRootHash(*v)
+	}
+	return _u
+}
+
+// ClearRootHash clears the value of the "root_hash" field.
+func (_u *NodeUpdateOne) ClearRootHash() *NodeUpdateOne {
+	_u.mutation.ClearRootHash()
+	return _u
+}
+
+// SetDepth sets the "depth" field.
+func (_u *NodeUpdateOne) SetDepth(v int) *NodeUpdateOne {
+	_u.mutation.ResetDepth()
+	_u.mutation.SetDepth(v)
+	return _u
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableDepth(v *int) *NodeUpdateOne {
+	if v != nil {
+		_u.SetDepth(*v)
+	}
+	return _u
+}
+
+// AddDepth adds value to the "depth" field.
+func (_u *NodeUpdateOne) AddDepth(v int) *NodeUpdateOne {
+	_u.mutation.AddDepth(v)
+	return _u
+}
+
+// SetIsLeaf sets the "is_leaf" field.
+func (_u *NodeUpdateOne) SetIsLeaf(v bool) *NodeUpdateOne {
+	_u.mutation.SetIsLeaf(v)
+	return _u
+}
+
+// SetNillableIsLeaf sets the "is_leaf" field if the given value is not nil.
+func (_u *NodeUpdateOne) SetNillableIsLeaf(v *bool) *NodeUpdateOne {
+	if v != nil {
+		_u.SetIsLeaf(*v)
+	}
+	return _u
+}
+
 // SetParentID sets the "parent" edge to the Node entity by ID.
 func (_u *NodeUpdateOne) SetParentID(id string) *NodeUpdateOne {
 	_u.mutation.SetParentID(id)
@@ -1358,6 +1931,84 @@ func (_u *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error) {
 	if _u.mutation.ProjectCleared() {
 		_spec.ClearField(node.FieldProject, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestedModel(); ok {
+		_spec.SetField(node.FieldRequestedModel, field.TypeString, value)
+	}
+	if _u.mutation.RequestedModelCleared() {
+		_spec.ClearField(node.FieldRequestedModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Upstream(); ok {
+		_spec.SetField(node.FieldUpstream, field.TypeString, value)
+	}
+	if _u.mutation.UpstreamCleared() {
+		_spec.ClearField(node.FieldUpstream, field.TypeString)
+	}
+	if value, ok := _u.mutation.GenerationParams(); ok {
+		_spec.SetField(node.FieldGenerationParams, field.TypeJSON, value)
+	}
+	if _u.mutation.GenerationParamsCleared() {
+		_spec.ClearField(node.FieldGenerationParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ProviderRequestID(); ok {
+		_spec.SetField(node.FieldProviderRequestID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderRequestIDCleared() {
+		_spec.ClearField(node.FieldProviderRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RateLimits(); ok {
+		_spec.SetField(node.FieldRateLimits, field.TypeJSON, value)
+	}
+	if _u.mutation.RateLimitsCleared() {
+		_spec.ClearField(node.FieldRateLimits, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChoiceIndex(); ok {
+		_spec.SetField(node.FieldChoiceIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChoiceIndex(); ok {
+		_spec.AddField(node.FieldChoiceIndex, field.TypeInt, value)
+	}
+	if _u.mutation.ChoiceIndexCleared() {
+		_spec.ClearField(node.FieldChoiceIndex, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ContextKey(); ok {
+		_spec.SetField(node.FieldContextKey, field.TypeString, value)
+	}
+	if _u.mutation.ContextKeyCleared() {
+		_spec.ClearField(node.FieldContextKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.CacheKey(); ok {
+		_spec.SetField(node.FieldCacheKey, field.TypeString, value)
+	}
+	if _u.mutation.CacheKeyCleared() {
+		_spec.ClearField(node.FieldCacheKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.CachedAt(); ok {
+		_spec.SetField(node.FieldCachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CachedAtCleared() {
+		_spec.ClearField(node.FieldCachedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CacheHits(); ok {
+		_spec.SetField(node.FieldCacheHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCacheHits(); ok {
+		_spec.AddField(node.FieldCacheHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RootHash(); ok {
+		_spec.SetField(node.FieldRootHash, field.TypeString, value)
+	}
+	if _u.mutation.RootHashCleared() {
+		_spec.ClearField(node.FieldRootHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Depth(); ok {
+		_spec.SetField(node.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDepth(); ok {
+		_spec.AddField(node.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsLeaf(); ok {
+		_spec.SetField(node.FieldIsLeaf, field.TypeBool, value)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
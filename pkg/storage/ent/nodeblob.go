@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+)
+
+// NodeBlob is the model entity for the NodeBlob schema.
+type NodeBlob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// NodeHash holds the value of the "node_hash" field.
+	NodeHash string `json:"node_hash,omitempty"`
+	// BlobHash holds the value of the "blob_hash" field.
+	BlobHash     string `json:"blob_hash,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NodeBlob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nodeblob.FieldID:
+			values[i] = new(sql.NullInt64)
+		case nodeblob.FieldNodeHash, nodeblob.FieldBlobHash:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NodeBlob fields.
+func (_m *NodeBlob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nodeblob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case nodeblob.FieldNodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_hash", values[i])
+			} else if value.Valid {
+				_m.NodeHash = value.String
+			}
+		case nodeblob.FieldBlobHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_hash", values[i])
+			} else if value.Valid {
+				_m.BlobHash = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NodeBlob.
+// This includes values selected through modifiers, order, etc.
+func (_m *NodeBlob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NodeBlob.
+// Note that you need to call NodeBlob.Unwrap() before calling this method if this NodeBlob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NodeBlob) Update() *NodeBlobUpdateOne {
+	return NewNodeBlobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NodeBlob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NodeBlob) Unwrap() *NodeBlob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeBlob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NodeBlob) String() string {
+	var builder strings.Builder
+	builder.WriteString("NodeBlob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("node_hash=")
+	builder.WriteString(_m.NodeHash)
+	builder.WriteString(", ")
+	builder.WriteString("blob_hash=")
+	builder.WriteString(_m.BlobHash)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NodeBlobs is a parsable slice of NodeBlob.
+type NodeBlobs []*NodeBlob
@@ -0,0 +1,62 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nodeblob
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the nodeblob type in the database.
+	Label = "node_blob"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNodeHash holds the string denoting the node_hash field in the database.
+	FieldNodeHash = "node_hash"
+	// FieldBlobHash holds the string denoting the blob_hash field in the database.
+	FieldBlobHash = "blob_hash"
+	// Table holds the table name of the nodeblob in the database.
+	Table = "node_blobs"
+)
+
+// Columns holds all SQL columns for nodeblob fields.
+var Columns = []string{
+	FieldID,
+	FieldNodeHash,
+	FieldBlobHash,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NodeHashValidator is a validator for the "node_hash" field. It is called by the builders before save.
+	NodeHashValidator func(string) error
+	// BlobHashValidator is a validator for the "blob_hash" field. It is called by the builders before save.
+	BlobHashValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the NodeBlob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNodeHash orders the results by the node_hash field.
+func ByNodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeHash, opts...).ToFunc()
+}
+
+// ByBlobHash orders the results by the blob_hash field.
+func ByBlobHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobHash, opts...).ToFunc()
+}
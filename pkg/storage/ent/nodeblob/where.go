@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nodeblob
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldLTE(FieldID, id))
+}
+
+// NodeHash applies equality check predicate on the "node_hash" field. It's identical to NodeHashEQ.
+func NodeHash(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEQ(FieldNodeHash, v))
+}
+
+// BlobHash applies equality check predicate on the "blob_hash" field. It's identical to BlobHashEQ.
+func BlobHash(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEQ(FieldBlobHash, v))
+}
+
+// NodeHashEQ applies the EQ predicate on the "node_hash" field.
+func NodeHashEQ(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEQ(FieldNodeHash, v))
+}
+
+// NodeHashNEQ applies the NEQ predicate on the "node_hash" field.
+func NodeHashNEQ(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldNEQ(FieldNodeHash, v))
+}
+
+// NodeHashIn applies the In predicate on the "node_hash" field.
+func NodeHashIn(vs ...string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldIn(FieldNodeHash, vs...))
+}
+
+// NodeHashNotIn applies the NotIn predicate on the "node_hash" field.
+func NodeHashNotIn(vs ...string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldNotIn(FieldNodeHash, vs...))
+}
+
+// NodeHashGT applies the GT predicate on the "node_hash" field.
+func NodeHashGT(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldGT(FieldNodeHash, v))
+}
+
+// NodeHashGTE applies the GTE predicate on the "node_hash" field.
+func NodeHashGTE(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldGTE(FieldNodeHash, v))
+}
+
+// NodeHashLT applies the LT predicate on the "node_hash" field.
+func NodeHashLT(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldLT(FieldNodeHash, v))
+}
+
+// NodeHashLTE applies the LTE predicate on the "node_hash" field.
+func NodeHashLTE(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldLTE(FieldNodeHash, v))
+}
+
+// NodeHashContains applies the Contains predicate on the "node_hash" field.
+func NodeHashContains(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldContains(FieldNodeHash, v))
+}
+
+// NodeHashHasPrefix applies the HasPrefix predicate on the "node_hash" field.
+func NodeHashHasPrefix(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldHasPrefix(FieldNodeHash, v))
+}
+
+// NodeHashHasSuffix applies the HasSuffix predicate on the "node_hash" field.
+func NodeHashHasSuffix(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldHasSuffix(FieldNodeHash, v))
+}
+
+// NodeHashEqualFold applies the EqualFold predicate on the "node_hash" field.
+func NodeHashEqualFold(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEqualFold(FieldNodeHash, v))
+}
+
+// NodeHashContainsFold applies the ContainsFold predicate on the "node_hash" field.
+func NodeHashContainsFold(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldContainsFold(FieldNodeHash, v))
+}
+
+// BlobHashEQ applies the EQ predicate on the "blob_hash" field.
+func BlobHashEQ(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEQ(FieldBlobHash, v))
+}
+
+// BlobHashNEQ applies the NEQ predicate on the "blob_hash" field.
+func BlobHashNEQ(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldNEQ(FieldBlobHash, v))
+}
+
+// BlobHashIn applies the In predicate on the "blob_hash" field.
+func BlobHashIn(vs ...string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldIn(FieldBlobHash, vs...))
+}
+
+// BlobHashNotIn applies the NotIn predicate on the "blob_hash" field.
+func BlobHashNotIn(vs ...string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldNotIn(FieldBlobHash, vs...))
+}
+
+// BlobHashGT applies the GT predicate on the "blob_hash" field.
+func BlobHashGT(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldGT(FieldBlobHash, v))
+}
+
+// BlobHashGTE applies the GTE predicate on the "blob_hash" field.
+func BlobHashGTE(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldGTE(FieldBlobHash, v))
+}
+
+// BlobHashLT applies the LT predicate on the "blob_hash" field.
+func BlobHashLT(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldLT(FieldBlobHash, v))
+}
+
+// BlobHashLTE applies the LTE predicate on the "blob_hash" field.
+func BlobHashLTE(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldLTE(FieldBlobHash, v))
+}
+
+// BlobHashContains applies the Contains predicate on the "blob_hash" field.
+func BlobHashContains(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldContains(FieldBlobHash, v))
+}
+
+// BlobHashHasPrefix applies the HasPrefix predicate on the "blob_hash" field.
+func BlobHashHasPrefix(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldHasPrefix(FieldBlobHash, v))
+}
+
+// BlobHashHasSuffix applies the HasSuffix predicate on the "blob_hash" field.
+func BlobHashHasSuffix(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldHasSuffix(FieldBlobHash, v))
+}
+
+// BlobHashEqualFold applies the EqualFold predicate on the "blob_hash" field.
+func BlobHashEqualFold(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldEqualFold(FieldBlobHash, v))
+}
+
+// BlobHashContainsFold applies the ContainsFold predicate on the "blob_hash" field.
+func BlobHashContainsFold(v string) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.FieldContainsFold(FieldBlobHash, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NodeBlob) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NodeBlob) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NodeBlob) predicate.NodeBlob {
+	return predicate.NodeBlob(sql.NotPredicates(p))
+}
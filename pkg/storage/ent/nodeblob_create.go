@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+)
+
+// NodeBlobCreate is the builder for creating a NodeBlob entity.
+type NodeBlobCreate struct {
+	config
+	mutation *NodeBlobMutation
+	hooks    []Hook
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (_c *NodeBlobCreate) SetNodeHash(v string) *NodeBlobCreate {
+	_c.mutation.SetNodeHash(v)
+	return _c
+}
+
+// SetBlobHash sets the "blob_hash" field.
+func (_c *NodeBlobCreate) SetBlobHash(v string) *NodeBlobCreate {
+	_c.mutation.SetBlobHash(v)
+	return _c
+}
+
+// Mutation returns the NodeBlobMutation object of the builder.
+func (_c *NodeBlobCreate) Mutation() *NodeBlobMutation {
+	return _c.mutation
+}
+
+// Save creates the NodeBlob in the database.
+func (_c *NodeBlobCreate) Save(ctx context.Context) (*NodeBlob, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NodeBlobCreate) SaveX(ctx context.Context) *NodeBlob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeBlobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeBlobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NodeBlobCreate) check() error {
+	if _, ok := _c.mutation.NodeHash(); !ok {
+		return &ValidationError{Name: "node_hash", err: errors.New(`ent: missing required field "NodeBlob.node_hash"`)}
+	}
+	if v, ok := _c.mutation.NodeHash(); ok {
+		if err := nodeblob.NodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "node_hash", err: fmt.Errorf(`ent: validator failed for field "NodeBlob.node_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlobHash(); !ok {
+		return &ValidationError{Name: "blob_hash", err: errors.New(`ent: missing required field "NodeBlob.blob_hash"`)}
+	}
+	if v, ok := _c.mutation.BlobHash(); ok {
+		if err := nodeblob.BlobHashValidator(v); err != nil {
+			return &ValidationError{Name: "blob_hash", err: fmt.Errorf(`ent: validator failed for field "NodeBlob.blob_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *NodeBlobCreate) sqlSave(ctx context.Context) (*NodeBlob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NodeBlobCreate) createSpec() (*NodeBlob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NodeBlob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(nodeblob.Table, sqlgraph.NewFieldSpec(nodeblob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.NodeHash(); ok {
+		_spec.SetField(nodeblob.FieldNodeHash, field.TypeString, value)
+		_node.NodeHash = value
+	}
+	if value, ok := _c.mutation.BlobHash(); ok {
+		_spec.SetField(nodeblob.FieldBlobHash, field.TypeString, value)
+		_node.BlobHash = value
+	}
+	return _node, _spec
+}
+
+// NodeBlobCreateBulk is the builder for creating many NodeBlob entities in bulk.
+type NodeBlobCreateBulk struct {
+	config
+	err      error
+	builders []*NodeBlobCreate
+}
+
+// Save creates the NodeBlob entities in the database.
+func (_c *NodeBlobCreateBulk) Save(ctx context.Context) ([]*NodeBlob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NodeBlob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeBlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NodeBlobCreateBulk) SaveX(ctx context.Context) []*NodeBlob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeBlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeBlobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
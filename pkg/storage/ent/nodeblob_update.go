@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// NodeBlobUpdate is the builder for updating NodeBlob entities.
+type NodeBlobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NodeBlobMutation
+}
+
+// Where appends a list predicates to the NodeBlobUpdate builder.
+func (_u *NodeBlobUpdate) Where(ps ...predicate.NodeBlob) *NodeBlobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (_u *NodeBlobUpdate) SetNodeHash(v string) *NodeBlobUpdate {
+	_u.mutation.SetNodeHash(v)
+	return _u
+}
+
+// SetNillableNodeHash sets the "node_hash" field if the given value is not nil.
+func (_u *NodeBlobUpdate) SetNillableNodeHash(v *string) *NodeBlobUpdate {
+	if v != nil {
+		_u.SetNodeHash(*v)
+	}
+	return _u
+}
+
+// SetBlobHash sets the "blob_hash" field.
+func (_u *NodeBlobUpdate) SetBlobHash(v string) *NodeBlobUpdate {
+	_u.mutation.SetBlobHash(v)
+	return _u
+}
+
+// SetNillableBlobHash sets the "blob_hash" field if the given value is not nil.
+func (_u *NodeBlobUpdate) SetNillableBlobHash(v *string) *NodeBlobUpdate {
+	if v != nil {
+		_u.SetBlobHash(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NodeBlobMutation object of the builder.
+func (_u *NodeBlobUpdate) Mutation() *NodeBlobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NodeBlobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeBlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NodeBlobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeBlobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NodeBlobUpdate) check() error {
+	if v, ok := _u.mutation.NodeHash(); ok {
+		if err := nodeblob.NodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "node_hash", err: fmt.Errorf(`ent: validator failed for field "NodeBlob.node_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BlobHash(); ok {
+		if err := nodeblob.BlobHashValidator(v); err != nil {
+			return &ValidationError{Name: "blob_hash", err: fmt.Errorf(`ent: validator failed for field "NodeBlob.blob_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NodeBlobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nodeblob.Table, nodeblob.Columns, sqlgraph.NewFieldSpec(nodeblob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NodeHash(); ok {
+		_spec.SetField(nodeblob.FieldNodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlobHash(); ok {
+		_spec.SetField(nodeblob.FieldBlobHash, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodeblob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NodeBlobUpdateOne is the builder for updating a single NodeBlob entity.
+type NodeBlobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NodeBlobMutation
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (_u *NodeBlobUpdateOne) SetNodeHash(v string) *NodeBlobUpdateOne {
+	_u.mutation.SetNodeHash(v)
+	return _u
+}
+
+// SetNillableNodeHash sets the "node_hash" field if the given value is not nil.
+func (_u *NodeBlobUpdateOne) SetNillableNodeHash(v *string) *NodeBlobUpdateOne {
+	if v != nil {
+		_u.SetNodeHash(*v)
+	}
+	return _u
+}
+
+// SetBlobHash sets the "blob_hash" field.
+func (_u *NodeBlobUpdateOne) SetBlobHash(v string) *NodeBlobUpdateOne {
+	_u.mutation.SetBlobHash(v)
+	return _u
+}
+
+// SetNillableBlobHash sets the "blob_hash" field if the given value is not nil.
+func (_u *NodeBlobUpdateOne) SetNillableBlobHash(v *string) *NodeBlobUpdateOne {
+	if v != nil {
+		_u.SetBlobHash(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NodeBlobMutation object of the builder.
+func (_u *NodeBlobUpdateOne) Mutation() *NodeBlobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NodeBlobUpdate builder.
+func (_u *NodeBlobUpdateOne) Where(ps ...predicate.NodeBlob) *NodeBlobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NodeBlobUpdateOne) Select(field string, fields ...string) *NodeBlobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NodeBlob entity.
+func (_u *NodeBlobUpdateOne) Save(ctx context.Context) (*NodeBlob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeBlobUpdateOne) SaveX(ctx context.Context) *NodeBlob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NodeBlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeBlobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NodeBlobUpdateOne) check() error {
+	if v, ok := _u.mutation.NodeHash(); ok {
+		if err := nodeblob.NodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "node_hash", err: fmt.Errorf(`ent: validator failed for field "NodeBlob.node_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BlobHash(); ok {
+		if err := nodeblob.BlobHashValidator(v); err != nil {
+			return &ValidationError{Name: "blob_hash", err: fmt.Errorf(`ent: validator failed for field "NodeBlob.blob_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NodeBlobUpdateOne) sqlSave(ctx context.Context) (_node *NodeBlob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nodeblob.Table, nodeblob.Columns, sqlgraph.NewFieldSpec(nodeblob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NodeBlob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nodeblob.FieldID)
+		for _, f := range fields {
+			if !nodeblob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nodeblob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NodeHash(); ok {
+		_spec.SetField(nodeblob.FieldNodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlobHash(); ok {
+		_spec.SetField(nodeblob.FieldBlobHash, field.TypeString, value)
+	}
+	_node = &NodeBlob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodeblob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+)
+
+// NodeLink is the model entity for the NodeLink schema.
+type NodeLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FromHash holds the value of the "from_hash" field.
+	FromHash string `json:"from_hash,omitempty"`
+	// ToHash holds the value of the "to_hash" field.
+	ToHash string `json:"to_hash,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ToolUseID holds the value of the "tool_use_id" field.
+	ToolUseID string `json:"tool_use_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NodeLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nodelink.FieldID:
+			values[i] = new(sql.NullInt64)
+		case nodelink.FieldFromHash, nodelink.FieldToHash, nodelink.FieldKind, nodelink.FieldReason, nodelink.FieldToolUseID:
+			values[i] = new(sql.NullString)
+		case nodelink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NodeLink fields.
+func (_m *NodeLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nodelink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case nodelink.FieldFromHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_hash", values[i])
+			} else if value.Valid {
+				_m.FromHash = value.String
+			}
+		case nodelink.FieldToHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_hash", values[i])
+			} else if value.Valid {
+				_m.ToHash = value.String
+			}
+		case nodelink.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case nodelink.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case nodelink.FieldToolUseID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_use_id", values[i])
+			} else if value.Valid {
+				_m.ToolUseID = value.String
+			}
+		case nodelink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NodeLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *NodeLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NodeLink.
+// Note that you need to call NodeLink.Unwrap() before calling this method if this NodeLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NodeLink) Update() *NodeLinkUpdateOne {
+	return NewNodeLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NodeLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NodeLink) Unwrap() *NodeLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NodeLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("NodeLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("from_hash=")
+	builder.WriteString(_m.FromHash)
+	builder.WriteString(", ")
+	builder.WriteString("to_hash=")
+	builder.WriteString(_m.ToHash)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("tool_use_id=")
+	builder.WriteString(_m.ToolUseID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NodeLinks is a parsable slice of NodeLink.
+type NodeLinks []*NodeLink
@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nodelink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the nodelink type in the database.
+	Label = "node_link"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFromHash holds the string denoting the from_hash field in the database.
+	FieldFromHash = "from_hash"
+	// FieldToHash holds the string denoting the to_hash field in the database.
+	FieldToHash = "to_hash"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldToolUseID holds the string denoting the tool_use_id field in the database.
+	FieldToolUseID = "tool_use_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the nodelink in the database.
+	Table = "node_links"
+)
+
+// Columns holds all SQL columns for nodelink fields.
+var Columns = []string{
+	FieldID,
+	FieldFromHash,
+	FieldToHash,
+	FieldKind,
+	FieldReason,
+	FieldToolUseID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FromHashValidator is a validator for the "from_hash" field. It is called by the builders before save.
+	FromHashValidator func(string) error
+	// ToHashValidator is a validator for the "to_hash" field. It is called by the builders before save.
+	ToHashValidator func(string) error
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the NodeLink queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFromHash orders the results by the from_hash field.
+func ByFromHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromHash, opts...).ToFunc()
+}
+
+// ByToHash orders the results by the to_hash field.
+func ByToHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToHash, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByToolUseID orders the results by the tool_use_id field.
+func ByToolUseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToolUseID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
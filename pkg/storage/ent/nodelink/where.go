@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nodelink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldID, id))
+}
+
+// FromHash applies equality check predicate on the "from_hash" field. It's identical to FromHashEQ.
+func FromHash(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldFromHash, v))
+}
+
+// ToHash applies equality check predicate on the "to_hash" field. It's identical to ToHashEQ.
+func ToHash(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldToHash, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldKind, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldReason, v))
+}
+
+// ToolUseID applies equality check predicate on the "tool_use_id" field. It's identical to ToolUseIDEQ.
+func ToolUseID(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldToolUseID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FromHashEQ applies the EQ predicate on the "from_hash" field.
+func FromHashEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldFromHash, v))
+}
+
+// FromHashNEQ applies the NEQ predicate on the "from_hash" field.
+func FromHashNEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldFromHash, v))
+}
+
+// FromHashIn applies the In predicate on the "from_hash" field.
+func FromHashIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldFromHash, vs...))
+}
+
+// FromHashNotIn applies the NotIn predicate on the "from_hash" field.
+func FromHashNotIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldFromHash, vs...))
+}
+
+// FromHashGT applies the GT predicate on the "from_hash" field.
+func FromHashGT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldFromHash, v))
+}
+
+// FromHashGTE applies the GTE predicate on the "from_hash" field.
+func FromHashGTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldFromHash, v))
+}
+
+// FromHashLT applies the LT predicate on the "from_hash" field.
+func FromHashLT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldFromHash, v))
+}
+
+// FromHashLTE applies the LTE predicate on the "from_hash" field.
+func FromHashLTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldFromHash, v))
+}
+
+// FromHashContains applies the Contains predicate on the "from_hash" field.
+func FromHashContains(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContains(FieldFromHash, v))
+}
+
+// FromHashHasPrefix applies the HasPrefix predicate on the "from_hash" field.
+func FromHashHasPrefix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasPrefix(FieldFromHash, v))
+}
+
+// FromHashHasSuffix applies the HasSuffix predicate on the "from_hash" field.
+func FromHashHasSuffix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasSuffix(FieldFromHash, v))
+}
+
+// FromHashEqualFold applies the EqualFold predicate on the "from_hash" field.
+func FromHashEqualFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEqualFold(FieldFromHash, v))
+}
+
+// FromHashContainsFold applies the ContainsFold predicate on the "from_hash" field.
+func FromHashContainsFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContainsFold(FieldFromHash, v))
+}
+
+// ToHashEQ applies the EQ predicate on the "to_hash" field.
+func ToHashEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldToHash, v))
+}
+
+// ToHashNEQ applies the NEQ predicate on the "to_hash" field.
+func ToHashNEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldToHash, v))
+}
+
+// ToHashIn applies the In predicate on the "to_hash" field.
+func ToHashIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldToHash, vs...))
+}
+
+// ToHashNotIn applies the NotIn predicate on the "to_hash" field.
+func ToHashNotIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldToHash, vs...))
+}
+
+// ToHashGT applies the GT predicate on the "to_hash" field.
+func ToHashGT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldToHash, v))
+}
+
+// ToHashGTE applies the GTE predicate on the "to_hash" field.
+func ToHashGTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldToHash, v))
+}
+
+// ToHashLT applies the LT predicate on the "to_hash" field.
+func ToHashLT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldToHash, v))
+}
+
+// ToHashLTE applies the LTE predicate on the "to_hash" field.
+func ToHashLTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldToHash, v))
+}
+
+// ToHashContains applies the Contains predicate on the "to_hash" field.
+func ToHashContains(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContains(FieldToHash, v))
+}
+
+// ToHashHasPrefix applies the HasPrefix predicate on the "to_hash" field.
+func ToHashHasPrefix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasPrefix(FieldToHash, v))
+}
+
+// ToHashHasSuffix applies the HasSuffix predicate on the "to_hash" field.
+func ToHashHasSuffix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasSuffix(FieldToHash, v))
+}
+
+// ToHashEqualFold applies the EqualFold predicate on the "to_hash" field.
+func ToHashEqualFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEqualFold(FieldToHash, v))
+}
+
+// ToHashContainsFold applies the ContainsFold predicate on the "to_hash" field.
+func ToHashContainsFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContainsFold(FieldToHash, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ToolUseIDEQ applies the EQ predicate on the "tool_use_id" field.
+func ToolUseIDEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldToolUseID, v))
+}
+
+// ToolUseIDNEQ applies the NEQ predicate on the "tool_use_id" field.
+func ToolUseIDNEQ(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldToolUseID, v))
+}
+
+// ToolUseIDIn applies the In predicate on the "tool_use_id" field.
+func ToolUseIDIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldToolUseID, vs...))
+}
+
+// ToolUseIDNotIn applies the NotIn predicate on the "tool_use_id" field.
+func ToolUseIDNotIn(vs ...string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldToolUseID, vs...))
+}
+
+// ToolUseIDGT applies the GT predicate on the "tool_use_id" field.
+func ToolUseIDGT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldToolUseID, v))
+}
+
+// ToolUseIDGTE applies the GTE predicate on the "tool_use_id" field.
+func ToolUseIDGTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldToolUseID, v))
+}
+
+// ToolUseIDLT applies the LT predicate on the "tool_use_id" field.
+func ToolUseIDLT(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldToolUseID, v))
+}
+
+// ToolUseIDLTE applies the LTE predicate on the "tool_use_id" field.
+func ToolUseIDLTE(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldToolUseID, v))
+}
+
+// ToolUseIDContains applies the Contains predicate on the "tool_use_id" field.
+func ToolUseIDContains(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContains(FieldToolUseID, v))
+}
+
+// ToolUseIDHasPrefix applies the HasPrefix predicate on the "tool_use_id" field.
+func ToolUseIDHasPrefix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasPrefix(FieldToolUseID, v))
+}
+
+// ToolUseIDHasSuffix applies the HasSuffix predicate on the "tool_use_id" field.
+func ToolUseIDHasSuffix(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldHasSuffix(FieldToolUseID, v))
+}
+
+// ToolUseIDIsNil applies the IsNil predicate on the "tool_use_id" field.
+func ToolUseIDIsNil() predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIsNull(FieldToolUseID))
+}
+
+// ToolUseIDNotNil applies the NotNil predicate on the "tool_use_id" field.
+func ToolUseIDNotNil() predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotNull(FieldToolUseID))
+}
+
+// ToolUseIDEqualFold applies the EqualFold predicate on the "tool_use_id" field.
+func ToolUseIDEqualFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEqualFold(FieldToolUseID, v))
+}
+
+// ToolUseIDContainsFold applies the ContainsFold predicate on the "tool_use_id" field.
+func ToolUseIDContainsFold(v string) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldContainsFold(FieldToolUseID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NodeLink {
+	return predicate.NodeLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NodeLink) predicate.NodeLink {
+	return predicate.NodeLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NodeLink) predicate.NodeLink {
+	return predicate.NodeLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NodeLink) predicate.NodeLink {
+	return predicate.NodeLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+)
+
+// NodeLinkCreate is the builder for creating a NodeLink entity.
+type NodeLinkCreate struct {
+	config
+	mutation *NodeLinkMutation
+	hooks    []Hook
+}
+
+// SetFromHash sets the "from_hash" field.
+func (_c *NodeLinkCreate) SetFromHash(v string) *NodeLinkCreate {
+	_c.mutation.SetFromHash(v)
+	return _c
+}
+
+// SetToHash sets the "to_hash" field.
+func (_c *NodeLinkCreate) SetToHash(v string) *NodeLinkCreate {
+	_c.mutation.SetToHash(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *NodeLinkCreate) SetKind(v string) *NodeLinkCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *NodeLinkCreate) SetReason(v string) *NodeLinkCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *NodeLinkCreate) SetNillableReason(v *string) *NodeLinkCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetToolUseID sets the "tool_use_id" field.
+func (_c *NodeLinkCreate) SetToolUseID(v string) *NodeLinkCreate {
+	_c.mutation.SetToolUseID(v)
+	return _c
+}
+
+// SetNillableToolUseID sets the "tool_use_id" field if the given value is not nil.
+func (_c *NodeLinkCreate) SetNillableToolUseID(v *string) *NodeLinkCreate {
+	if v != nil {
+		_c.SetToolUseID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NodeLinkCreate) SetCreatedAt(v time.Time) *NodeLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NodeLinkCreate) SetNillableCreatedAt(v *time.Time) *NodeLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NodeLinkMutation object of the builder.
+func (_c *NodeLinkCreate) Mutation() *NodeLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the NodeLink in the database.
+func (_c *NodeLinkCreate) Save(ctx context.Context) (*NodeLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NodeLinkCreate) SaveX(ctx context.Context) *NodeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NodeLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := nodelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NodeLinkCreate) check() error {
+	if _, ok := _c.mutation.FromHash(); !ok {
+		return &ValidationError{Name: "from_hash", err: errors.New(`ent: missing required field "NodeLink.from_hash"`)}
+	}
+	if v, ok := _c.mutation.FromHash(); ok {
+		if err := nodelink.FromHashValidator(v); err != nil {
+			return &ValidationError{Name: "from_hash", err: fmt.Errorf(`ent: validator failed for field "NodeLink.from_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToHash(); !ok {
+		return &ValidationError{Name: "to_hash", err: errors.New(`ent: missing required field "NodeLink.to_hash"`)}
+	}
+	if v, ok := _c.mutation.ToHash(); ok {
+		if err := nodelink.ToHashValidator(v); err != nil {
+			return &ValidationError{Name: "to_hash", err: fmt.Errorf(`ent: validator failed for field "NodeLink.to_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "NodeLink.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := nodelink.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NodeLink.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NodeLink.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *NodeLinkCreate) sqlSave(ctx context.Context) (*NodeLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NodeLinkCreate) createSpec() (*NodeLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NodeLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(nodelink.Table, sqlgraph.NewFieldSpec(nodelink.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.FromHash(); ok {
+		_spec.SetField(nodelink.FieldFromHash, field.TypeString, value)
+		_node.FromHash = value
+	}
+	if value, ok := _c.mutation.ToHash(); ok {
+		_spec.SetField(nodelink.FieldToHash, field.TypeString, value)
+		_node.ToHash = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(nodelink.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(nodelink.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ToolUseID(); ok {
+		_spec.SetField(nodelink.FieldToolUseID, field.TypeString, value)
+		_node.ToolUseID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(nodelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// NodeLinkCreateBulk is the builder for creating many NodeLink entities in bulk.
+type NodeLinkCreateBulk struct {
+	config
+	err      error
+	builders []*NodeLinkCreate
+}
+
+// Save creates the NodeLink entities in the database.
+func (_c *NodeLinkCreateBulk) Save(ctx context.Context) ([]*NodeLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NodeLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NodeLinkCreateBulk) SaveX(ctx context.Context) []*NodeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// NodeLinkUpdate is the builder for updating NodeLink entities.
+type NodeLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NodeLinkMutation
+}
+
+// Where appends a list predicates to the NodeLinkUpdate builder.
+func (_u *NodeLinkUpdate) Where(ps ...predicate.NodeLink) *NodeLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *NodeLinkUpdate) SetReason(v string) *NodeLinkUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *NodeLinkUpdate) SetNillableReason(v *string) *NodeLinkUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *NodeLinkUpdate) ClearReason() *NodeLinkUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetToolUseID sets the "tool_use_id" field.
+func (_u *NodeLinkUpdate) SetToolUseID(v string) *NodeLinkUpdate {
+	_u.mutation.SetToolUseID(v)
+	return _u
+}
+
+// SetNillableToolUseID sets the "tool_use_id" field if the given value is not nil.
+func (_u *NodeLinkUpdate) SetNillableToolUseID(v *string) *NodeLinkUpdate {
+	if v != nil {
+		_u.SetToolUseID(*v)
+	}
+	return _u
+}
+
+// ClearToolUseID clears the value of the "tool_use_id" field.
+func (_u *NodeLinkUpdate) ClearToolUseID() *NodeLinkUpdate {
+	_u.mutation.ClearToolUseID()
+	return _u
+}
+
+// Mutation returns the NodeLinkMutation object of the builder.
+func (_u *NodeLinkUpdate) Mutation() *NodeLinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NodeLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NodeLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *NodeLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(nodelink.Table, nodelink.Columns, sqlgraph.NewFieldSpec(nodelink.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(nodelink.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(nodelink.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToolUseID(); ok {
+		_spec.SetField(nodelink.FieldToolUseID, field.TypeString, value)
+	}
+	if _u.mutation.ToolUseIDCleared() {
+		_spec.ClearField(nodelink.FieldToolUseID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NodeLinkUpdateOne is the builder for updating a single NodeLink entity.
+type NodeLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NodeLinkMutation
+}
+
+// SetReason sets the "reason" field.
+func (_u *NodeLinkUpdateOne) SetReason(v string) *NodeLinkUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *NodeLinkUpdateOne) SetNillableReason(v *string) *NodeLinkUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *NodeLinkUpdateOne) ClearReason() *NodeLinkUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetToolUseID sets the "tool_use_id" field.
+func (_u *NodeLinkUpdateOne) SetToolUseID(v string) *NodeLinkUpdateOne {
+	_u.mutation.SetToolUseID(v)
+	return _u
+}
+
+// SetNillableToolUseID sets the "tool_use_id" field if the given value is not nil.
+func (_u *NodeLinkUpdateOne) SetNillableToolUseID(v *string) *NodeLinkUpdateOne {
+	if v != nil {
+		_u.SetToolUseID(*v)
+	}
+	return _u
+}
+
+// ClearToolUseID clears the value of the "tool_use_id" field.
+func (_u *NodeLinkUpdateOne) ClearToolUseID() *NodeLinkUpdateOne {
+	_u.mutation.ClearToolUseID()
+	return _u
+}
+
+// Mutation returns the NodeLinkMutation object of the builder.
+func (_u *NodeLinkUpdateOne) Mutation() *NodeLinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NodeLinkUpdate builder.
+func (_u *NodeLinkUpdateOne) Where(ps ...predicate.NodeLink) *NodeLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NodeLinkUpdateOne) Select(field string, fields ...string) *NodeLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NodeLink entity.
+func (_u *NodeLinkUpdateOne) Save(ctx context.Context) (*NodeLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeLinkUpdateOne) SaveX(ctx context.Context) *NodeLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NodeLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *NodeLinkUpdateOne) sqlSave(ctx context.Context) (_node *NodeLink, err error) {
+	_spec := sqlgraph.NewUpdateSpec(nodelink.Table, nodelink.Columns, sqlgraph.NewFieldSpec(nodelink.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NodeLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nodelink.FieldID)
+		for _, f := range fields {
+			if !nodelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nodelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(nodelink.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(nodelink.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToolUseID(); ok {
+		_spec.SetField(nodelink.FieldToolUseID, field.TypeString, value)
+	}
+	if _u.mutation.ToolUseIDCleared() {
+		_spec.ClearField(nodelink.FieldToolUseID, field.TypeString)
+	}
+	_node = &NodeLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
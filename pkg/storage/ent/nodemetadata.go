@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+)
+
+// NodeMetadata is the model entity for the NodeMetadata schema.
+type NodeMetadata struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// NodeHash holds the value of the "node_hash" field.
+	NodeHash string `json:"node_hash,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NodeMetadata) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nodemetadata.FieldID:
+			values[i] = new(sql.NullInt64)
+		case nodemetadata.FieldNodeHash, nodemetadata.FieldKey, nodemetadata.FieldValue:
+			values[i] = new(sql.NullString)
+		case nodemetadata.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NodeMetadata fields.
+func (_m *NodeMetadata) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nodemetadata.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case nodemetadata.FieldNodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_hash", values[i])
+			} else if value.Valid {
+				_m.NodeHash = value.String
+			}
+		case nodemetadata.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case nodemetadata.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case nodemetadata.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the NodeMetadata.
+// This includes values selected through modifiers, order, etc.
+func (_m *NodeMetadata) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NodeMetadata.
+// Note that you need to call NodeMetadata.Unwrap() before calling this method if this NodeMetadata
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NodeMetadata) Update() *NodeMetadataUpdateOne {
+	return NewNodeMetadataClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NodeMetadata entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NodeMetadata) Unwrap() *NodeMetadata {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeMetadata is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NodeMetadata) String() string {
+	var builder strings.Builder
+	builder.WriteString("NodeMetadata(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("node_hash=")
+	builder.WriteString(_m.NodeHash)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NodeMetadataSlice is a parsable slice of NodeMetadata.
+type NodeMetadataSlice []*NodeMetadata
@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nodemetadata
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the nodemetadata type in the database.
+	Label = "node_metadata"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNodeHash holds the string denoting the node_hash field in the database.
+	FieldNodeHash = "node_hash"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the nodemetadata in the database.
+	Table = "node_metadata"
+)
+
+// Columns holds all SQL columns for nodemetadata fields.
+var Columns = []string{
+	FieldID,
+	FieldNodeHash,
+	FieldKey,
+	FieldValue,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NodeHashValidator is a validator for the "node_hash" field. It is called by the builders before save.
+	NodeHashValidator func(string) error
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the NodeMetadata queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNodeHash orders the results by the node_hash field.
+func ByNodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeHash, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nodemetadata
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLTE(FieldID, id))
+}
+
+// NodeHash applies equality check predicate on the "node_hash" field. It's identical to NodeHashEQ.
+func NodeHash(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldNodeHash, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldKey, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldValue, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NodeHashEQ applies the EQ predicate on the "node_hash" field.
+func NodeHashEQ(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldNodeHash, v))
+}
+
+// NodeHashNEQ applies the NEQ predicate on the "node_hash" field.
+func NodeHashNEQ(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNEQ(FieldNodeHash, v))
+}
+
+// NodeHashIn applies the In predicate on the "node_hash" field.
+func NodeHashIn(vs ...string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldIn(FieldNodeHash, vs...))
+}
+
+// NodeHashNotIn applies the NotIn predicate on the "node_hash" field.
+func NodeHashNotIn(vs ...string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNotIn(FieldNodeHash, vs...))
+}
+
+// NodeHashGT applies the GT predicate on the "node_hash" field.
+func NodeHashGT(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGT(FieldNodeHash, v))
+}
+
+// NodeHashGTE applies the GTE predicate on the "node_hash" field.
+func NodeHashGTE(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGTE(FieldNodeHash, v))
+}
+
+// NodeHashLT applies the LT predicate on the "node_hash" field.
+func NodeHashLT(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLT(FieldNodeHash, v))
+}
+
+// NodeHashLTE applies the LTE predicate on the "node_hash" field.
+func NodeHashLTE(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLTE(FieldNodeHash, v))
+}
+
+// NodeHashContains applies the Contains predicate on the "node_hash" field.
+func NodeHashContains(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldContains(FieldNodeHash, v))
+}
+
+// NodeHashHasPrefix applies the HasPrefix predicate on the "node_hash" field.
+func NodeHashHasPrefix(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldHasPrefix(FieldNodeHash, v))
+}
+
+// NodeHashHasSuffix applies the HasSuffix predicate on the "node_hash" field.
+func NodeHashHasSuffix(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldHasSuffix(FieldNodeHash, v))
+}
+
+// NodeHashEqualFold applies the EqualFold predicate on the "node_hash" field.
+func NodeHashEqualFold(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEqualFold(FieldNodeHash, v))
+}
+
+// NodeHashContainsFold applies the ContainsFold predicate on the "node_hash" field.
+func NodeHashContainsFold(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldContainsFold(FieldNodeHash, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldContainsFold(FieldValue, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NodeMetadata) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NodeMetadata) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NodeMetadata) predicate.NodeMetadata {
+	return predicate.NodeMetadata(sql.NotPredicates(p))
+}
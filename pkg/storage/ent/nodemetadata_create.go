@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+)
+
+// NodeMetadataCreate is the builder for creating a NodeMetadata entity.
+type NodeMetadataCreate struct {
+	config
+	mutation *NodeMetadataMutation
+	hooks    []Hook
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (_c *NodeMetadataCreate) SetNodeHash(v string) *NodeMetadataCreate {
+	_c.mutation.SetNodeHash(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *NodeMetadataCreate) SetKey(v string) *NodeMetadataCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *NodeMetadataCreate) SetValue(v string) *NodeMetadataCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NodeMetadataCreate) SetCreatedAt(v time.Time) *NodeMetadataCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NodeMetadataCreate) SetNillableCreatedAt(v *time.Time) *NodeMetadataCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NodeMetadataMutation object of the builder.
+func (_c *NodeMetadataCreate) Mutation() *NodeMetadataMutation {
+	return _c.mutation
+}
+
+// Save creates the NodeMetadata in the database.
+func (_c *NodeMetadataCreate) Save(ctx context.Context) (*NodeMetadata, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NodeMetadataCreate) SaveX(ctx context.Context) *NodeMetadata {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeMetadataCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeMetadataCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NodeMetadataCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := nodemetadata.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NodeMetadataCreate) check() error {
+	if _, ok := _c.mutation.NodeHash(); !ok {
+		return &ValidationError{Name: "node_hash", err: errors.New(`ent: missing required field "NodeMetadata.node_hash"`)}
+	}
+	if v, ok := _c.mutation.NodeHash(); ok {
+		if err := nodemetadata.NodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "node_hash", err: fmt.Errorf(`ent: validator failed for field "NodeMetadata.node_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "NodeMetadata.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := nodemetadata.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "NodeMetadata.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "NodeMetadata.value"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NodeMetadata.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *NodeMetadataCreate) sqlSave(ctx context.Context) (*NodeMetadata, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NodeMetadataCreate) createSpec() (*NodeMetadata, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NodeMetadata{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(nodemetadata.Table, sqlgraph.NewFieldSpec(nodemetadata.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.NodeHash(); ok {
+		_spec.SetField(nodemetadata.FieldNodeHash, field.TypeString, value)
+		_node.NodeHash = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(nodemetadata.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(nodemetadata.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(nodemetadata.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// NodeMetadataCreateBulk is the builder for creating many NodeMetadata entities in bulk.
+type NodeMetadataCreateBulk struct {
+	config
+	err      error
+	builders []*NodeMetadataCreate
+}
+
+// Save creates the NodeMetadata entities in the database.
+func (_c *NodeMetadataCreateBulk) Save(ctx context.Context) ([]*NodeMetadata, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NodeMetadata, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeMetadataMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NodeMetadataCreateBulk) SaveX(ctx context.Context) []*NodeMetadata {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeMetadataCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeMetadataCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
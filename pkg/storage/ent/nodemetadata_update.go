@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/predicate"
+)
+
+// NodeMetadataUpdate is the builder for updating NodeMetadata entities.
+type NodeMetadataUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NodeMetadataMutation
+}
+
+// Where appends a list predicates to the NodeMetadataUpdate builder.
+func (_u *NodeMetadataUpdate) Where(ps ...predicate.NodeMetadata) *NodeMetadataUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (_u *NodeMetadataUpdate) SetNodeHash(v string) *NodeMetadataUpdate {
+	_u.mutation.SetNodeHash(v)
+	return _u
+}
+
+// SetNillableNodeHash sets the "node_hash" field if the given value is not nil.
+func (_u *NodeMetadataUpdate) SetNillableNodeHash(v *string) *NodeMetadataUpdate {
+	if v != nil {
+		_u.SetNodeHash(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *NodeMetadataUpdate) SetKey(v string) *NodeMetadataUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *NodeMetadataUpdate) SetNillableKey(v *string) *NodeMetadataUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *NodeMetadataUpdate) SetValue(v string) *NodeMetadataUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *NodeMetadataUpdate) SetNillableValue(v *string) *NodeMetadataUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NodeMetadataMutation object of the builder.
+func (_u *NodeMetadataUpdate) Mutation() *NodeMetadataMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NodeMetadataUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeMetadataUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NodeMetadataUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeMetadataUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NodeMetadataUpdate) check() error {
+	if v, ok := _u.mutation.NodeHash(); ok {
+		if err := nodemetadata.NodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "node_hash", err: fmt.Errorf(`ent: validator failed for field "NodeMetadata.node_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := nodemetadata.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "NodeMetadata.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NodeMetadataUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nodemetadata.Table, nodemetadata.Columns, sqlgraph.NewFieldSpec(nodemetadata.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NodeHash(); ok {
+		_spec.SetField(nodemetadata.FieldNodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(nodemetadata.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(nodemetadata.FieldValue, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodemetadata.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NodeMetadataUpdateOne is the builder for updating a single NodeMetadata entity.
+type NodeMetadataUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NodeMetadataMutation
+}
+
+// SetNodeHash sets the "node_hash" field.
+func (_u *NodeMetadataUpdateOne) SetNodeHash(v string) *NodeMetadataUpdateOne {
+	_u.mutation.SetNodeHash(v)
+	return _u
+}
+
+// SetNillableNodeHash sets the "node_hash" field if the given value is not nil.
+func (_u *NodeMetadataUpdateOne) SetNillableNodeHash(v *string) *NodeMetadataUpdateOne {
+	if v != nil {
+		_u.SetNodeHash(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *NodeMetadataUpdateOne) SetKey(v string) *NodeMetadataUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *NodeMetadataUpdateOne) SetNillableKey(v *string) *NodeMetadataUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *NodeMetadataUpdateOne) SetValue(v string) *NodeMetadataUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *NodeMetadataUpdateOne) SetNillableValue(v *string) *NodeMetadataUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// Mutation returns the NodeMetadataMutation object of the builder.
+func (_u *NodeMetadataUpdateOne) Mutation() *NodeMetadataMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NodeMetadataUpdate builder.
+func (_u *NodeMetadataUpdateOne) Where(ps ...predicate.NodeMetadata) *NodeMetadataUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NodeMetadataUpdateOne) Select(field string, fields ...string) *NodeMetadataUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NodeMetadata entity.
+func (_u *NodeMetadataUpdateOne) Save(ctx context.Context) (*NodeMetadata, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeMetadataUpdateOne) SaveX(ctx context.Context) *NodeMetadata {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NodeMetadataUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeMetadataUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NodeMetadataUpdateOne) check() error {
+	if v, ok := _u.mutation.NodeHash(); ok {
+		if err := nodemetadata.NodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "node_hash", err: fmt.Errorf(`ent: validator failed for field "NodeMetadata.node_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := nodemetadata.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "NodeMetadata.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NodeMetadataUpdateOne) sqlSave(ctx context.Context) (_node *NodeMetadata, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nodemetadata.Table, nodemetadata.Columns, sqlgraph.NewFieldSpec(nodemetadata.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NodeMetadata.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nodemetadata.FieldID)
+		for _, f := range fields {
+			if !nodemetadata.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nodemetadata.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NodeHash(); ok {
+		_spec.SetField(nodemetadata.FieldNodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(nodemetadata.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(nodemetadata.FieldValue, field.TypeString, value)
+	}
+	_node = &NodeMetadata{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodemetadata.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
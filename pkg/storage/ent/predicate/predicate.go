@@ -6,8 +6,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Annotation is the predicate function for annotation builders.
+type Annotation func(*sql.Selector)
+
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
+// EmbeddingCall is the predicate function for embeddingcall builders.
+type EmbeddingCall func(*sql.Selector)
+
 // Facet is the predicate function for facet builders.
 type Facet func(*sql.Selector)
 
 // Node is the predicate function for node builders.
 type Node func(*sql.Selector)
+
+// NodeBlob is the predicate function for nodeblob builders.
+type NodeBlob func(*sql.Selector)
+
+// NodeLink is the predicate function for nodelink builders.
+type NodeLink func(*sql.Selector)
+
+// NodeMetadata is the predicate function for nodemetadata builders.
+type NodeMetadata func(*sql.Selector)
+
+// Ref is the predicate function for ref builders.
+type Ref func(*sql.Selector)
+
+// Signature is the predicate function for signature builders.
+type Signature func(*sql.Selector)
@@ -5,15 +5,59 @@ package ent
 import (
 	"time"
 
+	"github.com/papercomputeco/tapes/pkg/storage/ent/annotation"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/blob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/embeddingcall"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/facet"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/node"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodeblob"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodelink"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/nodemetadata"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/ref"
 	"github.com/papercomputeco/tapes/pkg/storage/ent/schema"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/signature"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	annotationFields := schema.Annotation{}.Fields()
+	_ = annotationFields
+	// annotationDescHash is the schema descriptor for hash field.
+	annotationDescHash := annotationFields[0].Descriptor()
+	// annotation.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	annotation.HashValidator = annotationDescHash.Validators[0].(func(string) error)
+	// annotationDescAuthor is the schema descriptor for author field.
+	annotationDescAuthor := annotationFields[1].Descriptor()
+	// annotation.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	annotation.AuthorValidator = annotationDescAuthor.Validators[0].(func(string) error)
+	// annotationDescCreatedAt is the schema descriptor for created_at field.
+	annotationDescCreatedAt := annotationFields[5].Descriptor()
+	// annotation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	annotation.DefaultCreatedAt = annotationDescCreatedAt.Default.(func() time.Time)
+	// annotationDescUpdatedAt is the schema descriptor for updated_at field.
+	annotationDescUpdatedAt := annotationFields[6].Descriptor()
+	// annotation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	annotation.DefaultUpdatedAt = annotationDescUpdatedAt.Default.(func() time.Time)
+	// annotation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	annotation.UpdateDefaultUpdatedAt = annotationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[4].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	// blobDescID is the schema descriptor for id field.
+	blobDescID := blobFields[0].Descriptor()
+	// blob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	blob.IDValidator = blobDescID.Validators[0].(func(string) error)
+	embeddingcallFields := schema.EmbeddingCall{}.Fields()
+	_ = embeddingcallFields
+	// embeddingcallDescCreatedAt is the schema descriptor for created_at field.
+	embeddingcallDescCreatedAt := embeddingcallFields[10].Descriptor()
+	// embeddingcall.DefaultCreatedAt holds the default value on creation for the created_at field.
+	embeddingcall.DefaultCreatedAt = embeddingcallDescCreatedAt.Default.(func() time.Time)
 	facetFields := schema.Facet{}.Fields()
 	_ = facetFields
 	// facetDescSessionID is the schema descriptor for session_id field.
@@ -30,12 +74,100 @@ func init() {
 	facet.IDValidator = facetDescID.Validators[0].(func(string) error)
 	nodeFields := schema.Node{}.Fields()
 	_ = nodeFields
+	// nodeDescCacheHits is the schema descriptor for cache_hits field.
+	nodeDescCacheHits := nodeFields[27].Descriptor()
+	// node.DefaultCacheHits holds the default value on creation for the cache_hits field.
+	node.DefaultCacheHits = nodeDescCacheHits.Default.(int)
+	// nodeDescDepth is the schema descriptor for depth field.
+	nodeDescDepth := nodeFields[29].Descriptor()
+	// node.DefaultDepth holds the default value on creation for the depth field.
+	node.DefaultDepth = nodeDescDepth.Default.(int)
+	// nodeDescIsLeaf is the schema descriptor for is_leaf field.
+	nodeDescIsLeaf := nodeFields[30].Descriptor()
+	// node.DefaultIsLeaf holds the default value on creation for the is_leaf field.
+	node.DefaultIsLeaf = nodeDescIsLeaf.Default.(bool)
 	// nodeDescCreatedAt is the schema descriptor for created_at field.
-	nodeDescCreatedAt := nodeFields[18].Descriptor()
+	nodeDescCreatedAt := nodeFields[31].Descriptor()
 	// node.DefaultCreatedAt holds the default value on creation for the created_at field.
 	node.DefaultCreatedAt = nodeDescCreatedAt.Default.(func() time.Time)
 	// nodeDescID is the schema descriptor for id field.
 	nodeDescID := nodeFields[0].Descriptor()
 	// node.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	node.IDValidator = nodeDescID.Validators[0].(func(string) error)
+	nodeblobFields := schema.NodeBlob{}.Fields()
+	_ = nodeblobFields
+	// nodeblobDescNodeHash is the schema descriptor for node_hash field.
+	nodeblobDescNodeHash := nodeblobFields[0].Descriptor()
+	// nodeblob.NodeHashValidator is a validator for the "node_hash" field. It is called by the builders before save.
+	nodeblob.NodeHashValidator = nodeblobDescNodeHash.Validators[0].(func(string) error)
+	// nodeblobDescBlobHash is the schema descriptor for blob_hash field.
+	nodeblobDescBlobHash := nodeblobFields[1].Descriptor()
+	// nodeblob.BlobHashValidator is a validator for the "blob_hash" field. It is called by the builders before save.
+	nodeblob.BlobHashValidator = nodeblobDescBlobHash.Validators[0].(func(string) error)
+	nodelinkFields := schema.NodeLink{}.Fields()
+	_ = nodelinkFields
+	// nodelinkDescFromHash is the schema descriptor for from_hash field.
+	nodelinkDescFromHash := nodelinkFields[0].Descriptor()
+	// nodelink.FromHashValidator is a validator for the "from_hash" field. It is called by the builders before save.
+	nodelink.FromHashValidator = nodelinkDescFromHash.Validators[0].(func(string) error)
+	// nodelinkDescToHash is the schema descriptor for to_hash field.
+	nodelinkDescToHash := nodelinkFields[1].Descriptor()
+	// nodelink.ToHashValidator is a validator for the "to_hash" field. It is called by the builders before save.
+	nodelink.ToHashValidator = nodelinkDescToHash.Validators[0].(func(string) error)
+	// nodelinkDescKind is the schema descriptor for kind field.
+	nodelinkDescKind := nodelinkFields[2].Descriptor()
+	// nodelink.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	nodelink.KindValidator = nodelinkDescKind.Validators[0].(func(string) error)
+	// nodelinkDescCreatedAt is the schema descriptor for created_at field.
+	nodelinkDescCreatedAt := nodelinkFields[5].Descriptor()
+	// nodelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	nodelink.DefaultCreatedAt = nodelinkDescCreatedAt.Default.(func() time.Time)
+	nodemetadataFields := schema.NodeMetadata{}.Fields()
+	_ = nodemetadataFields
+	// nodemetadataDescNodeHash is the schema descriptor for node_hash field.
+	nodemetadataDescNodeHash := nodemetadataFields[0].Descriptor()
+	// nodemetadata.NodeHashValidator is a validator for the "node_hash" field. It is called by the builders before save.
+	nodemetadata.NodeHashValidator = nodemetadataDescNodeHash.Validators[0].(func(string) error)
+	// nodemetadataDescKey is the schema descriptor for key field.
+	nodemetadataDescKey := nodemetadataFields[1].Descriptor()
+	// nodemetadata.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	nodemetadata.KeyValidator = nodemetadataDescKey.Validators[0].(func(string) error)
+	// nodemetadataDescCreatedAt is the schema descriptor for created_at field.
+	nodemetadataDescCreatedAt := nodemetadataFields[3].Descriptor()
+	// nodemetadata.DefaultCreatedAt holds the default value on creation for the created_at field.
+	nodemetadata.DefaultCreatedAt = nodemetadataDescCreatedAt.Default.(func() time.Time)
+	refFields := schema.Ref{}.Fields()
+	_ = refFields
+	// refDescHash is the schema descriptor for hash field.
+	refDescHash := refFields[1].Descriptor()
+	// ref.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	ref.HashValidator = refDescHash.Validators[0].(func(string) error)
+	// refDescCreatedAt is the schema descriptor for created_at field.
+	refDescCreatedAt := refFields[3].Descriptor()
+	// ref.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ref.DefaultCreatedAt = refDescCreatedAt.Default.(func() time.Time)
+	// refDescUpdatedAt is the schema descriptor for updated_at field.
+	refDescUpdatedAt := refFields[4].Descriptor()
+	// ref.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ref.DefaultUpdatedAt = refDescUpdatedAt.Default.(func() time.Time)
+	// ref.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ref.UpdateDefaultUpdatedAt = refDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// refDescID is the schema descriptor for id field.
+	refDescID := refFields[0].Descriptor()
+	// ref.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ref.IDValidator = refDescID.Validators[0].(func(string) error)
+	signatureFields := schema.Signature{}.Fields()
+	_ = signatureFields
+	// signatureDescHash is the schema descriptor for hash field.
+	signatureDescHash := signatureFields[0].Descriptor()
+	// signature.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	signature.HashValidator = signatureDescHash.Validators[0].(func(string) error)
+	// signatureDescKeyID is the schema descriptor for key_id field.
+	signatureDescKeyID := signatureFields[1].Descriptor()
+	// signature.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	signature.KeyIDValidator = signatureDescKeyID.Validators[0].(func(string) error)
+	// signatureDescCreatedAt is the schema descriptor for created_at field.
+	signatureDescCreatedAt := signatureFields[4].Descriptor()
+	// signature.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signature.DefaultCreatedAt = signatureDescCreatedAt.Default.(func() time.Time)
 }
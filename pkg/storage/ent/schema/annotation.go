@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Annotation holds the schema definition for the Annotation entity.
+// Annotations are human feedback on a node: a rating, labels and notes. They
+// live beside the nodes and never affect a node's content hash.
+type Annotation struct {
+	ent.Schema
+}
+
+// Fields of the Annotation.
+func (Annotation) Fields() []ent.Field {
+	return []ent.Field{
+		// hash is the annotated node
+		field.String("hash").
+			NotEmpty().
+			Immutable(),
+
+		// author identifies who wrote the annotation
+		field.String("author").
+			NotEmpty().
+			Immutable(),
+
+		// rating is "good", "bad", "failure" or empty
+		field.String("rating").
+			Optional(),
+
+		field.JSON("labels", []string{}).
+			Optional(),
+
+		field.Text("notes").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Annotation.
+func (Annotation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash", "author").
+			Unique(),
+		index.Fields("rating"),
+	}
+}
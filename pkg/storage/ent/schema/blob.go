@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob holds the schema definition for the Blob entity.
+// This stores large content block payloads (base64 images, tool outputs)
+// outside of node rows so repeated payloads are kept once. Blobs never
+// affect a node's content hash.
+type Blob struct {
+	ent.Schema
+}
+
+// Fields of the Blob.
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the SHA-256 of the payload bytes (hex-encoded)
+		field.String("id").
+			StorageKey("hash").
+			Unique().
+			Immutable().
+			NotEmpty(),
+
+		// data is the payload; decoded bytes for images, UTF-8 text for
+		// tool outputs
+		field.Bytes("data").
+			Immutable(),
+
+		// media_type is the MIME type of image payloads
+		field.String("media_type").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Int("size").
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+	}
+}
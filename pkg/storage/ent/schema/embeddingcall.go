@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmbeddingCall holds the schema definition for the EmbeddingCall entity.
+// This records embeddings API calls made through the proxy. They are not
+// part of the conversation DAG.
+type EmbeddingCall struct {
+	ent.Schema
+}
+
+// Fields of the EmbeddingCall.
+func (EmbeddingCall) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			Immutable(),
+
+		field.String("model").
+			Immutable(),
+
+		field.String("agent_name").
+			Optional().
+			Immutable(),
+
+		field.String("project").
+			Optional().
+			Immutable(),
+
+		field.Int("input_count").
+			Immutable(),
+
+		// input holds the input texts when input capture is enabled
+		field.JSON("input", []string{}).
+			Optional().
+			Immutable(),
+
+		field.Int("dimensions").
+			Optional().
+			Immutable(),
+
+		field.Int("prompt_tokens").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Int("total_tokens").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// latency_ns is the proxy-observed latency in nanoseconds
+		field.Int64("latency_ns").
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Indexes of the EmbeddingCall.
+func (EmbeddingCall) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("model"),
+	}
+}
@@ -106,6 +106,76 @@ func (Node) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// requested_model is the model the client asked for (only for responses).
+		// It differs from model when a proxy routing rule rewrote the request.
+		field.String("requested_model").
+			Optional().
+			Nillable(),
+
+		// upstream is the upstream base URL that served the response
+		field.String("upstream").
+			Optional().
+			Nillable(),
+
+		// generation_params holds the generation parameters and provider
+		// request settings (thinking budget, reasoning effort, etc.) that
+		// produced a response. It does not affect the node hash.
+		field.JSON("generation_params", map[string]any{}).
+			Optional(),
+
+		// provider_request_id is the upstream provider's request ID for the
+		// response, for quoting in support tickets
+		field.String("provider_request_id").
+			Optional().
+			Nillable(),
+
+		// rate_limits holds the upstream rate-limit response headers keyed by
+		// lowercase header name
+		field.JSON("rate_limits", map[string]string{}).
+			Optional(),
+
+		// choice_index is the provider's index for a sampled choice when a
+		// response returned several alternatives as sibling nodes
+		field.Int("choice_index").
+			Optional().
+			Nillable(),
+
+		// context_key identifies the opaque context a completion API returned
+		// with the response, linking follow-up completions that pass it back
+		field.String("context_key").
+			Optional().
+			Nillable(),
+
+		// cache_key identifies the request a response may be replayed for
+		// by the proxy response cache. It does not affect the node hash.
+		field.String("cache_key").
+			Optional().
+			Nillable(),
+
+		// cached_at is when the response was last recorded under cache_key
+		field.Time("cached_at").
+			Optional().
+			Nillable(),
+
+		// cache_hits counts how often the response was served from cache
+		field.Int("cache_hits").
+			Default(0),
+
+		// root_hash is the hash of the root of the node's conversation,
+		// materialized at insert time for indexed traversal. It is null on
+		// rows recorded before the column existed until they are backfilled.
+		field.String("root_hash").
+			Optional().
+			Nillable(),
+
+		// depth is the node's distance from its root (0 for roots)
+		field.Int("depth").
+			Default(0),
+
+		// is_leaf reports whether the node has no children yet
+		field.Bool("is_leaf").
+			Default(true),
+
 		// created_at is the timestamp when the node was created
 		field.Time("created_at").
 			Default(time.Now).
@@ -137,6 +207,22 @@ func (Node) Indexes() []ent.Index {
 
 		// Index on project for filtering by project
 		index.Fields("project"),
+
+		// Index on cache_key for response cache lookups
+		index.Fields("cache_key"),
+
+		// Index on provider_request_id for lookups from support tickets
+		index.Fields("provider_request_id"),
+
+		// Index on context_key for completion continuity lookups
+		index.Fields("context_key"),
+
+		// Index on root_hash and depth for loading a conversation's
+		// ancestry in one query
+		index.Fields("root_hash", "depth"),
+
+		// Index on is_leaf for listing branch heads
+		index.Fields("is_leaf"),
 	}
 }
 
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NodeBlob holds the schema definition for the NodeBlob entity.
+// This records which nodes reference which blobs so that unreferenced blobs
+// can be garbage-collected.
+type NodeBlob struct {
+	ent.Schema
+}
+
+// Fields of the NodeBlob.
+func (NodeBlob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("node_hash").
+			NotEmpty(),
+
+		field.String("blob_hash").
+			NotEmpty(),
+	}
+}
+
+// Indexes of the NodeBlob.
+func (NodeBlob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("node_hash", "blob_hash").
+			Unique(),
+		index.Fields("blob_hash"),
+	}
+}
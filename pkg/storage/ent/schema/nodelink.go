@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NodeLink holds the schema definition for the NodeLink entity.
+// This stores relations between nodes that their parent hashes do not
+// express, such as a conversation continuing another after context
+// compaction or a subagent spawned by a tool call. Edges are not part of a node's content hash.
+type NodeLink struct {
+	ent.Schema
+}
+
+// Fields of the NodeLink.
+func (NodeLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_hash").
+			NotEmpty().
+			Immutable(),
+
+		field.String("to_hash").
+			NotEmpty().
+			Immutable(),
+
+		// kind is the relation, e.g. "continues"
+		field.String("kind").
+			NotEmpty().
+			Immutable(),
+
+		// reason records why the edge was inferred
+		field.String("reason").
+			Optional(),
+
+		// tool_use_id is the tool call of the from node the edge belongs to
+		field.String("tool_use_id").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Indexes of the NodeLink.
+func (NodeLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("from_hash", "to_hash", "kind").
+			Unique(),
+		index.Fields("to_hash", "kind"),
+		index.Fields("kind"),
+	}
+}
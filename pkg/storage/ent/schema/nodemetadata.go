@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NodeMetadata holds the schema definition for the NodeMetadata entity.
+// This stores caller-supplied key/value metadata (session IDs, tags) attached
+// to nodes. Metadata is not part of a node's content hash.
+type NodeMetadata struct {
+	ent.Schema
+}
+
+// Fields of the NodeMetadata.
+func (NodeMetadata) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("node_hash").
+			NotEmpty(),
+
+		field.String("key").
+			NotEmpty(),
+
+		field.String("value"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Indexes of the NodeMetadata.
+func (NodeMetadata) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("node_hash", "key").
+			Unique(),
+		index.Fields("key", "value"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Ref holds the schema definition for the Ref entity.
+// Refs are human-readable names (tags and branches) pointing at nodes in
+// the DAG. They live beside the nodes and never affect a node's content hash.
+type Ref struct {
+	ent.Schema
+}
+
+// Fields of the Ref.
+func (Ref) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ref name
+		field.String("id").
+			StorageKey("name").
+			Unique().
+			Immutable().
+			NotEmpty(),
+
+		// hash is the node the ref points at
+		field.String("hash").
+			NotEmpty(),
+
+		// kind is "tag" (fixed) or "branch" (advances as children arrive)
+		field.String("kind").
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Ref.
+func (Ref) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash"),
+	}
+}
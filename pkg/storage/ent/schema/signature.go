@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Signature holds the schema definition for the Signature entity.
+// Signatures attest that a key holder vouched for the conversation ending at
+// a node. They live beside the nodes and never affect a node's content hash.
+type Signature struct {
+	ent.Schema
+}
+
+// Fields of the Signature.
+func (Signature) Fields() []ent.Field {
+	return []ent.Field{
+		// hash is the signed node
+		field.String("hash").
+			NotEmpty().
+			Immutable(),
+
+		// key_id is a short fingerprint of the public key
+		field.String("key_id").
+			NotEmpty().
+			Immutable(),
+
+		// public_key is the raw ed25519 public key
+		field.Bytes("public_key").
+			Immutable(),
+
+		// signature is the raw ed25519 signature
+		field.Bytes("signature").
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Indexes of the Signature.
+func (Signature) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash", "key_id").
+			Unique(),
+	}
+}
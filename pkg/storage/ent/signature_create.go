@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/papercomputeco/tapes/pkg/storage/ent/signature"
+)
+
+// SignatureCreate is the builder for creating a Signature entity.
+type SignatureCreate struct {
+	config
+	mutation *SignatureMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (_c *SignatureCreate) SetHash(v string) *SignatureCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *SignatureCreate) SetKeyID(v string) *SignatureCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *SignatureCreate) SetPublicKey(v []byte) *SignatureCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *SignatureCreate) SetSignature(v []byte) *SignatureCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SignatureCreate) SetCreatedAt(v time.Time) *SignatureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SignatureCreate) SetNillableCreatedAt(v *time.Time) *SignatureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SignatureMutation object of the builder.
+func (_c *SignatureCreate) Mutation() *SignatureMutation {
+	return _c.mutation
+}
+
+// Save creates the Signature in the database.
+func (_c *SignatureCreate) Save(ctx context.Context) (*Signature, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SignatureCreate) SaveX(ctx context.Context) *Signature {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SignatureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SignatureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SignatureCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := signature.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SignatureCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "Signature.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := signature.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "Signature.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "Signature.key_id"`)}
+	}
+	if v, ok := _c.mutation.KeyID(); ok {
+		if err := signature.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "Signature.key_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "Signature.public_key"`)}
+	}
+	if _, ok := _c.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "Signature.signature"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Signature.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *SignatureCreate) sqlSave(ctx context.Context) (*Signature, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SignatureCreate) createSpec() (*Signature, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Signature{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(signature.Table, sqlgraph.NewFieldSpec(signature.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(signature.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(signature.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(signature.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(signature.FieldSignature, field.TypeBytes, value)
+		_node.Signature = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(signature.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SignatureCreateBulk is the builder for creating many Signature entities in bulk.
+type SignatureCreateBulk struct {
+	config
+	err      error
+	builders []*SignatureCreate
+}
+
+// Save creates the Signature entities in the database.
+func (_c *SignatureCreateBulk) Save(ctx context.Context) ([]*Signature, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Signature, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SignatureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SignatureCreateBulk) SaveX(ctx context.Context) []*Signature {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SignatureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SignatureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,10 +12,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Annotation is the client for interacting with the Annotation builders.
+	Annotation *AnnotationClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// EmbeddingCall is the client for interacting with the EmbeddingCall builders.
+	EmbeddingCall *EmbeddingCallClient
 	// Facet is the client for interacting with the Facet builders.
 	Facet *FacetClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// NodeBlob is the client for interacting with the NodeBlob builders.
+	NodeBlob *NodeBlobClient
+	// NodeLink is the client for interacting with the NodeLink builders.
+	NodeLink *NodeLinkClient
+	// NodeMetadata is the client for interacting with the NodeMetadata builders.
+	NodeMetadata *NodeMetadataClient
+	// Ref is the client for interacting with the Ref builders.
+	Ref *RefClient
+	// Signature is the client for interacting with the Signature builders.
+	Signature *SignatureClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +163,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Annotation = NewAnnotationClient(tx.config)
+	tx.Blob = NewBlobClient(tx.config)
+	tx.EmbeddingCall = NewEmbeddingCallClient(tx.config)
 	tx.Facet = NewFacetClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
+	tx.NodeBlob = NewNodeBlobClient(tx.config)
+	tx.NodeLink = NewNodeLinkClient(tx.config)
+	tx.NodeMetadata = NewNodeMetadataClient(tx.config)
+	tx.Ref = NewRefClient(tx.config)
+	tx.Signature = NewSignatureClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +182,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Facet.QueryXXX(), the query will be executed
+// applies a query, for example: Annotation.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
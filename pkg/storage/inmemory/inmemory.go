@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/papercomputeco/tapes/pkg/merkle"
 	"github.com/papercomputeco/tapes/pkg/storage"
@@ -18,17 +22,53 @@ type Driver struct {
 	// nodes is the in memory map of nodes where the key is the content-addressed
 	// hash for the node
 	nodes map[string]*merkle.Node
+
+	// cache maps response cache keys to the most recent entry for that key
+	cache map[string]cacheEntry
+
+	// cacheHits counts cache hits per response node hash
+	cacheHits map[string]int
+
+	// contexts maps completion context keys to the node that returned them
+	contexts map[string]string
+
+	// embeddingCalls are the recorded embedding calls in insertion order
+	embeddingCalls []*storage.EmbeddingCall
+
+	// refs maps ref names to the refs
+	refs map[string]storage.Ref
+
+	// signatures maps node hashes to their signatures in insertion order
+	signatures map[string][]*storage.Signature
+
+	// annotations maps node hashes to their annotations in insertion order
+	annotations map[string][]*storage.Annotation
+
+	// edges are the edges between nodes in insertion order
+	edges []*storage.Edge
+}
+
+type cacheEntry struct {
+	hash     string
+	cachedAt time.Time
 }
 
 // NewDriver creates a new in-memory storer.
 func NewDriver() *Driver {
 	return &Driver{
-		nodes: make(map[string]*merkle.Node),
+		nodes:       make(map[string]*merkle.Node),
+		cache:       make(map[string]cacheEntry),
+		cacheHits:   make(map[string]int),
+		contexts:    make(map[string]string),
+		refs:        make(map[string]storage.Ref),
+		signatures:  make(map[string][]*storage.Signature),
+		annotations: make(map[string][]*storage.Annotation),
 	}
 }
 
 // Put stores a node. Returns true if the node was newly inserted,
-// false if it already existed (no-op due to content-addressing).
+// false if it already existed (no-op due to content-addressing, except that
+// the node's metadata is merged into the stored metadata).
 func (s *Driver) Put(_ context.Context, node *merkle.Node) (bool, error) {
 	if node == nil {
 		return false, errors.New("cannot store nil node")
@@ -38,12 +78,25 @@ func (s *Driver) Put(_ context.Context, node *merkle.Node) (bool, error) {
 	defer s.mu.Unlock()
 
 	// Idempotent insert - deduplication via content-addressing
-	_, ok := s.nodes[node.Hash]
+	existing, ok := s.nodes[node.Hash]
 	if ok {
+		if len(node.Metadata) > 0 {
+			// Store a copy so readers holding the previous node are unaffected.
+			merged := *existing
+			merged.Metadata = maps.Clone(existing.Metadata)
+			if merged.Metadata == nil {
+				merged.Metadata = make(map[string]string, len(node.Metadata))
+			}
+			maps.Copy(merged.Metadata, node.Metadata)
+			s.nodes[node.Hash] = &merged
+		}
 		return false, nil
 	}
 
 	s.nodes[node.Hash] = node
+	if node.ContextKey != "" {
+		s.contexts[node.ContextKey] = node.Hash
+	}
 	return true, nil
 }
 
@@ -173,6 +226,325 @@ func (s *Driver) Depth(ctx context.Context, hash string) (int, error) {
 	return depth, nil
 }
 
+// CachedResponse returns the response node cached under key, if it is no
+// older than maxAge.
+func (s *Driver) CachedResponse(_ context.Context, key string, maxAge time.Duration) (*merkle.Node, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.cache[key]
+	if !ok || (maxAge > 0 && time.Since(entry.cachedAt) > maxAge) {
+		return nil, storage.NotFoundError{}
+	}
+
+	node, ok := s.nodes[entry.hash]
+	if !ok {
+		return nil, storage.NotFoundError{Hash: entry.hash}
+	}
+	return node, nil
+}
+
+// SetCacheKey records the node hash as the cached response for key.
+func (s *Driver) SetCacheKey(_ context.Context, hash, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.nodes[hash]; !ok {
+		return storage.NotFoundError{Hash: hash}
+	}
+	s.cache[key] = cacheEntry{hash: hash, cachedAt: time.Now()}
+	return nil
+}
+
+// RecordCacheHit increments the hit counter of a cached response node.
+func (s *Driver) RecordCacheHit(_ context.Context, hash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cacheHits[hash]++
+	return nil
+}
+
+// CacheHits returns the number of cache hits recorded for a node.
+func (s *Driver) CacheHits(hash string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cacheHits[hash]
+}
+
+// NodeByContextKey returns the node stored with the completion context key.
+func (s *Driver) NodeByContextKey(_ context.Context, key string) (*merkle.Node, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	hash, ok := s.contexts[key]
+	if !ok {
+		return nil, storage.NotFoundError{}
+	}
+	return s.nodes[hash], nil
+}
+
+// PutEmbeddingCall records an embedding call.
+func (s *Driver) PutEmbeddingCall(_ context.Context, call *storage.EmbeddingCall) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *call
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = time.Now()
+	}
+	s.embeddingCalls = append(s.embeddingCalls, &stored)
+	return nil
+}
+
+// EmbeddingCalls returns the embedding calls made at or after since.
+func (s *Driver) EmbeddingCalls(_ context.Context, since time.Time) ([]*storage.EmbeddingCall, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	calls := make([]*storage.EmbeddingCall, 0, len(s.embeddingCalls))
+	for _, call := range s.embeddingCalls {
+		if call.CreatedAt.Before(since) {
+			continue
+		}
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
+
+// Ref returns the ref with name.
+func (s *Driver) Ref(_ context.Context, name string) (*storage.Ref, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ref, ok := s.refs[name]
+	if !ok {
+		return nil, storage.RefNotFoundError{Name: name}
+	}
+	return &ref, nil
+}
+
+// Refs returns all refs ordered by name.
+func (s *Driver) Refs(_ context.Context) ([]*storage.Ref, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	refs := make([]*storage.Ref, 0, len(s.refs))
+	for _, name := range slices.Sorted(maps.Keys(s.refs)) {
+		ref := s.refs[name]
+		refs = append(refs, &ref)
+	}
+	return refs, nil
+}
+
+// PutRef creates ref or moves the existing ref of the same name and kind.
+func (s *Driver) PutRef(_ context.Context, ref *storage.Ref) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.refs[ref.Name]; ok && existing.Kind != ref.Kind {
+		return fmt.Errorf("ref %s is a %s, not a %s", ref.Name, existing.Kind, ref.Kind)
+	}
+	stored := *ref
+	stored.UpdatedAt = time.Now()
+	s.refs[ref.Name] = stored
+	return nil
+}
+
+// DeleteRef removes the ref with name.
+func (s *Driver) DeleteRef(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.refs[name]; !ok {
+		return storage.RefNotFoundError{Name: name}
+	}
+	delete(s.refs, name)
+	return nil
+}
+
+// AdvanceBranches moves the branches pointing at parent to its child.
+func (s *Driver) AdvanceBranches(_ context.Context, parent, child string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, ref := range s.refs {
+		if ref.Kind == storage.RefBranch && ref.Hash == parent {
+			ref.Hash = child
+			ref.UpdatedAt = time.Now()
+			s.refs[name] = ref
+		}
+	}
+	return nil
+}
+
+// PutSignature stores a signature, ignoring repeated signatures of the same
+// node by the same key.
+func (s *Driver) PutSignature(_ context.Context, sig *storage.Signature) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.signatures[sig.Hash] {
+		if existing.KeyID == sig.KeyID {
+			return nil
+		}
+	}
+	stored := *sig
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = time.Now()
+	}
+	s.signatures[sig.Hash] = append(s.signatures[sig.Hash], &stored)
+	return nil
+}
+
+// Signatures returns the signatures of the node with hash.
+func (s *Driver) Signatures(_ context.Context, hash string) ([]*storage.Signature, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.signatures[hash]), nil
+}
+
+// SignedHashes returns the hashes of all signed nodes in order.
+func (s *Driver) SignedHashes(_ context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Sorted(maps.Keys(s.signatures)), nil
+}
+
+// SignedDescendants returns, in order, the hashes of signed nodes that are
+// hash or one of its descendants.
+func (s *Driver) SignedDescendants(_ context.Context, hash string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var hashes []string
+	for signed := range s.signatures {
+		for current, ok := s.nodes[signed]; ok; {
+			if current.Hash == hash {
+				hashes = append(hashes, signed)
+				break
+			}
+			if current.ParentHash == nil {
+				break
+			}
+			current, ok = s.nodes[*current.ParentHash]
+		}
+	}
+	slices.Sort(hashes)
+	return hashes, nil
+}
+
+// PutAnnotation stores an annotation, replacing the author's previous
+// annotation of the same node.
+func (s *Driver) PutAnnotation(_ context.Context, a *storage.Annotation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	stored := *a
+	stored.Labels = slices.Clone(a.Labels)
+	if stored.UpdatedAt.IsZero() {
+		stored.UpdatedAt = now
+	}
+	for i, existing := range s.annotations[a.Hash] {
+		if existing.Author == a.Author {
+			stored.CreatedAt = existing.CreatedAt
+			s.annotations[a.Hash][i] = &stored
+			return nil
+		}
+	}
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = now
+	}
+	s.annotations[a.Hash] = append(s.annotations[a.Hash], &stored)
+	return nil
+}
+
+// Annotations returns the annotations of the node with hash.
+func (s *Driver) Annotations(_ context.Context, hash string) ([]*storage.Annotation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.annotations[hash]), nil
+}
+
+// DeleteAnnotation removes the author's annotation of the node with hash.
+func (s *Driver) DeleteAnnotation(_ context.Context, hash, author string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.annotations[hash] = slices.DeleteFunc(s.annotations[hash], func(a *storage.Annotation) bool {
+		return a.Author == author
+	})
+	if len(s.annotations[hash]) == 0 {
+		delete(s.annotations, hash)
+	}
+	return nil
+}
+
+// ListAnnotations returns every annotation matching filter.
+func (s *Driver) ListAnnotations(_ context.Context, filter storage.AnnotationFilter) ([]*storage.Annotation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []*storage.Annotation
+	for _, hash := range slices.Sorted(maps.Keys(s.annotations)) {
+		byAuthor := slices.Clone(s.annotations[hash])
+		slices.SortFunc(byAuthor, func(a, b *storage.Annotation) int { return strings.Compare(a.Author, b.Author) })
+		for _, a := range byAuthor {
+			if filter.Match(a) {
+				out = append(out, a)
+			}
+		}
+	}
+	return out, nil
+}
+
+// PutEdge stores an edge, ignoring repeated edges of the same kind between
+// the same nodes.
+func (s *Driver) PutEdge(_ context.Context, e *storage.Edge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.edges {
+		if existing.From == e.From && existing.To == e.To && existing.Kind == e.Kind {
+			return nil
+		}
+	}
+	stored := *e
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = time.Now()
+	}
+	s.edges = append(s.edges, &stored)
+	return nil
+}
+
+// EdgesFrom returns the edges of kind starting at the node with hash.
+func (s *Driver) EdgesFrom(_ context.Context, hash string, kind storage.EdgeKind) ([]*storage.Edge, error) {
+	return s.matchEdges(func(e *storage.Edge) bool { return e.From == hash && e.Kind == kind }), nil
+}
+
+// EdgesTo returns the edges of kind pointing to the node with hash.
+func (s *Driver) EdgesTo(_ context.Context, hash string, kind storage.EdgeKind) ([]*storage.Edge, error) {
+	return s.matchEdges(func(e *storage.Edge) bool { return e.To == hash && e.Kind == kind }), nil
+}
+
+// ListEdges returns every edge of kind.
+func (s *Driver) ListEdges(_ context.Context, kind storage.EdgeKind) ([]*storage.Edge, error) {
+	return s.matchEdges(func(e *storage.Edge) bool { return e.Kind == kind }), nil
+}
+
+func (s *Driver) matchEdges(match func(*storage.Edge) bool) []*storage.Edge {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []*storage.Edge
+	for _, e := range s.edges {
+		if match(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
 // Count returns the number of nodes in the in-memory store.
 func (s *Driver) Count() int {
 	s.mu.RLock()
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// RefKind distinguishes fixed tags from branches that follow new turns.
+type RefKind string
+
+const (
+	// RefTag is a name fixed to a single node.
+	RefTag RefKind = "tag"
+
+	// RefBranch is a name that advances to each new turn the proxy records
+	// under the node it points at, like a git branch following new commits.
+	RefBranch RefKind = "branch"
+)
+
+// HeadRef is the reserved name for the current checkout. It is resolved by
+// clients and can never be stored.
+const HeadRef = "HEAD"
+
+// Ref is a human-readable name for a node in the DAG. Refs are stored beside
+// the nodes and never affect a node's content hash.
+type Ref struct {
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Kind      RefKind   `json:"kind"`
+	UpdatedAt time.Time `json:"updated_at,omitzero"`
+}
+
+// RefStore is implemented by drivers that can store named refs. Put never
+// moves refs, so nodes arriving through merge, import or push leave local
+// branches in place.
+type RefStore interface {
+	// Ref returns the ref with name. Returns RefNotFoundError when there is
+	// no such ref.
+	Ref(ctx context.Context, name string) (*Ref, error)
+
+	// Refs returns all refs ordered by name.
+	Refs(ctx context.Context) ([]*Ref, error)
+
+	// PutRef creates ref or moves an existing ref of the same kind to
+	// ref.Hash.
+	PutRef(ctx context.Context, ref *Ref) error
+
+	// DeleteRef removes the ref with name. Returns RefNotFoundError when
+	// there is no such ref.
+	DeleteRef(ctx context.Context, name string) error
+
+	// AdvanceBranches moves every branch pointing at parent to its child.
+	// The proxy calls it for each node of a turn it records.
+	AdvanceBranches(ctx context.Context, parent, child string) error
+}
+
+// RefNotFoundError is returned when a ref doesn't exist in the store.
+type RefNotFoundError struct {
+	Name string
+}
+
+func (e RefNotFoundError) Error() string {
+	return "ref not found: " + e.Name
+}
+
+// RefConflictError is returned when a ref cannot be updated without
+// discarding the node it points at: tags never move, and branches only move
+// forward to descendants of their current node.
+type RefConflictError struct {
+	Name    string
+	Current string
+	Update  string
+}
+
+func (e RefConflictError) Error() string {
+	return fmt.Sprintf("ref %s points at %s and cannot move to %s", e.Name, e.Current, e.Update)
+}
+
+var (
+	refNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	hexPattern     = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+)
+
+// ValidateRefName reports whether name can be used for a ref. Names are made
+// of letters, digits, '.', '_' and '-', must not be HEAD, and must not look
+// like an abbreviated hash so refs and hashes never shadow each other.
+func ValidateRefName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("ref name is empty")
+	case name == HeadRef:
+		return fmt.Errorf("%s is reserved", HeadRef)
+	case !refNamePattern.MatchString(name):
+		return fmt.Errorf("invalid ref name %q: use letters, digits, '.', '_' and '-'", name)
+	case len(name) >= MinHashPrefix && hexPattern.MatchString(name):
+		return fmt.Errorf("invalid ref name %q: names must not look like a hash", name)
+	}
+	return nil
+}
+
+// SyncRef applies a ref received from another store, such as during push or
+// merge, without discarding local history. Missing refs are created, tags
+// are never moved, and branches are only fast-forwarded to descendants of
+// their current node. A branch that is already ahead of ref is left as is.
+//
+// Returns true if the store was changed and RefConflictError when the refs
+// have diverged.
+func SyncRef(ctx context.Context, driver Driver, ref *Ref) (bool, error) {
+	refs, ok := driver.(RefStore)
+	if !ok {
+		return false, errors.New("storage driver does not support refs")
+	}
+
+	current, err := refs.Ref(ctx, ref.Name)
+	if err != nil {
+		if !errors.As(err, new(RefNotFoundError)) {
+			return false, err
+		}
+		return true, refs.PutRef(ctx, ref)
+	}
+
+	if current.Hash == ref.Hash {
+		return false, nil
+	}
+	conflict := RefConflictError{Name: ref.Name, Current: current.Hash, Update: ref.Hash}
+	if current.Kind != RefBranch || ref.Kind != RefBranch {
+		return false, conflict
+	}
+
+	switch {
+	case isAncestor(ctx, driver, current.Hash, ref.Hash):
+		return true, refs.PutRef(ctx, ref)
+	case isAncestor(ctx, driver, ref.Hash, current.Hash):
+		return false, nil
+	default:
+		return false, conflict
+	}
+}
+
+// isAncestor reports whether ancestor is on the path from hash to its root.
+func isAncestor(ctx context.Context, driver Driver, ancestor, hash string) bool {
+	path, err := driver.Ancestry(ctx, hash)
+	if err != nil {
+		return false
+	}
+	for _, node := range path {
+		if node.Hash == ancestor {
+			return true
+		}
+	}
+	return false
+}
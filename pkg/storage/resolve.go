@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MinHashPrefix is the shortest abbreviated hash accepted in place of a full
+// node hash.
+const MinHashPrefix = 4
+
+// AmbiguousHashError is returned when an abbreviated hash matches more than
+// one node.
+type AmbiguousHashError struct {
+	Prefix  string
+	Matches []string
+}
+
+func (e AmbiguousHashError) Error() string {
+	return fmt.Sprintf("hash prefix %s is ambiguous: matches %s", e.Prefix, strings.Join(e.Matches, ", "))
+}
+
+// PrefixResolver is implemented by drivers that can look up node hashes by
+// prefix without listing the whole store.
+type PrefixResolver interface {
+	// HashesWithPrefix returns up to limit hashes of nodes starting with
+	// prefix, in ascending order.
+	HashesWithPrefix(ctx context.Context, prefix string, limit int) ([]string, error)
+}
+
+// ResolveHash expands an abbreviated node hash, like git's short hashes, to
+// the full hash of the single stored node it identifies. Full hashes of
+// stored nodes are returned unchanged, and ref names resolve to the node
+// they point at when the driver implements RefStore.
+//
+// Returns NotFoundError when no node matches and AmbiguousHashError when
+// several do. Drivers that do not implement PrefixResolver are scanned.
+func ResolveHash(ctx context.Context, driver Driver, hash string) (string, error) {
+	ok, err := driver.Has(ctx, hash)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return hash, nil
+	}
+
+	if refs, ok := driver.(RefStore); ok {
+		ref, err := refs.Ref(ctx, hash)
+		if err == nil {
+			return ref.Hash, nil
+		}
+		if !errors.As(err, new(RefNotFoundError)) {
+			return "", err
+		}
+	}
+
+	prefix := strings.ToLower(hash)
+	if len(prefix) < MinHashPrefix {
+		return "", NotFoundError{Hash: hash}
+	}
+
+	// Two matches are enough to tell that a prefix is ambiguous, but list a
+	// few so the caller can show the candidates.
+	const maxMatches = 5
+
+	var matches []string
+	if resolver, ok := driver.(PrefixResolver); ok {
+		matches, err = resolver.HashesWithPrefix(ctx, prefix, maxMatches)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		nodes, err := driver.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, node := range nodes {
+			if strings.HasPrefix(node.Hash, prefix) {
+				matches = append(matches, node.Hash)
+			}
+		}
+		slices.Sort(matches)
+		matches = matches[:min(len(matches), maxMatches)]
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", NotFoundError{Hash: hash}
+	case 1:
+		return matches[0], nil
+	default:
+		return "", AmbiguousHashError{Prefix: hash, Matches: matches}
+	}
+}
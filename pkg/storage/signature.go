@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// Signature is a key holder's attestation of the conversation ending at a
+// node. Because a node's hash covers its parent's hash, a signature over one
+// node vouches for its entire ancestry. Signatures are stored beside the
+// nodes and never affect a node's content hash.
+type Signature struct {
+	// Hash is the signed node, usually a leaf.
+	Hash string `json:"hash"`
+
+	// KeyID is a short fingerprint of PublicKey.
+	KeyID string `json:"key_id"`
+
+	// PublicKey is the raw ed25519 public key of the signer.
+	PublicKey []byte `json:"public_key"`
+
+	// Signature is the raw ed25519 signature.
+	Signature []byte `json:"signature"`
+
+	// CreatedAt is when the signature was made.
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// SignatureStore is implemented by drivers that can store node signatures.
+type SignatureStore interface {
+	// PutSignature stores a signature. Storing a second signature for the
+	// same node and key is a no-op.
+	PutSignature(ctx context.Context, sig *Signature) error
+
+	// Signatures returns the signatures of the node with hash, oldest first.
+	Signatures(ctx context.Context, hash string) ([]*Signature, error)
+
+	// SignedHashes returns the hashes of all signed nodes in order.
+	SignedHashes(ctx context.Context) ([]string, error)
+
+	// SignedDescendants returns, in order, the hashes of signed nodes that
+	// may be hash or one of its descendants. Drivers may include signed
+	// nodes from other branches of the same conversation tree; callers
+	// check inclusion themselves.
+	SignedDescendants(ctx context.Context, hash string) ([]string, error)
+}
@@ -45,9 +45,18 @@ func NewDriver(ctx context.Context, dbPath string) (*Driver, error) {
 		return nil, fmt.Errorf("failed to create schema: %w", err)
 	}
 
+	driver := &entdriver.EntDriver{
+		Client: client,
+	}
+
+	// Backfill the materialized traversal columns on rows recorded before
+	// they existed
+	if err := driver.Materialize(ctx); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to materialize nodes: %w", err)
+	}
+
 	return &Driver{
-		EntDriver: &entdriver.EntDriver{
-			Client: client,
-		},
+		EntDriver: driver,
 	}, nil
 }
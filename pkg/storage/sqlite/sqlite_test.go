@@ -1,16 +1,30 @@
 package sqlite_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/merkle"
 	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/ent"
 	"github.com/papercomputeco/tapes/pkg/storage/sqlite"
 )
 
@@ -112,6 +126,47 @@ var _ = Describe("Driver", func() {
 			Expect(nodes).To(HaveLen(1))
 		})
 
+		It("stores a node once when it is put concurrently", func() {
+			shared, err := sqlite.NewDriver(ctx, filepath.Join(GinkgoT().TempDir(), "tapes.db"))
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(shared.Close)
+
+			const nodes, puts = 20, 8
+			for i := range nodes {
+				node := merkle.NewNode(sqliteTestBucket(fmt.Sprintf("test %d", i)), nil)
+				node.Metadata = map[string]string{"session": "s1"}
+
+				var created atomic.Int32
+				start := make(chan struct{})
+				errs := make(chan error, puts)
+				var wg sync.WaitGroup
+				for range puts {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						<-start
+						isNew, err := shared.Put(ctx, node)
+						if isNew {
+							created.Add(1)
+						}
+						errs <- err
+					}()
+				}
+				close(start)
+				wg.Wait()
+				close(errs)
+
+				for err := range errs {
+					Expect(err).NotTo(HaveOccurred())
+				}
+				Expect(created.Load()).To(Equal(int32(1)))
+
+				got, err := shared.Get(ctx, node.Hash)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got.Metadata).To(Equal(node.Metadata))
+			}
+		})
+
 		It("rejects nil nodes", func() {
 			_, err := driver.Put(ctx, nil)
 			Expect(err).To(HaveOccurred())
@@ -411,6 +466,132 @@ var _ = Describe("Driver", func() {
 		})
 	})
 
+	Describe("Materialized traversal columns", func() {
+		It("records the root, depth and leaf flag on insert", func() {
+			root := merkle.NewNode(sqliteTestBucket("root"), nil)
+			child := merkle.NewNode(sqliteTestBucket("child"), root)
+			left := merkle.NewNode(sqliteTestBucket("left"), child)
+			right := merkle.NewNode(sqliteTestBucket("right"), child)
+			for _, n := range []*merkle.Node{root, child, left, right} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			row, err := driver.Client.Node.Get(ctx, left.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*row.RootHash).To(Equal(root.Hash))
+			Expect(row.Depth).To(Equal(2))
+			Expect(row.IsLeaf).To(BeTrue())
+
+			row, err = driver.Client.Node.Get(ctx, child.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(row.IsLeaf).To(BeFalse())
+
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(2))
+
+			ancestry, err := driver.Ancestry(ctx, right.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry).To(HaveLen(3))
+			Expect(ancestry[0].Hash).To(Equal(right.Hash))
+			Expect(ancestry[1].Hash).To(Equal(child.Hash))
+			Expect(ancestry[2].Hash).To(Equal(root.Hash))
+		})
+
+		It("backfills rows recorded without them", func() {
+			root := merkle.NewNode(sqliteTestBucket("root"), nil)
+			child := merkle.NewNode(sqliteTestBucket("child"), root)
+			grandchild := merkle.NewNode(sqliteTestBucket("grandchild"), child)
+			for _, n := range []*merkle.Node{root, child, grandchild} {
+				Expect(driver.Client.Node.Create().
+					SetID(n.Hash).
+					SetNillableParentHash(n.ParentHash).
+					Exec(ctx)).To(Succeed())
+			}
+
+			depth, err := driver.Depth(ctx, grandchild.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(depth).To(Equal(2))
+
+			Expect(driver.Materialize(ctx)).To(Succeed())
+
+			row, err := driver.Client.Node.Get(ctx, grandchild.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*row.RootHash).To(Equal(root.Hash))
+			Expect(row.Depth).To(Equal(2))
+
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].Hash).To(Equal(grandchild.Hash))
+
+			next := merkle.NewNode(sqliteTestBucket("next"), grandchild)
+			_, err = driver.Put(ctx, next)
+			Expect(err).NotTo(HaveOccurred())
+			depth, err = driver.Depth(ctx, next.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(depth).To(Equal(3))
+		})
+
+		It("places nodes stored before their parent once it arrives", func() {
+			dbPath := filepath.Join(GinkgoT().TempDir(), "tapes.db")
+			shared, err := sqlite.NewDriver(ctx, dbPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shared.Close()).To(Succeed())
+
+			root := merkle.NewNode(sqliteTestBucket("root"), nil)
+			child := merkle.NewNode(sqliteTestBucket("child"), root)
+			grandchild := merkle.NewNode(sqliteTestBucket("grandchild"), child)
+
+			// Connections without foreign keys enabled can store a node
+			// whose parent is missing.
+			db, err := sql.Open("sqlite3", dbPath)
+			Expect(err).NotTo(HaveOccurred())
+			client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+			for _, n := range []*merkle.Node{child, grandchild} {
+				Expect(client.Node.Create().
+					SetID(n.Hash).
+					SetNillableParentHash(n.ParentHash).
+					SetIsLeaf(n == grandchild).
+					Exec(ctx)).To(Succeed())
+			}
+			Expect(client.Close()).To(Succeed())
+
+			shared, err = sqlite.NewDriver(ctx, dbPath)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(shared.Close)
+
+			row, err := shared.Client.Node.Get(ctx, child.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(row.RootHash).To(BeNil())
+			Expect(row.IsLeaf).To(BeFalse())
+			roots, err := shared.Roots(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roots).To(BeEmpty())
+
+			_, err = shared.Put(ctx, root)
+			Expect(err).NotTo(HaveOccurred())
+			roots, err = shared.Roots(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roots).To(HaveLen(1))
+			Expect(roots[0].Hash).To(Equal(root.Hash))
+
+			row, err = shared.Client.Node.Get(ctx, grandchild.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*row.RootHash).To(Equal(root.Hash))
+			Expect(row.Depth).To(Equal(2))
+
+			row, err = shared.Client.Node.Get(ctx, root.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(row.IsLeaf).To(BeFalse())
+
+			ancestry, err := shared.Ancestry(ctx, grandchild.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry).To(HaveLen(3))
+		})
+	})
+
 	Describe("Complex content", func() {
 		It("stores and retrieves node with usage metadata", func() {
 			bucket := merkle.Bucket{
@@ -478,4 +659,588 @@ var _ = Describe("Driver", func() {
 			Expect(leaves).To(HaveLen(2))
 		})
 	})
+
+	Describe("Response cache", func() {
+		It("returns the node recorded under a cache key", func() {
+			node := merkle.NewNode(sqliteTestBucket("cached"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(driver.SetCacheKey(ctx, node.Hash, "key-1")).To(Succeed())
+
+			cached, err := driver.CachedResponse(ctx, "key-1", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached.Hash).To(Equal(node.Hash))
+		})
+
+		It("returns NotFoundError for unknown or expired keys", func() {
+			node := merkle.NewNode(sqliteTestBucket("cached"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(driver.SetCacheKey(ctx, node.Hash, "key-1")).To(Succeed())
+
+			_, err = driver.CachedResponse(ctx, "key-2", 0)
+			Expect(err).To(BeAssignableToTypeOf(storage.NotFoundError{}))
+
+			time.Sleep(time.Millisecond)
+			_, err = driver.CachedResponse(ctx, "key-1", time.Nanosecond)
+			Expect(err).To(BeAssignableToTypeOf(storage.NotFoundError{}))
+		})
+
+		It("counts cache hits", func() {
+			node := merkle.NewNode(sqliteTestBucket("cached"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(driver.RecordCacheHit(ctx, node.Hash)).To(Succeed())
+			Expect(driver.RecordCacheHit(ctx, node.Hash)).To(Succeed())
+
+			entNode, err := driver.Client.Node.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entNode.CacheHits).To(Equal(2))
+		})
+	})
+
+	Describe("Node metadata", func() {
+		It("stores metadata outside the node hash", func() {
+			node := merkle.NewNode(sqliteTestBucket("tagged"), nil,
+				merkle.NodeMeta{Metadata: map[string]string{"session": "run-1", "ticket": "ENG-42"}})
+			Expect(node.Hash).To(Equal(merkle.NewNode(sqliteTestBucket("tagged"), nil).Hash))
+
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			got, err := driver.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Metadata).To(Equal(map[string]string{"session": "run-1", "ticket": "ENG-42"}))
+		})
+
+		It("merges metadata when an existing node is stored again", func() {
+			root := merkle.NewNode(sqliteTestBucket("root"), nil)
+			first := merkle.NewNode(sqliteTestBucket("tagged"), root,
+				merkle.NodeMeta{Metadata: map[string]string{"session": "run-1", "arm": "a"}})
+			again := merkle.NewNode(sqliteTestBucket("tagged"), root,
+				merkle.NodeMeta{Metadata: map[string]string{"arm": "b"}})
+
+			for _, n := range []*merkle.Node{root, first, again} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			ancestry, err := driver.Ancestry(ctx, first.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry[0].Metadata).To(Equal(map[string]string{"session": "run-1", "arm": "b"}))
+			Expect(ancestry[1].Metadata).To(BeNil())
+
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].Metadata).To(HaveKeyWithValue("session", "run-1"))
+		})
+	})
+
+	Describe("Generation params", func() {
+		It("round-trips generation params outside the node hash", func() {
+			temperature := 0.7
+			maxTokens := 1024
+			params := &llm.GenerationParams{
+				Temperature: &temperature,
+				MaxTokens:   &maxTokens,
+				Stop:        []string{"END"},
+				Extra:       map[string]any{"reasoning_effort": "high"},
+			}
+			node := merkle.NewNode(sqliteTestBucket("answer"), nil, merkle.NodeMeta{Params: params})
+			Expect(node.Hash).To(Equal(merkle.NewNode(sqliteTestBucket("answer"), nil).Hash))
+
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			got, err := driver.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Params).To(Equal(params))
+		})
+	})
+
+	Describe("Provider response metadata", func() {
+		It("round-trips the provider request ID and rate limits", func() {
+			node := merkle.NewNode(sqliteTestBucket("answer"), nil, merkle.NodeMeta{
+				ProviderRequestID: "req_123",
+				RateLimits:        map[string]string{"x-ratelimit-remaining-requests": "499"},
+			})
+
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			got, err := driver.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.ProviderRequestID).To(Equal("req_123"))
+			Expect(got.RateLimits).To(Equal(map[string]string{"x-ratelimit-remaining-requests": "499"}))
+		})
+
+		It("round-trips the choice index", func() {
+			index := 1
+			node := merkle.NewNode(sqliteTestBucket("answer"), nil, merkle.NodeMeta{ChoiceIndex: &index})
+
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			got, err := driver.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.ChoiceIndex).To(Equal(&index))
+		})
+	})
+
+	Describe("Hash prefixes", func() {
+		// collidingNodes returns two nodes whose hashes share their first
+		// MinHashPrefix characters.
+		collidingNodes := func() (*merkle.Node, *merkle.Node) {
+			seen := map[string]*merkle.Node{}
+			for i := 0; ; i++ {
+				n := merkle.NewNode(sqliteTestBucket(fmt.Sprintf("message %d", i)), nil)
+				prefix := n.Hash[:storage.MinHashPrefix]
+				if other, ok := seen[prefix]; ok {
+					return other, n
+				}
+				seen[prefix] = n
+			}
+		}
+
+		It("resolves unique prefixes to full hashes", func() {
+			var _ storage.PrefixResolver = driver
+
+			node := merkle.NewNode(sqliteTestBucket("hello"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			hash, err := storage.ResolveHash(ctx, driver, node.Hash[:7])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal(node.Hash))
+
+			hash, err = storage.ResolveHash(ctx, driver, strings.ToUpper(node.Hash[:7]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal(node.Hash))
+
+			hash, err = storage.ResolveHash(ctx, driver, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal(node.Hash))
+		})
+
+		It("rejects ambiguous, short and unknown prefixes", func() {
+			a, b := collidingNodes()
+			_, err := driver.Put(ctx, a)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = driver.Put(ctx, b)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = storage.ResolveHash(ctx, driver, a.Hash[:storage.MinHashPrefix])
+			var ambiguous storage.AmbiguousHashError
+			Expect(errors.As(err, &ambiguous)).To(BeTrue())
+			Expect(ambiguous.Matches).To(ConsistOf(a.Hash, b.Hash))
+
+			_, err = storage.ResolveHash(ctx, driver, a.Hash[:storage.MinHashPrefix-1])
+			Expect(err).To(BeAssignableToTypeOf(storage.NotFoundError{}))
+
+			_, err = storage.ResolveHash(ctx, driver, "ffffffffffff")
+			Expect(err).To(BeAssignableToTypeOf(storage.NotFoundError{}))
+		})
+	})
+
+	Describe("Refs", func() {
+		var root, reply, fork *merkle.Node
+
+		BeforeEach(func() {
+			root = merkle.NewNode(sqliteTestBucket("hello"), nil)
+			reply = merkle.NewNode(sqliteTestBucket("hi"), root)
+			fork = merkle.NewNode(sqliteTestBucket("bye"), root)
+			_, err := driver.Put(ctx, root)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("advances branches to new children but leaves tags in place", func() {
+			var _ storage.RefStore = driver
+
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "main", Hash: root.Hash, Kind: storage.RefBranch})).To(Succeed())
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "v1", Hash: root.Hash, Kind: storage.RefTag})).To(Succeed())
+
+			_, err := driver.Put(ctx, reply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(driver.AdvanceBranches(ctx, root.Hash, reply.Hash)).To(Succeed())
+			_, err = driver.Put(ctx, fork)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(driver.AdvanceBranches(ctx, root.Hash, fork.Hash)).To(Succeed())
+
+			main, err := driver.Ref(ctx, "main")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(main.Hash).To(Equal(reply.Hash))
+
+			tag, err := driver.Ref(ctx, "v1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tag.Hash).To(Equal(root.Hash))
+
+			refs, err := driver.Refs(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(refs).To(HaveLen(2))
+			Expect(refs[0].Name).To(Equal("main"))
+		})
+
+		It("leaves branches in place when nodes are stored directly", func() {
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "main", Hash: root.Hash, Kind: storage.RefBranch})).To(Succeed())
+
+			_, err := driver.Put(ctx, reply)
+			Expect(err).NotTo(HaveOccurred())
+
+			main, err := driver.Ref(ctx, "main")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(main.Hash).To(Equal(root.Hash))
+		})
+
+		It("resolves ref names anywhere a hash is accepted", func() {
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "v1", Hash: root.Hash, Kind: storage.RefTag})).To(Succeed())
+
+			hash, err := storage.ResolveHash(ctx, driver, "v1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal(root.Hash))
+		})
+
+		It("deletes refs and refuses to change their kind", func() {
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "v1", Hash: root.Hash, Kind: storage.RefTag})).To(Succeed())
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "v1", Hash: root.Hash, Kind: storage.RefBranch})).NotTo(Succeed())
+
+			Expect(driver.DeleteRef(ctx, "v1")).To(Succeed())
+			_, err := driver.Ref(ctx, "v1")
+			Expect(err).To(BeAssignableToTypeOf(storage.RefNotFoundError{}))
+			Expect(driver.DeleteRef(ctx, "v1")).To(BeAssignableToTypeOf(storage.RefNotFoundError{}))
+		})
+
+		It("only fast-forwards synced branches", func() {
+			_, err := driver.Put(ctx, reply)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = driver.Put(ctx, fork)
+			Expect(err).NotTo(HaveOccurred())
+
+			changed, err := storage.SyncRef(ctx, driver, &storage.Ref{Name: "main", Hash: root.Hash, Kind: storage.RefBranch})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			changed, err = storage.SyncRef(ctx, driver, &storage.Ref{Name: "main", Hash: reply.Hash, Kind: storage.RefBranch})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			changed, err = storage.SyncRef(ctx, driver, &storage.Ref{Name: "main", Hash: root.Hash, Kind: storage.RefBranch})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+
+			_, err = storage.SyncRef(ctx, driver, &storage.Ref{Name: "main", Hash: fork.Hash, Kind: storage.RefBranch})
+			Expect(err).To(BeAssignableToTypeOf(storage.RefConflictError{}))
+
+			main, err := driver.Ref(ctx, "main")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(main.Hash).To(Equal(reply.Hash))
+		})
+	})
+
+	Describe("Signatures", func() {
+		It("stores one signature per node and key", func() {
+			var _ storage.SignatureStore = driver
+
+			node := merkle.NewNode(sqliteTestBucket("hello"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			sig := &storage.Signature{Hash: node.Hash, KeyID: "key-1", PublicKey: []byte("pub"), Signature: []byte("sig")}
+			Expect(driver.PutSignature(ctx, sig)).To(Succeed())
+			Expect(driver.PutSignature(ctx, sig)).To(Succeed())
+			Expect(driver.PutSignature(ctx, &storage.Signature{Hash: node.Hash, KeyID: "key-2", PublicKey: []byte("pub2"), Signature: []byte("sig2")})).To(Succeed())
+
+			sigs, err := driver.Signatures(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sigs).To(HaveLen(2))
+			Expect(sigs[0].KeyID).To(Equal("key-1"))
+			Expect(sigs[0].PublicKey).To(Equal([]byte("pub")))
+			Expect(sigs[0].Signature).To(Equal([]byte("sig")))
+
+			hashes, err := driver.SignedHashes(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashes).To(Equal([]string{node.Hash}))
+		})
+
+		It("lists signed nodes in the target's tree at or below its depth", func() {
+			root := merkle.NewNode(sqliteTestBucket("hello"), nil)
+			reply := merkle.NewNode(sqliteTestBucket("hi"), root)
+			leaf := merkle.NewNode(sqliteTestBucket("bye"), reply)
+			other := merkle.NewNode(sqliteTestBucket("unrelated"), nil)
+			for _, n := range []*merkle.Node{root, reply, leaf, other} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			for _, n := range []*merkle.Node{root, leaf, other} {
+				Expect(driver.PutSignature(ctx, &storage.Signature{Hash: n.Hash, KeyID: "key-1", PublicKey: []byte("pub"), Signature: []byte("sig")})).To(Succeed())
+			}
+
+			hashes, err := driver.SignedDescendants(ctx, reply.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashes).To(Equal([]string{leaf.Hash}))
+
+			hashes, err = driver.SignedDescendants(ctx, root.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashes).To(ConsistOf(root.Hash, leaf.Hash))
+
+			_, err = driver.SignedDescendants(ctx, "missing")
+			Expect(err).To(MatchError(storage.NotFoundError{Hash: "missing"}))
+		})
+	})
+
+	Describe("Annotations", func() {
+		It("keeps one annotation per node and author without changing the node", func() {
+			var _ storage.AnnotationStore = driver
+
+			node := merkle.NewNode(sqliteTestBucket("hello"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(driver.PutAnnotation(ctx, &storage.Annotation{Hash: node.Hash, Author: "alice", Rating: storage.RatingBad})).To(Succeed())
+			Expect(driver.PutAnnotation(ctx, &storage.Annotation{
+				Hash:   node.Hash,
+				Author: "alice",
+				Rating: storage.RatingFailure,
+				Labels: []string{"hallucination"},
+				Notes:  "invented an API",
+			})).To(Succeed())
+			Expect(driver.PutAnnotation(ctx, &storage.Annotation{Hash: node.Hash, Author: "bob", Rating: storage.RatingGood})).To(Succeed())
+
+			annotations, err := driver.Annotations(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(HaveLen(2))
+			Expect(annotations[0].Author).To(Equal("alice"))
+			Expect(annotations[0].Rating).To(Equal(storage.RatingFailure))
+			Expect(annotations[0].Labels).To(Equal([]string{"hallucination"}))
+			Expect(annotations[0].Notes).To(Equal("invented an API"))
+
+			failures, err := driver.ListAnnotations(ctx, storage.AnnotationFilter{Label: "hallucination"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0].Author).To(Equal("alice"))
+
+			good, err := driver.ListAnnotations(ctx, storage.AnnotationFilter{Rating: storage.RatingGood})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(good).To(HaveLen(1))
+			Expect(good[0].Author).To(Equal("bob"))
+
+			Expect(driver.DeleteAnnotation(ctx, node.Hash, "alice")).To(Succeed())
+			annotations, err = driver.Annotations(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(HaveLen(1))
+
+			stored, err := driver.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.Hash).To(Equal(node.Hash))
+			Expect(stored.Verify()).To(Succeed())
+		})
+	})
+
+	Describe("Edges", func() {
+		It("stores edges between nodes once per kind", func() {
+			var _ storage.EdgeStore = driver
+
+			earlier := merkle.NewNode(sqliteTestBucket("before compaction"), nil)
+			later := merkle.NewNode(sqliteTestBucket("after compaction"), nil)
+			for _, n := range []*merkle.Node{earlier, later} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			edge := &storage.Edge{From: later.Hash, To: earlier.Hash, Kind: storage.EdgeContinues, Reason: storage.ContinuesSummary}
+			Expect(driver.PutEdge(ctx, edge)).To(Succeed())
+			Expect(driver.PutEdge(ctx, edge)).To(Succeed())
+
+			from, err := driver.EdgesFrom(ctx, later.Hash, storage.EdgeContinues)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(from).To(HaveLen(1))
+			Expect(from[0].To).To(Equal(earlier.Hash))
+			Expect(from[0].Reason).To(Equal(storage.ContinuesSummary))
+
+			to, err := driver.EdgesTo(ctx, earlier.Hash, storage.EdgeContinues)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(to).To(HaveLen(1))
+			Expect(to[0].From).To(Equal(later.Hash))
+
+			all, err := driver.ListEdges(ctx, storage.EdgeContinues)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(all).To(HaveLen(1))
+
+			none, err := driver.EdgesFrom(ctx, earlier.Hash, storage.EdgeContinues)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(none).To(BeEmpty())
+		})
+	})
+
+	Describe("Completion context", func() {
+		It("finds the node that returned a completion context", func() {
+			var _ storage.ContextIndex = driver
+
+			key := llm.ContextKey([]int{1, 2, 3})
+			node := merkle.NewNode(sqliteTestBucket("answer"), nil, merkle.NodeMeta{ContextKey: key})
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			got, err := driver.NodeByContextKey(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Hash).To(Equal(node.Hash))
+			Expect(got.ContextKey).To(Equal(key))
+
+			_, err = driver.NodeByContextKey(ctx, llm.ContextKey([]int{4}))
+			Expect(err).To(BeAssignableToTypeOf(storage.NotFoundError{}))
+		})
+	})
+
+	Describe("Blobs", func() {
+		var image []byte
+
+		imageBucket := func(data []byte) merkle.Bucket {
+			return merkle.Bucket{
+				Type: "message",
+				Role: "user",
+				Content: []llm.ContentBlock{
+					{Type: "text", Text: "what is in this screenshot?"},
+					{Type: "image", MediaType: "image/png", ImageBase64: base64.StdEncoding.EncodeToString(data)},
+				},
+				Model:    "test-model",
+				Provider: "test-provider",
+			}
+		}
+
+		toolBucket := func(output string) merkle.Bucket {
+			return merkle.Bucket{
+				Type:     "message",
+				Role:     "user",
+				Content:  []llm.ContentBlock{{Type: "tool_result", ToolResultID: "call_1", ToolOutput: output}},
+				Model:    "test-model",
+				Provider: "test-provider",
+			}
+		}
+
+		BeforeEach(func() {
+			image = bytes.Repeat([]byte{0x89, 'P', 'N', 'G'}, 4096)
+		})
+
+		It("moves large images out of the node and restores them on read", func() {
+			node := merkle.NewNode(imageBucket(image), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			stored, err := driver.Client.Node.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			storedBlock := stored.Bucket["content"].([]any)[1].(map[string]any)
+			Expect(storedBlock).NotTo(HaveKey("image_base64"))
+			Expect(storedBlock).To(HaveKey("blob_refs"))
+			Expect(stored.Content[1]).NotTo(HaveKey("image_base64"))
+			Expect(stored.Content[1]).To(HaveKey("blob_refs"))
+
+			Expect(driver.ResolveBlobs(ctx, stored.Content...)).To(Succeed())
+			Expect(stored.Content[1]).To(HaveKeyWithValue("image_base64", base64.StdEncoding.EncodeToString(image)))
+			Expect(stored.Content[1]).NotTo(HaveKey("blob_refs"))
+
+			got, err := driver.Get(ctx, node.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Bucket).To(Equal(node.Bucket))
+			Expect(merkle.NewNode(got.Bucket, nil).Hash).To(Equal(node.Hash))
+
+			sum := sha256.Sum256(image)
+			blob, err := driver.Blob(ctx, hex.EncodeToString(sum[:]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blob.Data).To(Equal(image))
+			Expect(blob.MediaType).To(Equal("image/png"))
+		})
+
+		It("stores a repeated payload once", func() {
+			output := strings.Repeat("line of build output\n", 500)
+			first := merkle.NewNode(toolBucket(output), nil)
+			second := merkle.NewNode(sqliteTestBucket("again"), first)
+			third := merkle.NewNode(toolBucket(output), second)
+			for _, n := range []*merkle.Node{first, second, third} {
+				_, err := driver.Put(ctx, n)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(driver.Client.Blob.Query().Count(ctx)).To(Equal(1))
+
+			ancestry, err := driver.Ancestry(ctx, third.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry[0].Bucket.Content[0].ToolOutput).To(Equal(output))
+			Expect(ancestry[2].Bucket.Content[0].ToolOutput).To(Equal(output))
+		})
+
+		It("keeps small payloads inline", func() {
+			node := merkle.NewNode(toolBucket("ok"), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(driver.Client.Blob.Query().Count(ctx)).To(Equal(0))
+		})
+
+		It("returns NotFoundError for unknown blobs", func() {
+			_, err := driver.Blob(ctx, "missing")
+			Expect(err).To(BeAssignableToTypeOf(storage.NotFoundError{}))
+		})
+
+		It("collects blobs once their nodes are gone", func() {
+			node := merkle.NewNode(imageBucket(image), nil)
+			_, err := driver.Put(ctx, node)
+			Expect(err).NotTo(HaveOccurred())
+
+			removed, err := driver.CollectBlobs(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(Equal(0))
+
+			Expect(driver.Client.Node.DeleteOneID(node.Hash).Exec(ctx)).To(Succeed())
+
+			removed, err = driver.CollectBlobs(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(Equal(1))
+			Expect(driver.Client.NodeBlob.Query().Count(ctx)).To(Equal(0))
+		})
+	})
+
+	Describe("Embedding calls", func() {
+		It("round-trips embedding calls in time order", func() {
+			var _ storage.EmbeddingStore = driver
+
+			earlier := time.Now().Add(-time.Hour).Truncate(time.Second)
+			Expect(driver.PutEmbeddingCall(ctx, &storage.EmbeddingCall{
+				Provider:   "openai",
+				Model:      "text-embedding-3-small",
+				AgentName:  "rag",
+				Project:    "tapes",
+				InputCount: 2,
+				Input:      []string{"a", "b"},
+				Dimensions: 1536,
+				Usage:      &llm.Usage{PromptTokens: 4, TotalTokens: 4},
+				Latency:    120 * time.Millisecond,
+				CreatedAt:  earlier,
+			})).To(Succeed())
+			Expect(driver.PutEmbeddingCall(ctx, &storage.EmbeddingCall{
+				Provider:   "ollama",
+				Model:      "nomic-embed-text",
+				InputCount: 1,
+				Latency:    10 * time.Millisecond,
+			})).To(Succeed())
+
+			calls, err := driver.EmbeddingCalls(ctx, time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(HaveLen(2))
+			Expect(calls[0].Model).To(Equal("text-embedding-3-small"))
+			Expect(calls[0].AgentName).To(Equal("rag"))
+			Expect(calls[0].Input).To(Equal([]string{"a", "b"}))
+			Expect(calls[0].Dimensions).To(Equal(1536))
+			Expect(calls[0].Usage.PromptTokens).To(Equal(4))
+			Expect(calls[0].Latency).To(Equal(120 * time.Millisecond))
+			Expect(calls[0].CreatedAt.Equal(earlier)).To(BeTrue())
+			Expect(calls[1].Usage).To(BeNil())
+			Expect(calls[1].Input).To(BeEmpty())
+
+			recent, err := driver.EmbeddingCalls(ctx, earlier.Add(time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recent).To(HaveLen(1))
+			Expect(recent[0].Model).To(Equal("nomic-embed-text"))
+		})
+	})
 })
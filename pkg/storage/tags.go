@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseTags parses "key=value" filters (session metadata tags, generation
+// parameters) into a map. Keys are lowercased to match how they are recorded.
+func ParseTags(raw []string) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(raw))
+	for _, t := range raw {
+		k, v, ok := strings.Cut(t, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid tag %q: expected key=value", t)
+		}
+		tags[strings.ToLower(k)] = strings.TrimSpace(v)
+	}
+	return tags, nil
+}
+
+// MatchesTags reports whether metadata carries every key/value pair in tags.
+func MatchesTags(metadata, tags map[string]string) bool {
+	for k, v := range tags {
+		if got, ok := metadata[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
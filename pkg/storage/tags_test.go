@@ -0,0 +1,30 @@
+package storage_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+var _ = Describe("ParseTags", func() {
+	It("parses key=value pairs with lowercased keys", func() {
+		tags, err := storage.ParseTags([]string{"Ticket=ENG-42", "arm=b"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal(map[string]string{"ticket": "ENG-42", "arm": "b"}))
+	})
+
+	It("rejects tags without a key", func() {
+		_, err := storage.ParseTags([]string{"ENG-42"})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("MatchesTags", func() {
+	It("requires every tag to match", func() {
+		metadata := map[string]string{"ticket": "ENG-42", "arm": "b"}
+		Expect(storage.MatchesTags(metadata, map[string]string{"ticket": "ENG-42"})).To(BeTrue())
+		Expect(storage.MatchesTags(metadata, map[string]string{"ticket": "ENG-42", "arm": "a"})).To(BeFalse())
+		Expect(storage.MatchesTags(nil, nil)).To(BeTrue())
+	})
+})
@@ -0,0 +1,159 @@
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/eventstream"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+const (
+	bedrockModel        = "anthropic.claude-3-5-haiku-20241022-v1:0"
+	bedrockModelPath    = "/model/anthropic.claude-3-5-haiku-20241022-v1%3A0"
+	bedrockConverseBody = `{"messages":[{"role":"user","content":[{"text":"Name a color."}]}],"inferenceConfig":{"maxTokens":64}}`
+)
+
+// sigV4Headers are headers of a SigV4-signed request, which must reach the
+// upstream untouched.
+var sigV4Headers = map[string]string{
+	"Authorization":        "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260101/us-east-1/bedrock/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token, Signature=0123abcd",
+	"X-Amz-Date":           "20260101T000000Z",
+	"X-Amz-Security-Token": "session-token",
+	"X-Amz-Content-Sha256": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+}
+
+var _ = Describe("Bedrock", func() {
+	var (
+		p        *Proxy
+		driver   *inmemory.Driver
+		upstream *httptest.Server
+		received []*http.Request
+		bodies   []string
+	)
+
+	// newBedrockServer starts a fake Bedrock runtime answering Converse with
+	// converse and ConverseStream with stream.
+	newBedrockServer := func(converse string, stream []byte) {
+		received, bodies = nil, nil
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received = append(received, r.Clone(r.Context()))
+			bodies = append(bodies, string(body))
+
+			if strings.HasSuffix(r.URL.Path, "/converse-stream") {
+				w.Header().Set("Content-Type", eventstream.ContentType)
+				w.Write(stream)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(converse))
+		}))
+
+		logger, _ := zap.NewDevelopment()
+		driver = inmemory.NewDriver()
+		var err error
+		p, err = New(Config{ListenAddr: ":0", UpstreamURL: upstream.URL, ProviderType: "bedrock"}, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	post := func(path string) []byte {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(bedrockConverseBody))
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range sigV4Headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := p.server.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return body
+	}
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		upstream.Close()
+	})
+
+	It("records Converse calls and forwards signed requests untouched", func() {
+		newBedrockServer(`{
+			"output": {"message": {"role": "assistant", "content": [{"text": "Blue."}]}},
+			"stopReason": "end_turn",
+			"usage": {"inputTokens": 5, "outputTokens": 2, "totalTokens": 7},
+			"metrics": {"latencyMs": 120}
+		}`, nil)
+
+		Expect(string(post(bedrockModelPath + "/converse"))).To(ContainSubstring("Blue."))
+
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].URL.EscapedPath()).To(Equal(bedrockModelPath + "/converse"))
+		Expect(bodies[0]).To(Equal(bedrockConverseBody))
+		for k, v := range sigV4Headers {
+			Expect(received[0].Header.Get(k)).To(Equal(v), k)
+		}
+
+		p.Close()
+		p = nil
+
+		leaves, err := driver.Leaves(GinkgoT().Context())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Bucket.Provider).To(Equal("bedrock"))
+		Expect(leaves[0].Bucket.Model).To(Equal(bedrockModel))
+		Expect(leaves[0].Bucket.ExtractText()).To(Equal("Blue."))
+		Expect(leaves[0].StopReason).To(Equal("end_turn"))
+		Expect(leaves[0].Usage.PromptTokens).To(Equal(5))
+		Expect(leaves[0].Usage.CompletionTokens).To(Equal(2))
+	})
+
+	It("decodes ConverseStream event streams while passing them through", func() {
+		var stream []byte
+		for _, ev := range []struct{ eventType, payload string }{
+			{"messageStart", `{"role":"assistant"}`},
+			{"contentBlockDelta", `{"delta":{"text":"Deep "},"contentBlockIndex":0}`},
+			{"contentBlockDelta", `{"delta":{"text":"blue."},"contentBlockIndex":0}`},
+			{"contentBlockStop", `{"contentBlockIndex":0}`},
+			{"messageStop", `{"stopReason":"end_turn"}`},
+			{"metadata", `{"usage":{"inputTokens":5,"outputTokens":3,"totalTokens":8},"metrics":{"latencyMs":90}}`},
+		} {
+			encoded, err := eventstream.EncodeEvent(ev.eventType, []byte(ev.payload))
+			Expect(err).NotTo(HaveOccurred())
+			stream = append(stream, encoded...)
+		}
+		newBedrockServer("", stream)
+
+		Expect(bytes.Equal(post(bedrockModelPath+"/converse-stream"), stream)).To(BeTrue())
+
+		p.Close()
+		p = nil
+
+		leaves, err := driver.Leaves(GinkgoT().Context())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Bucket.Model).To(Equal(bedrockModel))
+		Expect(leaves[0].Bucket.ExtractText()).To(Equal("Deep blue."))
+		Expect(leaves[0].StopReason).To(Equal("end_turn"))
+		Expect(leaves[0].Usage.PromptTokens).To(Equal(5))
+		Expect(leaves[0].Usage.CompletionTokens).To(Equal(3))
+	})
+
+	It("intercepts regional Bedrock endpoints in forward mode", func() {
+		providerName, ok := interceptProvider(DefaultInterceptHosts, "bedrock-runtime.eu-west-1.amazonaws.com")
+		Expect(ok).To(BeTrue())
+		Expect(providerName).To(Equal(providerBedrock))
+
+		_, ok = interceptProvider(DefaultInterceptHosts, "s3.eu-west-1.amazonaws.com")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/proxy/header"
+)
+
+const (
+	cacheHit  = "HIT"
+	cacheMiss = "MISS"
+)
+
+// cacheKeyPayload is the canonical form of a request hashed into a cache key.
+// It covers everything that influences the generated response; Stream is
+// deliberately excluded so streaming and non-streaming clients share entries.
+type cacheKeyPayload struct {
+	Provider    string          `json:"provider"`
+	ServedBy    string          `json:"served_by"`
+	Model       string          `json:"model"`
+	System      string          `json:"system,omitempty"`
+	Messages    []llm.Message   `json:"messages"`
+	MaxTokens   *int            `json:"max_tokens,omitempty"`
+	Temperature *float64        `json:"temperature,omitempty"`
+	TopP        *float64        `json:"top_p,omitempty"`
+	TopK        *int            `json:"top_k,omitempty"`
+	Stop        []string        `json:"stop,omitempty"`
+	Seed        *int            `json:"seed,omitempty"`
+	Extra       map[string]any  `json:"extra,omitempty"`
+	Tools       json.RawMessage `json:"tools,omitempty"`
+	ToolChoice  json.RawMessage `json:"tool_choice,omitempty"`
+}
+
+// cacheKey hashes the parts of a request that determine its response.
+// Tool definitions are taken from the raw body rather than req.Tools, which
+// drops provider-specific fields such as Anthropic server tool types.
+func cacheKey(providerName, servedBy, model string, req *llm.ChatRequest, body []byte) (string, error) {
+	var tools struct {
+		Tools      json.RawMessage `json:"tools"`
+		ToolChoice json.RawMessage `json:"tool_choice"`
+	}
+	_ = json.Unmarshal(body, &tools)
+
+	payload, err := json.Marshal(cacheKeyPayload{
+		Provider:    providerName,
+		ServedBy:    servedBy,
+		Model:       model,
+		System:      req.System,
+		Messages:    req.Messages,
+		MaxTokens:   req.MaxTokens,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+		TopK:        req.TopK,
+		Stop:        req.Stop,
+		Seed:        req.Seed,
+		Extra:       req.Extra,
+		Tools:       tools.Tools,
+		ToolChoice:  tools.ToolChoice,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// cacheable reports whether a request may be answered from the cache: the
+// cache must be enabled and supported by the storage driver, and the request
+// must be deterministic (temperature 0) or explicitly marked cacheable.
+func (p *Proxy) cacheable(c *fiber.Ctx, req *llm.ChatRequest) bool {
+	// Cached responses are replayed in chat format, which completion
+	// clients cannot read.
+	if !p.config.Cache.Enabled || p.cache == nil || req == nil || req.Completion {
+		return false
+	}
+
+	switch strings.ToLower(c.Get(header.CacheHeader)) {
+	case "true", "1":
+		return true
+	case "false", "0":
+		return false
+	}
+
+	return req.Temperature != nil && *req.Temperature == 0
+}
+
+// cacheTTL returns the TTL for a request, honoring a matching route's override.
+func (p *Proxy) cacheTTL(routed *routedRequest) time.Duration {
+	if routed != nil && routed.route.CacheTTL > 0 {
+		return routed.route.CacheTTL
+	}
+	return p.config.Cache.TTL
+}
+
+// serveFromCache answers the request with a recorded response for key,
+// rendered in the client provider's format. Returns false when there is no
+// usable entry and the request should go upstream.
+func (p *Proxy) serveFromCache(c *fiber.Ctx, prov provider.Provider, key string, ttl time.Duration, stream bool) (bool, error) {
+	ctx := c.UserContext()
+
+	node, err := p.cache.CachedResponse(ctx, key, ttl)
+	if err != nil {
+		var notFound storage.NotFoundError
+		if !errors.As(err, &notFound) {
+			p.logger.Warn("response cache lookup failed", zap.Error(err))
+		}
+		return false, nil
+	}
+
+	body, contentType, err := serializeForClient(prov, cachedChatResponse(node), stream)
+	if err != nil {
+		p.logger.Warn("failed to render cached response",
+			zap.String("hash", node.Hash),
+			zap.Error(err),
+		)
+		return false, nil
+	}
+
+	if err := p.cache.RecordCacheHit(ctx, node.Hash); err != nil {
+		p.logger.Warn("failed to record cache hit",
+			zap.String("hash", node.Hash),
+			zap.Error(err),
+		)
+	}
+
+	p.logger.Debug("served response from cache",
+		zap.String("hash", node.Hash),
+		zap.String("provider", prov.Name()),
+	)
+
+	c.Set(header.CacheHeader, cacheHit)
+	c.Set(header.CacheHashHeader, node.Hash)
+	c.Set(fiber.HeaderContentType, contentType)
+	return true, c.Status(fiber.StatusOK).Send(body)
+}
+
+// cachedChatResponse rebuilds a complete response from a stored response node.
+func cachedChatResponse(node *merkle.Node) *llm.ChatResponse {
+	return &llm.ChatResponse{
+		Model: node.Bucket.Model,
+		Message: llm.Message{
+			Role:    node.Bucket.Role,
+			Content: node.Bucket.Content,
+		},
+		Done:       true,
+		StopReason: node.StopReason,
+		Usage:      node.Usage,
+	}
+}
@@ -0,0 +1,192 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+	"github.com/papercomputeco/tapes/proxy/header"
+)
+
+// newCachingTestProxy creates an ollama Proxy with the response cache enabled.
+func newCachingTestProxy(upstreamURL string, driver *inmemory.Driver, ttl time.Duration) *Proxy {
+	logger, _ := zap.NewDevelopment()
+
+	p, err := New(
+		Config{
+			ListenAddr:   ":0",
+			UpstreamURL:  upstreamURL,
+			ProviderType: "ollama",
+			Cache:        CacheConfig{Enabled: true, TTL: ttl},
+		},
+		driver,
+		logger,
+	)
+	Expect(err).NotTo(HaveOccurred())
+	return p
+}
+
+const (
+	deterministicRequest = `{"model":"test-model","stream":false,"options":{"temperature":0},"messages":[{"role":"user","content":"What is 2+2?"}]}`
+	sampledRequest       = `{"model":"test-model","stream":false,"options":{"temperature":0.7},"messages":[{"role":"user","content":"What is 2+2?"}]}`
+)
+
+var _ = Describe("Response cache", func() {
+	var (
+		p           *Proxy
+		driver      *inmemory.Driver
+		upstream    *httptest.Server
+		calls       atomic.Int32
+		cacheHeader atomic.Value
+	)
+
+	BeforeEach(func() {
+		calls.Store(0)
+		cacheHeader.Store("")
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			cacheHeader.Store(r.Header.Get(header.CacheHeader))
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(makeOllamaResponseBody("test-model", "assistant", "4"))
+		}))
+		driver = inmemory.NewDriver()
+		p = newCachingTestProxy(upstream.URL, driver, 0)
+	})
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		upstream.Close()
+	})
+
+	// send issues a chat request and returns the response and its body.
+	send := func(body string, headers map[string]string) (*http.Response, string) {
+		req := httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(body))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := p.server.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp, string(respBody)
+	}
+
+	// restart drains the worker pool so recorded responses are visible, then
+	// starts a fresh proxy over the same store.
+	restart := func(ttl time.Duration) {
+		p.Close()
+		p = newCachingTestProxy(upstream.URL, driver, ttl)
+	}
+
+	It("serves repeated deterministic requests from the DAG", func() {
+		resp, _ := send(deterministicRequest, nil)
+		Expect(resp.Header.Get(header.CacheHeader)).To(Equal("MISS"))
+		restart(0)
+
+		resp, body := send(deterministicRequest, nil)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get(header.CacheHeader)).To(Equal("HIT"))
+		Expect(body).To(ContainSubstring(`"content":"4"`))
+		Expect(calls.Load()).To(Equal(int32(1)))
+
+		hash := resp.Header.Get(header.CacheHashHeader)
+		Expect(hash).NotTo(BeEmpty())
+		Expect(driver.CacheHits(hash)).To(Equal(1))
+	})
+
+	It("replays cached responses as a stream for streaming clients", func() {
+		send(deterministicRequest, nil)
+		restart(0)
+
+		streamed := strings.Replace(deterministicRequest, `"stream":false`, `"stream":true`, 1)
+		resp, body := send(streamed, nil)
+		Expect(resp.Header.Get(header.CacheHeader)).To(Equal("HIT"))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+		Expect(body).To(ContainSubstring(`"done":true`))
+		Expect(calls.Load()).To(Equal(int32(1)))
+	})
+
+	It("does not cache sampled requests", func() {
+		resp, _ := send(sampledRequest, nil)
+		Expect(resp.Header.Get(header.CacheHeader)).To(BeEmpty())
+		restart(0)
+
+		send(sampledRequest, nil)
+		Expect(calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("caches requests explicitly marked cacheable", func() {
+		marked := map[string]string{header.CacheHeader: "true"}
+		send(sampledRequest, marked)
+		Expect(cacheHeader.Load()).To(BeEmpty(), "cache header must not reach the upstream")
+		restart(0)
+
+		resp, _ := send(sampledRequest, marked)
+		Expect(resp.Header.Get(header.CacheHeader)).To(Equal("HIT"))
+		Expect(calls.Load()).To(Equal(int32(1)))
+	})
+
+	It("bypasses the cache when asked to", func() {
+		send(deterministicRequest, nil)
+		restart(0)
+
+		resp, _ := send(deterministicRequest, map[string]string{header.CacheHeader: "false"})
+		Expect(resp.Header.Get(header.CacheHeader)).To(BeEmpty())
+		Expect(calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("ignores entries older than the TTL", func() {
+		send(deterministicRequest, nil)
+		restart(time.Nanosecond)
+
+		resp, _ := send(deterministicRequest, nil)
+		Expect(resp.Header.Get(header.CacheHeader)).To(Equal("MISS"))
+		Expect(calls.Load()).To(Equal(int32(2)))
+	})
+})
+
+var _ = Describe("cacheKey", func() {
+	temperature := func(t float64) *float64 { return &t }
+
+	request := func() *llm.ChatRequest {
+		return &llm.ChatRequest{
+			Model:       "test-model",
+			Temperature: temperature(0),
+			Messages:    []llm.Message{llm.NewTextMessage("user", "hi")},
+		}
+	}
+
+	key := func(req *llm.ChatRequest, body string) string {
+		k, err := cacheKey("ollama", "ollama", req.Model, req, []byte(body))
+		Expect(err).NotTo(HaveOccurred())
+		return k
+	}
+
+	It("ignores whether the client streams", func() {
+		streamed := request()
+		streamed.Stream = boolPtr(true)
+		Expect(key(streamed, `{}`)).To(Equal(key(request(), `{}`)))
+	})
+
+	It("covers sampling parameters", func() {
+		sampled := request()
+		sampled.Temperature = temperature(0.5)
+		Expect(key(sampled, `{}`)).NotTo(Equal(key(request(), `{}`)))
+	})
+
+	It("covers tool definitions from the raw body", func() {
+		Expect(key(request(), `{"tools":[{"name":"ls"}]}`)).NotTo(Equal(key(request(), `{}`)))
+	})
+})
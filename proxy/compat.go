@@ -0,0 +1,158 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+)
+
+// Auth header styles of OpenAI-compatible providers.
+const (
+	// AuthBearer sends the API key as "Authorization: Bearer <key>".
+	AuthBearer = "bearer"
+
+	// AuthAPIKey sends the API key as "api-key: <key>", as Azure OpenAI
+	// expects.
+	AuthAPIKey = "api-key"
+)
+
+// CompatProvider is a named OpenAI-compatible provider. Requests sent to
+// /providers/<Name>/... are parsed as OpenAI traffic, forwarded to
+// UpstreamURL and recorded with Name as their provider.
+type CompatProvider struct {
+	Name string
+
+	// UpstreamURL is the upstream base URL, including any version prefix.
+	UpstreamURL string
+
+	// AuthStyle is AuthBearer (the default) or AuthAPIKey. With AuthAPIKey,
+	// a bearer token sent by the client is moved to the api-key header.
+	AuthStyle string
+
+	// APIVersion is added as the api-version query parameter when the
+	// client does not send one.
+	APIVersion string
+
+	// Deployments maps deployment names to the model they serve.
+	Deployments map[string]string
+}
+
+// compatPreset holds the defaults of a well-known OpenAI-compatible server.
+type compatPreset struct {
+	upstreamURL string
+	authStyle   string
+	apiVersion  string
+}
+
+var compatPresets = map[string]compatPreset{
+	// Azure base URLs are per resource, so they must always be configured.
+	"azure":      {authStyle: AuthAPIKey, apiVersion: "2024-10-21"},
+	"vllm":       {upstreamURL: "http://localhost:8000/v1"},
+	"lmstudio":   {upstreamURL: "http://localhost:1234/v1"},
+	"openrouter": {upstreamURL: "https://openrouter.ai/api/v1"},
+}
+
+// CompatProvidersFromConfig converts the persisted [[proxy.providers]] entries
+// into compatible providers, applying preset defaults.
+func CompatProvidersFromConfig(entries []config.ProviderConfig) ([]CompatProvider, error) {
+	providers := make([]CompatProvider, 0, len(entries))
+	for _, entry := range entries {
+		cp := CompatProvider{
+			Name:        entry.Name,
+			UpstreamURL: entry.BaseURL,
+			AuthStyle:   entry.AuthHeader,
+			APIVersion:  entry.APIVersion,
+			Deployments: entry.Deployments,
+		}
+
+		if entry.Preset != "" {
+			preset, ok := compatPresets[entry.Preset]
+			if !ok {
+				return nil, fmt.Errorf("unknown preset %q for provider %q", entry.Preset, entry.Name)
+			}
+			if cp.UpstreamURL == "" {
+				cp.UpstreamURL = preset.upstreamURL
+			}
+			if cp.AuthStyle == "" {
+				cp.AuthStyle = preset.authStyle
+			}
+			if cp.APIVersion == "" {
+				cp.APIVersion = preset.apiVersion
+			}
+		}
+
+		providers = append(providers, cp)
+	}
+	return providers, nil
+}
+
+// validate reports misconfigured providers up front so that proxy startup
+// fails instead of requests going to the wrong place.
+func (cp *CompatProvider) validate() error {
+	if cp.Name == "" {
+		return fmt.Errorf("compatible provider with base URL %q has no name", cp.UpstreamURL)
+	}
+	if slices.Contains(provider.SupportedProviders(), cp.Name) {
+		return fmt.Errorf("compatible provider %q shadows a built-in provider", cp.Name)
+	}
+	if cp.UpstreamURL == "" {
+		return fmt.Errorf("compatible provider %q has no base URL", cp.Name)
+	}
+	switch cp.AuthStyle {
+	case "", AuthBearer, AuthAPIKey:
+	default:
+		return fmt.Errorf("invalid auth header %q for provider %q (want %q or %q)", cp.AuthStyle, cp.Name, AuthBearer, AuthAPIKey)
+	}
+	return nil
+}
+
+// prepareRequest adapts an upstream request to the provider's auth header
+// style and API version.
+func (cp *CompatProvider) prepareRequest(req *http.Request) {
+	if cp.AuthStyle == AuthAPIKey && req.Header.Get("api-key") == "" {
+		auth := req.Header.Get("Authorization")
+		if key, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			req.Header.Set("api-key", key)
+			req.Header.Del("Authorization")
+		}
+	}
+
+	if cp.APIVersion != "" {
+		query := req.URL.Query()
+		if query.Get("api-version") == "" {
+			query.Set("api-version", cp.APIVersion)
+			req.URL.RawQuery = query.Encode()
+		}
+	}
+}
+
+// mapModel resolves the model of a request addressed to a deployment, either
+// through the path (/openai/deployments/<name>/...) or the model field, to
+// the model the deployment serves.
+func (cp *CompatProvider) mapModel(reqPath string, req *llm.ChatRequest) {
+	deployment := req.Model
+	if d, ok := deploymentFromPath(reqPath); ok {
+		deployment = d
+	}
+	if model, ok := cp.Deployments[deployment]; ok {
+		req.Model = model
+	} else if req.Model == "" {
+		req.Model = deployment
+	}
+}
+
+// deploymentFromPath extracts the deployment name of an Azure-style
+// /openai/deployments/<name>/... path.
+func deploymentFromPath(reqPath string) (string, bool) {
+	_, rest, ok := strings.Cut(reqPath, "/deployments/")
+	if !ok {
+		return "", false
+	}
+	deployment, _, _ := strings.Cut(rest, "/")
+	return deployment, deployment != ""
+}
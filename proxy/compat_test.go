@@ -0,0 +1,134 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+const openAIChatResponse = `{
+	"id": "chatcmpl-1",
+	"object": "chat.completion",
+	"created": 1700000000,
+	"model": "gpt-4o-2024-08-06",
+	"choices": [{"index": 0, "message": {"role": "assistant", "content": "Blue."}, "finish_reason": "stop"}],
+	"usage": {"prompt_tokens": 5, "completion_tokens": 2, "total_tokens": 7}
+}`
+
+var _ = Describe("OpenAI-compatible providers", func() {
+	var (
+		p        *Proxy
+		driver   *inmemory.Driver
+		upstream *httptest.Server
+		received *http.Request
+	)
+
+	BeforeEach(func() {
+		received = nil
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r.Clone(r.Context())
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(openAIChatResponse))
+		}))
+
+		compat, err := CompatProvidersFromConfig([]config.ProviderConfig{{
+			Name:        "azure-east",
+			Preset:      "azure",
+			BaseURL:     upstream.URL,
+			Deployments: map[string]string{"chat": "gpt-4o"},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		logger, _ := zap.NewDevelopment()
+		driver = inmemory.NewDriver()
+		p, err = New(Config{
+			ListenAddr:      ":0",
+			UpstreamURL:     "http://localhost:11434",
+			ProviderType:    "ollama",
+			CompatProviders: compat,
+		}, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		upstream.Close()
+	})
+
+	It("forwards Azure deployment requests with api-key auth and records them under the provider name", func() {
+		req := httptest.NewRequest(http.MethodPost,
+			"/providers/azure-east/openai/deployments/chat/chat/completions",
+			strings.NewReader(`{"messages":[{"role":"user","content":"Name a color."}]}`))
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := p.server.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("Blue."))
+
+		Expect(received).NotTo(BeNil())
+		Expect(received.URL.Path).To(Equal("/openai/deployments/chat/chat/completions"))
+		Expect(received.URL.Query().Get("api-version")).To(Equal("2024-10-21"))
+		Expect(received.Header.Get("api-key")).To(Equal("secret"))
+		Expect(received.Header.Get("Authorization")).To(BeEmpty())
+
+		p.Close()
+		p = nil
+
+		ctx := GinkgoT().Context()
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+
+		ancestry, err := driver.Ancestry(ctx, leaves[0].Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ancestry).To(HaveLen(2))
+		Expect(ancestry[0].Bucket.Provider).To(Equal("azure-east"))
+		Expect(ancestry[1].Bucket.Provider).To(Equal("azure-east"))
+		Expect(ancestry[1].Bucket.Model).To(Equal("gpt-4o"))
+	})
+
+	It("keeps an api-version sent by the client", func() {
+		req := httptest.NewRequest(http.MethodPost,
+			"/providers/azure-east/openai/deployments/chat/chat/completions?api-version=2025-01-01-preview",
+			strings.NewReader(`{"messages":[{"role":"user","content":"Name a color."}]}`))
+		req.Header.Set("api-key", "secret")
+		resp, err := p.server.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(received.URL.Query().Get("api-version")).To(Equal("2025-01-01-preview"))
+		Expect(received.Header.Get("api-key")).To(Equal("secret"))
+	})
+
+	It("applies presets and rejects invalid providers", func() {
+		compat, err := CompatProvidersFromConfig([]config.ProviderConfig{{Name: "openrouter", Preset: "openrouter"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compat[0].UpstreamURL).To(Equal("https://openrouter.ai/api/v1"))
+
+		_, err = CompatProvidersFromConfig([]config.ProviderConfig{{Name: "x", Preset: "bogus"}})
+		Expect(err).To(MatchError(ContainSubstring("unknown preset")))
+
+		logger, _ := zap.NewDevelopment()
+		for _, cp := range []CompatProvider{
+			{Name: "openai", UpstreamURL: "http://localhost:8000/v1"},
+			{Name: "azure"},
+			{Name: "local", UpstreamURL: "http://localhost:8000/v1", AuthStyle: "basic"},
+		} {
+			_, err := New(Config{ProviderType: "ollama", CompatProviders: []CompatProvider{cp}}, inmemory.NewDriver(), logger)
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
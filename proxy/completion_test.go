@@ -0,0 +1,176 @@
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+var _ = Describe("Completion APIs", func() {
+	var (
+		p        *Proxy
+		driver   *inmemory.Driver
+		upstream *httptest.Server
+	)
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		upstream.Close()
+	})
+
+	post := func(path, body string) string {
+		resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		respBody, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(respBody)
+	}
+
+	Context("Ollama /api/generate", func() {
+		BeforeEach(func() {
+			turn := 0
+			upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/api/generate"))
+				turn++
+				w.Header().Set("Content-Type", "application/json")
+				switch turn {
+				case 1:
+					w.Write([]byte(`{"model":"llama3","response":"Hello!","done":true,"done_reason":"stop","context":[1,2,3],"prompt_eval_count":3,"eval_count":2}`))
+				default:
+					w.Write([]byte(`{"model":"llama3","response":"Goodbye!","done":true,"done_reason":"stop","context":[1,2,3,4,5,6],"prompt_eval_count":6,"eval_count":2}`))
+				}
+			}))
+			p, driver = newTestProxy(upstream.URL)
+		})
+
+		It("chains follow-up prompts through the returned context", func() {
+			Expect(post("/api/generate", `{"model":"llama3","prompt":"Hi","raw":true,"stream":false}`)).To(ContainSubstring("Hello!"))
+			Eventually(driver.Count).Should(Equal(2))
+
+			post("/api/generate", `{"model":"llama3","prompt":"Bye","context":[1,2,3],"stream":false}`)
+
+			p.Close()
+			p = nil
+
+			ctx := GinkgoT().Context()
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+
+			ancestry, err := driver.Ancestry(ctx, leaves[0].Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry).To(HaveLen(4))
+			Expect(ancestry[0].Bucket.ExtractText()).To(Equal("Goodbye!"))
+			Expect(ancestry[1].Bucket.Role).To(Equal("user"))
+			Expect(ancestry[1].Bucket.ExtractText()).To(Equal("Bye"))
+			Expect(ancestry[2].Bucket.Role).To(Equal("assistant"))
+			Expect(ancestry[2].Bucket.ExtractText()).To(Equal("Hello!"))
+			Expect(ancestry[2].Params.Extra).To(HaveKeyWithValue("raw", true))
+			Expect(ancestry[3].Bucket.ExtractText()).To(Equal("Hi"))
+		})
+	})
+
+	It("records streamed /api/generate completions", func() {
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			for _, word := range []string{"Hello", " there"} {
+				fmt.Fprintf(w, `{"model":"llama3","response":%q,"done":false}`+"\n", word)
+			}
+			fmt.Fprintln(w, `{"model":"llama3","response":"","done":true,"context":[7,8],"prompt_eval_count":2,"eval_count":2}`)
+		}))
+		p, driver = newTestProxy(upstream.URL)
+
+		post("/api/generate", `{"model":"llama3","prompt":"Hi"}`)
+
+		p.Close()
+		p = nil
+
+		leaves, err := driver.Leaves(GinkgoT().Context())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Bucket.Role).To(Equal("assistant"))
+		Expect(leaves[0].Bucket.ExtractText()).To(Equal("Hello there"))
+		Expect(leaves[0].Usage.CompletionTokens).To(Equal(2))
+	})
+
+	It("records OpenAI /v1/completions", func() {
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/v1/completions"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"cmpl-1","object":"text_completion","created":1700000000,"model":"gpt-3.5-turbo-instruct","choices":[{"text":"This is a test.","index":0,"finish_reason":"stop"}],"usage":{"prompt_tokens":5,"completion_tokens":5,"total_tokens":10}}`))
+		}))
+		logger, _ := zap.NewDevelopment()
+		driver = inmemory.NewDriver()
+		var err error
+		p, err = New(Config{ListenAddr: ":0", UpstreamURL: upstream.URL, ProviderType: "openai"}, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		post("/v1/completions", `{"model":"gpt-3.5-turbo-instruct","prompt":"Say this is a test","max_tokens":7}`)
+
+		p.Close()
+		p = nil
+
+		ctx := GinkgoT().Context()
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Bucket.ExtractText()).To(Equal("This is a test."))
+		Expect(leaves[0].StopReason).To(Equal("stop"))
+
+		ancestry, err := driver.Ancestry(ctx, leaves[0].Hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ancestry).To(HaveLen(2))
+		Expect(ancestry[1].Bucket.Role).To(Equal("user"))
+		Expect(ancestry[1].Bucket.ExtractText()).To(Equal("Say this is a test"))
+	})
+
+	It("records each prompt of a batched /v1/completions request as its own conversation", func() {
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"cmpl-1","object":"text_completion","created":1700000000,"model":"gpt-3.5-turbo-instruct","choices":[{"text":"Red.","index":0,"finish_reason":"stop"},{"text":"Four.","index":1,"finish_reason":"stop"}],"usage":{"prompt_tokens":8,"completion_tokens":4,"total_tokens":12}}`))
+		}))
+		logger, _ := zap.NewDevelopment()
+		driver = inmemory.NewDriver()
+		var err error
+		p, err = New(Config{ListenAddr: ":0", UpstreamURL: upstream.URL, ProviderType: "openai"}, driver, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		post("/v1/completions", `{"model":"gpt-3.5-turbo-instruct","prompt":["Name a color.","What is 2+2?"]}`)
+
+		p.Close()
+		p = nil
+
+		ctx := GinkgoT().Context()
+		roots, err := driver.Roots(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roots).To(HaveLen(2))
+
+		answers := map[string]string{}
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(2))
+		for _, leaf := range leaves {
+			Expect(leaf.ChoiceIndex).To(BeNil())
+			ancestry, err := driver.Ancestry(ctx, leaf.Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry).To(HaveLen(2))
+			answers[ancestry[1].Bucket.ExtractText()] = leaf.Bucket.ExtractText()
+		}
+		Expect(answers).To(Equal(map[string]string{
+			"Name a color.": "Red.",
+			"What is 2+2?":  "Four.",
+		}))
+	})
+})
@@ -1,15 +1,26 @@
 package proxy
 
 import (
+	"crypto/ed25519"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/ca"
 	"github.com/papercomputeco/tapes/pkg/embeddings"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/vector"
+	"github.com/papercomputeco/tapes/proxy/upstream"
 )
 
 // Config is the proxy server configuration.
 type Config struct {
-	// ListenAddr is the address to listen on (e.g., ":8080")
+	// ListenAddr is the address to listen on (e.g., ":8080"). Addresses
+	// prefixed with "unix:" listen on a unix domain socket.
 	ListenAddr string
 
+	// TLS optionally serves the proxy over HTTPS, verifying client
+	// certificates if a client CA is configured.
+	TLS listener.TLSConfig
+
 	// UpstreamURL is the upstream LLM provider URL (e.g., "http://localhost:11434")
 	UpstreamURL string
 
@@ -17,12 +28,64 @@ type Config struct {
 	// This determines how requests and responses are parsed.
 	ProviderType string
 
+	// CompatProviders declares named OpenAI-compatible providers, addressed
+	// as /providers/<name>/... and usable as a ProviderType anywhere.
+	CompatProviders []CompatProvider
+
 	// AgentRoutes maps agent names to provider routing configuration.
 	AgentRoutes map[string]AgentRoute
 
 	// ProviderUpstreams optionally overrides upstream URLs per provider.
 	ProviderUpstreams map[string]string
 
+	// Upstreams optionally configures several upstream base URLs per provider
+	// type. Requests to a provider with upstreams are load balanced across
+	// them by weighted round-robin, failing over on connection errors and
+	// 5xx responses. They take precedence over UpstreamURL and
+	// ProviderUpstreams for that provider.
+	Upstreams map[string][]upstream.Target
+
+	// HealthCheckInterval is how often every configured upstream is probed.
+	// Zero uses upstream.DefaultHealthCheckInterval.
+	HealthCheckInterval time.Duration
+
+	// Cache configures the opt-in exact-match response cache.
+	Cache CacheConfig
+
+	// Routes are declarative rules evaluated in order against each parsed
+	// chat request. The first matching rule may rewrite the model and
+	// redirect the request to another provider or upstream.
+	Routes []Route
+
+	// ForwardListenAddr optionally enables an HTTPS_PROXY-compatible forward
+	// proxy on this address. CONNECT tunnels to InterceptHosts are decrypted
+	// with certificates issued by CA and recorded like any other request.
+	// An address without a host binds to 127.0.0.1.
+	ForwardListenAddr string
+
+	// ForwardPassthrough lets the forward proxy pass traffic to hosts that
+	// are not intercepted through untouched; otherwise it is refused. The
+	// forward proxy does not authenticate clients, so with passthrough on
+	// anyone who can reach ForwardListenAddr can relay traffic to any host
+	// the proxy can reach, including loopback and internal addresses.
+	ForwardPassthrough bool
+
+	// CA issues the certificates for intercepted hosts. Required if
+	// ForwardListenAddr is set.
+	CA *ca.Authority
+
+	// InterceptHosts maps hostnames intercepted by the forward proxy to the
+	// provider type parsing their traffic. Nil uses DefaultInterceptHosts.
+	InterceptHosts map[string]string
+
+	// CaptureEmbeddingInput stores the input texts of embedding calls made
+	// through the proxy. By default only counts, usage and latency are kept.
+	CaptureEmbeddingInput bool
+
+	// Signer optionally signs every recorded response node with an ed25519
+	// key. Signatures are kept by drivers implementing storage.SignatureStore.
+	Signer ed25519.PrivateKey
+
 	// VectorDriver is an optional vector store for storing embeddings.
 	// If nil, vector storage is disabled.
 	VectorDriver vector.Driver
@@ -33,6 +96,11 @@ type Config struct {
 
 	// Project is the git repository or project name to tag on stored nodes.
 	Project string
+
+	// AgentPID optionally returns the PID of the running agent process
+	// named agentName, or 0 when it is unknown. Turns from the same process
+	// are linked across context compactions.
+	AgentPID func(agentName string) int
 }
 
 // AgentRoute defines proxy routing for a specific agent.
@@ -40,3 +108,15 @@ type AgentRoute struct {
 	ProviderType string
 	UpstreamURL  string
 }
+
+// CacheConfig configures the proxy response cache. When enabled, chat requests
+// with a temperature of 0, or marked cacheable with the X-Tapes-Cache header,
+// are answered from a previously recorded response for an identical request
+// instead of calling the upstream.
+type CacheConfig struct {
+	Enabled bool
+
+	// TTL bounds the age of a reusable response. Zero never expires entries.
+	// Routes may override it with Route.CacheTTL.
+	TTL time.Duration
+}
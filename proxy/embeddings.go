@@ -0,0 +1,108 @@
+package proxy
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/proxy/worker"
+)
+
+// embeddingParser returns the provider's embeddings parser if the request is
+// a call to one of its embeddings endpoints.
+func embeddingParser(prov provider.Provider, method, path string) (provider.EmbeddingParser, bool) {
+	if method != fiber.MethodPost {
+		return nil, false
+	}
+	parser, ok := prov.(provider.EmbeddingParser)
+	if !ok || !parser.IsEmbeddingPath(path) {
+		return nil, false
+	}
+	return parser, true
+}
+
+// handleEmbeddingProxy forwards an embeddings request and enqueues a record of
+// the call. Embedding calls are recorded on their own, outside the
+// conversation DAG; the vectors themselves are not kept.
+func (p *Proxy) handleEmbeddingProxy(c *fiber.Ctx, path string, upstreams []string, prov provider.Provider, parser provider.EmbeddingParser, agentName string, startTime time.Time) error {
+	body := c.Body()
+
+	req, err := parser.ParseEmbeddingRequest(body)
+	if err != nil {
+		p.logger.Warn("failed to parse embedding request",
+			zap.Error(err),
+			zap.String("provider", prov.Name()),
+			zap.String("agent", agentName),
+		)
+	}
+
+	httpResp, upstreamURL, err := p.sendUpstream(c, c.Context(), fiber.MethodPost, path, body, upstreams, prov.Name())
+	if err != nil {
+		if errors.Is(err, errBuildRequest) {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "internal error"})
+		}
+		return c.Status(fiber.StatusBadGateway).JSON(llm.ErrorResponse{Error: "upstream request failed"})
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		p.logger.Error("failed to read upstream response", zap.Error(err))
+		return c.Status(fiber.StatusBadGateway).JSON(llm.ErrorResponse{Error: "failed to read upstream response"})
+	}
+	latency := time.Since(startTime)
+
+	p.headerHandler.SetClientResponseHeaders(c, httpResp)
+
+	if req != nil && httpResp.StatusCode == http.StatusOK {
+		resp, err := parser.ParseEmbeddingResponse(respBody)
+		if err != nil {
+			p.logger.Warn("failed to parse embedding response",
+				zap.Error(err),
+				zap.String("provider", prov.Name()),
+				zap.String("agent", agentName),
+			)
+		} else {
+			p.workerPool.Enqueue(worker.Job{
+				Provider:  prov.Name(),
+				AgentName: agentName,
+				Upstream:  upstreamURL,
+				Embedding: p.embeddingCall(prov.Name(), agentName, req, resp, latency),
+			})
+		}
+	}
+
+	return c.Status(httpResp.StatusCode).Send(respBody)
+}
+
+// embeddingCall builds the record of an embedding call. Input texts are kept
+// only when input capture is enabled.
+func (p *Proxy) embeddingCall(providerName, agentName string, req *llm.EmbeddingRequest, resp *llm.EmbeddingResponse, latency time.Duration) *storage.EmbeddingCall {
+	call := &storage.EmbeddingCall{
+		Provider:   providerName,
+		Model:      resp.Model,
+		AgentName:  agentName,
+		InputCount: req.InputCount,
+		Dimensions: resp.Dimensions,
+		Usage:      resp.Usage,
+		Latency:    latency,
+		CreatedAt:  time.Now(),
+	}
+	if call.Model == "" {
+		call.Model = req.Model
+	}
+	if call.InputCount == 0 {
+		call.InputCount = resp.Count
+	}
+	if p.config.CaptureEmbeddingInput {
+		call.Input = req.Input
+	}
+	return call
+}
@@ -0,0 +1,163 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+const (
+	openAIEmbeddingRequest  = `{"model":"text-embedding-3-small","input":["first chunk","second chunk"]}`
+	openAIEmbeddingResponse = `{"object":"list","model":"text-embedding-3-small","data":[{"object":"embedding","index":0,"embedding":[0.1,0.2,0.3]},{"object":"embedding","index":1,"embedding":[0.4,0.5,0.6]}],"usage":{"prompt_tokens":6,"total_tokens":6}}`
+	ollamaEmbedRequest      = `{"model":"nomic-embed-text","input":"why is the sky blue?"}`
+	ollamaEmbedResponse     = `{"model":"nomic-embed-text","embeddings":[[0.1,0.2]],"prompt_eval_count":7,"total_duration":1200000}`
+)
+
+var _ = Describe("Embedding calls", func() {
+	var (
+		p        *Proxy
+		driver   *inmemory.Driver
+		upstream *httptest.Server
+		received []receivedRequest
+	)
+
+	newEmbeddingProxy := func(providerType string, capture bool) {
+		logger, _ := zap.NewDevelopment()
+		var err error
+		p, err = New(
+			Config{
+				ListenAddr:            ":0",
+				UpstreamURL:           upstream.URL,
+				ProviderType:          providerType,
+				CaptureEmbeddingInput: capture,
+			},
+			driver,
+			logger,
+		)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	startUpstream := func(respBody string) {
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received = append(received, receivedRequest{path: r.URL.Path, body: body})
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(respBody))
+		}))
+	}
+
+	send := func(path, body string) (*http.Response, string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := p.server.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp, string(respBody)
+	}
+
+	BeforeEach(func() {
+		driver = inmemory.NewDriver()
+		received = nil
+	})
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		upstream.Close()
+	})
+
+	It("forwards OpenAI embeddings and records the call without the input", func() {
+		startUpstream(openAIEmbeddingResponse)
+		newEmbeddingProxy("openai", false)
+
+		resp, body := send("/v1/embeddings", openAIEmbeddingRequest)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(body).To(MatchJSON(openAIEmbeddingResponse))
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].path).To(Equal("/v1/embeddings"))
+		Expect(string(received[0].body)).To(MatchJSON(openAIEmbeddingRequest))
+
+		p.Close()
+		p = nil
+
+		calls, err := driver.EmbeddingCalls(context.Background(), time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].Provider).To(Equal("openai"))
+		Expect(calls[0].Model).To(Equal("text-embedding-3-small"))
+		Expect(calls[0].InputCount).To(Equal(2))
+		Expect(calls[0].Dimensions).To(Equal(3))
+		Expect(calls[0].Usage).NotTo(BeNil())
+		Expect(calls[0].Usage.PromptTokens).To(Equal(6))
+		Expect(calls[0].Input).To(BeEmpty())
+		Expect(calls[0].Latency).To(BeNumerically(">", 0))
+
+		Expect(driver.Count()).To(BeZero(), "embedding calls are not conversation turns")
+	})
+
+	It("stores the input texts when capture is enabled", func() {
+		startUpstream(openAIEmbeddingResponse)
+		newEmbeddingProxy("openai", true)
+
+		resp, _ := send("/v1/embeddings", openAIEmbeddingRequest)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		p.Close()
+		p = nil
+
+		calls, err := driver.EmbeddingCalls(context.Background(), time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].Input).To(Equal([]string{"first chunk", "second chunk"}))
+	})
+
+	It("records Ollama /api/embed calls", func() {
+		startUpstream(ollamaEmbedResponse)
+		newEmbeddingProxy("ollama", false)
+
+		resp, _ := send("/api/embed", ollamaEmbedRequest)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		p.Close()
+		p = nil
+
+		calls, err := driver.EmbeddingCalls(context.Background(), time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].Provider).To(Equal("ollama"))
+		Expect(calls[0].Model).To(Equal("nomic-embed-text"))
+		Expect(calls[0].InputCount).To(Equal(1))
+		Expect(calls[0].Dimensions).To(Equal(2))
+		Expect(calls[0].Usage.PromptTokens).To(Equal(7))
+	})
+
+	It("does not record failed embedding calls", func() {
+		upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"bad input"}}`))
+		}))
+		newEmbeddingProxy("openai", false)
+
+		resp, _ := send("/v1/embeddings", openAIEmbeddingRequest)
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+		p.Close()
+		p = nil
+
+		calls, err := driver.EmbeddingCalls(context.Background(), time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(BeEmpty())
+	})
+})
@@ -0,0 +1,332 @@
+package proxy
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/ca"
+)
+
+// DefaultInterceptHosts maps the LLM API hosts whose TLS the forward proxy
+// terminates to the provider type that parses their traffic. Hosts may be
+// glob patterns (see path.Match), e.g. for regional endpoints.
+var DefaultInterceptHosts = map[string]string{
+	"api.anthropic.com":               providerAnthropic,
+	"api.openai.com":                  providerOpenAI,
+	"bedrock-runtime.*.amazonaws.com": providerBedrock,
+}
+
+const (
+	// tlsHandshakeTimeout bounds the TLS handshake with intercepted clients.
+	tlsHandshakeTimeout = 10 * time.Second
+
+	// tunnelDialTimeout bounds dialing the target of a pass-through tunnel.
+	tunnelDialTimeout = 30 * time.Second
+)
+
+// forwardProxy is an HTTPS_PROXY-compatible forward proxy. CONNECT requests
+// for intercepted hosts are answered with a certificate issued by the local
+// CA and the decrypted requests are served by the proxy's regular handler.
+// All other traffic is passed through untouched if passthrough is enabled
+// and refused otherwise.
+type forwardProxy struct {
+	proxy       *Proxy
+	ca          *ca.Authority
+	hosts       map[string]string
+	allowOthers bool
+	logger      *zap.Logger
+	server      *http.Server
+
+	// passthrough forwards plain-HTTP (absolute-form) requests.
+	passthrough *httputil.ReverseProxy
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// interceptedConn marks a decrypted client connection for an intercepted host,
+// letting the request handler resolve its provider and upstream.
+type interceptedConn struct {
+	net.Conn
+
+	// authority is the host[:port] the client connected to.
+	authority string
+
+	// provider is the provider type parsing the host's traffic.
+	provider string
+}
+
+// bufferedConn is a hijacked connection whose reads start with any bytes
+// already buffered by the HTTP server.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+func newForwardProxy(p *Proxy) *forwardProxy {
+	hosts := p.config.InterceptHosts
+	if hosts == nil {
+		hosts = DefaultInterceptHosts
+	}
+
+	f := &forwardProxy{
+		proxy:       p,
+		ca:          p.config.CA,
+		hosts:       hosts,
+		allowOthers: p.config.ForwardPassthrough,
+		logger:      p.logger.With(zap.String("component", "forward_proxy")),
+		passthrough: &httputil.ReverseProxy{
+			Rewrite: func(pr *httputil.ProxyRequest) {
+				pr.Out.Host = pr.In.Host
+			},
+		},
+		conns: make(map[net.Conn]struct{}),
+	}
+	f.server = &http.Server{
+		Handler:           f,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	return f
+}
+
+// ServeHTTP handles CONNECT tunnels and absolute-form plain-HTTP requests.
+func (f *forwardProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		f.handleConnect(w, r)
+		return
+	}
+
+	if r.URL.Host == "" {
+		http.Error(w, "tapes forward proxy: not a proxy request", http.StatusBadRequest)
+		return
+	}
+	if !f.allowOthers {
+		f.refuse(w, r.URL.Host)
+		return
+	}
+	f.passthrough.ServeHTTP(w, r)
+}
+
+// refuse answers a request for a host that is not intercepted while
+// passthrough is disabled.
+func (f *forwardProxy) refuse(w http.ResponseWriter, host string) {
+	f.logger.Debug("refusing traffic to a host that is not intercepted", zap.String("host", host))
+	http.Error(w, "tapes forward proxy: "+host+" is not intercepted and passthrough is disabled", http.StatusForbidden)
+}
+
+// forwardListenAddr binds a listen address without a host to loopback, so
+// that the unauthenticated forward proxy is not exposed by default.
+func forwardListenAddr(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || host != "" {
+		return addr
+	}
+	return net.JoinHostPort("127.0.0.1", port)
+}
+
+// interceptProvider returns the provider type of an intercepted host,
+// matching exact hostnames before glob patterns.
+func interceptProvider(hosts map[string]string, host string) (string, bool) {
+	if providerName, ok := hosts[host]; ok {
+		return providerName, true
+	}
+	for pattern, providerName := range hosts {
+		if ok, err := path.Match(pattern, host); err == nil && ok {
+			return providerName, true
+		}
+	}
+	return "", false
+}
+
+// handleConnect intercepts the tunnel if its host is a known LLM API host and
+// otherwise connects it to the requested target when passthrough is enabled.
+func (f *forwardProxy) handleConnect(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(r.Host); err == nil {
+		host = h
+	}
+	providerName, intercept := interceptProvider(f.hosts, strings.ToLower(host))
+	if !intercept && !f.allowOthers {
+		f.refuse(w, r.Host)
+		return
+	}
+
+	var target net.Conn
+	if !intercept {
+		var err error
+		target, err = net.DialTimeout("tcp", r.Host, tunnelDialTimeout)
+		if err != nil {
+			f.logger.Warn("could not reach tunnel target", zap.String("host", r.Host), zap.Error(err))
+			http.Error(w, "tapes forward proxy: could not reach "+r.Host, http.StatusBadGateway)
+			return
+		}
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		if target != nil {
+			target.Close()
+		}
+		http.Error(w, "tapes forward proxy: hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	clientConn, buf, err := hijacker.Hijack()
+	if err != nil {
+		if target != nil {
+			target.Close()
+		}
+		f.logger.Error("could not hijack connection", zap.Error(err))
+		return
+	}
+
+	var client net.Conn = clientConn
+	if buf.Reader.Buffered() > 0 {
+		client = &bufferedConn{Conn: clientConn, r: buf.Reader}
+	}
+
+	if _, err := io.WriteString(client, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		client.Close()
+		if target != nil {
+			target.Close()
+		}
+		return
+	}
+
+	if !intercept {
+		f.tunnel(client, target)
+		return
+	}
+	f.intercept(client, r.Host, host, providerName)
+}
+
+// intercept terminates TLS on the client connection and serves the decrypted
+// requests with the proxy's handler.
+func (f *forwardProxy) intercept(client net.Conn, authority, host, providerName string) {
+	tlsConn := tls.Server(client, &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"http/1.1"},
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			name := hello.ServerName
+			if name == "" {
+				name = host
+			}
+			return f.ca.LeafCertificate(name)
+		},
+	})
+
+	_ = tlsConn.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+	if err := tlsConn.Handshake(); err != nil {
+		f.logger.Warn("TLS handshake with client failed",
+			zap.String("host", authority),
+			zap.Error(err),
+		)
+		tlsConn.Close()
+		return
+	}
+	_ = tlsConn.SetDeadline(time.Time{})
+
+	f.logger.Debug("intercepting connection",
+		zap.String("host", authority),
+		zap.String("provider", providerName),
+	)
+
+	conn := &interceptedConn{Conn: tlsConn, authority: authority, provider: providerName}
+	if !f.track(conn) {
+		conn.Close()
+		return
+	}
+	defer f.untrack(conn)
+
+	if err := f.proxy.server.Server().ServeConn(conn); err != nil && !isClosedConnError(err) {
+		f.logger.Debug("intercepted connection ended", zap.String("host", authority), zap.Error(err))
+	}
+}
+
+// tunnel copies bytes between client and target until either side closes.
+func (f *forwardProxy) tunnel(client, target net.Conn) {
+	if !f.track(client) {
+		client.Close()
+		target.Close()
+		return
+	}
+	defer f.untrack(client)
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pipe(target, client)
+	go pipe(client, target)
+
+	<-done
+	client.Close()
+	target.Close()
+	<-done
+}
+
+// track registers a hijacked connection so it is closed on shutdown. Returns
+// false if the proxy is already shutting down.
+func (f *forwardProxy) track(conn net.Conn) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.conns == nil {
+		return false
+	}
+	f.conns[conn] = struct{}{}
+	return true
+}
+
+func (f *forwardProxy) untrack(conn net.Conn) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.conns, conn)
+	conn.Close()
+}
+
+// close stops accepting connections and closes every hijacked connection.
+// Intercepted connections must be closed before the proxy's fiber server
+// shuts down, which otherwise waits for them.
+func (f *forwardProxy) close() error {
+	err := f.server.Close()
+
+	f.mu.Lock()
+	conns := f.conns
+	f.conns = nil
+	f.mu.Unlock()
+
+	for conn := range conns {
+		conn.Close()
+	}
+	return err
+}
+
+func isClosedConnError(err error) bool {
+	return errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF)
+}
+
+// interceptedUpstream returns the provider and upstream for a request that
+// arrived over an intercepted connection.
+func (p *Proxy) interceptedUpstream(conn net.Conn) (string, string, bool) {
+	ic, ok := conn.(*interceptedConn)
+	if !ok {
+		return "", "", false
+	}
+	authority := strings.TrimSuffix(ic.authority, ":443")
+	return ic.provider, "https://" + authority, true
+}
@@ -0,0 +1,240 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/ca"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+const anthropicColorResponse = `{
+	"id": "msg_01",
+	"type": "message",
+	"role": "assistant",
+	"model": "claude-haiku-4-5",
+	"content": [{"type": "text", "text": "Blue."}],
+	"stop_reason": "end_turn",
+	"usage": {"input_tokens": 12, "output_tokens": 2}
+}`
+
+// newForwardTestProxy creates a Proxy with forward mode enabled on a local
+// listener, intercepting the given hosts and passing other traffic through
+// if passthrough is set. It returns the proxy, its storage, the CA and the
+// forward proxy URL.
+func newForwardTestProxy(intercept map[string]string, passthrough bool) (*Proxy, *inmemory.Driver, *ca.Authority, *url.URL) {
+	logger, _ := zap.NewDevelopment()
+	driver := inmemory.NewDriver()
+
+	authority, _, err := ca.LoadOrCreate(GinkgoT().TempDir())
+	Expect(err).NotTo(HaveOccurred())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	p, err := New(
+		Config{
+			ListenAddr:         ":0",
+			ProviderType:       "ollama",
+			ForwardListenAddr:  listener.Addr().String(),
+			ForwardPassthrough: passthrough,
+			CA:                 authority,
+			InterceptHosts:     intercept,
+		},
+		driver,
+		logger,
+	)
+	Expect(err).NotTo(HaveOccurred())
+
+	go p.RunForwardWithListener(listener)
+
+	forwardURL, err := url.Parse("http://" + listener.Addr().String())
+	Expect(err).NotTo(HaveOccurred())
+	return p, driver, authority, forwardURL
+}
+
+var _ = Describe("Forward proxy", func() {
+	var (
+		p        *Proxy
+		driver   *inmemory.Driver
+		upstream *httptest.Server
+	)
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		if upstream != nil {
+			upstream.Close()
+		}
+	})
+
+	It("requires a certificate authority", func() {
+		logger, _ := zap.NewDevelopment()
+		_, err := New(Config{
+			ProviderType:      "ollama",
+			ForwardListenAddr: "127.0.0.1:0",
+		}, inmemory.NewDriver(), logger)
+		Expect(err).To(MatchError(ContainSubstring("certificate authority")))
+	})
+
+	Context("when the host is intercepted", func() {
+		var (
+			authority  *ca.Authority
+			forwardURL *url.URL
+			received   chan receivedRequest
+		)
+
+		BeforeEach(func() {
+			received = make(chan receivedRequest, 1)
+			upstream = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received <- receivedRequest{path: r.URL.Path, body: body}
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, anthropicColorResponse)
+			}))
+			p, driver, authority, forwardURL = newForwardTestProxy(map[string]string{"127.0.0.1": "anthropic"}, false)
+			p.httpClient.Transport = upstream.Client().Transport
+		})
+
+		It("decrypts, forwards and records the request", func() {
+			client := &http.Client{Transport: &http.Transport{
+				Proxy:           http.ProxyURL(forwardURL),
+				TLSClientConfig: &tls.Config{RootCAs: authority.CertPool()},
+			}}
+
+			resp, err := client.Post(upstream.URL+"/v1/messages", "application/json", strings.NewReader(anthropicHaikuRequest))
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.TLS.PeerCertificates[0].Issuer.CommonName).To(ContainSubstring("tapes"))
+
+			var body map[string]any
+			Expect(json.NewDecoder(resp.Body).Decode(&body)).To(Succeed())
+			Expect(body["stop_reason"]).To(Equal("end_turn"))
+
+			var got receivedRequest
+			Eventually(received).Should(Receive(&got))
+			Expect(got.path).To(Equal("/v1/messages"))
+
+			client.CloseIdleConnections()
+			p.Close()
+			p = nil
+
+			leaves, err := driver.Leaves(GinkgoT().Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].Bucket.Provider).To(Equal("anthropic"))
+			Expect(leaves[0].Bucket.ExtractText()).To(Equal("Blue."))
+		})
+
+		It("is rejected by clients that do not trust the CA", func() {
+			client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(forwardURL)}}
+
+			_, err := client.Post(upstream.URL+"/v1/messages", "application/json", strings.NewReader(anthropicHaikuRequest))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the host is not intercepted", func() {
+		var forwardURL *url.URL
+
+		BeforeEach(func() {
+			upstream = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, anthropicColorResponse)
+			}))
+			p, _, _, forwardURL = newForwardTestProxy(map[string]string{"api.anthropic.com": "anthropic"}, false)
+		})
+
+		It("refuses to tunnel the connection", func() {
+			transport := upstream.Client().Transport.(*http.Transport).Clone()
+			transport.Proxy = http.ProxyURL(forwardURL)
+			client := &http.Client{Transport: transport}
+
+			_, err := client.Post(upstream.URL+"/v1/messages", "application/json", strings.NewReader(anthropicHaikuRequest))
+			Expect(err).To(MatchError(ContainSubstring("Forbidden")))
+		})
+
+		It("refuses plain HTTP requests", func() {
+			plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, "ok")
+			}))
+			defer plain.Close()
+
+			client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(forwardURL)}}
+			resp, err := client.Get(plain.URL + "/health")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Context("when the host is not intercepted and passthrough is enabled", func() {
+		var forwardURL *url.URL
+
+		BeforeEach(func() {
+			upstream = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, anthropicColorResponse)
+			}))
+			p, driver, _, forwardURL = newForwardTestProxy(map[string]string{"api.anthropic.com": "anthropic"}, true)
+		})
+
+		It("tunnels the connection without recording it", func() {
+			transport := upstream.Client().Transport.(*http.Transport).Clone()
+			transport.Proxy = http.ProxyURL(forwardURL)
+			client := &http.Client{Transport: transport}
+
+			resp, err := client.Post(upstream.URL+"/v1/messages", "application/json", strings.NewReader(anthropicHaikuRequest))
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.TLS.PeerCertificates[0].Issuer.CommonName).NotTo(ContainSubstring("tapes"))
+
+			client.CloseIdleConnections()
+			p.Close()
+			p = nil
+
+			nodes, err := driver.List(GinkgoT().Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodes).To(BeEmpty())
+		})
+
+		It("passes plain HTTP requests through", func() {
+			plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, "ok")
+			}))
+			defer plain.Close()
+
+			client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(forwardURL)}}
+			resp, err := client.Get(plain.URL + "/health")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("ok"))
+		})
+	})
+})
+
+var _ = Describe("forwardListenAddr", func() {
+	It("binds an address without a host to loopback", func() {
+		Expect(forwardListenAddr(":8088")).To(Equal("127.0.0.1:8088"))
+	})
+
+	It("keeps an explicit host", func() {
+		Expect(forwardListenAddr("0.0.0.0:8088")).To(Equal("0.0.0.0:8088"))
+		Expect(forwardListenAddr("localhost:8088")).To(Equal("localhost:8088"))
+	})
+})
@@ -9,6 +9,8 @@
 package header
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -26,6 +28,40 @@ func NewHandler() *Handler {
 // AgentNameHeader is the optional header used to tag agent requests.
 const AgentNameHeader = "X-Tapes-Agent-Name"
 
+// CacheHeader controls the proxy response cache on requests ("true" marks a
+// request cacheable, "false" bypasses the cache) and reports the cache
+// outcome ("HIT" or "MISS") on responses.
+const CacheHeader = "X-Tapes-Cache"
+
+// CacheHashHeader carries the hash of the response node served from cache.
+const CacheHashHeader = "X-Tapes-Cache-Hash"
+
+// SessionHeader is the optional header used to group requests into a
+// caller-defined session (a run ID, ticket number, CI job, etc.).
+const SessionHeader = "X-Tapes-Session"
+
+// TagHeaderPrefix prefixes optional per-key tag headers: "X-Tapes-Tag-Run: 42"
+// attaches the tag run=42 to the recorded conversation.
+const TagHeaderPrefix = "X-Tapes-Tag-"
+
+// MetaHeader carries a JSON object of arbitrary metadata to attach to the
+// recorded conversation.
+const MetaHeader = "X-Tapes-Meta"
+
+// ContinuesHeader optionally names the node a conversation continues, e.g.
+// the last response before an agent compacted its context and restarted
+// from a summary. Full hashes and unique prefixes are accepted.
+const ContinuesHeader = "X-Tapes-Continues"
+
+// ParentHeader optionally names the node that spawned a subagent, e.g. the
+// response holding the Task tool call, so the subagent's conversation is
+// linked to it. Full hashes and unique prefixes are accepted.
+const ParentHeader = "X-Tapes-Parent"
+
+// SessionMetadataKey is the metadata key under which the SessionHeader value
+// is stored.
+const SessionMetadataKey = "session"
+
 // skipRequest is the set of request headers (client --> proxy --> upstream)
 // that are not forwarded to the upstream LLM provider.
 var skipRequest = map[string]struct{}{
@@ -44,6 +80,16 @@ var skipRequest = map[string]struct{}{
 
 	// Internal agent routing header.
 	AgentNameHeader: {},
+
+	// Internal response cache control header.
+	CacheHeader: {},
+
+	// Internal session metadata headers. Tag headers are matched by prefix in
+	// isTagHeader.
+	SessionHeader:   {},
+	MetaHeader:      {},
+	ContinuesHeader: {},
+	ParentHeader:    {},
 }
 
 // skipResponse is the set of upstream response headers (client <-- proxy <-- upstream)
@@ -73,15 +119,131 @@ var skipResponse = map[string]struct{}{
 // SetUpstreamRequestHeaders copies request headers from the Fiber context to
 // the outgoing http.Request, filtering headers that the proxy should not forward
 // to the upstream API.
+//
+// Headers covered by an AWS SigV4 signature (e.g. Bedrock requests) are
+// forwarded untouched even if normally filtered, except Host, which is set
+// from the upstream URL.
 func (h *Handler) SetUpstreamRequestHeaders(c *fiber.Ctx, req *http.Request) {
+	signed := sigV4SignedHeaders(string(c.Request().Header.Peek("Authorization")))
 	c.Request().Header.VisitAll(func(key, value []byte) {
 		k := string(key)
-		if _, skip := skipRequest[k]; !skip {
+		_, skip := skipRequest[k]
+		if skip && k != "Host" {
+			_, isSigned := signed[strings.ToLower(k)]
+			skip = !isSigned
+		}
+		if !skip && !isTagHeader(k) {
 			req.Header.Set(k, string(value))
 		}
 	})
 }
 
+// sigV4SignedHeaders returns the lowercased names listed in the SignedHeaders
+// component of a SigV4 Authorization header, or nil for other schemes.
+func sigV4SignedHeaders(authorization string) map[string]struct{} {
+	params, ok := strings.CutPrefix(authorization, "AWS4-HMAC-SHA256 ")
+	if !ok {
+		return nil
+	}
+	for param := range strings.SplitSeq(params, ",") {
+		list, ok := strings.CutPrefix(strings.TrimSpace(param), "SignedHeaders=")
+		if !ok {
+			continue
+		}
+		signed := make(map[string]struct{})
+		for name := range strings.SplitSeq(list, ";") {
+			signed[strings.ToLower(name)] = struct{}{}
+		}
+		return signed
+	}
+	return nil
+}
+
+// Metadata collects the session metadata attached to a request via the
+// MetaHeader, TagHeaderPrefix and SessionHeader headers. Keys are lowercased.
+// Tag headers override MetaHeader entries, and SessionHeader overrides both.
+// Non-string MetaHeader values are stored as their JSON encoding. A malformed
+// MetaHeader is reported as an error alongside the remaining metadata.
+// Returns nil when the request carries no metadata.
+func (h *Handler) Metadata(c *fiber.Ctx) (map[string]string, error) {
+	metadata := map[string]string{}
+
+	var metaErr error
+	if raw := c.Get(MetaHeader); raw != "" {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+			metaErr = fmt.Errorf("parsing %s header: %w", MetaHeader, err)
+		}
+		for k, v := range fields {
+			var s string
+			if err := json.Unmarshal(v, &s); err == nil {
+				metadata[strings.ToLower(k)] = s
+			} else {
+				metadata[strings.ToLower(k)] = string(v)
+			}
+		}
+	}
+
+	c.Request().Header.VisitAll(func(key, value []byte) {
+		k := string(key)
+		if isTagHeader(k) && len(k) > len(TagHeaderPrefix) {
+			metadata[strings.ToLower(k[len(TagHeaderPrefix):])] = string(value)
+		}
+	})
+
+	if session := c.Get(SessionHeader); session != "" {
+		metadata[SessionMetadataKey] = session
+	}
+
+	if len(metadata) == 0 {
+		return nil, metaErr
+	}
+	return metadata, metaErr
+}
+
+// requestIDHeaders are upstream response headers carrying the provider's
+// request ID, in order of preference.
+var requestIDHeaders = []string{"Request-Id", "X-Request-Id"}
+
+// rateLimitPrefixes are the (lowercase) prefixes of upstream rate-limit
+// response headers.
+var rateLimitPrefixes = []string{"anthropic-ratelimit-", "x-ratelimit-"}
+
+// ProviderRequestID returns the provider's request ID from an upstream
+// response, or "" when it carries none.
+func (h *Handler) ProviderRequestID(resp *http.Response) string {
+	for _, k := range requestIDHeaders {
+		if v := resp.Header.Get(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// RateLimits returns the upstream response's rate-limit headers keyed by
+// lowercase header name, or nil when it carries none.
+func (h *Handler) RateLimits(resp *http.Response) map[string]string {
+	var limits map[string]string
+	for k, v := range resp.Header {
+		name := strings.ToLower(k)
+		for _, prefix := range rateLimitPrefixes {
+			if strings.HasPrefix(name, prefix) && len(v) > 0 {
+				if limits == nil {
+					limits = map[string]string{}
+				}
+				limits[name] = v[0]
+				break
+			}
+		}
+	}
+	return limits
+}
+
+// isTagHeader reports whether key is a TagHeaderPrefix header.
+func isTagHeader(key string) bool {
+	return len(key) >= len(TagHeaderPrefix) && strings.EqualFold(key[:len(TagHeaderPrefix)], TagHeaderPrefix)
+}
+
 // SetClientResponseHeaders copies response headers from the upstream API
 // http.Response to the Fiber context, filtering headers that the proxy should
 // not forward back down to the client.
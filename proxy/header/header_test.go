@@ -110,6 +110,34 @@ var _ = Describe("SetUpstreamRequestHeaders", func() {
 		// Other headers still forwarded
 		Expect(got.Get("Authorization")).To(Equal("Bearer token123"))
 	})
+
+	It("forwards headers covered by a SigV4 signature untouched", func() {
+		var got http.Header
+
+		app.Post("/test", func(c *fiber.Ctx) error {
+			req, _ := http.NewRequest(http.MethodPost, "http://upstream/test", nil)
+			hh.SetUpstreamRequestHeaders(c, req)
+			got = req.Header
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		auth := "AWS4-HMAC-SHA256 Credential=AKID/20260101/us-east-1/bedrock/aws4_request, " +
+			"SignedHeaders=accept-encoding;host;x-amz-date, Signature=abc123"
+		req := httptest.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set("Authorization", auth)
+		req.Header.Set("X-Amz-Date", "20260101T000000Z")
+		req.Header.Set("Accept-Encoding", "identity")
+		req.Header.Set("Host", "client.example.com")
+
+		resp, err := app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(got.Get("Authorization")).To(Equal(auth))
+		Expect(got.Get("X-Amz-Date")).To(Equal("20260101T000000Z"))
+		Expect(got.Get("Accept-Encoding")).To(Equal("identity"))
+		Expect(got.Get("Host")).To(BeEmpty())
+	})
 })
 
 var _ = Describe("SetClientResponseHeaders", func() {
@@ -253,3 +281,146 @@ var _ = Describe("SetClientResponseHeaders", func() {
 		Expect(resp.Header.Get("X-Multi")).To(Equal("value1, value2"))
 	})
 })
+
+var _ = Describe("Session metadata", func() {
+	var (
+		app *fiber.App
+		hh  *Handler
+	)
+
+	BeforeEach(func() {
+		app = fiber.New()
+		hh = NewHandler()
+	})
+
+	AfterEach(func() {
+		app.Shutdown()
+	})
+
+	It("strips session metadata headers from the upstream request", func() {
+		var got http.Header
+
+		app.Post("/test", func(c *fiber.Ctx) error {
+			req, _ := http.NewRequest(http.MethodPost, "http://upstream/test", nil)
+			hh.SetUpstreamRequestHeaders(c, req)
+			got = req.Header
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set(SessionHeader, "run-1")
+		req.Header.Set(TagHeaderPrefix+"Ticket", "ENG-42")
+		req.Header.Set(MetaHeader, `{"user":"ada"}`)
+		req.Header.Set(ContinuesHeader, "abc123")
+		req.Header.Set(ParentHeader, "def456")
+		req.Header.Set("X-Api-Key", "secret")
+
+		resp, err := app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(got.Get(SessionHeader)).To(BeEmpty())
+		Expect(got.Get(ContinuesHeader)).To(BeEmpty())
+		Expect(got.Get(ParentHeader)).To(BeEmpty())
+		Expect(got.Get(TagHeaderPrefix + "Ticket")).To(BeEmpty())
+		Expect(got.Get(MetaHeader)).To(BeEmpty())
+		Expect(got.Get("X-Api-Key")).To(Equal("secret"))
+	})
+
+	It("collects session, tag and meta headers", func() {
+		var (
+			got    map[string]string
+			gotErr error
+		)
+
+		app.Post("/test", func(c *fiber.Ctx) error {
+			got, gotErr = hh.Metadata(c)
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set(SessionHeader, "run-1")
+		req.Header.Set(TagHeaderPrefix+"Experiment-Arm", "b")
+		req.Header.Set(MetaHeader, `{"user":"ada","attempt":3,"arm":"a"}`)
+
+		resp, err := app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(gotErr).NotTo(HaveOccurred())
+		Expect(got).To(Equal(map[string]string{
+			"session":        "run-1",
+			"experiment-arm": "b",
+			"user":           "ada",
+			"attempt":        "3",
+			"arm":            "a",
+		}))
+	})
+
+	It("returns nil without metadata headers", func() {
+		var got map[string]string
+
+		app.Post("/test", func(c *fiber.Ctx) error {
+			got, _ = hh.Metadata(c)
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/test", nil))
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(got).To(BeNil())
+	})
+
+	It("reports malformed meta headers and keeps the remaining metadata", func() {
+		var (
+			got    map[string]string
+			gotErr error
+		)
+
+		app.Post("/test", func(c *fiber.Ctx) error {
+			got, gotErr = hh.Metadata(c)
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set(MetaHeader, `not json`)
+		req.Header.Set(SessionHeader, "run-1")
+
+		resp, err := app.Test(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(gotErr).To(HaveOccurred())
+		Expect(got).To(Equal(map[string]string{"session": "run-1"}))
+	})
+})
+
+var _ = Describe("Provider response metadata", func() {
+	hh := NewHandler()
+
+	It("extracts the provider request ID", func() {
+		resp := &http.Response{Header: http.Header{}}
+		Expect(hh.ProviderRequestID(resp)).To(BeEmpty())
+
+		resp.Header.Set("X-Request-Id", "req_456")
+		Expect(hh.ProviderRequestID(resp)).To(Equal("req_456"))
+
+		resp.Header.Set("Request-Id", "req_123")
+		Expect(hh.ProviderRequestID(resp)).To(Equal("req_123"))
+	})
+
+	It("collects rate-limit headers", func() {
+		resp := &http.Response{Header: http.Header{}}
+		Expect(hh.RateLimits(resp)).To(BeNil())
+
+		resp.Header.Set("Anthropic-Ratelimit-Tokens-Remaining", "9000")
+		resp.Header.Set("X-Ratelimit-Limit-Requests", "500")
+		resp.Header.Set("Content-Type", "application/json")
+
+		Expect(hh.RateLimits(resp)).To(Equal(map[string]string{
+			"anthropic-ratelimit-tokens-remaining": "9000",
+			"x-ratelimit-limit-requests":           "500",
+		}))
+	})
+})
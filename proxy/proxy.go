@@ -3,14 +3,15 @@ package proxy
 
 import (
 	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,11 +19,14 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/compress"
 	"go.uber.org/zap"
 
+	"github.com/papercomputeco/tapes/pkg/eventstream"
+	"github.com/papercomputeco/tapes/pkg/listener"
 	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/llm/provider"
 	"github.com/papercomputeco/tapes/pkg/sse"
 	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/proxy/header"
+	"github.com/papercomputeco/tapes/proxy/upstream"
 	"github.com/papercomputeco/tapes/proxy/worker"
 )
 
@@ -31,6 +35,15 @@ const (
 	providerOpenAI    = "openai"
 	providerAnthropic = "anthropic"
 	providerOllama    = "ollama"
+	providerBedrock   = "bedrock"
+
+	// bedrockUpstream is the Bedrock runtime endpoint used when none is
+	// configured. Requests are SigV4-signed for their region's endpoint, so
+	// other regions must be configured explicitly.
+	bedrockUpstream = "https://bedrock-runtime.us-east-1.amazonaws.com"
+
+	// openAIAuthUpstream serves codex's OAuth flow and is never balanced.
+	openAIAuthUpstream = "https://auth.openai.com"
 )
 
 // Proxy is a client, LLM inference proxy that instruments storing sessions as Merkle DAGs.
@@ -45,7 +58,20 @@ type Proxy struct {
 	server        *fiber.App
 	providers     map[string]provider.Provider
 	defaultProv   provider.Provider
+	compat        map[string]*CompatProvider
 	headerHandler *header.Handler
+
+	// balancers holds the upstream balancer of every provider with
+	// multiple configured upstreams.
+	balancers        map[string]*upstream.Balancer
+	stopHealthChecks context.CancelFunc
+
+	// cache is the storage driver's response cache, nil when unsupported.
+	cache storage.Cache
+
+	// forwarder is the HTTPS_PROXY-compatible forward proxy, nil unless
+	// ForwardListenAddr is configured.
+	forwarder *forwardProxy
 }
 
 // New creates a new Proxy.
@@ -56,8 +82,32 @@ func New(config Config, driver storage.Driver, logger *zap.Logger) (*Proxy, erro
 		return nil, errors.New("provider type is required")
 	}
 
+	compat := make(map[string]*CompatProvider, len(config.CompatProviders))
+	for i := range config.CompatProviders {
+		cp := &config.CompatProviders[i]
+		if err := cp.validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := compat[cp.Name]; exists {
+			return nil, fmt.Errorf("compatible provider %q is declared twice", cp.Name)
+		}
+		compat[cp.Name] = cp
+	}
+
+	// newProvider creates a built-in provider or a named compatible one.
+	newProvider := func(providerType string) (provider.Provider, error) {
+		if _, ok := compat[providerType]; ok {
+			return provider.NewCompatible(providerType), nil
+		}
+		return provider.New(providerType)
+	}
+
 	providers := make(map[string]provider.Provider)
-	defaultProv, err := provider.New(config.ProviderType)
+	for name := range compat {
+		providers[name] = provider.NewCompatible(name)
+	}
+
+	defaultProv, err := newProvider(config.ProviderType)
 	if err != nil {
 		return nil, fmt.Errorf("could not create new provider: %w", err)
 	}
@@ -70,13 +120,56 @@ func New(config Config, driver storage.Driver, logger *zap.Logger) (*Proxy, erro
 		if _, exists := providers[route.ProviderType]; exists {
 			continue
 		}
-		prov, err := provider.New(route.ProviderType)
+		prov, err := newProvider(route.ProviderType)
 		if err != nil {
 			return nil, fmt.Errorf("could not create provider %s: %w", route.ProviderType, err)
 		}
 		providers[route.ProviderType] = prov
 	}
 
+	for i := range config.Routes {
+		route := &config.Routes[i]
+		if err := route.validate(); err != nil {
+			return nil, err
+		}
+		if route.ProviderType == "" {
+			continue
+		}
+		if _, exists := providers[route.ProviderType]; exists {
+			continue
+		}
+		prov, err := newProvider(route.ProviderType)
+		if err != nil {
+			return nil, fmt.Errorf("could not create provider %s for route %q: %w", route.ProviderType, route.Name, err)
+		}
+		providers[route.ProviderType] = prov
+	}
+
+	if config.ForwardListenAddr != "" {
+		if config.CA == nil {
+			return nil, errors.New("forward proxy requires a certificate authority")
+		}
+		intercept := config.InterceptHosts
+		if intercept == nil {
+			intercept = DefaultInterceptHosts
+		}
+		for host, providerType := range intercept {
+			if _, exists := providers[providerType]; exists {
+				continue
+			}
+			prov, err := newProvider(providerType)
+			if err != nil {
+				return nil, fmt.Errorf("could not create provider %s for intercepted host %s: %w", providerType, host, err)
+			}
+			providers[providerType] = prov
+		}
+	}
+
+	balancers, err := newBalancers(config.Upstreams, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	app := fiber.New(fiber.Config{
 		// Disable startup message for cleaner logs
 		DisableStartupMessage: true,
@@ -92,6 +185,7 @@ func New(config Config, driver storage.Driver, logger *zap.Logger) (*Proxy, erro
 		VectorDriver: config.VectorDriver,
 		Embedder:     config.Embedder,
 		Project:      config.Project,
+		Signer:       config.Signer,
 		Logger:       logger,
 	})
 	if err != nil {
@@ -106,27 +200,44 @@ func New(config Config, driver storage.Driver, logger *zap.Logger) (*Proxy, erro
 		server:        app,
 		providers:     providers,
 		defaultProv:   defaultProv,
+		compat:        compat,
 		headerHandler: header.NewHandler(),
 		httpClient: &http.Client{
 			// LLM requests can be slow, especially with thinking blocks
 			Timeout: 5 * time.Minute,
 		},
+		balancers: balancers,
+	}
+
+	if cache, ok := driver.(storage.Cache); ok {
+		p.cache = cache
+	} else if config.Cache.Enabled {
+		logger.Warn("storage driver does not support the response cache, disabling it")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.stopHealthChecks = cancel
+	for _, balancer := range balancers {
+		go balancer.RunHealthChecks(ctx, p.httpClient, config.HealthCheckInterval)
 	}
 
 	// Register transparent proxy route - forwards any path to upstream
 	app.All("/*", p.handleProxy)
 
+	if config.ForwardListenAddr != "" {
+		p.forwarder = newForwardProxy(p)
+	}
+
 	return p, nil
 }
 
 // Run starts the proxy server on the given listening address
 func (p *Proxy) Run() error {
-	p.logger.Info("starting proxy server",
-		zap.String("listen", p.config.ListenAddr),
-		zap.String("upstream", p.config.UpstreamURL),
-	)
-
-	return p.server.Listen(p.config.ListenAddr)
+	ln, err := listener.Listen(p.config.ListenAddr, p.config.TLS)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", p.config.ListenAddr, err)
+	}
+	return p.RunWithListener(ln)
 }
 
 // RunWithListener starts the proxy server using the provided listener.
@@ -139,8 +250,47 @@ func (p *Proxy) RunWithListener(listener net.Listener) error {
 	return p.server.Listener(listener)
 }
 
+// RunForward starts the forward proxy on the configured forward listening address.
+func (p *Proxy) RunForward() error {
+	if p.forwarder == nil {
+		return errors.New("forward proxy is not configured")
+	}
+	addr := forwardListenAddr(p.config.ForwardListenAddr)
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", addr, err)
+	}
+	return p.RunForwardWithListener(ln)
+}
+
+// RunForwardWithListener starts the forward proxy using the provided listener.
+func (p *Proxy) RunForwardWithListener(listener net.Listener) error {
+	if p.forwarder == nil {
+		return errors.New("forward proxy is not configured")
+	}
+	p.logger.Info("starting forward proxy",
+		zap.String("listen", listener.Addr().String()),
+	)
+
+	// Intercepted connections are served by the fiber app directly, which
+	// needs its route tree built first.
+	p.server.Handler()
+
+	err := p.forwarder.server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
 // Close gracefully shuts down the proxy and waits for the worker pool to drain
 func (p *Proxy) Close() error {
+	p.stopHealthChecks()
+	if p.forwarder != nil {
+		if err := p.forwarder.close(); err != nil {
+			p.logger.Warn("could not close forward proxy", zap.Error(err))
+		}
+	}
 	p.workerPool.Close()
 	return p.server.Shutdown()
 }
@@ -153,8 +303,24 @@ func (p *Proxy) handleProxy(c *fiber.Ctx) error {
 	// Get the request path and method
 	agentName, providerName, path := p.resolveAgent(c.Path(), c.Get(header.AgentNameHeader))
 	prov, upstreamURL := p.resolveProvider(agentName, providerName, path)
+	interceptedProvider, interceptedUpstream, intercepted := p.interceptedUpstream(c.Context().Conn())
+	if intercepted {
+		// Requests decrypted by the forward proxy go to the host the client
+		// connected to, parsed by that host's provider.
+		if ip, ok := p.providers[interceptedProvider]; ok {
+			prov = ip
+		}
+		upstreamURL = interceptedUpstream
+	}
 	method := c.Method()
 
+	// Embedding calls are recorded on their own rather than as chat turns.
+	if parser, ok := embeddingParser(prov, method, path); ok {
+		pinned := intercepted || upstreamURL == openAIAuthUpstream
+		upstreams := p.upstreamCandidates(prov.Name(), upstreamURL, pinned)
+		return p.handleEmbeddingProxy(c, path, upstreams, prov, parser, agentName, startTime)
+	}
+
 	// Only process POST requests that look like chat/completion endpoints
 	body := c.Body()
 	isChatRequest := method == "POST" && len(body) > 0
@@ -171,6 +337,12 @@ func (p *Proxy) handleProxy(c *fiber.Ctx) error {
 				zap.String("agent", agentName),
 			)
 		} else {
+			if pp, ok := prov.(provider.PathParser); ok {
+				pp.ParseRequestPath(path, parsedReq)
+			}
+			if cp, ok := p.compat[prov.Name()]; ok {
+				cp.mapModel(path, parsedReq)
+			}
 			p.logger.Debug("parsed request",
 				zap.String("provider", prov.Name()),
 				zap.String("agent", agentName),
@@ -198,41 +370,92 @@ func (p *Proxy) handleProxy(c *fiber.Ctx) error {
 		}
 	}
 
-	if streaming && isChatRequest {
-		return p.handleStreamingProxy(c, path, upstreamURL, prov, agentName, body, parsedReq, startTime)
+	// Apply routing rules: a matching rule may rewrite the model and point
+	// the request at another upstream or provider.
+	var routed *routedRequest
+	if parsedReq != nil && len(p.config.Routes) > 0 {
+		routed = p.applyRoutes(agentName, prov, upstreamURL, path, body, parsedReq, streaming)
+		if routed != nil {
+			p.logger.Debug("applied route",
+				zap.String("route", routed.route.Name),
+				zap.String("model", parsedReq.Model),
+				zap.String("provider", routed.prov.Name()),
+				zap.String("upstream", routed.upstreamURL),
+			)
+			upstreamURL, path, body = routed.upstreamURL, routed.path, routed.body
+		}
 	}
 
-	return p.handleNonStreamingProxy(c, path, method, upstreamURL, prov, agentName, body, parsedReq, startTime)
-}
+	servingProv := prov
+	if routed != nil {
+		servingProv = routed.prov
+	}
+	pinned := intercepted || upstreamURL == openAIAuthUpstream || (routed != nil && routed.route.UpstreamURL != "")
+	upstreams := p.upstreamCandidates(servingProv.Name(), upstreamURL, pinned)
+
+	// job carries what is known about the turn so far; the handlers fill in
+	// the response before enqueueing it for storage.
+	job := worker.Job{
+		Provider:  prov.Name(),
+		AgentName: agentName,
+		Req:       parsedReq,
+		Continues: strings.TrimSpace(c.Get(header.ContinuesHeader)),
+		Parent:    strings.TrimSpace(c.Get(header.ParentHeader)),
+	}
+	if agentName != "" && p.config.AgentPID != nil {
+		job.AgentPID = p.config.AgentPID(agentName)
+	}
 
-// handleNonStreamingProxy handles non-streaming requests.
-func (p *Proxy) handleNonStreamingProxy(c *fiber.Ctx, path, method, upstreamURL string, prov provider.Provider, agentName string, body []byte, parsedReq *llm.ChatRequest, startTime time.Time) error {
-	// Build upstream URL
-	upstreamURL += path
+	metadata, err := p.headerHandler.Metadata(c)
+	if err != nil {
+		p.logger.Warn("ignoring invalid session metadata",
+			zap.String("agent", agentName),
+			zap.Error(err),
+		)
+	}
+	job.Metadata = metadata
 
-	// Create upstream request
-	var reqBody io.Reader
-	if len(body) > 0 {
-		reqBody = bytes.NewReader(body)
+	if isChatRequest && p.cacheable(c, parsedReq) {
+		model := parsedReq.Model
+		if routed != nil && routed.route.Model != "" {
+			model = routed.route.Model
+		}
+
+		key, err := cacheKey(prov.Name(), servingProv.Name(), model, parsedReq, c.Body())
+		if err != nil {
+			p.logger.Warn("failed to compute cache key", zap.Error(err))
+		} else {
+			if served, err := p.serveFromCache(c, prov, key, p.cacheTTL(routed), streaming); served {
+				return err
+			}
+			job.CacheKey = key
+			c.Set(header.CacheHeader, cacheMiss)
+		}
 	}
 
-	httpReq, err := http.NewRequestWithContext(c.Context(), method, upstreamURL, reqBody)
-	if err != nil {
-		p.logger.Error("failed to create upstream request", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "internal error"})
+	// Translated requests are always sent upstream as non-streaming.
+	if streaming && isChatRequest && (routed == nil || !routed.translated) {
+		return p.handleStreamingProxy(c, path, upstreams, prov, body, job, startTime)
 	}
 
-	p.headerHandler.SetUpstreamRequestHeaders(c, httpReq)
+	return p.handleNonStreamingProxy(c, path, method, upstreams, prov, body, job, routed, startTime)
+}
 
-	p.logger.Debug("forwarding request to upstream",
-		zap.String("method", method),
-		zap.String("url", upstreamURL),
-	)
+// handleNonStreamingProxy handles non-streaming requests.
+// When routed is a cross-provider route, the upstream response is parsed with
+// the serving provider and re-serialized in the client's format.
+func (p *Proxy) handleNonStreamingProxy(c *fiber.Ctx, path, method string, upstreams []string, prov provider.Provider, body []byte, job worker.Job, routed *routedRequest, startTime time.Time) error {
+	servingProv := prov
+	translated := routed != nil && routed.translated
+	if translated {
+		servingProv = routed.prov
+	}
 
-	// Make the request
-	httpResp, err := p.httpClient.Do(httpReq)
+	httpResp, upstreamURL, err := p.sendUpstream(c, c.Context(), method, path, body, upstreams, servingProv.Name())
 	if err != nil {
-		p.logger.Error("upstream request failed", zap.Error(err))
+		if errors.Is(err, errBuildRequest) {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "internal error"})
+		}
 		return c.Status(fiber.StatusBadGateway).JSON(llm.ErrorResponse{Error: "upstream request failed"})
 	}
 	defer httpResp.Body.Close()
@@ -247,29 +470,49 @@ func (p *Proxy) handleNonStreamingProxy(c *fiber.Ctx, path, method, upstreamURL
 	p.headerHandler.SetClientResponseHeaders(c, httpResp)
 
 	// If this was a chat request, enqueue for async storage
-	if parsedReq != nil && httpResp.StatusCode == http.StatusOK {
-		parsedResp, err := prov.ParseResponse(respBody)
+	if job.Req != nil && httpResp.StatusCode == http.StatusOK {
+		parsedResp, err := servingProv.ParseResponse(respBody)
 		if err != nil {
 			p.logger.Warn("failed to parse response",
 				zap.Error(err),
-				zap.String("provider", prov.Name()),
-				zap.String("agent", agentName),
+				zap.String("provider", servingProv.Name()),
+				zap.String("agent", job.AgentName),
 			)
+			if translated {
+				return c.Status(fiber.StatusBadGateway).JSON(llm.ErrorResponse{Error: "failed to translate upstream response"})
+			}
 		} else {
 			p.logger.Debug("received response from upstream",
 				zap.String("model", parsedResp.Model),
-				zap.String("provider", prov.Name()),
-				zap.String("agent", agentName),
+				zap.String("provider", servingProv.Name()),
+				zap.String("agent", job.AgentName),
 				zap.Duration("duration", time.Since(startTime)),
 			)
 
+			job.Resp = parsedResp
+			job.Upstream = upstreamURL
+			job.ProviderRequestID = p.headerHandler.ProviderRequestID(httpResp)
+			job.RateLimits = p.headerHandler.RateLimits(httpResp)
+
+			if translated {
+				job.ServedBy = servingProv.Name()
+
+				// Render the response in the format the client speaks.
+				clientBody, contentType, err := serializeForClient(prov, parsedResp, routed.stream)
+				if err != nil {
+					p.logger.Error("failed to translate response",
+						zap.Error(err),
+						zap.String("from", servingProv.Name()),
+						zap.String("to", prov.Name()),
+					)
+					return c.Status(fiber.StatusBadGateway).JSON(llm.ErrorResponse{Error: "failed to translate upstream response"})
+				}
+				respBody = clientBody
+				c.Set(fiber.HeaderContentType, contentType)
+			}
+
 			// Non-blocking enqueue for async storage
-			p.workerPool.Enqueue(worker.Job{
-				Provider:  prov.Name(),
-				AgentName: agentName,
-				Req:       parsedReq,
-				Resp:      parsedResp,
-			})
+			p.workerPool.Enqueue(job)
 		}
 	}
 
@@ -277,31 +520,30 @@ func (p *Proxy) handleNonStreamingProxy(c *fiber.Ctx, path, method, upstreamURL
 	return c.Status(httpResp.StatusCode).Send(respBody)
 }
 
-// handleStreamingProxy handles streaming requests.
-func (p *Proxy) handleStreamingProxy(c *fiber.Ctx, path, upstreamURL string, prov provider.Provider, agentName string, body []byte, parsedReq *llm.ChatRequest, startTime time.Time) error {
-	// Build upstream URL
-	upstreamURL += path
+// serializeForClient renders a response in the client provider's format,
+// as a complete stream when the client asked for one.
+func serializeForClient(prov provider.Provider, resp *llm.ChatResponse, stream bool) ([]byte, string, error) {
+	if stream {
+		body, err := prov.SerializeStream(resp)
+		return body, prov.StreamContentType(), err
+	}
+	body, err := prov.SerializeResponse(resp)
+	return body, fiber.MIMEApplicationJSON, err
+}
 
+// handleStreamingProxy handles streaming requests.
+// Failover to the next upstream is only possible until the first byte is
+// streamed to the client, i.e. on connection errors and 5xx responses.
+func (p *Proxy) handleStreamingProxy(c *fiber.Ctx, path string, upstreams []string, prov provider.Provider, body []byte, job worker.Job, startTime time.Time) error {
 	// Use context.Background() instead of c.Context() because fasthttp recycles
 	// its RequestCtx after the handler returns, but the streaming callback runs
 	// asynchronously in a separate goroutine and needs the upstream connection
 	// to remain open.
-	httpReq, err := http.NewRequestWithContext(context.Background(), http.MethodPost, upstreamURL, bytes.NewReader(body))
-	if err != nil {
-		p.logger.Error("failed to create upstream request", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "internal error"})
-	}
-
-	p.headerHandler.SetUpstreamRequestHeaders(c, httpReq)
-
-	p.logger.Debug("forwarding streaming request to upstream",
-		zap.String("url", upstreamURL),
-	)
-
-	// Make the request
-	httpResp, err := p.httpClient.Do(httpReq)
+	httpResp, upstreamURL, err := p.sendUpstream(c, context.Background(), http.MethodPost, path, body, upstreams, prov.Name())
 	if err != nil {
-		p.logger.Error("upstream request failed", zap.Error(err))
+		if errors.Is(err, errBuildRequest) {
+			return c.Status(fiber.StatusInternalServerError).JSON(llm.ErrorResponse{Error: "internal error"})
+		}
 		return c.Status(fiber.StatusBadGateway).JSON(llm.ErrorResponse{Error: "upstream request failed"})
 	}
 	if httpResp.StatusCode != http.StatusOK {
@@ -327,7 +569,10 @@ func (p *Proxy) handleStreamingProxy(c *fiber.Ctx, path, upstreamURL string, pro
 	// every chunk. This gives direct backpressure and true per-chunk streaming
 	// for LLM based.
 	pr, pw := io.Pipe()
-	go p.handleHTTPRespToPipeWriter(httpResp, pw, parsedReq, prov, agentName, startTime)
+	job.Upstream = upstreamURL
+	job.ProviderRequestID = p.headerHandler.ProviderRequestID(httpResp)
+	job.RateLimits = p.headerHandler.RateLimits(httpResp)
+	go p.handleHTTPRespToPipeWriter(httpResp, pw, prov, job, startTime)
 
 	// Set the pipe reader as the body stream with unknown size (-1),
 	// which triggers chunked transfer encoding in fasthttp.
@@ -336,23 +581,25 @@ func (p *Proxy) handleStreamingProxy(c *fiber.Ctx, path, upstreamURL string, pro
 	return nil
 }
 
-func (p *Proxy) handleHTTPRespToPipeWriter(httpResp *http.Response, pw *io.PipeWriter, parsedReq *llm.ChatRequest, prov provider.Provider, agentName string, startTime time.Time) {
+func (p *Proxy) handleHTTPRespToPipeWriter(httpResp *http.Response, pw *io.PipeWriter, prov provider.Provider, job worker.Job, startTime time.Time) {
 	// Close the upstream response body once streaming is complete.
 	defer httpResp.Body.Close()
 	defer pw.Close()
 
 	switch ct := httpResp.Header.Get("Content-Type"); {
 	case strings.HasPrefix(ct, "text/event-stream"):
-		p.handleSSEStream(httpResp, pw, parsedReq, prov, agentName, startTime)
+		p.handleSSEStream(httpResp, pw, prov, job, startTime)
+	case strings.HasPrefix(ct, eventstream.ContentType):
+		p.handleEventStream(httpResp, pw, prov, job, startTime)
 	default:
-		p.handleNDJSONStream(httpResp, pw, parsedReq, prov, agentName, startTime)
+		p.handleNDJSONStream(httpResp, pw, prov, job, startTime)
 	}
 }
 
 // handleSSEStream reads an SSE-formatted upstream response (used by OpenAI
 // and Anthropic), forwarding raw bytes verbatim to the pipe writer while
 // parsing events for telemetry accumulation.
-func (p *Proxy) handleSSEStream(httpResp *http.Response, pw *io.PipeWriter, parsedReq *llm.ChatRequest, prov provider.Provider, agentName string, startTime time.Time) {
+func (p *Proxy) handleSSEStream(httpResp *http.Response, pw *io.PipeWriter, prov provider.Provider, job worker.Job, startTime time.Time) {
 	var allChunks [][]byte
 	var fullContent strings.Builder
 	var streamUsage llm.Usage
@@ -380,19 +627,87 @@ func (p *Proxy) handleSSEStream(httpResp *http.Response, pw *io.PipeWriter, pars
 		allChunks = append(allChunks, chunkCopy)
 
 		// Best-effort content extraction from the JSON payload
-		p.extractContentFromJSON([]byte(ev.Data), prov.Name(), &fullContent)
+		p.extractContentFromJSON([]byte(ev.Data), provider.Format(prov), &fullContent, &meta)
 
 		// Accumulate usage from SSE events (Anthropic splits usage across events)
-		p.extractUsageFromSSE([]byte(ev.Data), prov.Name(), &streamUsage, &meta)
+		p.extractUsageFromSSE([]byte(ev.Data), provider.Format(prov), &streamUsage, &meta)
+	}
+
+	p.enqueueStreamedResponse(allChunks, fullContent.String(), &streamUsage, &meta, prov, job, startTime)
+}
+
+// handleEventStream reads an AWS event-stream upstream response (used by
+// Bedrock), forwarding raw bytes verbatim to the pipe writer while parsing
+// events with the provider for telemetry accumulation.
+func (p *Proxy) handleEventStream(httpResp *http.Response, pw *io.PipeWriter, prov provider.Provider, job worker.Job, startTime time.Time) {
+	var allChunks [][]byte
+	var fullContent strings.Builder
+	var streamUsage llm.Usage
+	var meta streamMeta
+
+	tr := eventstream.NewTeeReader(httpResp.Body, pw)
+
+	for {
+		msg, err := tr.Next()
+		if err != nil {
+			p.logger.Error("error reading event stream", zap.Error(err))
+			return
+		}
+		if msg == nil {
+			break
+		}
+
+		if msg.MessageType() != "event" {
+			p.logger.Warn("upstream stream reported an error",
+				zap.String("type", msg.Headers[eventstream.ExceptionTypeHeader]),
+				zap.String("payload", string(msg.Payload)),
+			)
+			continue
+		}
+
+		// Providers parse events wrapped in an object keyed by event type.
+		eventType, err := json.Marshal(msg.EventType())
+		if err != nil {
+			continue
+		}
+		chunkData := make([]byte, 0, len(eventType)+len(msg.Payload)+3)
+		chunkData = append(chunkData, '{')
+		chunkData = append(chunkData, eventType...)
+		chunkData = append(chunkData, ':')
+		chunkData = append(chunkData, msg.Payload...)
+		chunkData = append(chunkData, '}')
+		allChunks = append(allChunks, chunkData)
+
+		chunk, err := prov.ParseStreamChunk(chunkData)
+		if err != nil || chunk == nil {
+			continue
+		}
+		fullContent.WriteString(chunk.Message.GetText())
+		if chunk.Model != "" {
+			meta.Model = chunk.Model
+		}
+		if chunk.StopReason != "" {
+			meta.StopReason = chunk.StopReason
+		}
+		if u := chunk.Usage; u != nil {
+			if u.PromptTokens > 0 {
+				streamUsage.PromptTokens = u.PromptTokens
+				streamUsage.CacheCreationInputTokens = u.CacheCreationInputTokens
+				streamUsage.CacheReadInputTokens = u.CacheReadInputTokens
+			}
+			if u.CompletionTokens > 0 {
+				streamUsage.CompletionTokens = u.CompletionTokens
+			}
+		}
 	}
 
-	p.enqueueStreamedResponse(allChunks, fullContent.String(), &streamUsage, &meta, parsedReq, prov, agentName, startTime)
+	p.enqueueStreamedResponse(allChunks, fullContent.String(), &streamUsage, &meta, prov, job, startTime)
 }
 
 // handleNDJSONStream reads a newline-delimited JSON upstream response (used by
 // Ollama), forwarding raw bytes to the pipe writer while accumulating chunks
 // for telemetry.
-func (p *Proxy) handleNDJSONStream(httpResp *http.Response, pw *io.PipeWriter, parsedReq *llm.ChatRequest, prov provider.Provider, agentName string, startTime time.Time) {
+func (p *Proxy) handleNDJSONStream(httpResp *http.Response, pw *io.PipeWriter, prov provider.Provider, job worker.Job, startTime time.Time) {
 	var allChunks [][]byte
 	var fullContent strings.Builder
 	var streamUsage llm.Usage
@@ -414,10 +729,10 @@ func (p *Proxy) handleNDJSONStream(httpResp *http.Response, pw *io.PipeWriter, p
 		allChunks = append(allChunks, chunkCopy)
 
 		// Best-effort content extraction from the raw chunk
-		p.extractContentFromJSON(line, prov.Name(), &fullContent)
+		p.extractContentFromJSON(line, provider.Format(prov), &fullContent, &meta)
 
 		// Accumulate usage from NDJSON events
-		p.extractUsageFromSSE(line, prov.Name(), &streamUsage, &meta)
+		p.extractUsageFromSSE(line, provider.Format(prov), &streamUsage, &meta)
 
 		// Write chunk to client — pw.Write blocks until fasthttp reads
 		// from the pipe reader and flushes to the TCP socket.
@@ -436,12 +751,13 @@ func (p *Proxy) handleNDJSONStream(httpResp *http.Response, pw *io.PipeWriter, p
 		p.logger.Error("error reading NDJSON stream", zap.Error(err))
 	}
 
-	p.enqueueStreamedResponse(allChunks, fullContent.String(), &streamUsage, &meta, parsedReq, prov, agentName, startTime)
+	p.enqueueStreamedResponse(allChunks, fullContent.String(), &streamUsage, &meta, prov, job, startTime)
 }
 
 // extractContentFromJSON performs best-effort content extraction from a JSON
-// streaming chunk, dispatching on the configured provider.
-func (p *Proxy) extractContentFromJSON(data []byte, providerName string, content *strings.Builder) {
+// streaming chunk, dispatching on the provider's wire format. OpenAI deltas
+// are also accumulated per choice in meta; content holds the first choice.
+func (p *Proxy) extractContentFromJSON(data []byte, providerName string, content *strings.Builder, meta *streamMeta) {
 	var chunkData map[string]any
 	if err := json.Unmarshal(data, &chunkData); err != nil {
 		return
@@ -449,21 +765,41 @@ func (p *Proxy) extractContentFromJSON(data []byte, providerName string, content
 
 	switch providerName {
 	case providerOllama:
-		// Ollama NDJSON: message.content
+		// Ollama NDJSON: message.content, or response for /api/generate
 		if msg, ok := chunkData["message"].(map[string]any); ok {
 			if c, ok := msg["content"].(string); ok {
 				content.WriteString(c)
 			}
 		}
+		if c, ok := chunkData["response"].(string); ok {
+			content.WriteString(c)
+		}
 	case providerOpenAI:
-		// OpenAI SSE: choices[0].delta.content
-		if choices, ok := chunkData["choices"].([]any); ok && len(choices) > 0 {
-			if choice, ok := choices[0].(map[string]any); ok {
-				if delta, ok := choice["delta"].(map[string]any); ok {
-					if c, ok := delta["content"].(string); ok {
-						content.WriteString(c)
-					}
-				}
+		// OpenAI SSE: choices[].delta.content, or choices[].text for legacy
+		// completions. Streams with n > 1 interleave chunks of every choice,
+		// told apart by index.
+		choices, _ := chunkData["choices"].([]any)
+		for _, item := range choices {
+			choice, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			var text string
+			if delta, ok := choice["delta"].(map[string]any); ok {
+				text, _ = delta["content"].(string)
+			}
+			if c, ok := choice["text"].(string); ok {
+				text += c
+			}
+
+			index := jsonInt(choice, "index")
+			acc := meta.choice(index)
+			acc.text.WriteString(text)
+			if index == 0 {
+				content.WriteString(text)
+			}
+			if reason, ok := choice["finish_reason"].(string); ok && reason != "" {
+				acc.stopReason = reason
 			}
 		}
 	case providerAnthropic:
@@ -482,6 +818,59 @@ func (p *Proxy) extractContentFromJSON(data []byte, providerName string, content
 type streamMeta struct {
 	StopReason string
 	Model      string
+
+	// Choices accumulates OpenAI delta text and finish reasons by choice
+	// index.
+	Choices map[int]*streamChoice
+}
+
+// streamChoice is the accumulated text and finish reason of one choice.
+type streamChoice struct {
+	text       strings.Builder
+	stopReason string
+}
+
+// choice returns the accumulator for the choice at index, creating it on
+// first use.
+func (m *streamMeta) choice(index int) *streamChoice {
+	if m.Choices == nil {
+		m.Choices = make(map[int]*streamChoice)
+	}
+	c, ok := m.Choices[index]
+	if !ok {
+		c = &streamChoice{}
+		m.Choices[index] = c
+	}
+	return c
+}
+
+// applyChoices fills resp from the accumulated choices: the first choice's
+// finish reason when resp has none, and every choice when there are several,
+// so each is stored as a sibling like a non-streamed n > 1 response.
+func (m *streamMeta) applyChoices(resp *llm.ChatResponse) {
+	if m == nil || len(m.Choices) == 0 {
+		return
+	}
+
+	indexes := slices.Sorted(maps.Keys(m.Choices))
+	if resp.StopReason == "" {
+		resp.StopReason = m.Choices[indexes[0]].stopReason
+	}
+	if len(indexes) < 2 {
+		return
+	}
+
+	resp.Choices = make([]llm.Choice, 0, len(indexes))
+	for _, i := range indexes {
+		c := m.Choices[i]
+		resp.Choices = append(resp.Choices, llm.Choice{
+			Index:      i,
+			Message:    llm.NewTextMessage("assistant", c.text.String()),
+			StopReason: c.stopReason,
+		})
+	}
+	resp.Message = resp.Choices[0].Message
+	resp.StopReason = resp.Choices[0].StopReason
 }
 
 // extractUsageFromSSE extracts token usage from SSE event data.
@@ -548,23 +937,19 @@ func jsonInt(m map[string]any, key string) int {
 
 // enqueueStreamedResponse handles post-stream telemetry: logging and
 // enqueuing the reconstructed response for async storage.
-func (p *Proxy) enqueueStreamedResponse(allChunks [][]byte, fullContent string, streamUsage *llm.Usage, meta *streamMeta, parsedReq *llm.ChatRequest, prov provider.Provider, agentName string, startTime time.Time) {
-	if parsedReq != nil && len(allChunks) > 0 {
+func (p *Proxy) enqueueStreamedResponse(allChunks [][]byte, fullContent string, streamUsage *llm.Usage, meta *streamMeta, prov provider.Provider, job worker.Job, startTime time.Time) {
+	if job.Req != nil && len(allChunks) > 0 {
 		p.logger.Debug("streaming complete",
 			zap.String("content_preview", fullContent),
 			zap.Int("chunk_count", len(allChunks)),
-			zap.String("agent", agentName),
+			zap.String("agent", job.AgentName),
 			zap.Duration("duration", time.Since(startTime)),
 		)
 
 		finalResp := p.reconstructStreamedResponse(allChunks, fullContent, streamUsage, meta, prov)
 		if finalResp != nil {
-			p.workerPool.Enqueue(worker.Job{
-				Provider:  prov.Name(),
-				AgentName: agentName,
-				Req:       parsedReq,
-				Resp:      finalResp,
-			})
+			job.Resp = finalResp
+			p.workerPool.Enqueue(job)
 		}
 	}
 }
@@ -593,6 +978,7 @@ func (p *Proxy) reconstructStreamedResponse(chunks [][]byte, fullContent string,
 			if resp.Model == "" && meta != nil && meta.Model != "" {
 				resp.Model = meta.Model
 			}
+			meta.applyChoices(resp)
 			return resp
 		}
 	}
@@ -616,6 +1002,7 @@ func (p *Proxy) reconstructStreamedResponse(chunks [][]byte, fullContent string,
 		if meta != nil && meta.StopReason != "" {
 			resp.StopReason = meta.StopReason
 		}
+		meta.applyChoices(resp)
 		return resp
 	}
 
@@ -624,14 +1011,15 @@ func (p *Proxy) reconstructStreamedResponse(chunks [][]byte, fullContent string,
 
 func (p *Proxy) resolveAgent(path, headerValue string) (string, string, string) {
 	agent := strings.TrimSpace(headerValue)
+	if !strings.HasPrefix(path, agentPathPrefix) {
+		// Named providers may also be addressed without an agent prefix.
+		providerName, trimmedPath := resolveProviderOverride(path)
+		return agent, providerName, trimmedPath
+	}
 	if agent != "" {
 		return agent, "", path
 	}
 
-	if !strings.HasPrefix(path, agentPathPrefix) {
-		return "", "", path
-	}
-
 	remainder := strings.TrimPrefix(path, agentPathPrefix)
 	if remainder == "" {
 		return "", "", path
@@ -673,6 +1061,10 @@ func (p *Proxy) resolveProvider(agentName, providerName, path string) (provider.
 
 func (p *Proxy) providerByName(providerName, agentName, path string) (provider.Provider, string) {
 	if prov, ok := p.providers[providerName]; ok {
+		if cp, ok := p.compat[providerName]; ok {
+			return prov, p.providerUpstream(providerName, cp.UpstreamURL)
+		}
+
 		switch providerName {
 		case providerOpenAI:
 			upstream := p.providerUpstream(providerName, "https://api.openai.com/v1")
@@ -681,6 +1073,8 @@ func (p *Proxy) providerByName(providerName, agentName, path string) (provider.P
 			return prov, p.providerUpstream(providerName, "https://api.anthropic.com")
 		case providerOllama:
 			return prov, p.providerUpstream(providerName, p.config.UpstreamURL)
+		case providerBedrock:
+			return prov, p.providerUpstream(providerName, bedrockUpstream)
 		}
 
 		return prov, p.config.UpstreamURL
@@ -691,7 +1085,7 @@ func (p *Proxy) providerByName(providerName, agentName, path string) (provider.P
 
 func (p *Proxy) resolveOpenAIAuthUpstream(agentName, providerName, path, upstream string) string {
 	if providerName == providerOpenAI && agentName == "codex" && isOpenAIAuthPath(path) {
-		return "https://auth.openai.com"
+		return openAIAuthUpstream
 	}
 	return upstream
 }
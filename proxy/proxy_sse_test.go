@@ -167,6 +167,61 @@ var _ = Describe("SSE Streaming Proxy", func() {
 		})
 	})
 
+	Context("when upstream streams several choices (n=2)", func() {
+		BeforeEach(func() {
+			upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				flusher, ok := w.(http.Flusher)
+				Expect(ok).To(BeTrue())
+
+				events := []string{
+					"data: {\"id\":\"chatcmpl-2\",\"model\":\"gpt-4\",\"choices\":[{\"index\":0,\"delta\":{\"role\":\"assistant\",\"content\":\"Hello\"}}]}\n\n",
+					"data: {\"id\":\"chatcmpl-2\",\"model\":\"gpt-4\",\"choices\":[{\"index\":1,\"delta\":{\"role\":\"assistant\",\"content\":\"Hi\"}}]}\n\n",
+					"data: {\"id\":\"chatcmpl-2\",\"model\":\"gpt-4\",\"choices\":[{\"index\":1,\"delta\":{\"content\":\" there\"}}]}\n\n",
+					"data: {\"id\":\"chatcmpl-2\",\"model\":\"gpt-4\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\" world\"}}]}\n\n",
+					"data: {\"id\":\"chatcmpl-2\",\"model\":\"gpt-4\",\"choices\":[{\"index\":0,\"delta\":{},\"finish_reason\":\"stop\"}]}\n\n",
+					"data: {\"id\":\"chatcmpl-2\",\"model\":\"gpt-4\",\"choices\":[{\"index\":1,\"delta\":{},\"finish_reason\":\"length\"}]}\n\n",
+					"data: [DONE]\n\n",
+				}
+
+				for _, event := range events {
+					fmt.Fprint(w, event)
+					flusher.Flush()
+				}
+			}))
+			p, driver = newOpenAITestProxy(upstream.URL)
+		})
+
+		It("stores each choice as a sibling with its own text and finish reason", func() {
+			reqBody := makeOpenAIRequestBody("gpt-4", []openaiTestMsgEntry{
+				{Role: "user", Content: "Say hello"},
+			}, boolPtr(true))
+
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(string(reqBody))), -1)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			p.Close()
+			p = nil
+
+			ctx := GinkgoT().Context()
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(2))
+
+			byChoice := map[int]string{}
+			stopReasons := map[int]string{}
+			for _, leaf := range leaves {
+				Expect(leaf.ChoiceIndex).NotTo(BeNil())
+				Expect(leaf.ParentHash).To(Equal(leaves[0].ParentHash))
+				byChoice[*leaf.ChoiceIndex] = leaf.Bucket.ExtractText()
+				stopReasons[*leaf.ChoiceIndex] = leaf.StopReason
+			}
+			Expect(byChoice).To(Equal(map[int]string{0: "Hello world", 1: "Hi there"}))
+			Expect(stopReasons).To(Equal(map[int]string{0: "stop", 1: "length"}))
+		})
+	})
+
 	Context("when upstream returns an Anthropic-style SSE response with event types", func() {
 		BeforeEach(func() {
 			upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
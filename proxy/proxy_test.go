@@ -346,6 +346,67 @@ var _ = Describe("Non-Streaming Proxy", func() {
 
 			Expect(receivedHeaders.Get(header.AgentNameHeader)).To(BeEmpty())
 		})
+
+		It("records the provider request ID and rate-limit headers", func() {
+			upstream.Close()
+			upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("X-Request-Id", "req_123")
+				w.Header().Set("X-Ratelimit-Remaining-Requests", "499")
+				w.Write(makeOllamaResponseBody("test-model", "assistant", "hi"))
+			}))
+			p.Close()
+			p, driver = newTestProxy(upstream.URL)
+
+			reqBody := makeOllamaRequestBody("test-model", []ollamaTestMessage{
+				{Role: "user", Content: "hello"},
+			}, boolPtr(false))
+
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(string(reqBody))))
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.Header.Get("X-Request-Id")).To(Equal("req_123"))
+
+			p.Close()
+			p = nil
+
+			leaves, err := driver.Leaves(GinkgoT().Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].ProviderRequestID).To(Equal("req_123"))
+			Expect(leaves[0].RateLimits).To(Equal(map[string]string{"x-ratelimit-remaining-requests": "499"}))
+		})
+
+		It("records session metadata headers without forwarding them", func() {
+			reqBody := makeOllamaRequestBody("test-model", []ollamaTestMessage{
+				{Role: "user", Content: "hello"},
+			}, boolPtr(false))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(string(reqBody)))
+			req.Header.Set(header.SessionHeader, "run-1")
+			req.Header.Set(header.TagHeaderPrefix+"Ticket", "ENG-42")
+			req.Header.Set(header.MetaHeader, `{"user":"ada"}`)
+
+			resp, err := p.server.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			Expect(receivedHeaders.Get(header.SessionHeader)).To(BeEmpty())
+			Expect(receivedHeaders.Get(header.TagHeaderPrefix + "Ticket")).To(BeEmpty())
+			Expect(receivedHeaders.Get(header.MetaHeader)).To(BeEmpty())
+
+			p.Close()
+			p = nil
+
+			leaves, err := driver.Leaves(GinkgoT().Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].Metadata).To(Equal(map[string]string{
+				"session": "run-1",
+				"ticket":  "ENG-42",
+				"user":    "ada",
+			}))
+		})
 	})
 })
 
@@ -927,4 +988,25 @@ var _ = Describe("Storage Provider Metadata", func() {
 		Expect(leaves[0].Usage.CompletionTokens).To(Equal(5))
 		Expect(leaves[0].StopReason).To(Equal("stop"))
 	})
+
+	It("stores generation params on the response node only", func() {
+		resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(sampledRequest)))
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		p.Close()
+		p = nil
+
+		ctx := GinkgoT().Context()
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Params).NotTo(BeNil())
+		Expect(*leaves[0].Params.Temperature).To(Equal(0.7))
+
+		roots, err := driver.Roots(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roots).To(HaveLen(1))
+		Expect(roots[0].Params).To(BeNil())
+	})
 })
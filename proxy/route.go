@@ -0,0 +1,286 @@
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/llm/provider"
+)
+
+// Route is a declarative routing rule evaluated against each parsed chat
+// request. Rules are checked in order and the first match wins.
+type Route struct {
+	// Name identifies the rule in logs.
+	Name string
+
+	// Match selects which requests the rule applies to.
+	Match RouteMatch
+
+	// Model rewrites the requested model. Empty keeps the requested model.
+	Model string
+
+	// ProviderType redirects the request to another provider, translating
+	// the request and response through llm.ChatRequest / llm.ChatResponse.
+	// Empty keeps the provider resolved for the incoming request.
+	ProviderType string
+
+	// UpstreamURL overrides the upstream base URL. For OpenAI-format
+	// upstreams this includes the API version prefix (e.g. ".../v1").
+	// Empty uses the target provider's configured upstream.
+	UpstreamURL string
+
+	// CacheTTL overrides the response cache TTL for matching requests.
+	// Zero uses CacheConfig.TTL.
+	CacheTTL time.Duration
+}
+
+// RouteMatch holds the conditions of a Route. Agent, Model and Project are
+// glob patterns (see path.Match); empty conditions match everything.
+type RouteMatch struct {
+	Agent   string
+	Model   string
+	Project string
+
+	// MinRequestBytes and MaxRequestBytes bound the raw request body size.
+	// Zero disables the bound.
+	MinRequestBytes int
+	MaxRequestBytes int
+}
+
+// RoutesFromConfig converts the persisted [[proxy.routes]] rules into proxy routes.
+func RoutesFromConfig(rules []config.RouteConfig) ([]Route, error) {
+	routes := make([]Route, 0, len(rules))
+	for _, rule := range rules {
+		cacheTTL, err := rule.CacheTTLDuration()
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, Route{
+			Name: rule.Name,
+			Match: RouteMatch{
+				Agent:           rule.Agent,
+				Model:           rule.Model,
+				Project:         rule.Project,
+				MinRequestBytes: rule.MinRequestBytes,
+				MaxRequestBytes: rule.MaxRequestBytes,
+			},
+			Model:        rule.RewriteModel,
+			ProviderType: rule.Provider,
+			UpstreamURL:  rule.Upstream,
+			CacheTTL:     cacheTTL,
+		})
+	}
+	return routes, nil
+}
+
+// validate reports malformed glob patterns up front so that a bad rule fails
+// proxy startup instead of silently never matching.
+func (r *Route) validate() error {
+	for _, pattern := range []string{r.Match.Agent, r.Match.Model, r.Match.Project} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q in route %q: %w", pattern, r.Name, err)
+		}
+	}
+	return nil
+}
+
+// matches reports whether the rule applies to a request.
+func (r *Route) matches(agentName, model, project string, size int) bool {
+	if !globMatch(r.Match.Agent, agentName) ||
+		!globMatch(r.Match.Model, model) ||
+		!globMatch(r.Match.Project, project) {
+		return false
+	}
+	if r.Match.MinRequestBytes > 0 && size < r.Match.MinRequestBytes {
+		return false
+	}
+	if r.Match.MaxRequestBytes > 0 && size > r.Match.MaxRequestBytes {
+		return false
+	}
+	return true
+}
+
+func globMatch(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+// routedRequest is the result of applying a Route to an incoming request:
+// where to send it and in which format.
+type routedRequest struct {
+	route *Route
+
+	// prov is the provider serving the request upstream.
+	prov provider.Provider
+
+	upstreamURL string
+	path        string
+	body        []byte
+
+	// translated is true when prov differs from the client's provider, in
+	// which case the upstream response is re-serialized for the client.
+	translated bool
+
+	// stream is true when the client of a translated request asked for a
+	// streamed response.
+	stream bool
+}
+
+// applyRoutes finds the first rule matching the request and rewrites it.
+// Returns nil when no rule applies or the rule cannot be honored, in which
+// case the request is forwarded unchanged.
+func (p *Proxy) applyRoutes(agentName string, prov provider.Provider, upstreamURL, reqPath string, body []byte, parsedReq *llm.ChatRequest, streaming bool) *routedRequest {
+	var route *Route
+	for i := range p.config.Routes {
+		if p.config.Routes[i].matches(agentName, parsedReq.Model, p.config.Project, len(body)) {
+			route = &p.config.Routes[i]
+			break
+		}
+	}
+	if route == nil {
+		return nil
+	}
+
+	target := prov
+	if route.ProviderType != "" {
+		target = p.providers[route.ProviderType]
+	}
+
+	model := parsedReq.Model
+	if route.Model != "" {
+		model = route.Model
+	}
+
+	// Bedrock requests are SigV4-signed over their path and body, so they
+	// can be pointed at another upstream but never rewritten.
+	if (provider.Format(prov) == providerBedrock || provider.Format(target) == providerBedrock) &&
+		(model != parsedReq.Model || target.Name() != prov.Name()) {
+		p.logger.Debug("skipping route that would rewrite a signed Bedrock request",
+			zap.String("route", route.Name),
+		)
+		return nil
+	}
+
+	routed := &routedRequest{
+		route:       route,
+		prov:        target,
+		upstreamURL: route.UpstreamURL,
+		path:        reqPath,
+	}
+
+	if target.Name() == prov.Name() {
+		if routed.upstreamURL == "" {
+			routed.upstreamURL = upstreamURL
+		}
+		routed.body = body
+		if model != parsedReq.Model {
+			rewritten, err := rewriteModel(body, model)
+			if err != nil {
+				p.logger.Warn("failed to rewrite model, skipping route",
+					zap.String("route", route.Name),
+					zap.Error(err),
+				)
+				return nil
+			}
+			routed.body = rewritten
+		}
+		return routed
+	}
+
+	// Completion requests have no chat-format equivalent to translate to.
+	if parsedReq.Completion {
+		p.logger.Debug("skipping cross-provider route for completion request",
+			zap.String("route", route.Name),
+		)
+		return nil
+	}
+
+	// Cross-provider routes translate through the internal format. The
+	// upstream is always asked for a complete response, which is parsed and
+	// re-serialized for the client, as a replayed stream if it asked for one.
+	translatedReq := *parsedReq
+	translatedReq.Model = model
+	stream := false
+	translatedReq.Stream = &stream
+	translatedBody, err := target.SerializeRequest(&translatedReq)
+	if err != nil {
+		p.logger.Warn("failed to translate request, skipping route",
+			zap.String("route", route.Name),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if routed.upstreamURL == "" {
+		routed.upstreamURL = p.defaultUpstream(target.Name())
+	}
+	routed.path = chatPath(provider.Format(target))
+	routed.body = translatedBody
+	routed.translated = true
+	routed.stream = streaming
+	return routed
+}
+
+// defaultUpstream returns the upstream base URL for a provider that a route
+// redirects to without naming an explicit upstream.
+func (p *Proxy) defaultUpstream(providerName string) string {
+	if providerName == p.config.ProviderType {
+		return p.config.UpstreamURL
+	}
+
+	if cp, ok := p.compat[providerName]; ok {
+		return p.providerUpstream(providerName, cp.UpstreamURL)
+	}
+
+	switch providerName {
+	case providerOpenAI:
+		return p.providerUpstream(providerName, "https://api.openai.com/v1")
+	case providerAnthropic:
+		return p.providerUpstream(providerName, "https://api.anthropic.com")
+	case providerOllama:
+		return p.providerUpstream(providerName, "http://localhost:11434")
+	case providerBedrock:
+		return p.providerUpstream(providerName, bedrockUpstream)
+	}
+
+	return p.config.UpstreamURL
+}
+
+// chatPath returns the chat endpoint path for a provider, relative to the
+// upstream base URL returned by defaultUpstream.
+func chatPath(providerName string) string {
+	switch providerName {
+	case providerOpenAI:
+		return "/chat/completions"
+	case providerOllama:
+		return "/api/chat"
+	default:
+		return "/v1/messages"
+	}
+}
+
+// rewriteModel replaces the "model" field of a raw JSON request body,
+// leaving all other fields untouched.
+func rewriteModel(body []byte, model string) ([]byte, error) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	payload["model"] = encoded
+
+	return json.Marshal(payload)
+}
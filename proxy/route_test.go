@@ -0,0 +1,281 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+// newRoutedTestProxy creates an anthropic-default Proxy with the given routes.
+func newRoutedTestProxy(upstreamURL string, routes []Route) (*Proxy, *inmemory.Driver) {
+	logger, _ := zap.NewDevelopment()
+	driver := inmemory.NewDriver()
+
+	p, err := New(
+		Config{
+			ListenAddr:   ":0",
+			UpstreamURL:  upstreamURL,
+			ProviderType: "anthropic",
+			Project:      "tapes",
+			Routes:       routes,
+		},
+		driver,
+		logger,
+	)
+	Expect(err).NotTo(HaveOccurred())
+	return p, driver
+}
+
+const anthropicHaikuRequest = `{
+	"model": "claude-haiku-4-5",
+	"max_tokens": 512,
+	"system": "Be brief.",
+	"messages": [{"role": "user", "content": "Name a color."}]
+}`
+
+var _ = Describe("Route matching", func() {
+	It("matches glob patterns on agent, model and project", func() {
+		route := Route{Match: RouteMatch{Agent: "claude", Model: "claude-haiku-*", Project: "tap*"}}
+		Expect(route.matches("claude", "claude-haiku-4-5", "tapes", 10)).To(BeTrue())
+		Expect(route.matches("codex", "claude-haiku-4-5", "tapes", 10)).To(BeFalse())
+		Expect(route.matches("claude", "claude-sonnet-4-5", "tapes", 10)).To(BeFalse())
+		Expect(route.matches("claude", "claude-haiku-4-5", "other", 10)).To(BeFalse())
+	})
+
+	It("treats empty conditions as wildcards", func() {
+		route := Route{}
+		Expect(route.matches("", "any-model", "", 0)).To(BeTrue())
+	})
+
+	It("bounds the request size", func() {
+		route := Route{Match: RouteMatch{MinRequestBytes: 10, MaxRequestBytes: 100}}
+		Expect(route.matches("", "m", "", 5)).To(BeFalse())
+		Expect(route.matches("", "m", "", 50)).To(BeTrue())
+		Expect(route.matches("", "m", "", 500)).To(BeFalse())
+	})
+
+	It("rejects malformed patterns when the proxy is created", func() {
+		logger, _ := zap.NewDevelopment()
+		_, err := New(Config{
+			ProviderType: "anthropic",
+			Routes:       []Route{{Name: "bad", Match: RouteMatch{Model: "claude-["}}},
+		}, inmemory.NewDriver(), logger)
+		Expect(err).To(MatchError(ContainSubstring("bad")))
+	})
+
+	It("converts persisted route config", func() {
+		routes, err := RoutesFromConfig([]config.RouteConfig{{
+			Name:         "haiku-local",
+			Model:        "claude-haiku-*",
+			RewriteModel: "qwen3:8b",
+			Provider:     "ollama",
+			Upstream:     "http://localhost:11434",
+			CacheTTL:     "1h",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].Match.Model).To(Equal("claude-haiku-*"))
+		Expect(routes[0].Model).To(Equal("qwen3:8b"))
+		Expect(routes[0].ProviderType).To(Equal("ollama"))
+		Expect(routes[0].UpstreamURL).To(Equal("http://localhost:11434"))
+		Expect(routes[0].CacheTTL).To(Equal(time.Hour))
+	})
+
+	It("rejects malformed cache TTLs in persisted route config", func() {
+		_, err := RoutesFromConfig([]config.RouteConfig{{Name: "bad", CacheTTL: "soon"}})
+		Expect(err).To(MatchError(ContainSubstring("bad")))
+	})
+})
+
+var _ = Describe("Routed requests", func() {
+	var (
+		p        *Proxy
+		driver   *inmemory.Driver
+		upstream *httptest.Server
+		received chan receivedRequest
+	)
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		if upstream != nil {
+			upstream.Close()
+		}
+	})
+
+	Context("when a rule redirects to another provider", func() {
+		BeforeEach(func() {
+			received = make(chan receivedRequest, 1)
+			upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received <- receivedRequest{path: r.URL.Path, body: body}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(makeOllamaResponseBody("qwen3:8b", "assistant", "Blue."))
+			}))
+			p, driver = newRoutedTestProxy("http://unused.invalid", []Route{{
+				Name:         "haiku-local",
+				Match:        RouteMatch{Model: "claude-haiku-*"},
+				Model:        "qwen3:8b",
+				ProviderType: "ollama",
+				UpstreamURL:  upstream.URL,
+			}})
+		})
+
+		It("translates the request into the target provider's format", func() {
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(anthropicHaikuRequest)))
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			var got receivedRequest
+			Eventually(received).Should(Receive(&got))
+			Expect(got.path).To(Equal("/api/chat"))
+
+			var sent map[string]any
+			Expect(json.Unmarshal(got.body, &sent)).To(Succeed())
+			Expect(sent["model"]).To(Equal("qwen3:8b"))
+			Expect(sent["stream"]).To(BeFalse())
+			messages := sent["messages"].([]any)
+			Expect(messages).To(HaveLen(2))
+			Expect(messages[0].(map[string]any)["role"]).To(Equal("system"))
+			Expect(messages[1].(map[string]any)["content"]).To(Equal("Name a color."))
+		})
+
+		It("returns the response in the client's format", func() {
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(anthropicHaikuRequest)))
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			var body map[string]any
+			Expect(json.NewDecoder(resp.Body).Decode(&body)).To(Succeed())
+			Expect(body["type"]).To(Equal("message"))
+			Expect(body["model"]).To(Equal("qwen3:8b"))
+			Expect(body["stop_reason"]).To(Equal("end_turn"))
+			content := body["content"].([]any)
+			Expect(content[0].(map[string]any)["text"]).To(Equal("Blue."))
+		})
+
+		It("records both the requested and served model", func() {
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(anthropicHaikuRequest)))
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			p.Close()
+			p = nil
+
+			leaves, err := driver.Leaves(GinkgoT().Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].Bucket.Model).To(Equal("qwen3:8b"))
+			Expect(leaves[0].Bucket.Provider).To(Equal("ollama"))
+			Expect(leaves[0].RequestedModel).To(Equal("claude-haiku-4-5"))
+
+			ancestry, err := driver.Ancestry(GinkgoT().Context(), leaves[0].Hash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ancestry[1].Bucket.Model).To(Equal("claude-haiku-4-5"))
+			Expect(ancestry[1].Bucket.Provider).To(Equal("anthropic"))
+		})
+
+		It("replays the response as a stream for streaming clients", func() {
+			req := strings.Replace(anthropicHaikuRequest, `"max_tokens": 512,`, `"max_tokens": 512, "stream": true,`, 1)
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(req)))
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+			var got receivedRequest
+			Eventually(received).Should(Receive(&got))
+			var sent map[string]any
+			Expect(json.Unmarshal(got.body, &sent)).To(Succeed())
+			Expect(sent["stream"]).To(BeFalse())
+
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(ContainSubstring("event: message_start"))
+			Expect(string(body)).To(ContainSubstring(`"text":"Blue."`))
+			Expect(string(body)).To(ContainSubstring("event: message_stop"))
+		})
+
+		It("forwards non-matching requests unchanged", func() {
+			defaultUpstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"msg_1","type":"message","role":"assistant","model":"claude-sonnet-4-5","content":[{"type":"text","text":"Red."}],"stop_reason":"end_turn"}`))
+			}))
+			defer defaultUpstream.Close()
+			p.config.UpstreamURL = defaultUpstream.URL
+
+			req := strings.Replace(anthropicHaikuRequest, "claude-haiku-4-5", "claude-sonnet-4-5", 1)
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(req)))
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(ContainSubstring("Red."))
+			Consistently(received).ShouldNot(Receive())
+		})
+	})
+
+	Context("when a rule only rewrites the model", func() {
+		BeforeEach(func() {
+			received = make(chan receivedRequest, 1)
+			upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received <- receivedRequest{path: r.URL.Path, body: body}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"msg_1","type":"message","role":"assistant","model":"claude-sonnet-4-5","content":[{"type":"text","text":"Green."}],"stop_reason":"end_turn"}`))
+			}))
+			p, driver = newRoutedTestProxy(upstream.URL, []Route{{
+				Match: RouteMatch{Model: "claude-haiku-*"},
+				Model: "claude-sonnet-4-5",
+			}})
+		})
+
+		It("rewrites the model in place and keeps the path", func() {
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(anthropicHaikuRequest)))
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			var got receivedRequest
+			Eventually(received).Should(Receive(&got))
+			Expect(got.path).To(Equal("/v1/messages"))
+
+			var sent map[string]any
+			Expect(json.Unmarshal(got.body, &sent)).To(Succeed())
+			Expect(sent["model"]).To(Equal("claude-sonnet-4-5"))
+			Expect(sent["system"]).To(Equal("Be brief."))
+			Expect(sent["max_tokens"]).To(BeEquivalentTo(512))
+		})
+
+		It("records both the requested and served model", func() {
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(anthropicHaikuRequest)))
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			p.Close()
+			p = nil
+
+			leaves, err := driver.Leaves(GinkgoT().Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			Expect(leaves[0].Bucket.Model).To(Equal("claude-sonnet-4-5"))
+			Expect(leaves[0].RequestedModel).To(Equal("claude-haiku-4-5"))
+		})
+	})
+})
+
+type receivedRequest struct {
+	path string
+	body []byte
+}
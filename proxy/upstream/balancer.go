@@ -0,0 +1,191 @@
+// Package upstream provides load balancing and failover across multiple
+// upstream URLs for a single LLM provider.
+//
+// A Balancer picks upstreams by smooth weighted round-robin over the members
+// it currently believes are healthy. Health is tracked passively, from the
+// outcome of proxied requests, and actively, by periodically probing every
+// member.
+package upstream
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultHealthCheckInterval is used when no interval is configured.
+	DefaultHealthCheckInterval = 30 * time.Second
+
+	probeTimeout = 5 * time.Second
+)
+
+// Target is a single upstream URL and its relative weight.
+type Target struct {
+	// URL is the upstream base URL.
+	URL string
+
+	// Weight is the relative share of traffic. Values below 1 are treated as 1.
+	Weight int
+}
+
+type member struct {
+	target  Target
+	current int
+	healthy bool
+}
+
+// Balancer distributes requests across a set of upstream targets.
+type Balancer struct {
+	mu      sync.Mutex
+	members []*member
+	logger  *zap.Logger
+}
+
+// NewBalancer creates a Balancer over the given targets. All targets start
+// healthy.
+func NewBalancer(targets []Target, logger *zap.Logger) *Balancer {
+	members := make([]*member, 0, len(targets))
+	for _, t := range targets {
+		if t.Weight < 1 {
+			t.Weight = 1
+		}
+		members = append(members, &member{target: t, healthy: true})
+	}
+	return &Balancer{members: members, logger: logger}
+}
+
+// Candidates returns upstream URLs in the order they should be tried: the
+// weighted round-robin pick first, then the remaining healthy members, then
+// unhealthy members as a last resort.
+func (b *Balancer) Candidates() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Smooth weighted round-robin (as in nginx): every healthy member gains
+	// its weight, the largest is picked and pays back the total.
+	var picked *member
+	total := 0
+	for _, m := range b.members {
+		if !m.healthy {
+			continue
+		}
+		m.current += m.target.Weight
+		total += m.target.Weight
+		if picked == nil || m.current > picked.current {
+			picked = m
+		}
+	}
+
+	candidates := make([]string, 0, len(b.members))
+	if picked != nil {
+		picked.current -= total
+		candidates = append(candidates, picked.target.URL)
+	}
+	for _, m := range b.members {
+		if m.healthy && m != picked {
+			candidates = append(candidates, m.target.URL)
+		}
+	}
+	for _, m := range b.members {
+		if !m.healthy {
+			candidates = append(candidates, m.target.URL)
+		}
+	}
+	return candidates
+}
+
+// MarkFailed takes an upstream out of rotation until a health check or a
+// successful request marks it healthy again.
+func (b *Balancer) MarkFailed(url string) {
+	b.setHealthy(url, false)
+}
+
+// MarkHealthy returns an upstream to rotation.
+func (b *Balancer) MarkHealthy(url string) {
+	b.setHealthy(url, true)
+}
+
+// Healthy reports whether the given upstream is currently in rotation.
+func (b *Balancer) Healthy(url string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, m := range b.members {
+		if m.target.URL == url {
+			return m.healthy
+		}
+	}
+	return false
+}
+
+func (b *Balancer) setHealthy(url string, healthy bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, m := range b.members {
+		if m.target.URL != url || m.healthy == healthy {
+			continue
+		}
+		m.healthy = healthy
+		m.current = 0
+		if b.logger != nil {
+			b.logger.Info("upstream health changed",
+				zap.String("upstream", url),
+				zap.Bool("healthy", healthy),
+			)
+		}
+	}
+}
+
+// RunHealthChecks probes every member on the given interval until ctx is
+// cancelled. A probe is a GET of the upstream base URL: any response below
+// 500 counts as healthy, while connection errors and 5xx responses do not.
+func (b *Balancer) RunHealthChecks(ctx context.Context, client *http.Client, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultHealthCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.CheckHealth(ctx, client)
+		}
+	}
+}
+
+// CheckHealth probes every member once and updates its health.
+func (b *Balancer) CheckHealth(ctx context.Context, client *http.Client) {
+	b.mu.Lock()
+	urls := make([]string, 0, len(b.members))
+	for _, m := range b.members {
+		urls = append(urls, m.target.URL)
+	}
+	b.mu.Unlock()
+
+	for _, url := range urls {
+		b.setHealthy(url, probe(ctx, client, url))
+	}
+}
+
+func probe(ctx context.Context, client *http.Client, url string) bool {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode < http.StatusInternalServerError
+}
@@ -0,0 +1,77 @@
+package upstream
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Balancer", func() {
+	It("distributes picks by weight", func() {
+		b := NewBalancer([]Target{
+			{URL: "http://a", Weight: 3},
+			{URL: "http://b", Weight: 1},
+		}, nil)
+
+		counts := map[string]int{}
+		for range 8 {
+			counts[b.Candidates()[0]]++
+		}
+		Expect(counts).To(Equal(map[string]int{"http://a": 6, "http://b": 2}))
+	})
+
+	It("interleaves picks rather than bursting", func() {
+		b := NewBalancer([]Target{
+			{URL: "http://a", Weight: 2},
+			{URL: "http://b", Weight: 1},
+		}, nil)
+
+		picks := []string{b.Candidates()[0], b.Candidates()[0], b.Candidates()[0]}
+		Expect(picks).To(Equal([]string{"http://a", "http://b", "http://a"}))
+	})
+
+	It("orders failed upstreams last", func() {
+		b := NewBalancer([]Target{
+			{URL: "http://a", Weight: 1},
+			{URL: "http://b", Weight: 1},
+		}, nil)
+		b.MarkFailed("http://a")
+
+		Expect(b.Candidates()).To(Equal([]string{"http://b", "http://a"}))
+		Expect(b.Candidates()).To(Equal([]string{"http://b", "http://a"}))
+		Expect(b.Healthy("http://a")).To(BeFalse())
+
+		b.MarkHealthy("http://a")
+		Expect(b.Healthy("http://a")).To(BeTrue())
+	})
+
+	It("still returns every upstream when all have failed", func() {
+		b := NewBalancer([]Target{{URL: "http://a"}, {URL: "http://b"}}, nil)
+		b.MarkFailed("http://a")
+		b.MarkFailed("http://b")
+
+		Expect(b.Candidates()).To(ConsistOf("http://a", "http://b"))
+	})
+
+	It("updates health from active probes", func() {
+		up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer up.Close()
+		down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer down.Close()
+
+		b := NewBalancer([]Target{{URL: up.URL}, {URL: down.URL}, {URL: "http://127.0.0.1:1"}}, nil)
+		b.MarkFailed(up.URL)
+
+		b.CheckHealth(GinkgoT().Context(), http.DefaultClient)
+
+		Expect(b.Healthy(up.URL)).To(BeTrue())
+		Expect(b.Healthy(down.URL)).To(BeFalse())
+		Expect(b.Healthy("http://127.0.0.1:1")).To(BeFalse())
+	})
+})
@@ -0,0 +1,142 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/proxy/upstream"
+)
+
+// errBuildRequest is returned by sendUpstream when the upstream request could
+// not be constructed, as opposed to failing in flight.
+var errBuildRequest = errors.New("could not build upstream request")
+
+// UpstreamsFromConfig groups the persisted [[proxy.upstreams]] entries by provider.
+func UpstreamsFromConfig(entries []config.UpstreamConfig) map[string][]upstream.Target {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	upstreams := make(map[string][]upstream.Target)
+	for _, entry := range entries {
+		upstreams[entry.Provider] = append(upstreams[entry.Provider], upstream.Target{
+			URL:    entry.URL,
+			Weight: entry.Weight,
+		})
+	}
+	return upstreams
+}
+
+// newBalancers creates a balancer for every provider with configured upstreams.
+func newBalancers(upstreams map[string][]upstream.Target, logger *zap.Logger) (map[string]*upstream.Balancer, error) {
+	balancers := make(map[string]*upstream.Balancer, len(upstreams))
+	for providerName, targets := range upstreams {
+		if len(targets) == 0 {
+			continue
+		}
+		for _, t := range targets {
+			if t.URL == "" {
+				return nil, fmt.Errorf("upstream for provider %s has no URL", providerName)
+			}
+		}
+		balancers[providerName] = upstream.NewBalancer(targets, logger.With(zap.String("provider", providerName)))
+	}
+	return balancers, nil
+}
+
+// upstreamCandidates returns the upstream base URLs to try, in order, for a
+// request served by the given provider. Pinned upstreams (explicit route
+// upstreams, auth endpoints) and providers without a balancer always resolve
+// to the single resolved URL.
+func (p *Proxy) upstreamCandidates(providerName, resolved string, pinned bool) []string {
+	balancer, ok := p.balancers[providerName]
+	if !ok || pinned {
+		return []string{resolved}
+	}
+	return balancer.Candidates()
+}
+
+// sendUpstream sends the request to each candidate upstream in turn until one
+// answers. Connection errors and 5xx responses fail over to the next candidate;
+// the last candidate's response is returned as-is. Returns the response and the
+// base URL of the upstream that produced it.
+func (p *Proxy) sendUpstream(c *fiber.Ctx, ctx context.Context, method, path string, body []byte, upstreams []string, providerName string) (*http.Response, string, error) {
+	balancer := p.balancers[providerName]
+
+	var lastErr error
+	for i, base := range upstreams {
+		var reqBody io.Reader
+		if len(body) > 0 {
+			reqBody = bytes.NewReader(body)
+		}
+
+		target := base + path
+		if query := c.Request().URI().QueryString(); len(query) > 0 {
+			target += "?" + string(query)
+		}
+
+		httpReq, err := http.NewRequestWithContext(ctx, method, target, reqBody)
+		if err != nil {
+			p.logger.Error("failed to create upstream request", zap.Error(err))
+			return nil, "", fmt.Errorf("%w: %w", errBuildRequest, err)
+		}
+
+		p.headerHandler.SetUpstreamRequestHeaders(c, httpReq)
+		if cp, ok := p.compat[providerName]; ok {
+			cp.prepareRequest(httpReq)
+		}
+
+		p.logger.Debug("forwarding request to upstream",
+			zap.String("method", method),
+			zap.String("url", base+path),
+		)
+
+		last := i == len(upstreams)-1
+		httpResp, err := p.httpClient.Do(httpReq)
+		if err != nil {
+			p.logger.Error("upstream request failed",
+				zap.String("upstream", base),
+				zap.Error(err),
+			)
+			lastErr = err
+			if balancer != nil {
+				balancer.MarkFailed(base)
+			}
+			continue
+		}
+
+		if httpResp.StatusCode >= http.StatusInternalServerError {
+			if balancer != nil {
+				balancer.MarkFailed(base)
+			}
+			// The last candidate's error is returned to the client as is.
+			if last {
+				return httpResp, base, nil
+			}
+			p.logger.Warn("upstream returned server error, failing over",
+				zap.String("upstream", base),
+				zap.Int("status", httpResp.StatusCode),
+			)
+			httpResp.Body.Close()
+			continue
+		}
+
+		if balancer != nil {
+			balancer.MarkHealthy(base)
+		}
+		return httpResp, base, nil
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("no upstream available")
+	}
+	return nil, "", lastErr
+}
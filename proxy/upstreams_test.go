@@ -0,0 +1,155 @@
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/config"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+	"github.com/papercomputeco/tapes/proxy/upstream"
+)
+
+// newBalancedTestProxy creates an ollama Proxy load balancing across urls.
+func newBalancedTestProxy(urls ...string) (*Proxy, *inmemory.Driver) {
+	logger, _ := zap.NewDevelopment()
+	driver := inmemory.NewDriver()
+
+	targets := make([]upstream.Target, 0, len(urls))
+	for _, url := range urls {
+		targets = append(targets, upstream.Target{URL: url, Weight: 1})
+	}
+
+	p, err := New(
+		Config{
+			ListenAddr:   ":0",
+			UpstreamURL:  "http://unused.invalid",
+			ProviderType: "ollama",
+			Upstreams:    map[string][]upstream.Target{"ollama": targets},
+		},
+		driver,
+		logger,
+	)
+	Expect(err).NotTo(HaveOccurred())
+	return p, driver
+}
+
+var _ = Describe("Upstream failover", func() {
+	var (
+		p       *Proxy
+		driver  *inmemory.Driver
+		failing *httptest.Server
+		healthy *httptest.Server
+	)
+
+	BeforeEach(func() {
+		failing = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		healthy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), `"stream":true`) {
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				fmt.Fprintln(w, `{"model":"test-model","message":{"role":"assistant","content":"Hi."},"done":false}`)
+				fmt.Fprintln(w, `{"model":"test-model","message":{"role":"assistant","content":""},"done":true,"done_reason":"stop"}`)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(makeOllamaResponseBody("test-model", "assistant", "Hi."))
+		}))
+		p, driver = newBalancedTestProxy(failing.URL, healthy.URL)
+	})
+
+	AfterEach(func() {
+		if p != nil {
+			p.Close()
+		}
+		failing.Close()
+		healthy.Close()
+	})
+
+	It("fails over on 5xx and records the serving upstream", func() {
+		for range 2 {
+			reqBody := makeOllamaRequestBody("test-model", []ollamaTestMessage{
+				{Role: "user", Content: "Hello"},
+			}, boolPtr(false))
+
+			resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(string(reqBody))))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			resp.Body.Close()
+		}
+
+		Expect(p.balancers["ollama"].Healthy(failing.URL)).To(BeFalse())
+
+		p.Close()
+		p = nil
+
+		leaves, err := driver.Leaves(GinkgoT().Context())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Upstream).To(Equal(healthy.URL))
+	})
+
+	It("fails over before streaming begins", func() {
+		healthyURL := healthy.URL
+		healthy.Close()
+		healthy = httptest.NewServer(healthy.Config.Handler)
+		p.Close()
+		p, driver = newBalancedTestProxy(healthyURL, healthy.URL)
+
+		reqBody := makeOllamaRequestBody("test-model", []ollamaTestMessage{
+			{Role: "user", Content: "Hello"},
+		}, boolPtr(true))
+
+		resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(string(reqBody))), -1)
+		Expect(err).NotTo(HaveOccurred())
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(body)).To(ContainSubstring(`"content":"Hi."`))
+
+		p.Close()
+		p = nil
+
+		leaves, err := driver.Leaves(GinkgoT().Context())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(leaves).To(HaveLen(1))
+		Expect(leaves[0].Upstream).To(Equal(healthy.URL))
+	})
+
+	It("returns the last upstream's error when all fail", func() {
+		p.Close()
+		p, _ = newBalancedTestProxy(failing.URL)
+
+		reqBody := makeOllamaRequestBody("test-model", []ollamaTestMessage{
+			{Role: "user", Content: "Hello"},
+		}, boolPtr(false))
+
+		resp, err := p.server.Test(httptest.NewRequest(http.MethodPost, "/api/chat", strings.NewReader(string(reqBody))))
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(p.balancers["ollama"].Healthy(failing.URL)).To(BeFalse())
+	})
+
+	It("groups persisted upstreams by provider", func() {
+		upstreams := UpstreamsFromConfig([]config.UpstreamConfig{
+			{Provider: "ollama", URL: "http://gpu-1:11434", Weight: 3},
+			{Provider: "ollama", URL: "http://gpu-2:11434"},
+			{Provider: "openai", URL: "https://api.openai.com/v1"},
+		})
+		Expect(upstreams["ollama"]).To(Equal([]upstream.Target{
+			{URL: "http://gpu-1:11434", Weight: 3},
+			{URL: "http://gpu-2:11434"},
+		}))
+		Expect(upstreams["openai"]).To(HaveLen(1))
+	})
+})
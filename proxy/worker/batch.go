@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"github.com/papercomputeco/tapes/pkg/llm"
+)
+
+// splitBatch splits a batched completion job into one job per prompt, so
+// that independent prompts are recorded as separate conversations rather
+// than as sampled alternatives of one request. Choices are assigned to
+// prompts by index, n per prompt, as the OpenAI completions API orders them.
+// It returns nil when the choices cannot be attributed to prompts.
+func splitBatch(job Job) []Job {
+	if !job.Req.Batch || len(job.Req.Messages) != 1 {
+		return []Job{job}
+	}
+
+	prompts := job.Req.Messages[0].Content
+	choices := job.Resp.Choices
+	if len(choices) == 0 || len(choices)%len(prompts) != 0 {
+		return nil
+	}
+	n := len(choices) / len(prompts)
+
+	grouped := make([][]llm.Choice, len(prompts))
+	for _, choice := range choices {
+		i := choice.Index / n
+		if i < 0 || i >= len(prompts) {
+			return nil
+		}
+		choice.Index %= n
+		grouped[i] = append(grouped[i], choice)
+	}
+
+	jobs := make([]Job, 0, len(prompts))
+	for i, prompt := range prompts {
+		if len(grouped[i]) == 0 {
+			continue
+		}
+
+		req := *job.Req
+		req.Batch = false
+		req.Messages = []llm.Message{{Role: job.Req.Messages[0].Role, Content: []llm.ContentBlock{prompt}}}
+
+		resp := *job.Resp
+		resp.Message = grouped[i][0].Message
+		resp.StopReason = grouped[i][0].StopReason
+		resp.Choices = nil
+		if n > 1 {
+			resp.Choices = grouped[i]
+		}
+		// Usage covers the whole batch, so it is recorded on the first
+		// prompt only.
+		if i > 0 {
+			resp.Usage = nil
+		}
+
+		split := job
+		split.Req = &req
+		split.Resp = &resp
+		split.CacheKey = ""
+		jobs = append(jobs, split)
+	}
+	return jobs
+}
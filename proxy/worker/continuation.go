@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// continuationSummaries are phrases with which coding agents open the
+// conversation they restart from after compacting their context. The
+// restarted conversation has a new opening node, so it is linked to the one
+// it continues with a storage.EdgeContinues edge.
+var continuationSummaries = []string{
+	// Claude Code
+	"this session is being continued from a previous conversation",
+	// OpenCode
+	"use the above summary generated from your last session",
+	// Codex
+	"another language model started to solve this problem and produced a summary",
+}
+
+// maxTrackedToolIDs bounds the tool call IDs remembered for linking.
+const maxTrackedToolIDs = 4096
+
+// continuations remembers the most recent turns of each agent so that a
+// conversation restarted after context compaction can be linked to the one
+// it continues. It only sees turns recorded since the pool started.
+type continuations struct {
+	mu sync.Mutex
+
+	// latest maps an agent key to its most recent response hash
+	latest map[string]string
+
+	// bySystem maps an agent key and system prompt to the most recent
+	// response hash
+	bySystem map[string]string
+
+	// toolIDs maps tool call IDs to the response hash of the turn that
+	// last referenced them, evicted in toolOrder order
+	toolIDs   map[string]string
+	toolOrder []string
+}
+
+func newContinuations() *continuations {
+	return &continuations{
+		latest:   make(map[string]string),
+		bySystem: make(map[string]string),
+		toolIDs:  make(map[string]string),
+	}
+}
+
+// observe remembers a stored turn ending at head. When start is the newly
+// stored opening node of the turn's conversation, it returns the edge to the
+// conversation it continues, or nil if none was detected.
+func (c *continuations) observe(job Job, head string, start *merkle.Node) *storage.Edge {
+	agent := job.agentKey()
+	system := agent + "\x00" + systemPrompt(job.Req)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var edge *storage.Edge
+	if start != nil {
+		if to, reason := c.match(job, agent, system); to != "" {
+			edge = &storage.Edge{From: start.Hash, To: to, Kind: storage.EdgeContinues, Reason: reason}
+		}
+	}
+
+	c.latest[agent] = head
+	c.bySystem[system] = head
+	for _, msg := range job.Req.Messages {
+		c.rememberToolIDs(msg, head)
+	}
+	c.rememberToolIDs(job.Resp.Message, head)
+	for _, choice := range job.Resp.Choices {
+		c.rememberToolIDs(choice.Message, head)
+	}
+	return edge
+}
+
+// match returns the response a new conversation continues and the reason,
+// trying the strongest evidence first. A new conversation from the same
+// agent alone is no evidence, since agents also start fresh conversations,
+// e.g. on /clear.
+func (c *continuations) match(job Job, agent, system string) (string, string) {
+	if isContinuationSummary(job.Req.Messages) {
+		if head := c.bySystem[system]; head != "" {
+			return head, storage.ContinuesSummary
+		}
+		if head := c.latest[agent]; head != "" {
+			return head, storage.ContinuesSummary
+		}
+	}
+
+	for _, msg := range job.Req.Messages {
+		for _, id := range toolIDs(msg) {
+			if head := c.toolIDs[id]; head != "" {
+				return head, storage.ContinuesToolID
+			}
+		}
+	}
+	return "", ""
+}
+
+func (c *continuations) rememberToolIDs(msg llm.Message, head string) {
+	for _, id := range toolIDs(msg) {
+		if _, ok := c.toolIDs[id]; !ok {
+			c.toolOrder = append(c.toolOrder, id)
+		}
+		c.toolIDs[id] = head
+	}
+	for len(c.toolOrder) > maxTrackedToolIDs {
+		delete(c.toolIDs, c.toolOrder[0])
+		c.toolOrder = c.toolOrder[1:]
+	}
+}
+
+// toolIDs returns the tool call IDs referenced by msg.
+func toolIDs(msg llm.Message) []string {
+	var ids []string
+	for _, block := range msg.Content {
+		switch {
+		case block.Type == "tool_use" && block.ToolUseID != "":
+			ids = append(ids, block.ToolUseID)
+		case block.Type == "tool_result" && block.ToolResultID != "":
+			ids = append(ids, block.ToolResultID)
+		}
+	}
+	return ids
+}
+
+// isContinuationSummary reports whether a user message opens with an
+// agent's compaction summary.
+func isContinuationSummary(messages []llm.Message) bool {
+	for _, msg := range messages {
+		if msg.Role != "user" {
+			continue
+		}
+		text := strings.ToLower(msg.GetText())
+		for _, phrase := range continuationSummaries {
+			if strings.Contains(text, phrase) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// systemPrompt returns the request's system prompt, whether sent separately
+// or as leading system messages.
+func systemPrompt(req *llm.ChatRequest) string {
+	if req.System != "" {
+		return req.System
+	}
+	var parts []string
+	for _, msg := range req.Messages {
+		if !storage.IsSystemRole(msg.Role) {
+			break
+		}
+		parts = append(parts, msg.GetText())
+	}
+	return strings.Join(parts, "\n")
+}
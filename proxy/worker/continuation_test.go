@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+// turnJob builds a job for a turn whose request holds messages and whose
+// response is reply.
+func turnJob(system string, messages []llm.Message, reply llm.Message) Job {
+	return Job{
+		Provider:  "anthropic",
+		AgentName: "claude",
+		Req:       &llm.ChatRequest{Model: "test-model", System: system, Messages: messages},
+		Resp:      &llm.ChatResponse{Model: "test-model", Message: reply},
+	}
+}
+
+func textMessage(role, text string) llm.Message {
+	return llm.Message{Role: role, Content: []llm.ContentBlock{{Type: "text", Text: text}}}
+}
+
+var _ = Describe("Continuations", func() {
+	var (
+		wp     *Pool
+		driver *inmemory.Driver
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		wp, driver = newTestPool()
+		ctx = context.Background()
+		DeferCleanup(wp.Close)
+	})
+
+	// continuesEdges lists the recorded continues edges.
+	continuesEdges := func() []*storage.Edge {
+		edges, err := driver.ListEdges(ctx, storage.EdgeContinues)
+		Expect(err).NotTo(HaveOccurred())
+		return edges
+	}
+
+	It("links a conversation opening with a compaction summary", func() {
+		wp.processJob(turnJob("You are an agent.",
+			[]llm.Message{textMessage("user", "Refactor the parser")},
+			textMessage("assistant", "Started on the lexer")))
+		wp.processJob(turnJob("You are an agent.",
+			[]llm.Message{textMessage("user", "This session is being continued from a previous conversation that ran out of context.")},
+			textMessage("assistant", "Finishing the parser")))
+
+		edges := continuesEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].Reason).To(Equal(storage.ContinuesSummary))
+
+		earlier, err := driver.Ancestry(ctx, edges[0].To)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(earlier[0].Bucket.ExtractText()).To(Equal("Started on the lexer"))
+
+		root, err := driver.Get(ctx, edges[0].From)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(root.ParentHash).To(BeNil())
+	})
+
+	It("links a conversation sharing tool call IDs", func() {
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "List the files")}, llm.Message{
+			Role:    "assistant",
+			Content: []llm.ContentBlock{{Type: "tool_use", ToolUseID: "toolu_1", ToolName: "ls"}},
+		}))
+		wp.processJob(turnJob("", []llm.Message{
+			textMessage("user", "Summary of earlier work"),
+			{Role: "user", Content: []llm.ContentBlock{{Type: "tool_result", ToolResultID: "toolu_1", ToolOutput: "main.go"}}},
+		}, textMessage("assistant", "Found main.go")))
+
+		edges := continuesEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].Reason).To(Equal(storage.ContinuesToolID))
+	})
+
+	It("links a summary under a system message shared with the earlier conversation", func() {
+		system := textMessage("system", "You are an agent.")
+		wp.processJob(turnJob("", []llm.Message{system, textMessage("user", "Refactor the parser")}, textMessage("assistant", "Started on the lexer")))
+		wp.processJob(turnJob("", []llm.Message{system, textMessage("user", "This session is being continued from a previous conversation that ran out of context.")}, textMessage("assistant", "Finishing the parser")))
+
+		edges := continuesEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].Reason).To(Equal(storage.ContinuesSummary))
+
+		opening, err := driver.Get(ctx, edges[0].From)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opening.Bucket.Role).To(Equal("user"))
+		Expect(opening.ParentHash).NotTo(BeNil())
+	})
+
+	It("does not link a fresh conversation from the same agent process", func() {
+		first := turnJob("You are an agent.", []llm.Message{textMessage("user", "Refactor the parser")}, textMessage("assistant", "Done"))
+		first.AgentPID = 42
+		cleared := turnJob("You are an agent.", []llm.Message{textMessage("user", "Now the tests")}, textMessage("assistant", "On it"))
+		cleared.AgentPID = 42
+
+		wp.processJob(first)
+		wp.processJob(cleared)
+
+		Expect(continuesEdges()).To(BeEmpty())
+	})
+
+	It("links the node named by the X-Tapes-Continues header", func() {
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "Hello")}, textMessage("assistant", "Hi")))
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		job := turnJob("", []llm.Message{textMessage("user", "Picking up again")}, textMessage("assistant", "Welcome back"))
+		job.Continues = leaves[0].Hash[:12]
+		wp.processJob(job)
+
+		edges := continuesEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].To).To(Equal(leaves[0].Hash))
+		Expect(edges[0].Reason).To(Equal(storage.ContinuesHeader))
+	})
+
+	It("honors the X-Tapes-Continues header on a conversation that already exists", func() {
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "Hello")}, textMessage("assistant", "Hi")))
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		earlier := leaves[0].Hash
+
+		ask := textMessage("user", "Picking up again")
+		wp.processJob(turnJob("", []llm.Message{ask}, textMessage("assistant", "Welcome back")))
+		job := turnJob("", []llm.Message{ask, textMessage("assistant", "Welcome back"), textMessage("user", "Go on")}, textMessage("assistant", "Done"))
+		job.Continues = earlier
+		wp.processJob(job)
+
+		edges := continuesEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].To).To(Equal(earlier))
+		opening, err := driver.Get(ctx, edges[0].From)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opening.Bucket.ExtractText()).To(Equal("Picking up again"))
+	})
+
+	It("does not link follow-up turns of the same conversation", func() {
+		ask := textMessage("user", "This session is being continued from a previous conversation.")
+		wp.processJob(turnJob("", []llm.Message{ask}, textMessage("assistant", "Continuing")))
+		wp.processJob(turnJob("", []llm.Message{ask, textMessage("assistant", "Continuing"), textMessage("user", "Go on")}, textMessage("assistant", "Done")))
+
+		Expect(continuesEdges()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// agentKey identifies the agent process that sent a job, as far as it is
+// known.
+func (j Job) agentKey() string {
+	return j.AgentName + "/" + strconv.Itoa(j.AgentPID)
+}
+
+// linkConversation stores an edge when the turn started a new conversation
+// that belongs to an earlier one: a subagent is linked to the tool call that
+// spawned it, and otherwise a restart after context compaction is linked to
+// the conversation it continues. The X-Tapes-Parent and X-Tapes-Continues
+// headers take precedence over detection and are honored on every turn.
+// Requires a driver implementing storage.EdgeStore.
+func (p *Pool) linkConversation(ctx context.Context, job Job, turn storedTurn) {
+	var start *merkle.Node
+	if turn.started {
+		start = turn.opening
+	}
+
+	edge := p.subagents.observe(job, turn.head, start)
+	if turn.opening != nil && job.Parent != "" {
+		if parent := p.headerParent(ctx, job, turn.opening); parent != nil {
+			edge = parent
+		}
+	}
+
+	if edge != nil {
+		// A subagent's conversation is never a continuation.
+		p.continuations.observe(job, turn.head, nil)
+	} else {
+		edge = p.continuations.observe(job, turn.head, start)
+		if turn.opening != nil && job.Continues != "" {
+			if to, ok := p.resolve(ctx, job.Continues); ok {
+				edge = &storage.Edge{From: turn.opening.Hash, To: to, Kind: storage.EdgeContinues, Reason: storage.ContinuesHeader}
+			}
+		}
+	}
+	if edge == nil {
+		return
+	}
+
+	store, ok := p.config.Driver.(storage.EdgeStore)
+	if !ok {
+		p.logger.Debug("storage driver does not store edges",
+			zap.String("hash", edge.From),
+		)
+		return
+	}
+	if err := store.PutEdge(ctx, edge); err != nil {
+		p.logger.Warn("failed to link conversation",
+			zap.String("kind", string(edge.Kind)),
+			zap.String("from", edge.From),
+			zap.Error(err),
+		)
+		return
+	}
+
+	p.logger.Info("linked conversation",
+		zap.String("kind", string(edge.Kind)),
+		zap.String("from", edge.From),
+		zap.String("to", edge.To),
+		zap.String("reason", edge.Reason),
+	)
+}
+
+// headerParent returns the subagent edge from the node named by the
+// X-Tapes-Parent header to opening. The edge names the parent's subagent tool
+// call when it made one, or one whose prompt opens the conversation.
+func (p *Pool) headerParent(ctx context.Context, job Job, opening *merkle.Node) *storage.Edge {
+	hash, ok := p.resolve(ctx, job.Parent)
+	if !ok {
+		return nil
+	}
+	edge := &storage.Edge{From: hash, To: opening.Hash, Kind: storage.EdgeSubagent, Reason: storage.SubagentHeader}
+
+	parent, err := p.config.Driver.Get(ctx, hash)
+	if err != nil {
+		return edge
+	}
+	calls := subagentCalls(llm.Message{Role: parent.Bucket.Role, Content: parent.Bucket.Content})
+	text := openingText(job.Req.Messages)
+	for _, call := range calls {
+		if len(calls) == 1 || (subagentPrompt(call) != "" && strings.Contains(text, subagentPrompt(call))) {
+			edge.ToolUseID = call.ToolUseID
+			break
+		}
+	}
+	return edge
+}
+
+// resolve expands a caller-supplied hash or unique prefix, logging hashes
+// that name no node.
+func (p *Pool) resolve(ctx context.Context, hash string) (string, bool) {
+	resolved, err := storage.ResolveHash(ctx, p.config.Driver, hash)
+	if err != nil {
+		p.logger.Warn("ignoring unknown linked node",
+			zap.String("hash", hash),
+			zap.Error(err),
+		)
+		return "", false
+	}
+	return resolved, true
+}
@@ -8,6 +8,8 @@ package worker
 
 import (
 	"context"
+	"crypto/ed25519"
+	"errors"
 	"fmt"
 	"math"
 	"sync"
@@ -17,6 +19,7 @@ import (
 	"github.com/papercomputeco/tapes/pkg/embeddings"
 	"github.com/papercomputeco/tapes/pkg/llm"
 	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
 	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/vector"
 )
@@ -32,6 +35,57 @@ type Job struct {
 	AgentName string
 	Req       *llm.ChatRequest
 	Resp      *llm.ChatResponse
+
+	// ServedBy is the provider that actually produced Resp when a routing
+	// rule redirected the request. Empty means Provider served it.
+	ServedBy string
+
+	// Upstream is the upstream base URL that produced Resp.
+	Upstream string
+
+	// CacheKey, when set, records the response node as the cached answer
+	// for the request. Requires a driver implementing storage.Cache.
+	CacheKey string
+
+	// ProviderRequestID is the upstream provider's ID for the request.
+	ProviderRequestID string
+
+	// RateLimits are the upstream rate-limit response headers.
+	RateLimits map[string]string
+
+	// Metadata is caller-supplied session metadata (session ID, tags) to
+	// attach to the response node.
+	Metadata map[string]string
+
+	// Continues is the caller-supplied hash (or unique prefix) of the node
+	// a new conversation continues, from the X-Tapes-Continues header.
+	Continues string
+
+	// Parent is the caller-supplied hash (or unique prefix) of the node
+	// that spawned a subagent's new conversation, from the X-Tapes-Parent
+	// header.
+	Parent string
+
+	// AgentPID is the PID of the agent process that sent the request, or 0
+	// when unknown.
+	AgentPID int
+
+	// Embedding, when set, makes this an embedding call record instead of
+	// a conversation turn; Req and Resp are unused. Requires a driver
+	// implementing storage.EmbeddingStore.
+	Embedding *storage.EmbeddingCall
+}
+
+// model returns the requested model for logging.
+func (j Job) model() string {
+	switch {
+	case j.Embedding != nil:
+		return j.Embedding.Model
+	case j.Req != nil:
+		return j.Req.Model
+	default:
+		return ""
+	}
 }
 
 // Config is the configuration options for the worker pool.
@@ -55,6 +109,10 @@ type Config struct {
 	// Project is the git repository or project name to tag on stored nodes.
 	Project string
 
+	// Signer, when set, signs every newly stored response node. Requires a
+	// driver implementing storage.SignatureStore.
+	Signer ed25519.PrivateKey
+
 	// Logger is the provided zap logger
 	Logger *zap.Logger
 }
@@ -65,6 +123,9 @@ type Pool struct {
 	queue  chan Job
 	wg     sync.WaitGroup
 	logger *zap.Logger
+
+	continuations *continuations
+	subagents     *subagents
 }
 
 // NewPool creates a new Storer and starts its worker goroutines.
@@ -85,6 +146,9 @@ func NewPool(c *Config) (*Pool, error) {
 		config: c,
 		queue:  make(chan Job, c.QueueSize),
 		logger: c.Logger,
+
+		continuations: newContinuations(),
+		subagents:     newSubagents(),
 	}
 
 	wp.wg.Add(int(c.NumWorkers))
@@ -102,13 +166,13 @@ func (p *Pool) Enqueue(job Job) bool {
 	case p.queue <- job:
 		p.logger.Debug("job queued",
 			zap.String("provider", job.Provider),
-			zap.String("model", job.Req.Model),
+			zap.String("model", job.model()),
 		)
 		return true
 	default:
 		p.logger.Error("job not queued, queue full, job dropped",
 			zap.String("provider", job.Provider),
-			zap.String("model", job.Req.Model),
+			zap.String("model", job.model()),
 		)
 		return false
 	}
@@ -138,7 +202,33 @@ func (p *Pool) worker(id uint) {
 func (p *Pool) processJob(job Job) {
 	ctx := context.Background()
 
-	head, newNodes, err := p.storeConversationTurn(ctx, job)
+	if job.Embedding != nil {
+		p.storeEmbeddingCall(ctx, job)
+		return
+	}
+
+	if job.Req.Batch {
+		jobs := splitBatch(job)
+		if jobs == nil {
+			p.logger.Warn("cannot attribute batched completions to their prompts, skipping storage",
+				zap.String("provider", job.Provider),
+				zap.Int("prompts", len(job.Req.Messages[0].Content)),
+				zap.Int("choices", len(job.Resp.Choices)),
+			)
+		}
+		for _, j := range jobs {
+			p.storeTurn(ctx, j)
+		}
+		return
+	}
+
+	p.storeTurn(ctx, job)
+}
+
+// storeTurn stores a conversation turn, links it to related conversations
+// and embeds its new nodes.
+func (p *Pool) storeTurn(ctx context.Context, job Job) {
+	turn, err := p.storeConversationTurn(ctx, job)
 	if err != nil {
 		p.logger.Error("async DAG storage failed",
 			zap.String("provider", job.Provider),
@@ -148,24 +238,74 @@ func (p *Pool) processJob(job Job) {
 	}
 
 	p.logger.Info("conversation stored",
-		zap.String("head", head),
+		zap.String("head", turn.head),
 		zap.String("provider", job.Provider),
 	)
 
+	p.linkConversation(ctx, job, turn)
+
 	// If the vector store is configured, process newly inserted nodes
-	if p.config.VectorDriver != nil && p.config.Embedder != nil && len(newNodes) > 0 {
+	if p.config.VectorDriver != nil && p.config.Embedder != nil && len(turn.newNodes) > 0 {
 		p.logger.Debug("storing embeddings for new nodes",
-			zap.Int("new_node_count", len(newNodes)),
+			zap.Int("new_node_count", len(turn.newNodes)),
+		)
+		p.storeEmbeddings(ctx, turn.newNodes)
+	}
+}
+
+// storeEmbeddingCall records an embedding call, tagged with the pool's project.
+func (p *Pool) storeEmbeddingCall(ctx context.Context, job Job) {
+	store, ok := p.config.Driver.(storage.EmbeddingStore)
+	if !ok {
+		p.logger.Debug("storage driver does not record embedding calls",
+			zap.String("provider", job.Provider),
+		)
+		return
+	}
+
+	call := *job.Embedding
+	if call.Project == "" {
+		call.Project = p.config.Project
+	}
+
+	if err := store.PutEmbeddingCall(ctx, &call); err != nil {
+		p.logger.Error("embedding call storage failed",
+			zap.String("provider", job.Provider),
+			zap.Error(err),
 		)
-		p.storeEmbeddings(ctx, newNodes)
+		return
 	}
+
+	p.logger.Info("embedding call stored",
+		zap.String("provider", job.Provider),
+		zap.String("model", call.Model),
+		zap.Int("inputs", call.InputCount),
+	)
+}
+
+// storedTurn is a request-response pair stored in the merkle dag.
+type storedTurn struct {
+	// head is the hash of the response node, the first choice's node for
+	// multi-choice responses.
+	head string
+
+	// opening is the node of the request's first message that is not a
+	// system message, by which linked conversations are known.
+	opening *merkle.Node
+
+	// started reports whether the turn started a new conversation: its
+	// opening node was newly stored and it continued no completion context.
+	started bool
+
+	// newNodes are the nodes that were newly Put.
+	newNodes []*merkle.Node
 }
 
 // storeConversationTurn stores a request-response pair in the merkle dag.
-// Returns the head hash and the slice of nodes that were newly Put.
-func (p *Pool) storeConversationTurn(ctx context.Context, job Job) (string, []*merkle.Node, error) {
-	var parent *merkle.Node
-	var newNodes []*merkle.Node
+func (p *Pool) storeConversationTurn(ctx context.Context, job Job) (storedTurn, error) {
+	parent := p.contextParent(ctx, job.Req)
+	turn := storedTurn{}
+	continued := parent != nil
 
 	// Store each message from the request as nodes.
 	for _, msg := range job.Req.Messages {
@@ -182,7 +322,7 @@ func (p *Pool) storeConversationTurn(ctx context.Context, job Job) (string, []*m
 
 		isNew, err := p.config.Driver.Put(ctx, node)
 		if err != nil {
-			return "", nil, fmt.Errorf("storing message node: %w", err)
+			return storedTurn{}, fmt.Errorf("storing message node: %w", err)
 		}
 
 		p.logger.Debug("stored message in DAG",
@@ -193,46 +333,170 @@ func (p *Pool) storeConversationTurn(ctx context.Context, job Job) (string, []*m
 		)
 
 		if isNew {
-			newNodes = append(newNodes, node)
+			turn.newNodes = append(turn.newNodes, node)
+			p.advanceBranches(ctx, node)
+		}
+		if turn.opening == nil && !storage.IsSystemRole(msg.Role) {
+			turn.opening = node
+			turn.started = isNew && !continued
 		}
 		parent = node
 	}
 
-	responseBucket := merkle.Bucket{
-		Type:      "message",
-		Role:      job.Resp.Message.Role,
-		Content:   job.Resp.Message.Content,
-		Model:     job.Resp.Model,
-		Provider:  job.Provider,
-		AgentName: job.AgentName,
+	servedBy := job.Provider
+	if job.ServedBy != "" {
+		servedBy = job.ServedBy
 	}
 
-	responseNode := merkle.NewNode(
-		responseBucket,
-		parent,
-		merkle.NodeMeta{
-			StopReason: job.Resp.StopReason,
-			Usage:      job.Resp.Usage,
-			Project:    p.config.Project,
-		},
-	)
+	// A response with several sampled choices (n > 1) stores each choice as
+	// a sibling under the same request chain. Usage covers the whole
+	// response, so it is recorded on the first choice only.
+	choices := job.Resp.Choices
+	if len(choices) == 0 {
+		choices = []llm.Choice{{Message: job.Resp.Message, StopReason: job.Resp.StopReason}}
+	}
 
-	isNew, err := p.config.Driver.Put(ctx, responseNode)
-	if err != nil {
-		return "", nil, fmt.Errorf("storing response node: %w", err)
+	// Some APIs (e.g. Bedrock) name the model only in the request.
+	model := job.Resp.Model
+	if model == "" {
+		model = job.Req.Model
 	}
 
-	p.logger.Debug("stored response in DAG",
-		zap.String("hash", responseNode.Hash),
-		zap.String("content_preview", job.Resp.Message.GetText()),
-		zap.Bool("is_new", isNew),
-	)
+	var head *merkle.Node
+	for i, choice := range choices {
+		meta := merkle.NodeMeta{
+			StopReason:        choice.StopReason,
+			Project:           p.config.Project,
+			RequestedModel:    job.Req.Model,
+			Upstream:          job.Upstream,
+			Params:            job.Req.GenerationParams(),
+			ProviderRequestID: job.ProviderRequestID,
+			RateLimits:        job.RateLimits,
+			Metadata:          job.Metadata,
+		}
+		if i == 0 {
+			meta.Usage = job.Resp.Usage
+			meta.ContextKey = llm.ContextKey(job.Resp.Context)
+		}
+		if len(job.Resp.Choices) > 0 {
+			index := choice.Index
+			meta.ChoiceIndex = &index
+		}
+
+		responseNode := merkle.NewNode(
+			merkle.Bucket{
+				Type:      "message",
+				Role:      choice.Message.Role,
+				Content:   choice.Message.Content,
+				Model:     model,
+				Provider:  servedBy,
+				AgentName: job.AgentName,
+			},
+			parent,
+			meta,
+		)
+
+		isNew, err := p.config.Driver.Put(ctx, responseNode)
+		if err != nil {
+			return storedTurn{}, fmt.Errorf("storing response node: %w", err)
+		}
+
+		p.logger.Debug("stored response in DAG",
+			zap.String("hash", responseNode.Hash),
+			zap.String("content_preview", choice.Message.GetText()),
+			zap.Bool("is_new", isNew),
+		)
+
+		if isNew {
+			turn.newNodes = append(turn.newNodes, responseNode)
+			p.advanceBranches(ctx, responseNode)
+			p.sign(ctx, responseNode)
+		}
+		if head == nil {
+			head = responseNode
+		}
+	}
+
+	// The cache replays a single response node, so multi-choice responses
+	// are never recorded as cached answers.
+	if job.CacheKey != "" && len(job.Resp.Choices) == 0 {
+		if cache, ok := p.config.Driver.(storage.Cache); ok {
+			if err := cache.SetCacheKey(ctx, head.Hash, job.CacheKey); err != nil {
+				p.logger.Warn("failed to record cache key",
+					zap.String("hash", head.Hash),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+
+	turn.head = head.Hash
+	return turn, nil
+}
+
+// advanceBranches moves the branches pointing at node's parent to node, so
+// a checked-out branch follows the turns recorded through the proxy.
+func (p *Pool) advanceBranches(ctx context.Context, node *merkle.Node) {
+	refs, ok := p.config.Driver.(storage.RefStore)
+	if !ok || node.ParentHash == nil {
+		return
+	}
+	if err := refs.AdvanceBranches(ctx, *node.ParentHash, node.Hash); err != nil {
+		p.logger.Warn("failed to advance branches",
+			zap.String("hash", node.Hash),
+			zap.Error(err),
+		)
+	}
+}
+
+// sign stores a signature of node when a signer is configured. Response
+// nodes are the leaves of each recorded turn, and a node's hash covers its
+// whole ancestry, so signing them signs the entire conversation.
+func (p *Pool) sign(ctx context.Context, node *merkle.Node) {
+	if p.config.Signer == nil {
+		return
+	}
+	store, ok := p.config.Driver.(storage.SignatureStore)
+	if !ok {
+		p.logger.Debug("storage driver does not store signatures",
+			zap.String("hash", node.Hash),
+		)
+		return
+	}
 
-	if isNew {
-		newNodes = append(newNodes, responseNode)
+	if err := store.PutSignature(ctx, signing.Sign(p.config.Signer, node.Hash)); err != nil {
+		p.logger.Warn("failed to sign node",
+			zap.String("hash", node.Hash),
+			zap.Error(err),
+		)
 	}
+}
 
-	return responseNode.Hash, newNodes, nil
+// contextParent returns the response node a completion request continues
+// from, found by the context it passed back. Returns nil when the request
+// carries no context or the driver cannot look it up.
+func (p *Pool) contextParent(ctx context.Context, req *llm.ChatRequest) *merkle.Node {
+	key := llm.ContextKey(req.Context)
+	if key == "" {
+		return nil
+	}
+	index, ok := p.config.Driver.(storage.ContextIndex)
+	if !ok {
+		return nil
+	}
+
+	node, err := index.NodeByContextKey(ctx, key)
+	if err != nil {
+		var notFound storage.NotFoundError
+		if !errors.As(err, &notFound) {
+			p.logger.Warn("failed to look up completion context",
+				zap.String("context_key", key),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+	return node
 }
 
 // storeEmbeddings generates and stores embeddings for the given nodes.
@@ -8,6 +8,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/signing"
+	"github.com/papercomputeco/tapes/pkg/storage"
 	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
 )
 
@@ -211,4 +214,136 @@ var _ = Describe("Worker Pool", func() {
 			})
 		})
 	})
+
+	Describe("Multi-Choice Response Storage", func() {
+		BeforeEach(func() {
+			wp.Enqueue(Job{
+				Provider: "test-provider",
+				Req: &llm.ChatRequest{
+					Model: "test-model",
+					Messages: []llm.Message{
+						{Role: "user", Content: []llm.ContentBlock{{Type: "text", Text: "Name a color."}}},
+					},
+				},
+				Resp: &llm.ChatResponse{
+					Model:      "test-model",
+					StopReason: "stop",
+					Usage:      &llm.Usage{PromptTokens: 5, CompletionTokens: 4},
+					Message: llm.Message{
+						Role:    "assistant",
+						Content: []llm.ContentBlock{{Type: "text", Text: "Red."}},
+					},
+					Choices: []llm.Choice{
+						{Index: 0, StopReason: "stop", Message: llm.Message{Role: "assistant", Content: []llm.ContentBlock{{Type: "text", Text: "Red."}}}},
+						{Index: 1, StopReason: "length", Message: llm.Message{Role: "assistant", Content: []llm.ContentBlock{{Type: "text", Text: "Blu"}}}},
+					},
+				},
+			})
+			wp.Close()
+		})
+
+		It("stores each choice as a sibling of the request chain", func() {
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(2))
+			Expect(*leaves[0].ParentHash).To(Equal(*leaves[1].ParentHash))
+
+			byIndex := map[int]string{}
+			for _, leaf := range leaves {
+				Expect(leaf.ChoiceIndex).NotTo(BeNil())
+				byIndex[*leaf.ChoiceIndex] = leaf.StopReason
+			}
+			Expect(byIndex).To(Equal(map[int]string{0: "stop", 1: "length"}))
+		})
+
+		It("records usage on the first choice only", func() {
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			for _, leaf := range leaves {
+				if *leaf.ChoiceIndex == 0 {
+					Expect(leaf.Usage).NotTo(BeNil())
+				} else {
+					Expect(leaf.Usage).To(BeNil())
+				}
+			}
+		})
+	})
+
+	Describe("Branches", func() {
+		It("advances branches to each recorded turn", func() {
+			ask := merkle.NewNode(merkle.Bucket{
+				Type: "message", Role: "user", Model: "test-model", Provider: "test-provider",
+				Content: []llm.ContentBlock{{Type: "text", Text: "hello"}},
+			}, nil)
+			_, err := driver.Put(ctx, ask)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(driver.PutRef(ctx, &storage.Ref{Name: "main", Hash: ask.Hash, Kind: storage.RefBranch})).To(Succeed())
+
+			wp.processJob(Job{
+				Provider: "test-provider",
+				Req: &llm.ChatRequest{
+					Model: "test-model",
+					Messages: []llm.Message{
+						{Role: "user", Content: []llm.ContentBlock{{Type: "text", Text: "hello"}}},
+					},
+				},
+				Resp: &llm.ChatResponse{
+					Model: "test-model",
+					Message: llm.Message{
+						Role:    "assistant",
+						Content: []llm.ContentBlock{{Type: "text", Text: "hi"}},
+					},
+				},
+			})
+			wp.Close()
+
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+			main, err := driver.Ref(ctx, "main")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(main.Hash).To(Equal(leaves[0].Hash))
+		})
+	})
+
+	Describe("Signing", func() {
+		It("signs every new response node", func() {
+			logger, _ := zap.NewDevelopment()
+			key, err := signing.GenerateKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			signed, err := NewPool(&Config{Driver: driver, Signer: key, Logger: logger})
+			Expect(err).NotTo(HaveOccurred())
+			signed.Enqueue(Job{
+				Provider: "test-provider",
+				Req: &llm.ChatRequest{
+					Model: "test-model",
+					Messages: []llm.Message{
+						{Role: "user", Content: []llm.ContentBlock{{Type: "text", Text: "hello"}}},
+					},
+				},
+				Resp: &llm.ChatResponse{
+					Model: "test-model",
+					Message: llm.Message{
+						Role:    "assistant",
+						Content: []llm.ContentBlock{{Type: "text", Text: "hi"}},
+					},
+				},
+			})
+			signed.Close()
+			wp.Close()
+
+			leaves, err := driver.Leaves(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaves).To(HaveLen(1))
+
+			hashes, err := driver.SignedHashes(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashes).To(Equal([]string{leaves[0].Hash}))
+
+			attestations, err := signing.Attest(ctx, driver, *leaves[0].ParentHash)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attestations).To(HaveLen(1))
+		})
+	})
 })
@@ -0,0 +1,160 @@
+package worker
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/merkle"
+	"github.com/papercomputeco/tapes/pkg/storage"
+)
+
+// subagentTools are the (case-insensitive) names of tools with which coding
+// agents spawn subagents: Claude Code's Task and Agent tools and OpenCode's
+// task tool. The subagent's conversation opens with the call's prompt input.
+var subagentTools = []string{"task", "agent"}
+
+const (
+	// subagentWindow bounds how long after a tool call its subagent may
+	// start.
+	subagentWindow = 10 * time.Minute
+
+	// maxPendingSubagents bounds the tool calls awaiting their subagent.
+	maxPendingSubagents = 256
+)
+
+// pendingSubagent is a tool call that spawns a subagent whose conversation
+// has not been seen yet.
+type pendingSubagent struct {
+	agent     string
+	head      string
+	toolUseID string
+	prompt    string
+	at        time.Time
+}
+
+// subagents matches the conversations of subagents to the tool calls that
+// spawned them. It only sees turns recorded since the pool started.
+type subagents struct {
+	mu      sync.Mutex
+	pending []pendingSubagent
+	now     func() time.Time
+}
+
+func newSubagents() *subagents {
+	return &subagents{now: time.Now}
+}
+
+// observe remembers the subagent tool calls of a stored turn ending at head.
+// When start is the newly stored opening node of the turn's conversation, it
+// returns the edge from the tool call that spawned it, or nil if none
+// matches.
+func (s *subagents) observe(job Job, head string, start *merkle.Node) *storage.Edge {
+	agent := job.agentKey()
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = dropExpired(s.pending, now)
+
+	var edge *storage.Edge
+	if start != nil {
+		if i := s.match(agent, openingText(job.Req.Messages)); i >= 0 {
+			call := s.pending[i]
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			edge = &storage.Edge{
+				From:      call.head,
+				To:        start.Hash,
+				Kind:      storage.EdgeSubagent,
+				Reason:    storage.SubagentPrompt,
+				ToolUseID: call.toolUseID,
+			}
+		}
+	}
+
+	messages := []llm.Message{job.Resp.Message}
+	for _, choice := range job.Resp.Choices {
+		messages = append(messages, choice.Message)
+	}
+	for _, msg := range messages {
+		for _, call := range subagentCalls(msg) {
+			s.pending = append(s.pending, pendingSubagent{
+				agent:     agent,
+				head:      head,
+				toolUseID: call.ToolUseID,
+				prompt:    subagentPrompt(call),
+				at:        now,
+			})
+		}
+	}
+	if n := len(s.pending) - maxPendingSubagents; n > 0 {
+		s.pending = s.pending[n:]
+	}
+	return edge
+}
+
+// match returns the index of the most recent pending call from agent whose
+// prompt opens text, or -1.
+func (s *subagents) match(agent, text string) int {
+	if text == "" {
+		return -1
+	}
+	for i := len(s.pending) - 1; i >= 0; i-- {
+		call := s.pending[i]
+		if call.agent == agent && call.prompt != "" && strings.Contains(text, call.prompt) {
+			return i
+		}
+	}
+	return -1
+}
+
+// dropExpired drops pending calls older than subagentWindow.
+func dropExpired(pending []pendingSubagent, now time.Time) []pendingSubagent {
+	kept := pending[:0]
+	for _, call := range pending {
+		if now.Sub(call.at) <= subagentWindow {
+			kept = append(kept, call)
+		}
+	}
+	return kept
+}
+
+// subagentCalls returns the tool calls in msg that spawn subagents.
+func subagentCalls(msg llm.Message) []llm.ContentBlock {
+	var calls []llm.ContentBlock
+	for _, block := range msg.Content {
+		if block.Type == "tool_use" && isSubagentTool(block.ToolName) {
+			calls = append(calls, block)
+		}
+	}
+	return calls
+}
+
+func isSubagentTool(name string) bool {
+	for _, tool := range subagentTools {
+		if strings.EqualFold(name, tool) {
+			return true
+		}
+	}
+	return false
+}
+
+// subagentPrompt returns the prompt a subagent tool call hands its subagent.
+func subagentPrompt(call llm.ContentBlock) string {
+	prompt, _ := call.ToolInput["prompt"].(string)
+	return strings.TrimSpace(prompt)
+}
+
+// openingText returns the text of the user messages a conversation opens
+// with.
+func openingText(messages []llm.Message) string {
+	var parts []string
+	for _, msg := range messages {
+		if msg.Role == "user" {
+			parts = append(parts, msg.GetText())
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/papercomputeco/tapes/pkg/llm"
+	"github.com/papercomputeco/tapes/pkg/storage"
+	"github.com/papercomputeco/tapes/pkg/storage/inmemory"
+)
+
+// taskCall is a response spawning a subagent with prompt.
+func taskCall(id, prompt string) llm.Message {
+	return llm.Message{
+		Role: "assistant",
+		Content: []llm.ContentBlock{{
+			Type:      "tool_use",
+			ToolUseID: id,
+			ToolName:  "Task",
+			ToolInput: map[string]any{"description": "Explore", "prompt": prompt},
+		}},
+	}
+}
+
+var _ = Describe("Subagents", func() {
+	var (
+		wp     *Pool
+		driver *inmemory.Driver
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		wp, driver = newTestPool()
+		ctx = context.Background()
+		DeferCleanup(wp.Close)
+	})
+
+	subagentEdges := func() []*storage.Edge {
+		edges, err := driver.ListEdges(ctx, storage.EdgeSubagent)
+		Expect(err).NotTo(HaveOccurred())
+		return edges
+	}
+
+	It("links a subagent's conversation to the Task call that spawned it", func() {
+		wp.processJob(turnJob("You are an agent.",
+			[]llm.Message{textMessage("user", "Fix the flaky test")},
+			taskCall("toolu_task", "Find where the test fixtures are created")))
+		wp.processJob(turnJob("You are a search agent.",
+			[]llm.Message{textMessage("user", "Find where the test fixtures are created")},
+			textMessage("assistant", "In testdata/setup.go")))
+
+		edges := subagentEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].ToolUseID).To(Equal("toolu_task"))
+		Expect(edges[0].Reason).To(Equal(storage.SubagentPrompt))
+
+		parent, err := driver.Get(ctx, edges[0].From)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parent.Bucket.Content[0].ToolName).To(Equal("Task"))
+		root, err := driver.Get(ctx, edges[0].To)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(root.ParentHash).To(BeNil())
+
+		continues, err := driver.ListEdges(ctx, storage.EdgeContinues)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(continues).To(BeEmpty())
+	})
+
+	It("links a subagent whose system message is shared with its siblings", func() {
+		system := textMessage("system", "You are a search agent.")
+		wp.processJob(turnJob("", []llm.Message{system, textMessage("user", "Look for the config loader")}, textMessage("assistant", "In config.go")))
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "Fix the flaky test")}, taskCall("toolu_task", "Find where the test fixtures are created")))
+		wp.processJob(turnJob("", []llm.Message{system, textMessage("user", "Find where the test fixtures are created")}, textMessage("assistant", "In testdata/setup.go")))
+
+		edges := subagentEdges()
+		Expect(edges).To(HaveLen(1))
+		opening, err := driver.Get(ctx, edges[0].To)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opening.Bucket.ExtractText()).To(Equal("Find where the test fixtures are created"))
+	})
+
+	It("ignores subagents that start after the window or from other agents", func() {
+		start := time.Now()
+		wp.subagents.now = func() time.Time { return start }
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "Fix it")}, taskCall("toolu_1", "Look around")))
+
+		other := turnJob("", []llm.Message{textMessage("user", "Look around")}, textMessage("assistant", "Nothing here"))
+		other.AgentName = "codex"
+		wp.processJob(other)
+
+		wp.subagents.now = func() time.Time { return start.Add(subagentWindow + time.Minute) }
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "Look around")}, textMessage("assistant", "Found it")))
+
+		Expect(subagentEdges()).To(BeEmpty())
+	})
+
+	It("links the parent named by the X-Tapes-Parent header", func() {
+		wp.processJob(turnJob("", []llm.Message{textMessage("user", "Fix it")}, taskCall("toolu_1", "Look around")))
+		leaves, err := driver.Leaves(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		job := turnJob("", []llm.Message{textMessage("user", "Explore the repository")}, textMessage("assistant", "Done"))
+		job.Parent = leaves[0].Hash[:12]
+		wp.processJob(job)
+
+		edges := subagentEdges()
+		Expect(edges).To(HaveLen(1))
+		Expect(edges[0].From).To(Equal(leaves[0].Hash))
+		Expect(edges[0].ToolUseID).To(Equal("toolu_1"))
+		Expect(edges[0].Reason).To(Equal(storage.SubagentHeader))
+	})
+})
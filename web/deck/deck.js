@@ -25,6 +25,7 @@ const analyticsDurationEl = document.getElementById("analytics-duration");
 const analyticsCostEl = document.getElementById("analytics-cost");
 const analyticsModelsEl = document.getElementById("analytics-models");
 const analyticsProvidersEl = document.getElementById("analytics-providers");
+const analyticsHeadroomEl = document.getElementById("analytics-headroom");
 const analyticsSubtitleEl = document.getElementById("analytics-subtitle");
 const analyticsPeriodEl = document.getElementById("analytics-period");
 const analyticsInsightsEl = document.getElementById("analytics-insights");
@@ -730,6 +731,15 @@ const renderConversation = (detail) => {
       { label: "tokens", value: `In ${formatTokens(msg.input_tokens)}  Out ${formatTokens(msg.output_tokens)}  Total ${formatTokens(msg.total_tokens)}` },
       { label: "cost", value: `In ${formatCost(msg.input_cost)}  Out ${formatCost(msg.output_cost)}  Total ${formatCost(msg.total_cost)}` },
     ];
+    if (msg.rating) {
+      metaItems.push({ label: "rating", value: msg.rating });
+    }
+    if (msg.labels && msg.labels.length) {
+      metaItems.push({ label: "labels", value: msg.labels.join(", ") });
+    }
+    if (msg.subagents && msg.subagents.length) {
+      metaItems.push({ label: "subagents", value: `${msg.subagents.length}  Cost ${formatCost(msg.subagent_cost || 0)}` });
+    }
     metaItems.forEach((item) => {
       const block = document.createElement("div");
       block.textContent = item.label;
@@ -817,6 +827,10 @@ const renderAnalyticsSummary = (data) => {
     { label: "avg duration", value: formatDuration(data.avg_duration_ns) },
     { label: "models tracked", value: data.model_performance ? data.model_performance.length : 0 },
   ];
+  const cacheTotal = (data.cache_hits || 0) + (data.cache_misses || 0);
+  if (cacheTotal > 0) {
+    items.push({ label: "cache hit rate", value: formatPercent(data.cache_hit_rate) });
+  }
   items.forEach((item) => {
     const card = document.createElement("div");
     card.className = "metric";
@@ -1082,6 +1096,36 @@ const renderHistogram = (el, buckets) => {
   });
 };
 
+const renderHeadroom = (data) => {
+  analyticsHeadroomEl.innerHTML = "";
+  const points = data.rate_limit_headroom || [];
+  if (points.length === 0) {
+    analyticsHeadroomEl.textContent = "no rate limit data";
+    return;
+  }
+  points.forEach((point) => {
+    const row = document.createElement("div");
+    row.className = "histogram__row";
+    const label = document.createElement("div");
+    label.className = "histogram__label";
+    label.textContent = `${point.date} ${point.provider} ${point.metric}`;
+    const barWrap = document.createElement("div");
+    barWrap.className = "histogram__bar-wrap";
+    const bar = document.createElement("div");
+    bar.className = "histogram__bar";
+    bar.style.width = `${Math.max(Math.round(point.headroom * 100), 2)}%`;
+    barWrap.appendChild(bar);
+    const count = document.createElement("div");
+    count.className = "histogram__count";
+    count.textContent = formatPercent(point.headroom);
+    count.title = `${point.remaining} of ${point.limit} remaining`;
+    row.appendChild(label);
+    row.appendChild(barWrap);
+    row.appendChild(count);
+    analyticsHeadroomEl.appendChild(row);
+  });
+};
+
 const renderModelComparison = (data) => {
   analyticsModelsEl.innerHTML = "";
   const models = data.model_performance || [];
@@ -1594,6 +1638,7 @@ const loadAnalytics = async () => {
   renderHistogram(analyticsCostEl, data.cost_buckets);
   renderModelComparison(data);
   renderProviderSplit(data);
+  renderHeadroom(data);
   renderAnalyticsPeriodControls();
 
   // Load AI insights via facets
@@ -1787,6 +1832,21 @@ const moveSession = (delta) => {
   }
 };
 
+const ratingKeys = { "+": "good", "-": "bad", "!": "failure", "0": "" };
+
+const rateSelectedMessage = async (rating) => {
+  if (!sessionDetailState || !selectedSessionId) return;
+  const msg = sessionDetailState.messages[selectedMessageIndex];
+  if (!msg) return;
+  const res = await fetch(`/api/message/${encodeURIComponent(msg.hash)}/rating`, {
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ rating }),
+  });
+  if (!res.ok) return;
+  await loadSession(selectedSessionId, true);
+};
+
 const moveMessage = (delta) => {
   if (!detailEl || !detailEl.querySelectorAll) return;
   const rows = detailEl.querySelectorAll(".conversation__row:not(.conversation__row--header)");
@@ -1866,6 +1926,14 @@ const handleKey = (event) => {
         }
       }
       break;
+    case "+":
+    case "-":
+    case "!":
+    case "0":
+      if (currentView === "session") {
+        rateSelectedMessage(ratingKeys[event.key]).catch(console.error);
+      }
+      break;
     case "a":
       if (currentView === "overview") {
         filters.periodEnabled = true;